@@ -42,5 +42,5 @@ func accessContextManagerOperationWaitTime(config *Config, op map[string]interfa
 	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
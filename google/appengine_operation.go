@@ -28,18 +28,28 @@ func (w *AppEngineOperationWaiter) QueryOp() (interface{}, error) {
 	return w.Service.Apps.Operations.Get(w.AppId, matches[1]).Do()
 }
 
-func appEngineOperationWait(client *appengine.APIService, op *appengine.Operation, appId, activity string) error {
-	return appEngineOperationWaitTime(client, op, appId, activity, 4)
+func appEngineOperationWait(config *Config, op *appengine.Operation, appId, activity string) error {
+	return appEngineOperationWaitTime(config, op, appId, activity, 4)
 }
 
-func appEngineOperationWaitTime(client *appengine.APIService, op *appengine.Operation, appId, activity string, timeoutMinutes int) error {
+func appEngineOperationWaitTime(config *Config, op *appengine.Operation, appId, activity string, timeoutMinutes int) error {
 	w := &AppEngineOperationWaiter{
-		Service: client,
+		Service: config.clientAppEngine,
 		AppId:   appId,
 	}
 
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
+}
+
+// appEngineOperationWaitTimeFromResponse waits on an operation returned by a
+// request sent with sendRequest rather than through the client library.
+func appEngineOperationWaitTimeFromResponse(config *Config, res map[string]interface{}, appId, activity string, timeoutMinutes int) error {
+	op := &appengine.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	return appEngineOperationWaitTime(config, op, appId, activity, timeoutMinutes)
 }
@@ -0,0 +1,134 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+)
+
+// The default time to wait for other requests to join a batch before it's
+// sent. It's short enough not to slow down a single request noticeably, but
+// long enough that resources created in parallel end up in the same batch.
+const defaultBatchSendAfter = 3 * time.Second
+
+// RequestBatcher combines requests with the same batch key that are made
+// within a short time of each other into a single API call. It's used where
+// many resources in a config call the same API method on the same parent,
+// such as enabling services on a project, which would otherwise race each
+// other and use up quota.
+type RequestBatcher struct {
+	debugId   string
+	sendAfter time.Duration
+
+	mu      sync.Mutex
+	batches map[string]*startedBatch
+}
+
+// BatchRequest is a single request to be combined into a batch.
+type BatchRequest struct {
+	// Body is the request body. Bodies of requests in the same batch are
+	// merged with CombineF.
+	Body interface{}
+
+	// CombineF merges the body of a new request into the body of the batch.
+	CombineF batcherCombineFunc
+
+	// SendF sends the combined body of the batch.
+	SendF batcherSendFunc
+
+	// DebugId describes the request in logs.
+	DebugId string
+}
+
+type batcherCombineFunc func(body interface{}, toAdd interface{}) (interface{}, error)
+
+type batcherSendFunc func(body interface{}) (interface{}, error)
+
+type startedBatch struct {
+	body        interface{}
+	sendF       batcherSendFunc
+	subscribers []chan batchResponse
+	timer       *time.Timer
+}
+
+type batchResponse struct {
+	body interface{}
+	err  error
+}
+
+func NewRequestBatcher(debugId string, sendAfter time.Duration) *RequestBatcher {
+	return &RequestBatcher{
+		debugId:   debugId,
+		sendAfter: sendAfter,
+		batches:   make(map[string]*startedBatch),
+	}
+}
+
+// SendRequestWithTimeout adds the request to the batch for batchKey, starting
+// a new batch if there isn't one, and waits for the batch to be sent. Every
+// request in the batch gets the response and error of the combined call.
+func (b *RequestBatcher) SendRequestWithTimeout(batchKey string, req *BatchRequest, timeout time.Duration) (interface{}, error) {
+	if req == nil || req.CombineF == nil || req.SendF == nil {
+		return nil, fmt.Errorf("Invalid request to batcher %q: body, combine and send functions are all required", b.debugId)
+	}
+
+	respCh, err := b.registerRequest(batchKey, req)
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case resp := <-respCh:
+		return resp.body, resp.err
+	case <-time.After(timeout):
+		return nil, fmt.Errorf("Request %q timed out after %s waiting for batch %q", req.DebugId, timeout, batchKey)
+	}
+}
+
+func (b *RequestBatcher) registerRequest(batchKey string, req *BatchRequest) (<-chan batchResponse, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// The channel is buffered so that sending a batch never blocks on a
+	// subscriber that has already timed out.
+	respCh := make(chan batchResponse, 1)
+
+	if batch, ok := b.batches[batchKey]; ok {
+		body, err := req.CombineF(batch.body, req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error adding request %q to batch %q: %s", req.DebugId, batchKey, err)
+		}
+		log.Printf("[DEBUG] Adding request %q to batch %q in batcher %q", req.DebugId, batchKey, b.debugId)
+		batch.body = body
+		batch.subscribers = append(batch.subscribers, respCh)
+		return respCh, nil
+	}
+
+	log.Printf("[DEBUG] Starting batch %q with request %q in batcher %q", batchKey, req.DebugId, b.debugId)
+	batch := &startedBatch{
+		body:        req.Body,
+		sendF:       req.SendF,
+		subscribers: []chan batchResponse{respCh},
+	}
+	b.batches[batchKey] = batch
+	batch.timer = time.AfterFunc(b.sendAfter, func() {
+		b.sendBatch(batchKey, batch)
+	})
+	return respCh, nil
+}
+
+func (b *RequestBatcher) sendBatch(batchKey string, batch *startedBatch) {
+	// Stop new requests from joining the batch once it's being sent.
+	b.mu.Lock()
+	if b.batches[batchKey] == batch {
+		delete(b.batches, batchKey)
+	}
+	b.mu.Unlock()
+
+	log.Printf("[DEBUG] Sending batch %q of %d request(s) in batcher %q", batchKey, len(batch.subscribers), b.debugId)
+	body, err := batch.sendF(batch.body)
+	for _, ch := range batch.subscribers {
+		ch <- batchResponse{body: body, err: err}
+	}
+}
@@ -0,0 +1,133 @@
+package google
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRequestBatcher_combinesRequests(t *testing.T) {
+	t.Parallel()
+
+	b := NewRequestBatcher("test", 50*time.Millisecond)
+
+	var mu sync.Mutex
+	var sent [][]string
+	sendF := func(body interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, body.([]string))
+		return len(sent), nil
+	}
+
+	services := []string{"a.googleapis.com", "b.googleapis.com", "c.googleapis.com", "a.googleapis.com"}
+	var wg sync.WaitGroup
+	for _, s := range services {
+		wg.Add(1)
+		go func(s string) {
+			defer wg.Done()
+			resp, err := b.SendRequestWithTimeout("project/test/services:batchEnable", &BatchRequest{
+				Body:     []string{s},
+				CombineF: combineServiceUsageServicesBatches,
+				SendF:    sendF,
+				DebugId:  s,
+			}, time.Second)
+			if err != nil {
+				t.Errorf("unexpected error for %q: %s", s, err)
+			}
+			if resp != 1 {
+				t.Errorf("expected response of the first batch for %q, got %v", s, resp)
+			}
+		}(s)
+	}
+	wg.Wait()
+
+	if len(sent) != 1 {
+		t.Fatalf("expected requests to be sent in one batch, got %d: %v", len(sent), sent)
+	}
+	got := sent[0]
+	sort.Strings(got)
+	expected := []string{"a.googleapis.com", "b.googleapis.com", "c.googleapis.com"}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Errorf("expected batch body %v, got %v", expected, got)
+	}
+}
+
+func TestRequestBatcher_separatesBatchKeys(t *testing.T) {
+	t.Parallel()
+
+	b := NewRequestBatcher("test", 50*time.Millisecond)
+
+	var mu sync.Mutex
+	count := 0
+	sendF := func(body interface{}) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		count++
+		return nil, nil
+	}
+
+	var wg sync.WaitGroup
+	for _, key := range []string{"project/one", "project/two"} {
+		wg.Add(1)
+		go func(key string) {
+			defer wg.Done()
+			if _, err := b.SendRequestWithTimeout(key, &BatchRequest{
+				Body:     []string{"a.googleapis.com"},
+				CombineF: combineServiceUsageServicesBatches,
+				SendF:    sendF,
+			}, time.Second); err != nil {
+				t.Errorf("unexpected error for %q: %s", key, err)
+			}
+		}(key)
+	}
+	wg.Wait()
+
+	if count != 2 {
+		t.Errorf("expected one batch per key, got %d", count)
+	}
+}
+
+func TestRequestBatcher_returnsErrorToAllRequests(t *testing.T) {
+	t.Parallel()
+
+	b := NewRequestBatcher("test", 50*time.Millisecond)
+	sendF := func(body interface{}) (interface{}, error) {
+		return nil, fmt.Errorf("quota exceeded")
+	}
+
+	var wg sync.WaitGroup
+	for _, s := range []string{"a.googleapis.com", "b.googleapis.com"} {
+		wg.Add(1)
+		go func(s string) {
+			defer wg.Done()
+			_, err := b.SendRequestWithTimeout("project/test", &BatchRequest{
+				Body:     []string{s},
+				CombineF: combineServiceUsageServicesBatches,
+				SendF:    sendF,
+			}, time.Second)
+			if err == nil || err.Error() != "quota exceeded" {
+				t.Errorf("expected the batch error for %q, got %v", s, err)
+			}
+		}(s)
+	}
+	wg.Wait()
+}
+
+func TestRequestBatcher_timeout(t *testing.T) {
+	t.Parallel()
+
+	b := NewRequestBatcher("test", time.Second)
+	_, err := b.SendRequestWithTimeout("project/test", &BatchRequest{
+		Body:     []string{"a.googleapis.com"},
+		CombineF: combineServiceUsageServicesBatches,
+		SendF: func(body interface{}) (interface{}, error) {
+			return nil, nil
+		},
+	}, 10*time.Millisecond)
+	if err == nil {
+		t.Errorf("expected the request to time out before the batch was sent")
+	}
+}
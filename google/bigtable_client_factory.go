@@ -9,14 +9,26 @@ import (
 )
 
 type BigtableClientFactory struct {
-	UserAgent   string
-	TokenSource oauth2.TokenSource
+	UserAgent      string
+	TokenSource    oauth2.TokenSource
+	UniverseDomain string
 }
 
 func (s BigtableClientFactory) NewInstanceAdminClient(project string) (*bigtable.InstanceAdminClient, error) {
-	return bigtable.NewInstanceAdminClient(context.Background(), project, option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent))
+	return bigtable.NewInstanceAdminClient(context.Background(), project, s.clientOptions()...)
 }
 
 func (s BigtableClientFactory) NewAdminClient(project, instance string) (*bigtable.AdminClient, error) {
-	return bigtable.NewAdminClient(context.Background(), project, instance, option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent))
+	return bigtable.NewAdminClient(context.Background(), project, instance, s.clientOptions()...)
+}
+
+func (s BigtableClientFactory) clientOptions() []option.ClientOption {
+	opts := []option.ClientOption{option.WithTokenSource(s.TokenSource), option.WithUserAgent(s.UserAgent)}
+
+	// The Bigtable clients use gRPC rather than HTTP, so requests for another
+	// universe don't go through the universe domain transport.
+	if s.UniverseDomain != "" && s.UniverseDomain != defaultUniverseDomain {
+		opts = append(opts, option.WithEndpoint(universeDomainHost("bigtableadmin.googleapis.com:443", "", s.UniverseDomain)))
+	}
+	return opts
 }
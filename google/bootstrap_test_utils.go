@@ -0,0 +1,300 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/cloudresourcemanager/v1"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/iam/v1"
+)
+
+// The helpers in this file get or create infrastructure that is shared across
+// acceptance tests, such as KMS keys that can't be deleted. They live outside of
+// _test.go files so that they can also be used by the tests of resources built on
+// top of this provider.
+
+/**
+* bootstrapConfig returns a Config for the test project described by the test
+* environment variables, or nil if acceptance tests aren't running.
+**/
+func bootstrapConfig(t *testing.T) *Config {
+	if v := os.Getenv("TF_ACC"); v == "" {
+		log.Println("Acceptance tests and bootstrapping skipped unless env 'TF_ACC' set")
+		return nil
+	}
+
+	config := &Config{
+		Credentials: getTestCredsFromEnv(),
+		Project:     getTestProjectFromEnv(),
+		Region:      getTestRegionFromEnv(),
+		Zone:        getTestZoneFromEnv(),
+	}
+
+	if err := config.LoadAndValidate(); err != nil {
+		t.Fatalf("Unable to bootstrap test infrastructure: %s", err)
+	}
+
+	return config
+}
+
+var SharedKeyRing = "tftest-shared-keyring-1"
+var SharedCyptoKey = "tftest-shared-key-1"
+
+type bootstrappedKMS struct {
+	*cloudkms.KeyRing
+	*cloudkms.CryptoKey
+}
+
+/**
+* BootstrapKMSkey will return a KMS key that can be used in tests that are
+* testing KMS integration with other resources.
+*
+* This will either return an existing key or create one if it hasn't been created
+* in the project yet. The motivation is because keyrings don't get deleted and we
+* don't want a linear growth of disabled keyrings in a project. We also don't want
+* to incur the overhead of creating a new project for each test that needs to use
+* a KMS key.
+**/
+func BootstrapKMSKey(t *testing.T) bootstrappedKMS {
+	config := bootstrapConfig(t)
+	if config == nil {
+		// If not running acceptance tests, return an empty object
+		return bootstrappedKMS{
+			&cloudkms.KeyRing{},
+			&cloudkms.CryptoKey{},
+		}
+	}
+
+	projectID := getTestProjectFromEnv()
+	locationID := "global"
+	keyRingParent := fmt.Sprintf("projects/%s/locations/%s", projectID, locationID)
+	keyRingName := fmt.Sprintf("%s/keyRings/%s", keyRingParent, SharedKeyRing)
+	keyParent := fmt.Sprintf("projects/%s/locations/%s/keyRings/%s", projectID, locationID, SharedKeyRing)
+	keyName := fmt.Sprintf("%s/cryptoKeys/%s", keyParent, SharedCyptoKey)
+
+	// Get or Create the hard coded shared keyring for testing
+	kmsClient := config.clientKms
+	keyRing, err := kmsClient.Projects.Locations.KeyRings.Get(keyRingName).Do()
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			keyRing, err = kmsClient.Projects.Locations.KeyRings.Create(keyRingParent, &cloudkms.KeyRing{}).
+				KeyRingId(SharedKeyRing).Do()
+			if err != nil {
+				t.Errorf("Unable to bootstrap KMS key. Cannot create keyRing: %s", err)
+			}
+		} else {
+			t.Errorf("Unable to bootstrap KMS key. Cannot retrieve keyRing: %s", err)
+		}
+	}
+
+	if keyRing == nil {
+		t.Fatalf("Unable to bootstrap KMS key. keyRing is nil!")
+	}
+
+	// Get or Create the hard coded, shared crypto key for testing
+	cryptoKey, err := kmsClient.Projects.Locations.KeyRings.CryptoKeys.Get(keyName).Do()
+	if err != nil {
+		if isGoogleApiErrorWithCode(err, 404) {
+			newKey := cloudkms.CryptoKey{
+				Purpose: "ENCRYPT_DECRYPT",
+			}
+
+			cryptoKey, err = kmsClient.Projects.Locations.KeyRings.CryptoKeys.Create(keyParent, &newKey).
+				CryptoKeyId(SharedCyptoKey).Do()
+			if err != nil {
+				t.Errorf("Unable to bootstrap KMS key. Cannot create new CryptoKey: %s", err)
+			}
+
+		} else {
+			t.Errorf("Unable to bootstrap KMS key. Cannot call CryptoKey service: %s", err)
+		}
+	}
+
+	if cryptoKey == nil {
+		t.Fatalf("Unable to bootstrap KMS key. CryptoKey is nil!")
+	}
+
+	return bootstrappedKMS{
+		keyRing,
+		cryptoKey,
+	}
+}
+
+var SharedServiceConnectionPolicyNetwork = "tftest-shared-psc-network-1"
+
+/**
+* BootstrapSharedServiceConnectionPolicy will return the name of a network that
+* has a service connection policy for the given service class in us-central1.
+*
+* Memorystore and Redis Cluster instances connect to the consumer network
+* through Private Service Connect, which requires such a policy to exist before
+* the instance is created. The network and the policy are shared across tests
+* and created on first use.
+**/
+func BootstrapSharedServiceConnectionPolicy(t *testing.T, serviceClass string) string {
+	config := bootstrapConfig(t)
+	if config == nil {
+		return ""
+	}
+
+	projectID := getTestProjectFromEnv()
+	region := "us-central1"
+	network := bootstrapNetwork(t, config, SharedServiceConnectionPolicyNetwork)
+
+	// Get or Create the service connection policy for the service class
+	policyId := fmt.Sprintf("tftest-%s", serviceClass)
+	policyParent := fmt.Sprintf("https://networkconnectivity.googleapis.com/v1/projects/%s/locations/%s/serviceConnectionPolicies", projectID, region)
+	policyUrl := fmt.Sprintf("%s/%s", policyParent, policyId)
+
+	if _, err := sendRequest(config, "GET", policyUrl, nil); err != nil {
+		if !isGoogleApiErrorWithCode(err, 404) {
+			t.Fatalf("Unable to bootstrap service connection policy. Cannot retrieve policy: %s", err)
+		}
+
+		obj := map[string]interface{}{
+			"network":      fmt.Sprintf("projects/%s/global/networks/%s", projectID, network.Name),
+			"serviceClass": serviceClass,
+			"pscConfig": map[string]interface{}{
+				"subnetworks": []string{fmt.Sprintf("projects/%s/regions/%s/subnetworks/%s", projectID, region, network.Name)},
+			},
+		}
+
+		if _, err := sendRequest(config, "POST", fmt.Sprintf("%s?serviceConnectionPolicyId=%s", policyParent, policyId), obj); err != nil {
+			t.Fatalf("Unable to bootstrap service connection policy. Cannot create policy: %s", err)
+		}
+
+		// Creation is asynchronous; wait for the policy to become readable.
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			if _, err := sendRequest(config, "GET", policyUrl, nil); err != nil {
+				return resource.RetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Unable to bootstrap service connection policy. Policy was not created: %s", err)
+		}
+	}
+
+	return network.Name
+}
+
+/**
+* BootstrapSharedTestNetwork will return the name of an auto-mode network that
+* can be used by tests that need a network other than "default", for example
+* because they peer it or reserve ranges in it.
+*
+* The network is named after testId and is created on first use. It is never
+* deleted, so tests sharing a testId must not make conflicting changes to it.
+**/
+func BootstrapSharedTestNetwork(t *testing.T, testId string) string {
+	config := bootstrapConfig(t)
+	if config == nil {
+		return ""
+	}
+
+	return bootstrapNetwork(t, config, "tf-bootstrap-net-"+testId).Name
+}
+
+// bootstrapNetwork gets or creates an auto-mode network in the test project.
+func bootstrapNetwork(t *testing.T, config *Config, networkName string) *compute.Network {
+	projectID := getTestProjectFromEnv()
+
+	network, err := config.clientCompute.Networks.Get(projectID, networkName).Do()
+	if err == nil {
+		return network
+	}
+	if !isGoogleApiErrorWithCode(err, 404) {
+		t.Fatalf("Unable to bootstrap network %q. Cannot retrieve network: %s", networkName, err)
+	}
+
+	log.Printf("[DEBUG] Network %q not found, bootstrapping", networkName)
+	op, err := config.clientCompute.Networks.Insert(projectID, &compute.Network{
+		Name:                  networkName,
+		AutoCreateSubnetworks: true,
+	}).Do()
+	if err != nil {
+		t.Fatalf("Unable to bootstrap network %q. Cannot create network: %s", networkName, err)
+	}
+
+	if err := computeOperationWait(config, op, projectID, "Creating Network"); err != nil {
+		t.Fatalf("Unable to bootstrap network %q. Error waiting on network creation: %s", networkName, err)
+	}
+
+	network, err = config.clientCompute.Networks.Get(projectID, networkName).Do()
+	if err != nil {
+		t.Fatalf("Unable to bootstrap network %q. Cannot retrieve network: %s", networkName, err)
+	}
+
+	return network
+}
+
+/**
+* BootstrapServiceAccount will return the email of a service account in the
+* test project that has been granted the given project-level roles.
+*
+* The account is named after testId and is created on first use. Roles are
+* added to the project's IAM policy if they are missing, but never removed, so
+* that tests running in parallel can share the same account.
+**/
+func BootstrapServiceAccount(t *testing.T, testId string, roles []string) string {
+	config := bootstrapConfig(t)
+	if config == nil {
+		return ""
+	}
+
+	projectID := getTestProjectFromEnv()
+	accountId := "tf-bootstrap-sa-" + testId
+	email := fmt.Sprintf("%s@%s.iam.gserviceaccount.com", accountId, projectID)
+
+	// Get or Create the service account for the test
+	_, err := config.clientIAM.Projects.ServiceAccounts.Get(fmt.Sprintf("projects/%s/serviceAccounts/%s", projectID, email)).Do()
+	if err != nil {
+		if !isGoogleApiErrorWithCode(err, 404) {
+			t.Fatalf("Unable to bootstrap service account. Cannot retrieve service account: %s", err)
+		}
+
+		log.Printf("[DEBUG] Service account %q not found, bootstrapping", email)
+		_, err = config.clientIAM.Projects.ServiceAccounts.Create("projects/"+projectID, &iam.CreateServiceAccountRequest{
+			AccountId: accountId,
+			ServiceAccount: &iam.ServiceAccount{
+				DisplayName: "Bootstrapped service account for Terraform tests",
+			},
+		}).Do()
+		if err != nil {
+			t.Fatalf("Unable to bootstrap service account. Cannot create service account: %s", err)
+		}
+	}
+
+	if len(roles) == 0 {
+		return email
+	}
+
+	// Grant the roles the test needs to the service account
+	member := "serviceAccount:" + email
+	updater := &ProjectIamUpdater{
+		resourceId: projectID,
+		Config:     config,
+	}
+	err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
+		bindings := p.Bindings
+		for _, role := range roles {
+			bindings = append(bindings, &cloudresourcemanager.Binding{
+				Role:    role,
+				Members: []string{member},
+			})
+		}
+		p.Bindings = mergeBindings(bindings)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Unable to bootstrap service account. Cannot grant roles: %s", err)
+	}
+
+	return email
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"fmt"
+)
+
+type CloudRunV2OperationWaiter struct {
+	Config *Config
+	CommonOperationWaiter
+}
+
+func (w *CloudRunV2OperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	// Returns the proper get.
+	url := fmt.Sprintf("https://run.googleapis.com/v2/%s", w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", url, nil)
+}
+
+func cloudRunV2OperationWaitTime(config *Config, op map[string]interface{}, project, activity string, timeoutMinutes int) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w := &CloudRunV2OperationWaiter{
+		Config: config,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return err
+	}
+	return OperationWait(config.context, w, activity, timeoutMinutes)
+}
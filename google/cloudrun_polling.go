@@ -0,0 +1,84 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const readyStatusType string = "Ready"
+const pendingCertificateReason string = "CertificatePending"
+
+type Condition struct {
+	Type    string
+	Status  string
+	Reason  string
+	Message string
+}
+
+// KnativeStatus is a struct that can contain a Knative style resource's Status block. It is not
+// intended to be used for anything other than polling for the success of the given resource.
+type KnativeStatus struct {
+	Metadata struct {
+		Name       string
+		Namespace  string
+		SelfLink   string
+		Generation int64
+	}
+	Status struct {
+		Conditions         []Condition
+		ObservedGeneration int64
+	}
+}
+
+// Knative resources are created and updated synchronously, but are only
+// serving once their controller has reconciled the latest generation and
+// reported the Ready condition.
+func cloudRunPollReady(d *schema.ResourceData, config *Config, selfUrl string, timeout time.Duration) error {
+	url, err := replaceVars(d, config, selfUrl)
+	if err != nil {
+		return err
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		status := KnativeStatus{}
+		if err := Convert(res, &status); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if status.Status.ObservedGeneration < status.Metadata.Generation {
+			log.Printf("[DEBUG] Waiting for %s to reconcile generation %d", status.Metadata.SelfLink, status.Metadata.Generation)
+			return resource.RetryableError(fmt.Errorf("waiting for generation %d to be observed", status.Metadata.Generation))
+		}
+
+		for _, condition := range status.Status.Conditions {
+			if condition.Type != readyStatusType {
+				continue
+			}
+			switch condition.Status {
+			case "True":
+				return nil
+			case "False":
+				return resource.NonRetryableError(fmt.Errorf(`resource is in failed state "Ready:False", message: %s`, condition.Message))
+			}
+			// A domain mapping stays pending on its certificate until the DNS
+			// records it exports have been published, so that is as ready as
+			// it gets on creation.
+			if condition.Reason == pendingCertificateReason {
+				return nil
+			}
+			log.Printf("[DEBUG] %s is not ready yet: %s", status.Metadata.SelfLink, condition.Message)
+			return resource.RetryableError(fmt.Errorf("resource is not ready: %s", condition.Message))
+		}
+
+		return resource.RetryableError(fmt.Errorf("no %q condition reported yet", readyStatusType))
+	})
+}
@@ -1,10 +1,12 @@
 package google
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	cloudresourcemanager "google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -112,7 +114,7 @@ func CommonRefreshFunc(w Waiter) resource.StateRefreshFunc {
 	}
 }
 
-func OperationWait(w Waiter, activity string, timeoutMinutes int) error {
+func OperationWait(ctx context.Context, w Waiter, activity string, timeoutMinutes int) error {
 	if OperationDone(w) {
 		if w.Error() != nil {
 			return w.Error()
@@ -120,14 +122,26 @@ func OperationWait(w Waiter, activity string, timeoutMinutes int) error {
 		return nil
 	}
 
+	refresh := CommonRefreshFunc(w)
 	c := &resource.StateChangeConf{
-		Pending:    w.PendingStates(),
-		Target:     w.TargetStates(),
-		Refresh:    CommonRefreshFunc(w),
+		Pending: w.PendingStates(),
+		Target:  w.TargetStates(),
+		Refresh: func() (interface{}, string, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, "", err
+			}
+			return refresh()
+		},
 		Timeout:    time.Duration(timeoutMinutes) * time.Minute,
 		MinTimeout: 2 * time.Second,
 	}
 	opRaw, err := c.WaitForState()
+	if ctx.Err() != nil {
+		// Terraform is shutting down. The operation carries on server side, so
+		// leave a trail that lets it be found and checked on by hand.
+		log.Printf("[WARN] Stopped waiting for %s, operation %q is still pending", activity, w.OpName())
+		return &OperationCancelledError{Activity: activity, OpName: w.OpName()}
+	}
 	if err != nil {
 		return fmt.Errorf("Error waiting for %s: %s", activity, err)
 	}
@@ -143,6 +157,24 @@ func OperationWait(w Waiter, activity string, timeoutMinutes int) error {
 	return nil
 }
 
+// OperationCancelledError is returned by OperationWait when Terraform stops
+// the provider before the operation finished. The operation may still succeed,
+// so resources that were being created should be kept in state.
+type OperationCancelledError struct {
+	Activity string
+	OpName   string
+}
+
+func (e *OperationCancelledError) Error() string {
+	return fmt.Sprintf("Stopped waiting for %s, operation %s may still be running", e.Activity, e.OpName)
+}
+
+// isOperationCancelledError also finds an OperationCancelledError that has been
+// wrapped with errwrap.
+func isOperationCancelledError(err error) bool {
+	return errwrap.ContainsType(err, &OperationCancelledError{})
+}
+
 // The cloud resource manager API operation is an example of one of many
 // interchangeable API operations. Choose it somewhat arbitrarily to represent
 // the "common" operation.
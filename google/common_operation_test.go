@@ -0,0 +1,73 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/errwrap"
+)
+
+// pendingOperationWaiter is an operation that never finishes.
+type pendingOperationWaiter struct {
+	CommonOperationWaiter
+	queries int
+}
+
+func (w *pendingOperationWaiter) QueryOp() (interface{}, error) {
+	w.queries++
+	return w.Op, nil
+}
+
+func TestOperationWait_cancelled(t *testing.T) {
+	w := &pendingOperationWaiter{}
+	w.Op.Name = "operations/pending"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := OperationWait(ctx, w, "test", 1)
+	if !isOperationCancelledError(err) {
+		t.Fatalf("Expected an OperationCancelledError, got %v", err)
+	}
+	if w.queries != 0 {
+		t.Errorf("Expected no polling after cancellation, operation was queried %d times", w.queries)
+	}
+	if got := err.(*OperationCancelledError).OpName; got != "operations/pending" {
+		t.Errorf("Expected the pending operation's name in the error, got %q", got)
+	}
+}
+
+func TestIsOperationCancelledError(t *testing.T) {
+	cancelled := &OperationCancelledError{Activity: "test", OpName: "operations/pending"}
+
+	cases := map[string]struct {
+		Err      error
+		Expected bool
+	}{
+		"nil": {
+			Err: nil,
+		},
+		"other error": {
+			Err: fmt.Errorf("Error waiting for test: timeout"),
+		},
+		"cancelled": {
+			Err:      cancelled,
+			Expected: true,
+		},
+		"wrapped": {
+			Err:      errwrap.Wrapf("Error waiting to patch router: {{err}}", cancelled),
+			Expected: true,
+		},
+		"wrapped twice": {
+			Err:      errwrap.Wrapf("Error creating: {{err}}", errwrap.Wrapf("Error waiting: {{err}}", cancelled)),
+			Expected: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := isOperationCancelledError(tc.Err); got != tc.Expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
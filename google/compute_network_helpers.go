@@ -0,0 +1,38 @@
+package google
+
+import (
+	"fmt"
+)
+
+// resolveAutoSubnetwork returns the subnetwork that an auto-mode network has in
+// region, so resources that need a subnetwork can be configured with only a
+// network. Legacy networks have no subnetworks and resolve to an empty value,
+// custom-mode networks have no default to pick from and return an error.
+func resolveAutoSubnetwork(network *GlobalFieldValue, region string, config *Config) (*RegionalFieldValue, error) {
+	net, err := config.clientCompute.Networks.Get(network.Project, network.Name).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading network %q: %s", network.Name, err)
+	}
+
+	if net.IPv4Range != "" {
+		return &RegionalFieldValue{resourceType: "subnetworks"}, nil
+	}
+
+	if !net.AutoCreateSubnetworks {
+		return nil, fmt.Errorf("network %q is a custom-mode network, a subnetwork must be specified", network.Name)
+	}
+
+	r := mustCompileRegexpCached(fmt.Sprintf(regionalLinkBasePattern, "subnetworks"))
+	for _, link := range net.Subnetworks {
+		if parts := r.FindStringSubmatch(link); parts != nil && parts[2] == region {
+			return &RegionalFieldValue{
+				Project:      parts[1],
+				Region:       parts[2],
+				Name:         parts[3],
+				resourceType: "subnetworks",
+			}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("auto-mode network %q has no subnetwork in region %q", network.Name, region)
+}
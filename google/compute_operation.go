@@ -68,13 +68,13 @@ func (w *ComputeOperationWaiter) TargetStates() []string {
 	return []string{"DONE"}
 }
 
-func computeOperationWait(client *compute.Service, op *compute.Operation, project, activity string) error {
-	return computeOperationWaitTime(client, op, project, activity, 4)
+func computeOperationWait(config *Config, op *compute.Operation, project, activity string) error {
+	return computeOperationWaitTime(config, op, project, activity, 4)
 }
 
-func computeOperationWaitTime(client *compute.Service, op *compute.Operation, project, activity string, timeoutMinutes int) error {
+func computeOperationWaitTime(config *Config, op *compute.Operation, project, activity string, timeoutMinutes int) error {
 	w := &ComputeOperationWaiter{
-		Service: client,
+		Service: config.clientCompute,
 		Op:      op,
 		Project: project,
 	}
@@ -82,17 +82,17 @@ func computeOperationWaitTime(client *compute.Service, op *compute.Operation, pr
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
 
-func computeBetaOperationWaitTime(client *compute.Service, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
+func computeBetaOperationWaitTime(config *Config, op *computeBeta.Operation, project, activity string, timeoutMin int) error {
 	opV1 := &compute.Operation{}
 	err := Convert(op, opV1)
 	if err != nil {
 		return err
 	}
 
-	return computeOperationWaitTime(client, opV1, project, activity, timeoutMin)
+	return computeOperationWaitTime(config, opV1, project, activity, timeoutMin)
 }
 
 // ComputeOperationError wraps compute.OperationError and implements the
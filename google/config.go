@@ -57,6 +57,31 @@ type Config struct {
 	Zone        string
 	Scopes      []string
 
+	// StorageLocation and KmsLocation are used when a bucket or key ring
+	// doesn't set its own location.
+	StorageLocation string
+	KmsLocation     string
+
+	// DefaultResourceTags are bound to resources that support tags when
+	// they're created, keyed by the namespaced name of the tag key.
+	DefaultResourceTags map[string]string
+
+	// ReadCacheTTL is how long identical GET responses are reused for. The
+	// read cache is disabled when it is zero.
+	ReadCacheTTL time.Duration
+
+	// UniverseDomain is the domain that Google APIs are served from. It's
+	// googleapis.com unless it's set to a partner universe.
+	UniverseDomain string
+
+	// readConfig is a copy of this Config whose clients share the read
+	// cache. It's nil when the read cache is disabled.
+	readConfig *Config
+
+	// context is cancelled when Terraform asks the provider to stop. Retries
+	// and operation waits give up once it's done.
+	context context.Context
+
 	client    *http.Client
 	userAgent string
 
@@ -95,6 +120,8 @@ type Config struct {
 	clientStorageTransfer        *storagetransfer.Service
 
 	bigtableClientFactory *BigtableClientFactory
+
+	requestBatcherServiceUsage *RequestBatcher
 }
 
 var defaultClientScopes = []string{
@@ -109,6 +136,14 @@ func (c *Config) LoadAndValidate() error {
 		c.Scopes = defaultClientScopes
 	}
 
+	if c.UniverseDomain == "" {
+		c.UniverseDomain = defaultUniverseDomain
+	}
+
+	if c.context == nil {
+		c.context = context.Background()
+	}
+
 	tokenSource, err := c.getTokenSource(c.Scopes)
 	if err != nil {
 		return err
@@ -122,6 +157,18 @@ func (c *Config) LoadAndValidate() error {
 	// timeout for the maximum amount of time a logical request can take.
 	client.Timeout, _ = time.ParseDuration("30s")
 
+	if c.UniverseDomain != defaultUniverseDomain {
+		log.Printf("[INFO] Sending requests to the %q universe", c.UniverseDomain)
+		client.Transport = newUniverseDomainTransport(client.Transport, c.UniverseDomain)
+	}
+
+	var readCache *readCacheTransport
+	if c.ReadCacheTTL > 0 {
+		log.Printf("[INFO] Caching identical GET requests made by Read for %s", c.ReadCacheTTL)
+		readCache = newReadCacheTransport(client.Transport, tokenSource, c.ReadCacheTTL)
+		client.Transport = readCache.uncached()
+	}
+
 	terraformVersion := httpclient.UserAgentString()
 	providerVersion := fmt.Sprintf("terraform-provider-google/%s", version.ProviderVersion)
 	terraformWebsite := "(+https://www.terraform.io)"
@@ -130,6 +177,33 @@ func (c *Config) LoadAndValidate() error {
 	c.client = client
 	c.userAgent = userAgent
 
+	c.requestBatcherServiceUsage = NewRequestBatcher("Service Usage", defaultBatchSendAfter)
+
+	if err := c.loadClients(); err != nil {
+		return err
+	}
+
+	if readCache != nil {
+		readConfig := *c
+		readConfig.client = &http.Client{
+			Transport: readCache,
+			Timeout:   client.Timeout,
+		}
+		if err := readConfig.loadClients(); err != nil {
+			return err
+		}
+		c.readConfig = &readConfig
+	}
+
+	return nil
+}
+
+// loadClients instantiates the API clients using c.client.
+func (c *Config) loadClients() error {
+	client := c.client
+	userAgent := c.userAgent
+
+	var err error
 	log.Printf("[INFO] Instantiating GCE client...")
 	c.clientCompute, err = compute.New(client)
 	if err != nil {
@@ -292,8 +366,9 @@ func (c *Config) LoadAndValidate() error {
 	c.clientCloudFunctions.UserAgent = userAgent
 
 	c.bigtableClientFactory = &BigtableClientFactory{
-		UserAgent:   userAgent,
-		TokenSource: tokenSource,
+		UserAgent:      userAgent,
+		TokenSource:    c.tokenSource,
+		UniverseDomain: c.UniverseDomain,
 	}
 
 	log.Printf("[INFO] Instantiating Google Cloud Source Repo Client...")
@@ -378,6 +453,10 @@ func (c *Config) getTokenSource(clientScopes []string) (oauth2.TokenSource, erro
 			return nil, fmt.Errorf("Unable to parse credentials from '%s': %s", contents, err)
 		}
 
+		if err := validateUniverseDomainCredentials(creds.JSON, c.UniverseDomain); err != nil {
+			return nil, err
+		}
+
 		log.Printf("[INFO] Authenticating using configured Google JSON 'credentials'...")
 		log.Printf("[INFO]   -- Scopes: %s", clientScopes)
 		return creds.TokenSource, nil
@@ -385,5 +464,17 @@ func (c *Config) getTokenSource(clientScopes []string) (oauth2.TokenSource, erro
 
 	log.Printf("[INFO] Authenticating using DefaultClient...")
 	log.Printf("[INFO]   -- Scopes: %s", clientScopes)
-	return googleoauth.DefaultTokenSource(context.Background(), clientScopes...)
+	creds, err := googleoauth.FindDefaultCredentials(context.Background(), clientScopes...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credentials from the GCE metadata server have no JSON, and belong to
+	// the universe of the instance they're served on.
+	if len(creds.JSON) > 0 {
+		if err := validateUniverseDomainCredentials(creds.JSON, c.UniverseDomain); err != nil {
+			return nil, err
+		}
+	}
+	return creds.TokenSource, nil
 }
@@ -2,10 +2,13 @@ package google
 
 import (
 	"fmt"
+	"time"
 
 	container "google.golang.org/api/container/v1beta1"
 )
 
+const containerBetaBasePath = "https://container.googleapis.com/v1beta1/"
+
 type ContainerOperationWaiter struct {
 	Service  *container.Service
 	Op       *container.Operation
@@ -86,5 +89,20 @@ func containerOperationWait(config *Config, op *container.Operation, project, lo
 		return err
 	}
 
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
+}
+
+// sendContainerRequest sends obj to path under the GKE API and
+// returns the operation it started.
+func sendContainerRequest(config *Config, method, path string, obj map[string]interface{}, timeout time.Duration) (*container.Operation, error) {
+	res, err := sendRequestWithTimeout(config, method, containerBetaBasePath+path, obj, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &container.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
 }
@@ -0,0 +1,81 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceDnsRecordSet() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDnsRecordSetRead,
+
+		Schema: map[string]*schema.Schema{
+			"managed_zone": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"rrdatas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone := d.Get("managed_zone").(string)
+	name := d.Get("name").(string)
+	dnsType := d.Get("type").(string)
+
+	resp, err := config.clientDns.ResourceRecordSets.List(
+		project, zone).Name(name).Type(dnsType).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading DNS record set %q of type %q in zone %q: %s", name, dnsType, zone, err)
+	}
+	if len(resp.Rrsets) == 0 {
+		return fmt.Errorf("DNS record set %q of type %q not found in zone %q", name, dnsType, zone)
+	}
+	if len(resp.Rrsets) > 1 {
+		return fmt.Errorf("Only expected 1 record set, got %d", len(resp.Rrsets))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", zone, name, dnsType))
+	d.Set("rrdatas", resp.Rrsets[0].Rrdatas)
+	d.Set("ttl", resp.Rrsets[0].Ttl)
+	d.Set("project", project)
+
+	return nil
+}
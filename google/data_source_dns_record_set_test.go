@@ -0,0 +1,56 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceDnsRecordSet_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := fmt.Sprintf("tf-test-zone-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDnsRecordSet_basic(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_dns_record_set.rs", "ttl", "300"),
+					resource.TestCheckResourceAttr("data.google_dns_record_set.rs", "rrdatas.#", "2"),
+					resource.TestCheckResourceAttrPair("data.google_dns_record_set.rs", "rrdatas.0", "google_dns_record_set.foo", "rrdatas.0"),
+					resource.TestCheckResourceAttrPair("data.google_dns_record_set.rs", "rrdatas.1", "google_dns_record_set.foo", "rrdatas.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDnsRecordSet_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "google_dns_managed_zone" "parent-zone" {
+	name		= "%s"
+	dns_name	= "hashicorptest.com."
+	description	= "Test Description"
+}
+
+resource "google_dns_record_set" "foo" {
+	managed_zone	= "${google_dns_managed_zone.parent-zone.name}"
+	name		= "test-record.hashicorptest.com."
+	type		= "A"
+	rrdatas		= ["127.0.0.1", "127.0.0.10"]
+	ttl		= 300
+}
+
+data "google_dns_record_set" "rs" {
+	managed_zone	= "${google_dns_record_set.foo.managed_zone}"
+	name		= "${google_dns_record_set.foo.name}"
+	type		= "${google_dns_record_set.foo.type}"
+}
+`, zoneName)
+}
@@ -0,0 +1,57 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// cloudRunInvokerRole is the role members need to invoke a Cloud Run service
+// that doesn't allow unauthenticated requests.
+const cloudRunInvokerRole = "roles/run.invoker"
+
+func dataSourceGoogleCloudRunService() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceCloudRunService().Schema)
+
+	// Set 'Required' schema elements
+	addRequiredFieldsToSchema(dsSchema, "name", "location")
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	dsSchema["url"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	dsSchema["invoker_role"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleCloudRunServiceRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleCloudRunServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := replaceVars(d, config, "locations/{{location}}/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if err := resourceCloudRunServiceRead(d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("Service %q not found", id)
+	}
+
+	d.Set("url", d.Get("status.0.url"))
+	d.Set("invoker_role", cloudRunInvokerRole)
+	return nil
+}
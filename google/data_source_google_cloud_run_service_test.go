@@ -0,0 +1,59 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleCloudRunService_basic(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-cloudrun-srv%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleCloudRunService_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_cloud_run_service.default", "id", "google_cloud_run_service.default", "id"),
+					resource.TestCheckResourceAttrPair("data.google_cloud_run_service.default", "template.0.spec.0.containers.0.image", "google_cloud_run_service.default", "template.0.spec.0.containers.0.image"),
+					resource.TestCheckResourceAttrPair("data.google_cloud_run_service.default", "url", "google_cloud_run_service.default", "status.0.url"),
+					resource.TestCheckResourceAttr("data.google_cloud_run_service.default", "invoker_role", "roles/run.invoker"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleCloudRunService_basic(name string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "%s"
+  location = "us-central1"
+
+  template {
+    spec {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+
+  traffic {
+    percent         = 100
+    latest_revision = true
+  }
+}
+
+data "google_cloud_run_service" "default" {
+  name     = "${google_cloud_run_service.default.name}"
+  location = "${google_cloud_run_service.default.location}"
+}
+`, name)
+}
@@ -1,9 +1,15 @@
 package google
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// cloudFunctionsInvokerRole is the role members need to call an HTTP function
+// that doesn't allow unauthenticated invocations.
+const cloudFunctionsInvokerRole = "roles/cloudfunctions.invoker"
+
 func dataSourceGoogleCloudFunctionsFunction() *schema.Resource {
 	// Generate datasource schema from resource
 	dsSchema := datasourceSchemaFromResourceSchema(resourceCloudFunctionsFunction().Schema)
@@ -14,6 +20,11 @@ func dataSourceGoogleCloudFunctionsFunction() *schema.Resource {
 	// Set 'Optional' schema elements
 	addOptionalFieldsToSchema(dsSchema, "project", "region")
 
+	dsSchema["invoker_role"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	return &schema.Resource{
 		Read:   dataSourceGoogleCloudFunctionsFunctionRead,
 		Schema: dsSchema,
@@ -45,6 +56,10 @@ func dataSourceGoogleCloudFunctionsFunctionRead(d *schema.ResourceData, meta int
 	if err != nil {
 		return err
 	}
+	if d.Id() == "" {
+		return fmt.Errorf("Cloud Function %q not found", cloudFuncId.cloudFunctionId())
+	}
 
+	d.Set("invoker_role", cloudFunctionsInvokerRole)
 	return nil
 }
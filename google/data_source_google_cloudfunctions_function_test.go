@@ -33,6 +33,7 @@ func TestAccDataSourceGoogleCloudFunctionsFunction_basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourceGoogleCloudFunctionsFunctionCheck(funcDataNameHttp,
 						"google_cloudfunctions_function.function_http"),
+					resource.TestCheckResourceAttr(funcDataNameHttp, "invoker_role", "roles/cloudfunctions.invoker"),
 				),
 			},
 		},
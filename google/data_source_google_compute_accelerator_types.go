@@ -0,0 +1,119 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeAcceleratorTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeAcceleratorTypesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accelerator_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maximum_cards_per_instance": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deprecated": computeDeprecationStatusSchema(),
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeAcceleratorTypesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	acceleratorTypes := make([]*compute.AcceleratorType, 0)
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.AcceleratorTypes.List(project, zone).Filter(d.Get("filter").(string)).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("Error listing accelerator types in zone %q: %s", zone, err)
+		}
+
+		acceleratorTypes = append(acceleratorTypes, resp.Items...)
+
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	sort.Slice(acceleratorTypes, func(i, j int) bool {
+		return acceleratorTypes[i].Name < acceleratorTypes[j].Name
+	})
+	log.Printf("[DEBUG] Received %d Google Compute accelerator types in zone %q", len(acceleratorTypes), zone)
+
+	names := make([]string, 0, len(acceleratorTypes))
+	result := make([]map[string]interface{}, 0, len(acceleratorTypes))
+	for _, at := range acceleratorTypes {
+		names = append(names, at.Name)
+		result = append(result, map[string]interface{}{
+			"name":                       at.Name,
+			"description":                at.Description,
+			"maximum_cards_per_instance": at.MaximumCardsPerInstance,
+			"deprecated":                 flattenComputeDeprecationStatus(at.Deprecated),
+			"self_link":                  ConvertSelfLinkToV1(at.SelfLink),
+		})
+	}
+
+	if err := d.Set("accelerator_types", result); err != nil {
+		return fmt.Errorf("Error setting accelerator_types: %s", err)
+	}
+	d.Set("names", names)
+	d.Set("zone", zone)
+	d.Set("project", project)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccComputeAcceleratorTypes_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleComputeAcceleratorTypesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.available", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.available", "names.0", "nvidia-tesla-k80"),
+					resource.TestCheckResourceAttr("data.google_compute_accelerator_types.available", "accelerator_types.0.maximum_cards_per_instance", "8"),
+				),
+			},
+		},
+	})
+}
+
+var testAccCheckGoogleComputeAcceleratorTypesConfig = `
+data "google_compute_accelerator_types" "available" {
+  zone   = "us-central1-a"
+  filter = "name = nvidia-tesla-k80"
+}
+`
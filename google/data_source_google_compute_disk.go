@@ -0,0 +1,146 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeDisk() *schema.Resource {
+	dsSchema := datasourceSchemaFromResourceSchema(resourceComputeDisk().Schema)
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project", "zone")
+
+	// The disk is looked up either by name, or as the newest disk matching
+	// a label selector and/or a filter.
+	dsSchema["name"].Optional = true
+	dsSchema["name"].ConflictsWith = []string{"label_selector", "filter"}
+	dsSchema["label_selector"] = computeLabelSelectorSchema()
+	dsSchema["filter"] = computeLookupFilterSchema()
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeDiskRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleComputeDiskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if _, ok := d.GetOk("name"); !ok {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		zone, err := getZone(d, config)
+		if err != nil {
+			return err
+		}
+
+		filter, err := computeLookupFilter(d)
+		if err != nil {
+			return err
+		}
+
+		var names, timestamps []string
+		token := ""
+		for paginate := true; paginate; {
+			resp, err := config.clientCompute.Disks.List(project, zone).Filter(filter).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("Error listing disks in zone %q: %s", zone, err)
+			}
+
+			for _, disk := range resp.Items {
+				names = append(names, disk.Name)
+				timestamps = append(timestamps, disk.CreationTimestamp)
+			}
+
+			token = resp.NextPageToken
+			paginate = token != ""
+		}
+
+		name, err := newestComputeResourceName(names, timestamps)
+		if err != nil {
+			return fmt.Errorf("Error finding a disk in zone %q matching %q: %s", zone, filter, err)
+		}
+		log.Printf("[DEBUG] Found disk %q as the newest of %d disks matching %q", name, len(names), filter)
+
+		d.Set("name", name)
+		d.Set("zone", zone)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceComputeDiskRead(d, meta)
+}
+
+func computeLabelSelectorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func computeLookupFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+}
+
+// computeLookupFilter builds a Compute Engine list filter from the
+// `label_selector` and `filter` fields of a data source. Every expression
+// must match.
+func computeLookupFilter(d *schema.ResourceData) (string, error) {
+	selector := d.Get("label_selector").(map[string]interface{})
+	filter := d.Get("filter").(string)
+	if len(selector) == 0 && filter == "" {
+		return "", fmt.Errorf("one of `name`, `label_selector` or `filter` must be set")
+	}
+
+	keys := make([]string, 0, len(selector))
+	for k := range selector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	expressions := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		expressions = append(expressions, fmt.Sprintf("(labels.%s = %q)", k, selector[k].(string)))
+	}
+	if filter != "" {
+		expressions = append(expressions, fmt.Sprintf("(%s)", filter))
+	}
+
+	return strings.Join(expressions, " "), nil
+}
+
+// newestComputeResourceName returns the name of the most recently created
+// resource, given the names and RFC3339 creation timestamps of the matches.
+func newestComputeResourceName(names, timestamps []string) (string, error) {
+	if len(names) == 0 {
+		return "", fmt.Errorf("no matching resources found")
+	}
+
+	var newestName string
+	var newest time.Time
+	for i, name := range names {
+		created, err := time.Parse(time.RFC3339, timestamps[i])
+		if err != nil {
+			return "", fmt.Errorf("Error parsing creation timestamp of %q: %s", name, err)
+		}
+		if newestName == "" || created.After(newest) {
+			newestName = name
+			newest = created
+		}
+	}
+
+	return newestName, nil
+}
@@ -0,0 +1,145 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccDataSourceComputeDisk(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeDiskConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_disk.by_name", "name", name+"-old"),
+					resource.TestCheckResourceAttr("data.google_compute_disk.by_name", "size", "10"),
+					resource.TestCheckResourceAttrSet("data.google_compute_disk.by_name", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_disk.newest", "name", name+"-new"),
+					resource.TestCheckResourceAttr("data.google_compute_disk.newest", "zone", "us-central1-a"),
+					resource.TestCheckResourceAttr("data.google_compute_disk.newest", "labels.backup", name),
+				),
+			},
+		},
+	})
+}
+
+func TestComputeLookupNewestResourceName(t *testing.T) {
+	t.Parallel()
+
+	name, err := newestComputeResourceName(
+		[]string{"old", "newest", "new"},
+		[]string{"2018-01-01T10:00:00.000-07:00", "2018-01-01T11:00:00.000-09:00", "2018-01-01T12:00:00.000-07:00"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if name != "newest" {
+		t.Errorf("expected the newest resource to be %q, got %q", "newest", name)
+	}
+
+	if _, err := newestComputeResourceName(nil, nil); err == nil {
+		t.Errorf("expected an error when there are no matching resources")
+	}
+
+	if _, err := newestComputeResourceName([]string{"bad"}, []string{"yesterday"}); err == nil {
+		t.Errorf("expected an error for an invalid timestamp")
+	}
+}
+
+func TestComputeLookupFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Raw         map[string]interface{}
+		Expected    string
+		ExpectError bool
+	}{
+		"label selector": {
+			Raw: map[string]interface{}{
+				"label_selector": map[string]interface{}{"role": "db", "backup": "true"},
+			},
+			Expected: `(labels.backup = "true") (labels.role = "db")`,
+		},
+		"label selector and filter": {
+			Raw: map[string]interface{}{
+				"label_selector": map[string]interface{}{"backup": "true"},
+				"filter":         "status = READY",
+			},
+			Expected: `(labels.backup = "true") (status = READY)`,
+		},
+		"nothing set": {
+			Raw:         map[string]interface{}{},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceGoogleComputeDisk().Schema, tc.Raw)
+		filter, err := computeLookupFilter(d)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if filter != tc.Expected {
+			t.Errorf("%s: expected filter %q, got %q", tn, tc.Expected, filter)
+		}
+	}
+}
+
+func testAccDataSourceComputeDiskConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "old" {
+  name = "%s-old"
+  zone = "us-central1-a"
+  size = 10
+
+  labels = {
+    backup = "%s"
+  }
+}
+
+resource "google_compute_disk" "new" {
+  name = "%s-new"
+  zone = "us-central1-a"
+  size = 10
+
+  labels = {
+    backup = "%s"
+  }
+
+  depends_on = ["google_compute_disk.old"]
+}
+
+data "google_compute_disk" "by_name" {
+  name = "${google_compute_disk.old.name}"
+  zone = "us-central1-a"
+}
+
+data "google_compute_disk" "newest" {
+  zone = "us-central1-a"
+
+  label_selector = {
+    backup = "%s"
+  }
+
+  depends_on = ["google_compute_disk.new"]
+}
+`, name, name, name, name, name)
+}
@@ -0,0 +1,198 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+)
+
+func dataSourceGoogleComputeMachineTypes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleComputeMachineTypesRead,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"min_cpus": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_memory_mb": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"machine_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"guest_cpus": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_shared_cpu": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"maximum_persistent_disks": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"maximum_persistent_disks_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"deprecated": computeDeprecationStatusSchema(),
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGoogleComputeMachineTypesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return err
+	}
+
+	minCpus := int64(d.Get("min_cpus").(int))
+	minMemoryMb := int64(d.Get("min_memory_mb").(int))
+
+	machineTypes := make([]*compute.MachineType, 0)
+	token := ""
+	for paginate := true; paginate; {
+		resp, err := config.clientCompute.MachineTypes.List(project, zone).Filter(d.Get("filter").(string)).PageToken(token).Do()
+		if err != nil {
+			return fmt.Errorf("Error listing machine types in zone %q: %s", zone, err)
+		}
+
+		for _, mt := range resp.Items {
+			if mt.GuestCpus < minCpus || mt.MemoryMb < minMemoryMb {
+				continue
+			}
+			machineTypes = append(machineTypes, mt)
+		}
+
+		token = resp.NextPageToken
+		paginate = token != ""
+	}
+
+	// Machine types are sorted from the smallest to the largest, so the first
+	// one is the smallest machine type that meets the constraints.
+	sort.SliceStable(machineTypes, func(i, j int) bool {
+		a, b := machineTypes[i], machineTypes[j]
+		if a.GuestCpus != b.GuestCpus {
+			return a.GuestCpus < b.GuestCpus
+		}
+		if a.MemoryMb != b.MemoryMb {
+			return a.MemoryMb < b.MemoryMb
+		}
+		return a.Name < b.Name
+	})
+	log.Printf("[DEBUG] Received %d Google Compute machine types in zone %q", len(machineTypes), zone)
+
+	if err := d.Set("machine_types", flattenComputeMachineTypes(machineTypes)); err != nil {
+		return fmt.Errorf("Error setting machine_types: %s", err)
+	}
+	names := make([]string, 0, len(machineTypes))
+	for _, mt := range machineTypes {
+		names = append(names, mt.Name)
+	}
+	d.Set("names", names)
+	d.Set("zone", zone)
+	d.Set("project", project)
+	d.SetId(time.Now().UTC().String())
+
+	return nil
+}
+
+func flattenComputeMachineTypes(machineTypes []*compute.MachineType) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(machineTypes))
+	for _, mt := range machineTypes {
+		result = append(result, map[string]interface{}{
+			"name":                             mt.Name,
+			"description":                      mt.Description,
+			"guest_cpus":                       mt.GuestCpus,
+			"memory_mb":                        mt.MemoryMb,
+			"is_shared_cpu":                    mt.IsSharedCpu,
+			"maximum_persistent_disks":         mt.MaximumPersistentDisks,
+			"maximum_persistent_disks_size_gb": mt.MaximumPersistentDisksSizeGb,
+			"deprecated":                       flattenComputeDeprecationStatus(mt.Deprecated),
+			"self_link":                        ConvertSelfLinkToV1(mt.SelfLink),
+		})
+	}
+	return result
+}
+
+func computeDeprecationStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"replacement": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenComputeDeprecationStatus(status *compute.DeprecationStatus) []map[string]interface{} {
+	if status == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"replacement": status.Replacement,
+		"state":       status.State,
+	}}
+}
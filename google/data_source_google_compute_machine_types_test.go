@@ -0,0 +1,75 @@
+package google
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeMachineTypes_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleComputeMachineTypesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleComputeMachineTypesMeta("data.google_compute_machine_types.available", 4, 8192),
+					resource.TestCheckResourceAttr("data.google_compute_machine_types.available", "zone", "us-central1-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleComputeMachineTypesMeta(n string, minCpus, minMemoryMb int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find machine types data source: %s", n)
+		}
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["machine_types.#"])
+		if err != nil {
+			return fmt.Errorf("failed to read number of machine types: %s", err)
+		}
+		if count < 1 {
+			return fmt.Errorf("expected at least 1 machine type, received %d", count)
+		}
+
+		lastCpus := 0
+		for i := 0; i < count; i++ {
+			cpus, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("machine_types.%d.guest_cpus", i)])
+			if err != nil {
+				return err
+			}
+			memory, err := strconv.Atoi(rs.Primary.Attributes[fmt.Sprintf("machine_types.%d.memory_mb", i)])
+			if err != nil {
+				return err
+			}
+			if cpus < minCpus || memory < minMemoryMb {
+				return fmt.Errorf("machine type %d has %d vCPUs and %d MB of memory, expected at least %d vCPUs and %d MB", i, cpus, memory, minCpus, minMemoryMb)
+			}
+			if cpus < lastCpus {
+				return fmt.Errorf("machine types are not sorted by vCPU count at index %d", i)
+			}
+			lastCpus = cpus
+		}
+
+		return nil
+	}
+}
+
+var testAccCheckGoogleComputeMachineTypesConfig = `
+data "google_compute_machine_types" "available" {
+  zone          = "us-central1-a"
+  filter        = "isSharedCpu = false"
+  min_cpus      = 4
+  min_memory_mb = 8192
+}
+`
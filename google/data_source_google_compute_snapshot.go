@@ -0,0 +1,72 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleComputeSnapshot() *schema.Resource {
+	dsSchema := datasourceSchemaFromResourceSchema(resourceComputeSnapshot().Schema)
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "project")
+
+	// The snapshot is looked up either by name, or as the newest snapshot
+	// matching a label selector and/or a filter.
+	dsSchema["name"].Optional = true
+	dsSchema["name"].ConflictsWith = []string{"label_selector", "filter"}
+	dsSchema["label_selector"] = computeLabelSelectorSchema()
+	dsSchema["filter"] = computeLookupFilterSchema()
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleComputeSnapshotRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleComputeSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if _, ok := d.GetOk("name"); !ok {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+
+		filter, err := computeLookupFilter(d)
+		if err != nil {
+			return err
+		}
+
+		var names, timestamps []string
+		token := ""
+		for paginate := true; paginate; {
+			resp, err := config.clientCompute.Snapshots.List(project).Filter(filter).PageToken(token).Do()
+			if err != nil {
+				return fmt.Errorf("Error listing snapshots: %s", err)
+			}
+
+			for _, snapshot := range resp.Items {
+				names = append(names, snapshot.Name)
+				timestamps = append(timestamps, snapshot.CreationTimestamp)
+			}
+
+			token = resp.NextPageToken
+			paginate = token != ""
+		}
+
+		name, err := newestComputeResourceName(names, timestamps)
+		if err != nil {
+			return fmt.Errorf("Error finding a snapshot matching %q: %s", filter, err)
+		}
+		log.Printf("[DEBUG] Found snapshot %q as the newest of %d snapshots matching %q", name, len(names), filter)
+
+		d.Set("name", name)
+	}
+
+	d.SetId(d.Get("name").(string))
+
+	return resourceComputeSnapshotRead(d, meta)
+}
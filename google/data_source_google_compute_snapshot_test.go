@@ -0,0 +1,74 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceComputeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeSnapshotConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_compute_snapshot.by_name", "name", name+"-old"),
+					resource.TestCheckResourceAttrSet("data.google_compute_snapshot.by_name", "self_link"),
+					resource.TestCheckResourceAttr("data.google_compute_snapshot.newest", "name", name+"-new"),
+					resource.TestCheckResourceAttr("data.google_compute_snapshot.newest", "labels.backup", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeSnapshotConfig(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_disk" "disk" {
+  name  = "%s-disk"
+  image = "debian-cloud/debian-9"
+  zone  = "us-central1-a"
+}
+
+resource "google_compute_snapshot" "old" {
+  name        = "%s-old"
+  source_disk = "${google_compute_disk.disk.name}"
+  zone        = "us-central1-a"
+
+  labels = {
+    backup = "%s"
+  }
+}
+
+resource "google_compute_snapshot" "new" {
+  name        = "%s-new"
+  source_disk = "${google_compute_disk.disk.name}"
+  zone        = "us-central1-a"
+
+  labels = {
+    backup = "%s"
+  }
+
+  depends_on = ["google_compute_snapshot.old"]
+}
+
+data "google_compute_snapshot" "by_name" {
+  name = "${google_compute_snapshot.old.name}"
+}
+
+data "google_compute_snapshot" "newest" {
+  filter = "labels.backup = %s"
+
+  depends_on = ["google_compute_snapshot.new"]
+}
+`, name, name, name, name, name, name)
+}
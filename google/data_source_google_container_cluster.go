@@ -1,6 +1,8 @@
 package google
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -25,5 +27,15 @@ func datasourceContainerClusterRead(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(clusterName)
 
-	return resourceContainerClusterRead(d, meta)
+	if err := resourceContainerClusterRead(d, meta); err != nil {
+		return err
+	}
+
+	// The resource read clears the ID when the cluster is gone; a data source
+	// should fail instead of handing empty credentials to another provider.
+	if d.Id() == "" {
+		return fmt.Errorf("Container Cluster %q not found", clusterName)
+	}
+
+	return nil
 }
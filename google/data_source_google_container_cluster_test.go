@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -43,6 +44,40 @@ func TestAccContainerClusterDatasource_regional(t *testing.T) {
 	})
 }
 
+func TestAccContainerClusterDatasource_providerChaining(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerClusterDatasource_zonal(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.google_container_cluster.kubes", "endpoint"),
+					resource.TestCheckResourceAttrSet("data.google_container_cluster.kubes", "master_auth.0.cluster_ca_certificate"),
+					resource.TestCheckResourceAttrSet("data.google_container_cluster.kubes", "master_version"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerClusterDatasource_notFound(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccContainerClusterDatasource_notFound(),
+				ExpectError: regexp.MustCompile("not found"),
+			},
+		},
+	})
+}
+
 func testAccDataSourceGoogleContainerClusterCheck(dataSourceName string, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ds, ok := s.RootModule().Resources[dataSourceName]
@@ -140,3 +175,12 @@ data "google_container_cluster" "kubes" {
 }
 `, acctest.RandString(10))
 }
+
+func testAccContainerClusterDatasource_notFound() string {
+	return fmt.Sprintf(`
+data "google_container_cluster" "kubes" {
+	name     = "cluster-test-%s"
+	location = "us-central1-a"
+}
+`, acctest.RandString(10))
+}
@@ -0,0 +1,42 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceGoogleContainerRegistry() *schema.Resource {
+	// Generate datasource schema from resource
+	dsSchema := datasourceSchemaFromResourceSchema(resourceContainerRegistry().Schema)
+
+	// Set 'Optional' schema elements
+	addOptionalFieldsToSchema(dsSchema, "location", "project")
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleContainerRegistryRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := containerRegistryBucketName(project, d.Get("location").(string))
+	bucket, err := config.clientStorage.Buckets.Get(name).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading Container Registry bucket %q, is the registry initialized? %s", name, err)
+	}
+
+	d.SetId(bucket.Name)
+	d.Set("project", project)
+	d.Set("bucket_name", bucket.Name)
+	d.Set("bucket_self_link", bucket.SelfLink)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The configmanagement feature is shared by the whole project, so this test
+// isn't run in parallel with the feature membership tests that also use it.
+func TestAccDataSourceGoogleGKEHubFeature_fleetDefaultMemberConfig(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleGKEHubFeature_fleetDefaultMemberConfig(),
+				Check: testAccDataSourceGoogleGKEHubCheck("data.google_gke_hub_feature.feature", "google_gke_hub_feature.feature",
+					[]string{
+						"id",
+						"fleet_default_member_config.0.configmanagement.0.config_sync.0.source_format",
+						"fleet_default_member_config.0.configmanagement.0.config_sync.0.git.0.sync_repo",
+						"fleet_default_member_config.0.configmanagement.0.config_sync.0.git.0.sync_branch",
+					}),
+			},
+		},
+	})
+}
+
+func testAccDataSourceGoogleGKEHubFeature_fleetDefaultMemberConfig() string {
+	return `
+resource "google_gke_hub_feature" "feature" {
+  name     = "configmanagement"
+  location = "global"
+
+  fleet_default_member_config {
+    configmanagement {
+      config_sync {
+        source_format = "unstructured"
+        git {
+          sync_repo   = "https://github.com/GoogleCloudPlatform/anthos-config-management-samples"
+          sync_branch = "main"
+          policy_dir  = "quickstart/config-sync"
+          secret_type = "none"
+        }
+      }
+    }
+  }
+}
+
+data "google_gke_hub_feature" "feature" {
+  name     = "${google_gke_hub_feature.feature.name}"
+  location = "${google_gke_hub_feature.feature.location}"
+}
+`
+}
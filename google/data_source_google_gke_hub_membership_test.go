@@ -0,0 +1,82 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataSourceGoogleGKEHubMembership_basic(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleGKEHubMembership_basic(context),
+				Check: testAccDataSourceGoogleGKEHubCheck("data.google_gke_hub_membership.membership", "google_gke_hub_membership.membership",
+					[]string{"id", "name", "membership_id", "labels.%", "labels.env", "endpoint.0.gke_cluster.0.resource_link"}),
+			},
+		},
+	})
+}
+
+// testAccDataSourceGoogleGKEHubCheck checks that the data source read the
+// given attributes of the resource.
+func testAccDataSourceGoogleGKEHubCheck(dataSourceName, resourceName string, attrs []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ds, ok := s.RootModule().Resources[dataSourceName]
+		if !ok {
+			return fmt.Errorf("root module has no resource called %s", dataSourceName)
+		}
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("can't find %s in state", resourceName)
+		}
+
+		for _, attr := range attrs {
+			if ds.Primary.Attributes[attr] != rs.Primary.Attributes[attr] {
+				return fmt.Errorf("%s is %q; want %q", attr, ds.Primary.Attributes[attr], rs.Primary.Attributes[attr])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDataSourceGoogleGKEHubMembership_basic(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster%{random_suffix}"
+  location           = "us-central1-a"
+  initial_node_count = 1
+}
+
+resource "google_gke_hub_membership" "membership" {
+  membership_id = "tf-test-membership%{random_suffix}"
+  endpoint {
+    gke_cluster {
+      resource_link = "//container.googleapis.com/projects/%{project}/locations/us-central1-a/clusters/${google_container_cluster.primary.name}"
+    }
+  }
+  labels = {
+    env = "test"
+  }
+}
+
+data "google_gke_hub_membership" "membership" {
+  membership_id = "${google_gke_hub_membership.membership.membership_id}"
+}
+`, context)
+}
@@ -0,0 +1,263 @@
+package google
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// dataSourceGoogleIamEffectivePolicy reads the IAM policies that apply to a
+// project, folder or organization, including those inherited from its
+// ancestors, using the Policy Analyzer of the Cloud Asset API. It allows
+// policy-as-code checks to assert on effective access rather than on the
+// bindings Terraform manages itself.
+func dataSourceGoogleIamEffectivePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleIamEffectivePolicyRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"folder", "organization"},
+			},
+			"folder": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project", "organization"},
+			},
+			"organization": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"project", "folder"},
+			},
+			"scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"full_resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attached_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"policy_data": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"bindings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attached_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"members": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      iamMemberHash,
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"expression": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"policy_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+type effectiveIamPolicy struct {
+	AttachedResource string
+	Policy           *cloudresourcemanager.Policy
+}
+
+type effectiveIamPolicyResult struct {
+	FullResourceName string
+	Policies         []effectiveIamPolicy
+}
+
+func dataSourceGoogleIamEffectivePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	resourceName, err := effectiveIamPolicyResourceName(d, config)
+	if err != nil {
+		return err
+	}
+	fullResourceName := "//cloudresourcemanager.googleapis.com/" + resourceName
+
+	// Policies are returned for the resource and its ancestors up to the
+	// scope, so it defaults to the top-level ancestor to include every
+	// inherited binding.
+	var scope string
+	if v, ok := d.GetOk("scope"); ok {
+		scope = v.(string)
+	} else if scope, err = effectiveIamPolicyDefaultScope(resourceName, config); err != nil {
+		return err
+	}
+
+	u := fmt.Sprintf("https://cloudasset.googleapis.com/v1/%s/effectiveIamPolicies:batchGet?names=%s", scope, url.QueryEscape(fullResourceName))
+	res, err := sendRequest(config, "GET", u, nil)
+	if err != nil {
+		return fmt.Errorf("Error analyzing effective IAM policy of %s: %s", fullResourceName, err)
+	}
+
+	var results struct {
+		PolicyResults []effectiveIamPolicyResult
+	}
+	if err := Convert(res, &results); err != nil {
+		return err
+	}
+	if len(results.PolicyResults) != 1 {
+		return fmt.Errorf("Expected a single effective IAM policy result for %s, got %d", fullResourceName, len(results.PolicyResults))
+	}
+	result := results.PolicyResults[0]
+
+	var policies []map[string]interface{}
+	var bindings []map[string]interface{}
+	var allBindings []*cloudresourcemanager.Binding
+	for _, p := range result.Policies {
+		if p.Policy == nil {
+			continue
+		}
+		policies = append(policies, map[string]interface{}{
+			"attached_resource": p.AttachedResource,
+			"etag":              p.Policy.Etag,
+			"policy_data":       marshalIamPolicy(p.Policy),
+		})
+		for _, b := range canonicalIamBindings(p.Policy.Bindings) {
+			bindings = append(bindings, map[string]interface{}{
+				"attached_resource": p.AttachedResource,
+				"role":              b.Role,
+				"members":           schema.NewSet(iamMemberHash, convertStringArrToInterface(b.Members)),
+				"condition":         flattenIamCondition(b.Condition),
+			})
+		}
+		allBindings = append(allBindings, p.Policy.Bindings...)
+	}
+
+	d.SetId(fullResourceName)
+	d.Set("scope", scope)
+	d.Set("full_resource_name", result.FullResourceName)
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("Error setting policies: %s", err)
+	}
+	if err := d.Set("bindings", bindings); err != nil {
+		return fmt.Errorf("Error setting bindings: %s", err)
+	}
+	d.Set("policy_data", marshalIamPolicy(&cloudresourcemanager.Policy{Bindings: allBindings}))
+
+	return nil
+}
+
+// effectiveIamPolicyDefaultScope returns the top-level ancestor of the
+// resource: its organization, or the resource itself if it has none.
+func effectiveIamPolicyDefaultScope(resourceName string, config *Config) (string, error) {
+	switch {
+	case strings.HasPrefix(resourceName, "projects/"):
+		pid := strings.TrimPrefix(resourceName, "projects/")
+		ancestry, err := config.clientResourceManager.Projects.GetAncestry(pid, &cloudresourcemanager.GetAncestryRequest{}).Do()
+		if err != nil {
+			return "", fmt.Errorf("Error reading ancestry for project %q, set scope to read its effective IAM policy: %s", pid, err)
+		}
+		return effectiveIamPolicyScopeFromAncestry(resourceName, ancestry.Ancestor), nil
+	case strings.HasPrefix(resourceName, "folders/"):
+		folder, err := config.clientResourceManagerV2Beta1.Folders.Get(resourceName).Do()
+		if err != nil {
+			return "", fmt.Errorf("Error reading folder %q, set scope to read its effective IAM policy: %s", resourceName, err)
+		}
+		org, err := lookupOrganizationName(folder, config)
+		if err != nil {
+			return "", err
+		}
+		if org == "" {
+			return resourceName, nil
+		}
+		return org, nil
+	}
+	return resourceName, nil
+}
+
+// effectiveIamPolicyScopeFromAncestry returns the last of a project's
+// ancestors, which are ordered from the project itself up to the organization.
+func effectiveIamPolicyScopeFromAncestry(resourceName string, ancestors []*cloudresourcemanager.Ancestor) string {
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		id := ancestors[i].ResourceId
+		if id == nil {
+			continue
+		}
+		switch id.Type {
+		case "organization":
+			return "organizations/" + id.Id
+		case "folder":
+			return "folders/" + id.Id
+		case "project":
+			return "projects/" + id.Id
+		}
+	}
+	return resourceName
+}
+
+// effectiveIamPolicyResourceName returns the relative name of the resource
+// whose effective policy is read, defaulting to the provider project.
+func effectiveIamPolicyResourceName(d *schema.ResourceData, config *Config) (string, error) {
+	if v, ok := d.GetOk("folder"); ok {
+		return "folders/" + strings.TrimPrefix(v.(string), "folders/"), nil
+	}
+	if v, ok := d.GetOk("organization"); ok {
+		return "organizations/" + strings.TrimPrefix(v.(string), "organizations/"), nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return "", err
+	}
+	d.Set("project", project)
+	return "projects/" + project, nil
+}
@@ -0,0 +1,122 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestEffectiveIamPolicyScopeFromAncestry(t *testing.T) {
+	t.Parallel()
+
+	ancestor := func(typ, id string) *cloudresourcemanager.Ancestor {
+		return &cloudresourcemanager.Ancestor{ResourceId: &cloudresourcemanager.ResourceId{Type: typ, Id: id}}
+	}
+
+	cases := map[string]struct {
+		Ancestors []*cloudresourcemanager.Ancestor
+		Expected  string
+	}{
+		"organization": {
+			Ancestors: []*cloudresourcemanager.Ancestor{ancestor("project", "my-project"), ancestor("folder", "456"), ancestor("organization", "123")},
+			Expected:  "organizations/123",
+		},
+		"no organization": {
+			Ancestors: []*cloudresourcemanager.Ancestor{ancestor("project", "my-project")},
+			Expected:  "projects/my-project",
+		},
+		"no ancestry": {
+			Expected: "projects/my-project",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := effectiveIamPolicyScopeFromAncestry("projects/my-project", tc.Ancestors); got != tc.Expected {
+			t.Errorf("%s: expected scope %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccDataSourceGoogleIamEffectivePolicy_project(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamEffectivePolicy_project(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_iam_effective_policy.policy", "full_resource_name", "//cloudresourcemanager.googleapis.com/projects/"+project),
+					resource.TestCheckResourceAttr("data.google_iam_effective_policy.policy", "policies.0.attached_resource", "//cloudresourcemanager.googleapis.com/projects/"+project),
+					resource.TestCheckResourceAttrSet("data.google_iam_effective_policy.policy", "bindings.0.role"),
+					resource.TestCheckResourceAttrSet("data.google_iam_effective_policy.policy", "policy_data"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceGoogleIamEffectivePolicy_inherited(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceGoogleIamEffectivePolicy_inherited(project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.google_iam_effective_policy.policy", "scope", "organizations/"+org),
+					testAccCheckGoogleIamEffectivePolicyHasBindingFrom("data.google_iam_effective_policy.policy", "//cloudresourcemanager.googleapis.com/organizations/"+org),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckGoogleIamEffectivePolicyHasBindingFrom checks that a binding
+// attached to the given ancestor was read.
+func testAccCheckGoogleIamEffectivePolicyHasBindingFrom(n, attachedResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		attrs := rs.Primary.Attributes
+		for i := 0; ; i++ {
+			v, ok := attrs[fmt.Sprintf("bindings.%d.attached_resource", i)]
+			if !ok {
+				return fmt.Errorf("No binding attached to %s found in %s", attachedResource, n)
+			}
+			if v == attachedResource {
+				return nil
+			}
+		}
+	}
+}
+
+func testAccDataSourceGoogleIamEffectivePolicy_project(project string) string {
+	return fmt.Sprintf(`
+data "google_iam_effective_policy" "policy" {
+  project = "%s"
+}
+`, project)
+}
+
+func testAccDataSourceGoogleIamEffectivePolicy_inherited(project string) string {
+	return fmt.Sprintf(`
+data "google_iam_effective_policy" "policy" {
+  project = "%s"
+}
+`, project)
+}
@@ -7,8 +7,7 @@ import (
 func dataSourceGoogleKmsKeyRing() *schema.Resource {
 	dsSchema := datasourceSchemaFromResourceSchema(resourceKmsKeyRing().Schema)
 	addRequiredFieldsToSchema(dsSchema, "name")
-	addRequiredFieldsToSchema(dsSchema, "location")
-	addOptionalFieldsToSchema(dsSchema, "project")
+	addOptionalFieldsToSchema(dsSchema, "location", "project")
 
 	return &schema.Resource{
 		Read:   dataSourceGoogleKmsKeyRingRead,
@@ -24,9 +23,14 @@ func dataSourceGoogleKmsKeyRingRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	location, err := getKmsLocation(d, config)
+	if err != nil {
+		return err
+	}
+
 	keyRingId := kmsKeyRingId{
 		Name:     d.Get("name").(string),
-		Location: d.Get("location").(string),
+		Location: location,
 		Project:  project,
 	}
 	d.SetId(keyRingId.terraformId())
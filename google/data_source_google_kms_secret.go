@@ -22,6 +22,11 @@ func dataSourceGoogleKmsSecret() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"additional_authenticated_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
 			"plaintext": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -46,6 +51,10 @@ func dataSourceGoogleKmsSecretRead(d *schema.ResourceData, meta interface{}) err
 		Ciphertext: ciphertext,
 	}
 
+	if aad, ok := d.GetOk("additional_authenticated_data"); ok {
+		kmsDecryptRequest.AdditionalAuthenticatedData = base64.StdEncoding.EncodeToString([]byte(aad.(string)))
+	}
+
 	decryptResponse, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Decrypt(cryptoKeyId.cryptoKeyId(), kmsDecryptRequest).Do()
 
 	if err != nil {
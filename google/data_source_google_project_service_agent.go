@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Service agents that don't follow the
+// service-PROJECT_NUMBER@gcp-sa-SERVICE.iam.gserviceaccount.com convention.
+var wellKnownServiceAgents = map[string]string{
+	"bigquery.googleapis.com":          "bq-%d@bigquery-encryption.iam.gserviceaccount.com",
+	"cloudfunctions.googleapis.com":    "service-%d@gcf-admin-robot.iam.gserviceaccount.com",
+	"composer.googleapis.com":          "service-%d@cloudcomposer-accounts.iam.gserviceaccount.com",
+	"compute.googleapis.com":           "service-%d@compute-system.iam.gserviceaccount.com",
+	"container.googleapis.com":         "service-%d@container-engine-robot.iam.gserviceaccount.com",
+	"containerregistry.googleapis.com": "service-%d@containerregistry.iam.gserviceaccount.com",
+	"dataflow.googleapis.com":          "service-%d@dataflow-service-producer-prod.iam.gserviceaccount.com",
+	"dataproc.googleapis.com":          "service-%d@dataproc-accounts.iam.gserviceaccount.com",
+	"run.googleapis.com":               "service-%d@serverless-robot-prod.iam.gserviceaccount.com",
+	"sqladmin.googleapis.com":          "service-%d@gcp-sa-cloud-sql.iam.gserviceaccount.com",
+	"storage.googleapis.com":           "service-%d@gs-project-accounts.iam.gserviceaccount.com",
+}
+
+func dataSourceGoogleProjectServiceAgent() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGoogleProjectServiceAgentRead,
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleProjectServiceAgentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	p, err := config.clientResourceManager.Projects.Get(project).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Project %q", project))
+	}
+
+	email := serviceAgentEmail(d.Get("service").(string), p.ProjectNumber)
+
+	d.SetId(email)
+	d.Set("project", project)
+	d.Set("email", email)
+	d.Set("member", "serviceAccount:"+email)
+
+	return nil
+}
+
+func serviceAgentEmail(service string, projectNumber int64) string {
+	if format, ok := wellKnownServiceAgents[service]; ok {
+		return fmt.Sprintf(format, projectNumber)
+	}
+	return fmt.Sprintf("service-%d@gcp-sa-%s.iam.gserviceaccount.com", projectNumber, strings.TrimSuffix(service, ".googleapis.com"))
+}
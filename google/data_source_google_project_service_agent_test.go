@@ -0,0 +1,79 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestServiceAgentEmail(t *testing.T) {
+	cases := map[string]struct {
+		Service  string
+		Expected string
+	}{
+		"well known": {
+			Service:  "storage.googleapis.com",
+			Expected: "service-123@gs-project-accounts.iam.gserviceaccount.com",
+		},
+		"well known without service prefix": {
+			Service:  "bigquery.googleapis.com",
+			Expected: "bq-123@bigquery-encryption.iam.gserviceaccount.com",
+		},
+		// Not the legacy %d@cloudbuild.gserviceaccount.com build account.
+		"cloud build service agent": {
+			Service:  "cloudbuild.googleapis.com",
+			Expected: "service-123@gcp-sa-cloudbuild.iam.gserviceaccount.com",
+		},
+		"conventional": {
+			Service:  "pubsub.googleapis.com",
+			Expected: "service-123@gcp-sa-pubsub.iam.gserviceaccount.com",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := serviceAgentEmail(tc.Service, 123); got != tc.Expected {
+			t.Errorf("bad: %s, expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccDataSourceGoogleProjectServiceAgent_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_project_service_agent.pubsub"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckGoogleProjectServiceAgent_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "email"),
+					resource.TestCheckResourceAttrSet(resourceName, "member"),
+				),
+			},
+			{
+				Config: testAccCheckGoogleProjectServiceAgent_matchesStorage,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_project_service_agent.storage", "email",
+						"data.google_storage_project_service_account.gcs_account", "email_address"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckGoogleProjectServiceAgent_basic = `
+data "google_project_service_agent" "pubsub" {
+  service = "pubsub.googleapis.com"
+}
+`
+
+const testAccCheckGoogleProjectServiceAgent_matchesStorage = `
+data "google_project_service_agent" "storage" {
+  service = "storage.googleapis.com"
+}
+
+data "google_storage_project_service_account" "gcs_account" {}
+`
@@ -50,6 +50,9 @@ func testAccDataSourceGoogleProjectCheck(dataSourceName string, resourceName str
 			"org_id",
 			"folder_id",
 			"number",
+			"ancestry.#",
+			"ancestry.0.type",
+			"ancestry.0.id",
 		}
 
 		for _, attr := range projectAttrToCheck {
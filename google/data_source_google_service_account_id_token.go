@@ -0,0 +1,71 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	iamcredentials "google.golang.org/api/iamcredentials/v1"
+)
+
+func dataSourceGoogleServiceAccountIdToken() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceGoogleServiceAccountIdTokenRead,
+		Schema: map[string]*schema.Schema{
+			"target_service_account": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateRegexp("(" + strings.Join(PossibleServiceAccountNames, "|") + ")"),
+			},
+			"target_audience": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"delegates": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateRegexp(ServiceAccountLinkRegex),
+				},
+			},
+			"include_email": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"id_token": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+		},
+	}
+}
+
+func dataSourceGoogleServiceAccountIdTokenRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	log.Printf("[INFO] Acquire Service Account IdToken for %s", d.Get("target_service_account").(string))
+
+	service := config.clientIamCredentials
+
+	name := fmt.Sprintf("projects/-/serviceAccounts/%s", d.Get("target_service_account").(string))
+	tokenRequest := &iamcredentials.GenerateIdTokenRequest{
+		Audience:     d.Get("target_audience").(string),
+		Delegates:    convertStringSet(d.Get("delegates").(*schema.Set)),
+		IncludeEmail: d.Get("include_email").(bool),
+	}
+	at, err := service.Projects.ServiceAccounts.GenerateIdToken(name, tokenRequest).Do()
+	if err != nil {
+		return err
+	}
+
+	d.SetId(time.Now().UTC().String())
+	d.Set("id_token", at.Token)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"testing"
+
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceGoogleServiceAccountIdToken_basic(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "data.google_service_account_id_token.default"
+
+	targetServiceAccountEmail := getTestServiceAccountFromEnv(t)
+	targetAudience := "https://foo.bar/"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:  testAccCheckGoogleServiceAccountIdToken_datasource(targetServiceAccountEmail, targetAudience),
+				Destroy: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target_service_account", targetServiceAccountEmail),
+					resource.TestCheckResourceAttr(resourceName, "target_audience", targetAudience),
+					testAccCheckServiceAccountAccessTokenValue("id_token", targetServiceAccountEmail),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleServiceAccountIdToken_datasource(targetServiceAccountID, targetAudience string) string {
+
+	return fmt.Sprintf(`
+
+	data "google_service_account_id_token" "default" {
+		target_service_account = "%s"
+		target_audience        = "%s"
+		include_email          = true
+	}
+
+	output "id_token" {
+		value = "${data.google_service_account_id_token.default.id_token}"
+	}
+	`, targetServiceAccountID, targetAudience)
+}
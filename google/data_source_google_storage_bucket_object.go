@@ -38,6 +38,7 @@ func dataSourceGoogleStorageBucketObjectRead(d *schema.ResourceData, meta interf
 	d.Set("content_language", res["contentLanguage"])
 	d.Set("content_type", res["contentType"])
 	d.Set("crc32c", res["crc32c"])
+	d.Set("kms_key_name", res["kmsKeyName"])
 	d.Set("self_link", res["selfLink"])
 	d.Set("storage_class", res["storageClass"])
 	d.Set("md5hash", res["md5Hash"])
@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSecretManagerSecretVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecretManagerSecretVersionRead,
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"secret": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destroy_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"secret_data": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecretManagerSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	secret := d.Get("secret").(string)
+	// The secret can be given either by its full name or by its id in the project.
+	if parts := regexp.MustCompile(`^projects/([^/]+)/secrets/([^/]+)$`).FindStringSubmatch(secret); parts != nil {
+		d.Set("project", parts[1])
+		secret = parts[2]
+	} else {
+		project, err := getProject(d, config)
+		if err != nil {
+			return err
+		}
+		d.Set("project", project)
+	}
+
+	version := "latest"
+	if v, ok := d.GetOk("version"); ok {
+		version = v.(string)
+	}
+
+	name := fmt.Sprintf("projects/%s/secrets/%s/versions/%s", d.Get("project").(string), secret, version)
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s", name)
+
+	log.Printf("[DEBUG] Reading SecretVersion %q", name)
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return fmt.Errorf("Error retrieving SecretVersion %q: %s", name, err)
+	}
+
+	// Resolve aliases such as "latest" to the actual version.
+	name = res["name"].(string)
+	parts := secretManagerSecretVersionNameRegex.FindStringSubmatch(name)
+	if parts == nil {
+		return fmt.Errorf("Unexpected SecretVersion name %q", name)
+	}
+
+	data, err := accessSecretManagerSecretVersion(config, name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(name)
+	d.Set("name", name)
+	d.Set("version", parts[2])
+	d.Set("create_time", res["createTime"])
+	d.Set("destroy_time", res["destroyTime"])
+	d.Set("enabled", res["state"] == "ENABLED")
+	d.Set("secret_data", data)
+
+	return nil
+}
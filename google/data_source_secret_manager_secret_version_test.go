@@ -0,0 +1,57 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceSecretManagerSecretVersion_basic(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecretManagerSecretVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSecretManagerSecretVersion_basic(suffix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_secret_manager_secret_version.latest", "secret_data", "google_secret_manager_secret_version.secret-version-basic", "secret_data"),
+					resource.TestCheckResourceAttrPair("data.google_secret_manager_secret_version.latest", "name", "google_secret_manager_secret_version.secret-version-basic", "name"),
+					resource.TestCheckResourceAttrPair("data.google_secret_manager_secret_version.by_version", "secret_data", "google_secret_manager_secret_version.secret-version-basic", "secret_data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSecretManagerSecretVersion_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_secret_manager_secret" "secret-basic" {
+  secret_id = "tf-test-secret-version-%s"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret_version" "secret-version-basic" {
+  secret      = "${google_secret_manager_secret.secret-basic.id}"
+  secret_data = "my-tf-test-secret%s"
+}
+
+data "google_secret_manager_secret_version" "latest" {
+  secret = "${google_secret_manager_secret_version.secret-version-basic.secret}"
+}
+
+data "google_secret_manager_secret_version" "by_version" {
+  secret  = "${google_secret_manager_secret.secret-basic.secret_id}"
+  version = "${google_secret_manager_secret_version.secret-version-basic.version}"
+}
+`, suffix, suffix)
+}
@@ -25,5 +25,5 @@ func dataprocClusterOperationWait(config *Config, op *dataproc.Operation, activi
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
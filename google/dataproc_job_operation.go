@@ -68,7 +68,7 @@ func dataprocJobOperationWait(config *Config, region, projectId, jobId string, a
 		ProjectId: projectId,
 		JobId:     jobId,
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
 
 type DataprocDeleteJobOperationWaiter struct {
@@ -108,5 +108,5 @@ func dataprocDeleteOperationWait(config *Config, region, projectId, jobId string
 			JobId:     jobId,
 		},
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
@@ -0,0 +1,75 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+// tagBindingsBasePath returns the Resource Manager endpoint that manages tag
+// bindings on resources in location. Only regions and zones have their own
+// endpoint. Global resources, such as projects, and multi-region or
+// dual-region resources, such as buckets in US or NAM4, use the global one.
+func tagBindingsBasePath(location string) string {
+	if !strings.Contains(location, "-") {
+		return "https://cloudresourcemanager.googleapis.com/v3/"
+	}
+	return fmt.Sprintf("https://%s-cloudresourcemanager.googleapis.com/v3/", strings.ToLower(location))
+}
+
+// expandDefaultResourceTagBindings returns the tag bindings attaching tags to
+// parent, the full resource name of a resource. Tags are keyed by the
+// namespaced name of their key, such as "123456789/environment", and their
+// value is either the short name of a value or its "tagValues/" id.
+func expandDefaultResourceTagBindings(tags map[string]string, parent string) []map[string]interface{} {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	bindings := make([]map[string]interface{}, 0, len(tags))
+	for _, k := range keys {
+		binding := map[string]interface{}{
+			"parent": parent,
+		}
+		if v := tags[k]; strings.HasPrefix(v, "tagValues/") {
+			binding["tagValue"] = v
+		} else {
+			binding["tagValueNamespacedName"] = fmt.Sprintf("%s/%s", k, v)
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
+
+// createDefaultResourceTagBindings binds the provider's default_resource_tags
+// to a newly created resource. Bindings are only made at creation, so tags
+// later removed from the resource aren't added back.
+func createDefaultResourceTagBindings(config *Config, parent, location string, timeoutMinutes int) error {
+	if len(config.DefaultResourceTags) == 0 {
+		return nil
+	}
+
+	basePath := tagBindingsBasePath(location)
+	for _, binding := range expandDefaultResourceTagBindings(config.DefaultResourceTags, parent) {
+		log.Printf("[DEBUG] Creating default tag binding: %#v", binding)
+		res, err := sendRequest(config, "POST", basePath+"tagBindings", binding)
+		if err != nil {
+			// The binding already exists, such as when the tag was set
+			// through another binding resource.
+			if isGoogleApiErrorWithCode(err, 409) {
+				continue
+			}
+			return fmt.Errorf("Error binding default tags to %s: %s", parent, err)
+		}
+
+		err = tagsOperationWaitTime(config, res, basePath, "Creating TagBinding", timeoutMinutes)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
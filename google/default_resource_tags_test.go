@@ -0,0 +1,49 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTagBindingsBasePath(t *testing.T) {
+	cases := map[string]string{
+		"":              "https://cloudresourcemanager.googleapis.com/v3/",
+		"global":        "https://cloudresourcemanager.googleapis.com/v3/",
+		"us-central1":   "https://us-central1-cloudresourcemanager.googleapis.com/v3/",
+		"us-central1-a": "https://us-central1-a-cloudresourcemanager.googleapis.com/v3/",
+		"EUROPE-WEST1":  "https://europe-west1-cloudresourcemanager.googleapis.com/v3/",
+		"US":            "https://cloudresourcemanager.googleapis.com/v3/",
+		"EU":            "https://cloudresourcemanager.googleapis.com/v3/",
+		"NAM4":          "https://cloudresourcemanager.googleapis.com/v3/",
+		"ASIA1":         "https://cloudresourcemanager.googleapis.com/v3/",
+	}
+
+	for location, expected := range cases {
+		if actual := tagBindingsBasePath(location); actual != expected {
+			t.Errorf("tagBindingsBasePath(%q) = %q, expected %q", location, actual, expected)
+		}
+	}
+}
+
+func TestExpandDefaultResourceTagBindings(t *testing.T) {
+	parent := "//storage.googleapis.com/projects/_/buckets/my-bucket"
+	tags := map[string]string{
+		"123456789/environment": "production",
+		"my-project/team":       "tagValues/456",
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"parent":                 parent,
+			"tagValueNamespacedName": "123456789/environment/production",
+		},
+		{
+			"parent":   parent,
+			"tagValue": "tagValues/456",
+		},
+	}
+
+	if actual := expandDefaultResourceTagBindings(tags, parent); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected bindings %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,28 @@
+package google
+
+import (
+	"google.golang.org/api/googleapi"
+)
+
+// RetryErrorPredicateFunc reports whether err should be retried, along with
+// the reason to log when it is. Predicates add to the errors isRetryableError
+// already retries and are passed to the request that may hit them.
+type RetryErrorPredicateFunc func(error) (bool, string)
+
+// Resources such as addresses can't be deleted while another resource uses
+// them. A user that was just deleted can still be reported for a short while,
+// so the delete is retried until it drains or the delete timeout expires.
+func isResourceInUseByAnotherResourceError(err error) (bool, string) {
+	gerr, ok := err.(*googleapi.Error)
+	if !ok || gerr.Code != 400 {
+		return false, ""
+	}
+
+	for _, e := range gerr.Errors {
+		if e.Reason == "resourceInUseByAnotherResource" {
+			return true, "Resource is still in use by another resource"
+		}
+	}
+
+	return false, ""
+}
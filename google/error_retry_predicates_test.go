@@ -0,0 +1,49 @@
+package google
+
+import (
+	"testing"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestIsResourceInUseByAnotherResourceError(t *testing.T) {
+	cases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"in use": {
+			err: &googleapi.Error{
+				Code:   400,
+				Errors: []googleapi.ErrorItem{{Reason: "resourceInUseByAnotherResource"}},
+			},
+			expected: true,
+		},
+		"other bad request": {
+			err: &googleapi.Error{
+				Code:   400,
+				Errors: []googleapi.ErrorItem{{Reason: "invalid"}},
+			},
+			expected: false,
+		},
+		"in use with a different code": {
+			err: &googleapi.Error{
+				Code:   409,
+				Errors: []googleapi.ErrorItem{{Reason: "resourceInUseByAnotherResource"}},
+			},
+			expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if retry, _ := isResourceInUseByAnotherResourceError(tc.err); retry != tc.expected {
+			t.Errorf("%s: expected %t, got %t", tn, tc.expected, retry)
+		}
+	}
+
+	if !isRetryableError(cases["in use"].err, isResourceInUseByAnotherResourceError) {
+		t.Errorf("expected the predicate to make the error retryable")
+	}
+	if isRetryableError(cases["in use"].err) {
+		t.Errorf("expected the error not to be retryable without the predicate")
+	}
+}
@@ -2,7 +2,6 @@ package google
 
 import (
 	"fmt"
-	"regexp"
 )
 
 const (
@@ -109,7 +108,7 @@ func parseGlobalFieldValue(resourceType, fieldValue, projectSchemaField string,
 		return nil, fmt.Errorf("The global field for resource %s cannot be empty", resourceType)
 	}
 
-	r := regexp.MustCompile(fmt.Sprintf(globalLinkBasePattern, resourceType))
+	r := mustCompileRegexpCached(fmt.Sprintf(globalLinkBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &GlobalFieldValue{
 			Project: parts[1],
@@ -165,7 +164,7 @@ func parseZonalFieldValue(resourceType, fieldValue, projectSchemaField, zoneSche
 		return nil, fmt.Errorf("The zonal field for resource %s cannot be empty.", resourceType)
 	}
 
-	r := regexp.MustCompile(fmt.Sprintf(zonalLinkBasePattern, resourceType))
+	r := mustCompileRegexpCached(fmt.Sprintf(zonalLinkBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &ZonalFieldValue{
 			Project:      parts[1],
@@ -180,7 +179,7 @@ func parseZonalFieldValue(resourceType, fieldValue, projectSchemaField, zoneSche
 		return nil, err
 	}
 
-	r = regexp.MustCompile(fmt.Sprintf(zonalPartialLinkBasePattern, resourceType))
+	r = mustCompileRegexpCached(fmt.Sprintf(zonalPartialLinkBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &ZonalFieldValue{
 			Project:      project,
@@ -246,7 +245,7 @@ func parseOrganizationFieldValue(resourceType, fieldValue string, isEmptyValid b
 		return nil, fmt.Errorf("The organization field for resource %s cannot be empty", resourceType)
 	}
 
-	r := regexp.MustCompile(fmt.Sprintf(organizationBasePattern, resourceType))
+	r := mustCompileRegexpCached(fmt.Sprintf(organizationBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &OrganizationFieldValue{
 			OrgId: parts[1],
@@ -292,7 +291,7 @@ func parseRegionalFieldValue(resourceType, fieldValue, projectSchemaField, regio
 		return nil, fmt.Errorf("The regional field for resource %s cannot be empty.", resourceType)
 	}
 
-	r := regexp.MustCompile(fmt.Sprintf(regionalLinkBasePattern, resourceType))
+	r := mustCompileRegexpCached(fmt.Sprintf(regionalLinkBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &RegionalFieldValue{
 			Project:      parts[1],
@@ -307,7 +306,7 @@ func parseRegionalFieldValue(resourceType, fieldValue, projectSchemaField, regio
 		return nil, err
 	}
 
-	r = regexp.MustCompile(fmt.Sprintf(regionalPartialLinkBasePattern, resourceType))
+	r = mustCompileRegexpCached(fmt.Sprintf(regionalPartialLinkBasePattern, resourceType))
 	if parts := r.FindStringSubmatch(fieldValue); parts != nil {
 		return &RegionalFieldValue{
 			Project:      project,
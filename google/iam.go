@@ -3,6 +3,8 @@ package google
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/errwrap"
@@ -123,12 +125,12 @@ func iamPolicyReadModifyWrite(updater ResourceIamUpdater, modify iamPolicyModify
 	return nil
 }
 
-// Takes a single binding and will either overwrite the same role in a list or append it to the end
+// Takes a single binding and will either overwrite the same role and condition in a list or append it to the end
 func overwriteBinding(bindings []*cloudresourcemanager.Binding, overwrite *cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
 	var found bool
 
 	for i, b := range bindings {
-		if b.Role == overwrite.Role {
+		if b.Role == overwrite.Role && conditionKeyFromCondition(b.Condition) == conditionKeyFromCondition(overwrite.Condition) {
 			bindings[i] = overwrite
 			found = true
 			break
@@ -142,15 +144,16 @@ func overwriteBinding(bindings []*cloudresourcemanager.Binding, overwrite *cloud
 	return bindings
 }
 
-// Merge multiple Bindings such that Bindings with the same Role result in
-// a single Binding with combined Members
+// Merge multiple Bindings such that Bindings with the same Role and Condition
+// result in a single Binding with combined Members
 func mergeBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
 	bm := rolesToMembersMap(bindings)
 	rb := make([]*cloudresourcemanager.Binding, 0)
 
-	for role, members := range bm {
+	for key, members := range bm {
 		var b cloudresourcemanager.Binding
-		b.Role = role
+		b.Role = key.Role
+		b.Condition = key.Condition.Expr()
 		b.Members = make([]string, 0)
 		for m := range members {
 			b.Members = append(b.Members, m)
@@ -163,24 +166,245 @@ func mergeBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourceman
 	return rb
 }
 
-// Map a role to a map of members, allowing easy merging of multiple bindings.
-func rolesToMembersMap(bindings []*cloudresourcemanager.Binding) map[string]map[string]bool {
-	bm := make(map[string]map[string]bool)
+// canonicalIamBindings merges bindings and orders them by role and condition,
+// with their members normalized and sorted. Serializing policies this way
+// means a changed policy_data only differs in the members and roles that
+// actually changed, rather than in the order the API happened to return.
+func canonicalIamBindings(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
+	rb := mergeBindings(bindings)
+	sort.Sort(sortableBindings(rb))
+	for _, b := range rb {
+		sort.Strings(b.Members)
+	}
+	return rb
+}
+
+// Map a role and condition to a map of members, allowing easy merging of multiple bindings.
+func rolesToMembersMap(bindings []*cloudresourcemanager.Binding) map[iamBindingKey]map[string]bool {
+	bm := make(map[iamBindingKey]map[string]bool)
 	// Get each binding
 	for _, b := range bindings {
+		key := iamBindingKey{Role: b.Role, Condition: conditionKeyFromCondition(b.Condition)}
 		// Initialize members map
-		if _, ok := bm[b.Role]; !ok {
-			bm[b.Role] = make(map[string]bool)
+		if _, ok := bm[key]; !ok {
+			bm[key] = make(map[string]bool)
 		}
 		// Get each member (user/principal) for the binding
 		for _, m := range b.Members {
 			// Add the member
-			bm[b.Role][m] = true
+			bm[key][normalizeIamMemberCasing(m)] = true
 		}
 	}
 	return bm
 }
 
+// The member types whose identifier is an email address or domain name. IAM
+// treats these case-insensitively and returns them lowercased.
+var iamCaseInsensitiveMemberTypes = []string{"user", "serviceAccount", "group", "domain"}
+
+const iamDeletedMemberPrefix = "deleted:"
+
+// normalizeIamMemberCasing returns member in the form the IAM API returns it,
+// so that "User:Jane@Example.com" and "user:jane@example.com" compare equal.
+// Members of a deleted principal ("deleted:serviceAccount:...?uid=123") are
+// normalized on the wrapped member. Other member types are left untouched.
+func normalizeIamMemberCasing(member string) string {
+	if strings.HasPrefix(strings.ToLower(member), iamDeletedMemberPrefix) {
+		return iamDeletedMemberPrefix + normalizeIamMemberCasing(member[len(iamDeletedMemberPrefix):])
+	}
+
+	parts := strings.SplitN(member, ":", 2)
+	if len(parts) != 2 {
+		return member
+	}
+	for _, t := range iamCaseInsensitiveMemberTypes {
+		if !strings.EqualFold(parts[0], t) {
+			continue
+		}
+		// Deleted principals carry a "?uid=" suffix which must keep its case.
+		id, suffix := parts[1], ""
+		if i := strings.Index(id, "?"); i >= 0 {
+			id, suffix = id[:i], id[i:]
+		}
+		return t + ":" + strings.ToLower(id) + suffix
+	}
+	return member
+}
+
+func isDeletedIamMember(member string) bool {
+	return strings.HasPrefix(strings.ToLower(member), iamDeletedMemberPrefix)
+}
+
+// pruneDeletedIamMembers returns members without those of deleted principals.
+func pruneDeletedIamMembers(members []string) []string {
+	pruned := make([]string, 0, len(members))
+	for _, m := range members {
+		if isDeletedIamMember(m) {
+			continue
+		}
+		pruned = append(pruned, m)
+	}
+	return pruned
+}
+
+// pruneDeletedIamBindingMembers removes members of deleted principals from
+// bindings, dropping any binding that is left without members since an empty
+// binding would otherwise show up as a diff.
+func pruneDeletedIamBindingMembers(bindings []*cloudresourcemanager.Binding) []*cloudresourcemanager.Binding {
+	pruned := make([]*cloudresourcemanager.Binding, 0, len(bindings))
+	for _, b := range bindings {
+		b.Members = pruneDeletedIamMembers(b.Members)
+		if len(b.Members) == 0 {
+			continue
+		}
+		pruned = append(pruned, b)
+	}
+	return pruned
+}
+
+// iamMemberHash hashes members of an IAM binding set by their normalized form,
+// so that plans only show members that were actually added or removed.
+func iamMemberHash(v interface{}) int {
+	return schema.HashString(normalizeIamMemberCasing(v.(string)))
+}
+
+func iamMemberDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeIamMemberCasing(old) == normalizeIamMemberCasing(new)
+}
+
+// iamBindingKey identifies a binding within a policy. Since IAM Conditions
+// were introduced, a policy may hold several bindings for the same role as
+// long as their conditions differ.
+type iamBindingKey struct {
+	Role      string
+	Condition conditionKey
+}
+
+// conditionKey is a comparable representation of an IAM Condition.
+type conditionKey struct {
+	Description string
+	Expression  string
+	Title       string
+}
+
+func conditionKeyFromCondition(condition *cloudresourcemanager.Expr) conditionKey {
+	if condition == nil {
+		return conditionKey{}
+	}
+	return conditionKey{
+		Description: condition.Description,
+		Expression:  condition.Expression,
+		Title:       condition.Title,
+	}
+}
+
+func (k conditionKey) Empty() bool {
+	return k == conditionKey{}
+}
+
+func (k conditionKey) Expr() *cloudresourcemanager.Expr {
+	if k.Empty() {
+		return nil
+	}
+	return &cloudresourcemanager.Expr{
+		Description: k.Description,
+		Expression:  k.Expression,
+		Title:       k.Title,
+	}
+}
+
+// The IAM policy version to request and send when a resource supports IAM
+// Conditions. Policies containing conditional bindings must use version 3.
+const iamPolicyVersion = 3
+
+// IamSettings holds optional behaviour for the generic IAM binding and member
+// resources.
+type IamSettings struct {
+	EnableConditions    bool
+	PruneDeletedMembers bool
+}
+
+// IamWithConditions adds the `condition` block to IAM binding and member
+// resources. Only use it for resources whose updater reads and writes
+// version 3 policies.
+func IamWithConditions() func(*IamSettings) {
+	return func(s *IamSettings) {
+		s.EnableConditions = true
+	}
+}
+
+// IamWithDeletedMemberPruning hides members of deleted principals
+// ("deleted:serviceAccount:...") when reading IAM binding and policy
+// resources. The API keeps these members on a binding after the principal is
+// deleted, so without pruning they show up as a perpetual diff. They are
+// dropped from the remote policy the next time the binding or policy is
+// written.
+func IamWithDeletedMemberPruning() func(*IamSettings) {
+	return func(s *IamSettings) {
+		s.PruneDeletedMembers = true
+	}
+}
+
+func newIamSettings(options ...func(*IamSettings)) *IamSettings {
+	settings := &IamSettings{}
+	for _, o := range options {
+		o(settings)
+	}
+	return settings
+}
+
+var iamConditionSchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	ForceNew: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"expression": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	},
+}
+
+func expandIamCondition(v interface{}) *cloudresourcemanager.Expr {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	original := l[0].(map[string]interface{})
+	return &cloudresourcemanager.Expr{
+		Description: original["description"].(string),
+		Expression:  original["expression"].(string),
+		Title:       original["title"].(string),
+	}
+}
+
+func flattenIamCondition(condition *cloudresourcemanager.Expr) []map[string]interface{} {
+	if conditionKeyFromCondition(condition).Empty() {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"expression":  condition.Expression,
+			"title":       condition.Title,
+			"description": condition.Description,
+		},
+	}
+}
+
 // Merge multiple Audit Configs such that configs with the same service result in
 // a single exemption list with combined members
 func mergeAuditConfigs(auditConfigs []*cloudresourcemanager.AuditConfig) []*cloudresourcemanager.AuditConfig {
@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamArtifactRegistryRepositorySchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"repository": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type ArtifactRegistryRepositoryIamUpdater struct {
+	project    string
+	location   string
+	repository string
+	Config     *Config
+}
+
+func NewArtifactRegistryRepositoryIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArtifactRegistryRepositoryIamUpdater{
+		project:    project,
+		location:   d.Get("location").(string),
+		repository: d.Get("repository").(string),
+		Config:     config,
+	}, nil
+}
+
+func ArtifactRegistryRepositoryIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/repositories/(?P<repository>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<repository>[^/]+)",
+		"(?P<location>[^/]+)/(?P<repository>[^/]+)",
+	}, d, config)
+}
+
+func (u *ArtifactRegistryRepositoryIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://artifactregistry.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "POST", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert an Artifact Registry policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *ArtifactRegistryRepositoryIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://artifactregistry.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *ArtifactRegistryRepositoryIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/locations/%s/repositories/%s", u.project, u.location, u.repository)
+}
+
+func (u *ArtifactRegistryRepositoryIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-artifactregistry-repository-%s", u.GetResourceId())
+}
+
+func (u *ArtifactRegistryRepositoryIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("artifactregistry repository %q", u.GetResourceId())
+}
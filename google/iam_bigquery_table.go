@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamBigQueryTableSchema = map[string]*schema.Schema{
+	"dataset_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"table_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type BigQueryTableIamUpdater struct {
+	project   string
+	datasetId string
+	tableId   string
+	Config    *Config
+}
+
+func NewBigQueryTableIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BigQueryTableIamUpdater{
+		project:   project,
+		datasetId: d.Get("dataset_id").(string),
+		tableId:   d.Get("table_id").(string),
+		Config:    config,
+	}, nil
+}
+
+func BigQueryTableIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)/tables/(?P<table_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<dataset_id>[^/]+)/(?P<table_id>[^/]+)",
+		"(?P<dataset_id>[^/]+)/(?P<table_id>[^/]+)",
+	}, d, config)
+}
+
+// The vendored bigquery client has no IAM methods, so table policies are read
+// and written through the JSON API.
+func (u *BigQueryTableIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://bigquery.googleapis.com/bigquery/v2/%s:getIamPolicy", u.GetResourceId())
+
+	obj := map[string]interface{}{
+		"options": map[string]interface{}{
+			"requestedPolicyVersion": iamPolicyVersion,
+		},
+	}
+
+	res, err := sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a bigquery policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *BigQueryTableIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	// Conditional bindings can only be written to a version 3 policy.
+	policy.Version = iamPolicyVersion
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://bigquery.googleapis.com/bigquery/v2/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *BigQueryTableIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/datasets/%s/tables/%s", u.project, u.datasetId, u.tableId)
+}
+
+func (u *BigQueryTableIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-bigquery-table-%s", u.GetResourceId())
+}
+
+func (u *BigQueryTableIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("bigquery table %q", u.GetResourceId())
+}
@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamCloudRunServiceSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"service": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type CloudRunServiceIamUpdater struct {
+	project  string
+	location string
+	service  string
+	Config   *Config
+}
+
+func NewCloudRunServiceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CloudRunServiceIamUpdater{
+		project:  project,
+		location: d.Get("location").(string),
+		service:  d.Get("service").(string),
+		Config:   config,
+	}, nil
+}
+
+func CloudRunServiceIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/services/(?P<service>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<service>[^/]+)",
+		"(?P<location>[^/]+)/(?P<service>[^/]+)",
+	}, d, config)
+}
+
+func (u *CloudRunServiceIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://run.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "GET", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a Cloud Run policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *CloudRunServiceIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://run.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *CloudRunServiceIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/locations/%s/services/%s", u.project, u.location, u.service)
+}
+
+func (u *CloudRunServiceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-cloudrun-service-%s", u.GetResourceId())
+}
+
+func (u *CloudRunServiceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("cloudrun service %q", u.GetResourceId())
+}
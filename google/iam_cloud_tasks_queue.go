@@ -0,0 +1,105 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamCloudTasksQueueSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type CloudTasksQueueIamUpdater struct {
+	project  string
+	location string
+	name     string
+	Config   *Config
+}
+
+func NewCloudTasksQueueIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CloudTasksQueueIamUpdater{
+		project:  project,
+		location: d.Get("location").(string),
+		name:     d.Get("name").(string),
+		Config:   config,
+	}, nil
+}
+
+func CloudTasksQueueIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/queues/(?P<name>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)",
+		"(?P<location>[^/]+)/(?P<name>[^/]+)",
+	}, d, config)
+}
+
+func (u *CloudTasksQueueIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://cloudtasks.googleapis.com/v2/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "POST", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a Cloud Tasks policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *CloudTasksQueueIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://cloudtasks.googleapis.com/v2/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *CloudTasksQueueIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/locations/%s/queues/%s", u.project, u.location, u.name)
+}
+
+func (u *CloudTasksQueueIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-cloudtasks-queue-%s", u.GetResourceId())
+}
+
+func (u *CloudTasksQueueIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("cloudtasks queue %q", u.GetResourceId())
+}
@@ -0,0 +1,117 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamIapTunnelInstanceSchema = map[string]*schema.Schema{
+	"instance": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: compareSelfLinkOrResourceName,
+	},
+	"zone": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type IapTunnelInstanceIamUpdater struct {
+	project  string
+	zone     string
+	instance string
+	Config   *Config
+}
+
+func NewIapTunnelInstanceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Both fields are computed, so record the provider defaults that were used.
+	d.Set("project", project)
+	d.Set("zone", zone)
+
+	return &IapTunnelInstanceIamUpdater{
+		project:  project,
+		zone:     zone,
+		instance: GetResourceNameFromSelfLink(d.Get("instance").(string)),
+		Config:   config,
+	}, nil
+}
+
+func IapTunnelInstanceIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/iap_tunnel/zones/(?P<zone>[^/]+)/instances/(?P<instance>[^/]+)",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<instance>[^/]+)",
+		"(?P<zone>[^/]+)/(?P<instance>[^/]+)",
+		"(?P<instance>[^/]+)",
+	}, d, config)
+}
+
+func (u *IapTunnelInstanceIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://iap.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "POST", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert an IAP policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *IapTunnelInstanceIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://iap.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *IapTunnelInstanceIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/iap_tunnel/zones/%s/instances/%s", u.project, u.zone, u.instance)
+}
+
+func (u *IapTunnelInstanceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-iap-tunnel-instance-%s", u.GetResourceId())
+}
+
+func (u *IapTunnelInstanceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("iap tunnel instance %q", u.GetResourceId())
+}
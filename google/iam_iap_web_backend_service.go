@@ -0,0 +1,99 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamIapWebBackendServiceSchema = map[string]*schema.Schema{
+	"web_backend_service": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: compareSelfLinkOrResourceName,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type IapWebBackendServiceIamUpdater struct {
+	project           string
+	webBackendService string
+	Config            *Config
+}
+
+func NewIapWebBackendServiceIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IapWebBackendServiceIamUpdater{
+		project:           project,
+		webBackendService: GetResourceNameFromSelfLink(d.Get("web_backend_service").(string)),
+		Config:            config,
+	}, nil
+}
+
+func IapWebBackendServiceIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/iap_web/compute/services/(?P<web_backend_service>[^/]+)",
+		"(?P<project>[^/]+)/(?P<web_backend_service>[^/]+)",
+		"(?P<web_backend_service>[^/]+)",
+	}, d, config)
+}
+
+func (u *IapWebBackendServiceIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://iap.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "POST", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert an IAP policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *IapWebBackendServiceIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://iap.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *IapWebBackendServiceIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/iap_web/compute/services/%s", u.project, u.webBackendService)
+}
+
+func (u *IapWebBackendServiceIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-iap-web-backend-service-%s", u.GetResourceId())
+}
+
+func (u *IapWebBackendServiceIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("iap web backend service %q", u.GetResourceId())
+}
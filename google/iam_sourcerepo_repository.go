@@ -0,0 +1,97 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamSourceRepoRepositorySchema = map[string]*schema.Schema{
+	"repository": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type SourceRepoRepositoryIamUpdater struct {
+	project    string
+	repository string
+	Config     *Config
+}
+
+func NewSourceRepoRepositoryIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SourceRepoRepositoryIamUpdater{
+		project:    project,
+		repository: d.Get("repository").(string),
+		Config:     config,
+	}, nil
+}
+
+func SourceRepoRepositoryIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/repos/(?P<repository>.+)",
+		"(?P<repository>.+)",
+	}, d, config)
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://sourcerepo.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "GET", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a Source Repositories policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *SourceRepoRepositoryIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://sourcerepo.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/repos/%s", u.project, u.repository)
+}
+
+func (u *SourceRepoRepositoryIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-sourcerepo-repository-%s", u.GetResourceId())
+}
+
+func (u *SourceRepoRepositoryIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("sourcerepo repository %q", u.GetResourceId())
+}
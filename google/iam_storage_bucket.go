@@ -2,10 +2,10 @@ package google
 
 import (
 	"fmt"
+
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudresourcemanager/v1"
-	"google.golang.org/api/storage/v1"
 )
 
 var IamStorageBucketSchema = map[string]*schema.Schema{
@@ -30,14 +30,20 @@ func NewStorageBucketIamUpdater(d *schema.ResourceData, config *Config) (Resourc
 	}, nil
 }
 
+func StorageBucketIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{"b/(?P<bucket>[^/]+)", "(?P<bucket>[^/]+)"}, d, config)
+}
+
+// The vendored storage client predates IAM Conditions: its Policy has no version
+// and can't request one, so policies are read and written through the JSON API.
 func (u *StorageBucketIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
-	p, err := u.Config.clientStorage.Buckets.GetIamPolicy(u.bucket).Do()
+	p, err := u.getStoragePolicy()
 	if err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	cloudResourcePolicy, err := storageToResourceManagerPolicy(p)
-	if err != nil {
+	cloudResourcePolicy := &cloudresourcemanager.Policy{}
+	if err := Convert(p, cloudResourcePolicy); err != nil {
 		return nil, errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
@@ -45,19 +51,21 @@ func (u *StorageBucketIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.
 }
 
 func (u *StorageBucketIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
-	storagePolicy, err := resourceManagerToStoragePolicy(policy)
-
+	// Conditional bindings can only be written to a version 3 policy.
+	policy.Version = iamPolicyVersion
+	storagePolicy, err := ConvertToMap(policy)
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Invalid IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
 
-	ppolicy, err := u.Config.clientStorage.Buckets.GetIamPolicy(u.bucket).Do()
+	ppolicy, err := u.getStoragePolicy()
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
-	storagePolicy.Etag = ppolicy.Etag
-	_, err = u.Config.clientStorage.Buckets.SetIamPolicy(u.bucket, storagePolicy).Do()
+	storagePolicy["etag"] = ppolicy["etag"]
 
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s/iam", u.bucket)
+	_, err = sendRequest(u.Config, "PUT", url, storagePolicy)
 	if err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
 	}
@@ -65,6 +73,11 @@ func (u *StorageBucketIamUpdater) SetResourceIamPolicy(policy *cloudresourcemana
 	return nil
 }
 
+func (u *StorageBucketIamUpdater) getStoragePolicy() (map[string]interface{}, error) {
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s/iam?optionsRequestedPolicyVersion=%d", u.bucket, iamPolicyVersion)
+	return sendRequest(u.Config, "GET", url, nil)
+}
+
 func (u *StorageBucketIamUpdater) GetResourceId() string {
 	return u.bucket
 }
@@ -76,21 +89,3 @@ func (u *StorageBucketIamUpdater) GetMutexKey() string {
 func (u *StorageBucketIamUpdater) DescribeResource() string {
 	return fmt.Sprintf("Storage Bucket %q", u.bucket)
 }
-
-func resourceManagerToStoragePolicy(p *cloudresourcemanager.Policy) (*storage.Policy, error) {
-	out := &storage.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a v1 policy to a storage policy: {{err}}", err)
-	}
-	return out, nil
-}
-
-func storageToResourceManagerPolicy(p *storage.Policy) (*cloudresourcemanager.Policy, error) {
-	out := &cloudresourcemanager.Policy{}
-	err := Convert(p, out)
-	if err != nil {
-		return nil, errwrap.Wrapf("Cannot convert a storage policy to a v1 policy: {{err}}", err)
-	}
-	return out, nil
-}
@@ -0,0 +1,119 @@
+package google
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+var IamWorkstationsWorkstationSchema = map[string]*schema.Schema{
+	"location": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"workstation_cluster_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"workstation_config_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"workstation_id": {
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	},
+	"project": {
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+	},
+}
+
+type WorkstationsWorkstationIamUpdater struct {
+	project              string
+	location             string
+	workstationClusterId string
+	workstationConfigId  string
+	workstationId        string
+	Config               *Config
+}
+
+func NewWorkstationsWorkstationIamUpdater(d *schema.ResourceData, config *Config) (ResourceIamUpdater, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkstationsWorkstationIamUpdater{
+		project:              project,
+		location:             d.Get("location").(string),
+		workstationClusterId: d.Get("workstation_cluster_id").(string),
+		workstationConfigId:  d.Get("workstation_config_id").(string),
+		workstationId:        d.Get("workstation_id").(string),
+		Config:               config,
+	}, nil
+}
+
+func WorkstationsWorkstationIdParseFunc(d *schema.ResourceData, config *Config) error {
+	return parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workstationClusters/(?P<workstation_cluster_id>[^/]+)/workstationConfigs/(?P<workstation_config_id>[^/]+)/workstations/(?P<workstation_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<workstation_cluster_id>[^/]+)/(?P<workstation_config_id>[^/]+)/(?P<workstation_id>[^/]+)",
+	}, d, config)
+}
+
+func (u *WorkstationsWorkstationIamUpdater) GetResourceIamPolicy() (*cloudresourcemanager.Policy, error) {
+	url := fmt.Sprintf("https://workstations.googleapis.com/v1/%s:getIamPolicy", u.GetResourceId())
+
+	res, err := sendRequest(u.Config, "GET", url, nil)
+	if err != nil {
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	out := &cloudresourcemanager.Policy{}
+	if err := Convert(res, out); err != nil {
+		return nil, errwrap.Wrapf("Cannot convert a workstations policy to a v1 policy: {{err}}", err)
+	}
+
+	return out, nil
+}
+
+func (u *WorkstationsWorkstationIamUpdater) SetResourceIamPolicy(policy *cloudresourcemanager.Policy) error {
+	json, err := ConvertToMap(policy)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"policy": json,
+	}
+
+	url := fmt.Sprintf("https://workstations.googleapis.com/v1/%s:setIamPolicy", u.GetResourceId())
+
+	_, err = sendRequest(u.Config, "POST", url, obj)
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error setting IAM policy for %s: {{err}}", u.DescribeResource()), err)
+	}
+
+	return nil
+}
+
+func (u *WorkstationsWorkstationIamUpdater) GetResourceId() string {
+	return fmt.Sprintf("projects/%s/locations/%s/workstationClusters/%s/workstationConfigs/%s/workstations/%s",
+		u.project, u.location, u.workstationClusterId, u.workstationConfigId, u.workstationId)
+}
+
+func (u *WorkstationsWorkstationIamUpdater) GetMutexKey() string {
+	return fmt.Sprintf("iam-workstations-workstation-%s", u.GetResourceId())
+}
+
+func (u *WorkstationsWorkstationIamUpdater) DescribeResource() string {
+	return fmt.Sprintf("workstations workstation %q", u.GetResourceId())
+}
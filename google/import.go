@@ -3,7 +3,6 @@ package google
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"strconv"
 	"strings"
 )
@@ -17,7 +16,7 @@ import (
 // - (?P<name>[^/]+) (applied last)
 func parseImportId(idRegexes []string, d TerraformResourceData, config *Config) error {
 	for _, idFormat := range idRegexes {
-		re, err := regexp.Compile(idFormat)
+		re, err := compileRegexpCached(idFormat)
 
 		if err != nil {
 			log.Printf("[DEBUG] Could not compile %s.", idFormat)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,7 +18,7 @@ var mutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a terraform.ResourceProvider.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"credentials": {
 				Type:     schema.TypeString,
@@ -69,40 +70,88 @@ func Provider() terraform.ResourceProvider {
 					"CLOUDSDK_COMPUTE_ZONE",
 				}, nil),
 			},
+
+			"storage_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_STORAGE_LOCATION",
+				}, nil),
+			},
+
+			"kms_location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_KMS_LOCATION",
+				}, nil),
+			},
+
+			"default_resource_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"scopes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"read_cache_ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_READ_CACHE_TTL",
+				}, nil),
+				ValidateFunc: validateDuration(),
+			},
+
+			"universe_domain": {
+				Type:     schema.TypeString,
+				Optional: true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{
+					"GOOGLE_UNIVERSE_DOMAIN",
+				}, nil),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"google_active_folder":                            dataSourceGoogleActiveFolder(),
 			"google_billing_account":                          dataSourceGoogleBillingAccount(),
 			"google_dns_managed_zone":                         dataSourceDnsManagedZone(),
+			"google_dns_record_set":                           dataSourceDnsRecordSet(),
 			"google_client_config":                            dataSourceGoogleClientConfig(),
 			"google_client_openid_userinfo":                   dataSourceGoogleClientOpenIDUserinfo(),
+			"google_cloud_run_service":                        dataSourceGoogleCloudRunService(),
 			"google_cloudfunctions_function":                  dataSourceGoogleCloudFunctionsFunction(),
+			"google_compute_accelerator_types":                dataSourceGoogleComputeAcceleratorTypes(),
 			"google_compute_address":                          dataSourceGoogleComputeAddress(),
 			"google_compute_backend_service":                  dataSourceGoogleComputeBackendService(),
 			"google_compute_default_service_account":          dataSourceGoogleComputeDefaultServiceAccount(),
+			"google_compute_disk":                             dataSourceGoogleComputeDisk(),
 			"google_compute_forwarding_rule":                  dataSourceGoogleComputeForwardingRule(),
 			"google_compute_image":                            dataSourceGoogleComputeImage(),
 			"google_compute_instance":                         dataSourceGoogleComputeInstance(),
 			"google_compute_global_address":                   dataSourceGoogleComputeGlobalAddress(),
 			"google_compute_instance_group":                   dataSourceGoogleComputeInstanceGroup(),
 			"google_compute_lb_ip_ranges":                     dataSourceGoogleComputeLbIpRanges(),
+			"google_compute_machine_types":                    dataSourceGoogleComputeMachineTypes(),
 			"google_compute_network":                          dataSourceGoogleComputeNetwork(),
 			"google_compute_regions":                          dataSourceGoogleComputeRegions(),
 			"google_compute_region_instance_group":            dataSourceGoogleComputeRegionInstanceGroup(),
+			"google_compute_snapshot":                         dataSourceGoogleComputeSnapshot(),
 			"google_compute_subnetwork":                       dataSourceGoogleComputeSubnetwork(),
 			"google_compute_zones":                            dataSourceGoogleComputeZones(),
 			"google_compute_vpn_gateway":                      dataSourceGoogleComputeVpnGateway(),
 			"google_compute_ssl_policy":                       dataSourceGoogleComputeSslPolicy(),
 			"google_container_cluster":                        dataSourceGoogleContainerCluster(),
 			"google_container_engine_versions":                dataSourceGoogleContainerEngineVersions(),
+			"google_container_registry":                       dataSourceGoogleContainerRegistry(),
 			"google_container_registry_repository":            dataSourceGoogleContainerRepo(),
 			"google_container_registry_image":                 dataSourceGoogleContainerImage(),
+			"google_iam_effective_policy":                     dataSourceGoogleIamEffectivePolicy(),
 			"google_iam_policy":                               dataSourceGoogleIamPolicy(),
 			"google_iam_role":                                 dataSourceGoogleIamRole(),
 			"google_kms_secret":                               dataSourceGoogleKmsSecret(),
@@ -110,14 +159,19 @@ func Provider() terraform.ResourceProvider {
 			"google_kms_crypto_key":                           dataSourceGoogleKmsCryptoKey(),
 			"google_folder":                                   dataSourceGoogleFolder(),
 			"google_folder_organization_policy":               dataSourceGoogleFolderOrganizationPolicy(),
+			"google_gke_hub_feature":                          dataSourceGoogleGKEHubFeature(),
+			"google_gke_hub_membership":                       dataSourceGoogleGKEHubMembership(),
 			"google_netblock_ip_ranges":                       dataSourceGoogleNetblockIpRanges(),
 			"google_organization":                             dataSourceGoogleOrganization(),
 			"google_project":                                  dataSourceGoogleProject(),
 			"google_projects":                                 dataSourceGoogleProjects(),
 			"google_project_organization_policy":              dataSourceGoogleProjectOrganizationPolicy(),
 			"google_project_services":                         dataSourceGoogleProjectServices(),
+			"google_project_service_agent":                    dataSourceGoogleProjectServiceAgent(),
+			"google_secret_manager_secret_version":            dataSourceSecretManagerSecretVersion(),
 			"google_service_account":                          dataSourceGoogleServiceAccount(),
 			"google_service_account_access_token":             dataSourceGoogleServiceAccountAccessToken(),
+			"google_service_account_id_token":                 dataSourceGoogleServiceAccountIdToken(),
 			"google_service_account_key":                      dataSourceGoogleServiceAccountKey(),
 			"google_storage_bucket_object":                    dataSourceGoogleStorageBucketObject(),
 			"google_storage_object_signed_url":                dataSourceGoogleSignedUrl(),
@@ -127,9 +181,20 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: ResourceMap(),
+	}
 
-		ConfigureFunc: providerConfigure,
+	for _, r := range provider.DataSourcesMap {
+		cacheReads(r)
 	}
+	for _, r := range provider.ResourcesMap {
+		cacheReads(r)
+	}
+
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider)
+	}
+
+	return provider
 }
 
 func ResourceMap() map[string]*schema.Resource {
@@ -154,123 +219,248 @@ func ResourceMapWithErrors() (map[string]*schema.Resource, error) {
 		GeneratedTpuResourcesMap,
 		GeneratedMonitoringResourcesMap,
 		map[string]*schema.Resource{
-			"google_app_engine_application":                resourceAppEngineApplication(),
-			"google_bigquery_dataset":                      resourceBigQueryDataset(),
-			"google_bigquery_table":                        resourceBigQueryTable(),
-			"google_bigtable_instance":                     resourceBigtableInstance(),
-			"google_bigtable_table":                        resourceBigtableTable(),
-			"google_billing_account_iam_binding":           ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_billing_account_iam_member":            ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_billing_account_iam_policy":            ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
-			"google_cloudfunctions_function":               resourceCloudFunctionsFunction(),
-			"google_cloudiot_registry":                     resourceCloudIoTRegistry(),
-			"google_composer_environment":                  resourceComposerEnvironment(),
-			"google_compute_attached_disk":                 resourceComputeAttachedDisk(),
-			"google_compute_global_forwarding_rule":        resourceComputeGlobalForwardingRule(),
-			"google_compute_instance":                      resourceComputeInstance(),
-			"google_compute_instance_from_template":        resourceComputeInstanceFromTemplate(),
-			"google_compute_instance_group":                resourceComputeInstanceGroup(),
-			"google_compute_instance_group_manager":        resourceComputeInstanceGroupManager(),
-			"google_compute_instance_template":             resourceComputeInstanceTemplate(),
-			"google_compute_network_peering":               resourceComputeNetworkPeering(),
-			"google_compute_project_metadata":              resourceComputeProjectMetadata(),
-			"google_compute_project_metadata_item":         resourceComputeProjectMetadataItem(),
-			"google_compute_region_backend_service":        resourceComputeRegionBackendService(),
-			"google_compute_region_instance_group_manager": resourceComputeRegionInstanceGroupManager(),
-			"google_compute_router_interface":              resourceComputeRouterInterface(),
-			"google_compute_router_nat":                    resourceComputeRouterNat(),
-			"google_compute_router_peer":                   resourceComputeRouterPeer(),
-			"google_compute_security_policy":               resourceComputeSecurityPolicy(),
-			"google_compute_shared_vpc_host_project":       resourceComputeSharedVpcHostProject(),
-			"google_compute_shared_vpc_service_project":    resourceComputeSharedVpcServiceProject(),
-			"google_compute_target_pool":                   resourceComputeTargetPool(),
-			"google_container_cluster":                     resourceContainerCluster(),
-			"google_container_node_pool":                   resourceContainerNodePool(),
-			"google_dataflow_job":                          resourceDataflowJob(),
-			"google_dataproc_cluster":                      resourceDataprocCluster(),
-			"google_dataproc_job":                          resourceDataprocJob(),
-			"google_dns_record_set":                        resourceDnsRecordSet(),
-			"google_endpoints_service":                     resourceEndpointsService(),
-			"google_folder":                                resourceGoogleFolder(),
-			"google_folder_iam_binding":                    ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_iam_member":                     ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_iam_policy":                     ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
-			"google_folder_organization_policy":            resourceGoogleFolderOrganizationPolicy(),
-			"google_logging_billing_account_sink":          resourceLoggingBillingAccountSink(),
-			"google_logging_billing_account_exclusion":     ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
-			"google_logging_organization_sink":             resourceLoggingOrganizationSink(),
-			"google_logging_organization_exclusion":        ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
-			"google_logging_folder_sink":                   resourceLoggingFolderSink(),
-			"google_logging_folder_exclusion":              ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
-			"google_logging_project_sink":                  resourceLoggingProjectSink(),
-			"google_logging_project_exclusion":             ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
-			"google_kms_key_ring":                          resourceKmsKeyRing(),
-			"google_kms_key_ring_iam_binding":              ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_key_ring_iam_member":               ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_key_ring_iam_policy":               ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
-			"google_kms_crypto_key":                        resourceKmsCryptoKey(),
-			"google_kms_crypto_key_iam_binding":            ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-			"google_kms_crypto_key_iam_member":             ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
-			"google_spanner_instance_iam_binding":          ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_instance_iam_member":           ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_instance_iam_policy":           ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
-			"google_spanner_database_iam_binding":          ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_spanner_database_iam_member":           ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_spanner_database_iam_policy":           ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
-			"google_sql_database":                          resourceSqlDatabase(),
-			"google_sql_database_instance":                 resourceSqlDatabaseInstance(),
-			"google_sql_ssl_cert":                          resourceSqlSslCert(),
-			"google_sql_user":                              resourceSqlUser(),
-			"google_organization_iam_binding":              ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_iam_custom_role":          resourceGoogleOrganizationIamCustomRole(),
-			"google_organization_iam_member":               ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_iam_policy":               ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
-			"google_organization_policy":                   resourceGoogleOrganizationPolicy(),
-			"google_project":                               resourceGoogleProject(),
-			"google_project_iam_policy":                    resourceGoogleProjectIamPolicy(),
-			"google_project_iam_binding":                   ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_iam_member":                    ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_iam_audit_config":              ResourceIamAuditConfigWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
-			"google_project_service":                       resourceGoogleProjectService(),
-			"google_project_iam_custom_role":               resourceGoogleProjectIamCustomRole(),
-			"google_project_organization_policy":           resourceGoogleProjectOrganizationPolicy(),
-			"google_project_usage_export_bucket":           resourceProjectUsageBucket(),
-			"google_project_services":                      resourceGoogleProjectServices(),
-			"google_pubsub_topic_iam_binding":              ResourceIamBindingWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-			"google_pubsub_topic_iam_member":               ResourceIamMemberWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-			"google_pubsub_topic_iam_policy":               ResourceIamPolicyWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
-			"google_pubsub_subscription_iam_binding":       ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_pubsub_subscription_iam_member":        ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_pubsub_subscription_iam_policy":        ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
-			"google_runtimeconfig_config":                  resourceRuntimeconfigConfig(),
-			"google_runtimeconfig_variable":                resourceRuntimeconfigVariable(),
-			"google_service_account":                       resourceGoogleServiceAccount(),
-			"google_service_account_iam_binding":           ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_iam_member":            ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_iam_policy":            ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
-			"google_service_account_key":                   resourceGoogleServiceAccountKey(),
-			"google_storage_bucket":                        resourceStorageBucket(),
-			"google_storage_bucket_acl":                    resourceStorageBucketAcl(),
+			"google_api_gateway_api":                          resourceApiGatewayApi(),
+			"google_api_gateway_api_config":                   resourceApiGatewayApiConfig(),
+			"google_api_gateway_gateway":                      resourceApiGatewayGateway(),
+			"google_app_engine_application":                   resourceAppEngineApplication(),
+			"google_app_engine_flexible_app_version":          resourceAppEngineFlexibleAppVersion(),
+			"google_app_engine_service_split_traffic":         resourceAppEngineServiceSplitTraffic(),
+			"google_app_engine_standard_app_version":          resourceAppEngineStandardAppVersion(),
+			"google_artifact_registry_repository":             resourceArtifactRegistryRepository(),
+			"google_artifact_registry_repository_iam_binding": ResourceIamBindingWithImport(IamArtifactRegistryRepositorySchema, NewArtifactRegistryRepositoryIamUpdater, ArtifactRegistryRepositoryIdParseFunc),
+			"google_artifact_registry_repository_iam_member":  ResourceIamMemberWithImport(IamArtifactRegistryRepositorySchema, NewArtifactRegistryRepositoryIamUpdater, ArtifactRegistryRepositoryIdParseFunc),
+			"google_artifact_registry_repository_iam_policy":  ResourceIamPolicyWithImport(IamArtifactRegistryRepositorySchema, NewArtifactRegistryRepositoryIamUpdater, ArtifactRegistryRepositoryIdParseFunc),
+			"google_bigquery_bi_reservation":                  resourceBigqueryReservationBiReservation(),
+			"google_bigquery_capacity_commitment":             resourceBigqueryReservationCapacityCommitment(),
+			"google_bigquery_data_transfer_config":            resourceBigqueryDataTransferConfig(),
+			"google_bigquery_dataset":                         resourceBigQueryDataset(),
+			"google_bigquery_dataset_access":                  resourceBigQueryDatasetAccess(),
+			"google_bigquery_reservation":                     resourceBigqueryReservationReservation(),
+			"google_bigquery_reservation_assignment":          resourceBigqueryReservationReservationAssignment(),
+			"google_bigquery_routine":                         resourceBigQueryRoutine(),
+			"google_bigquery_table":                           resourceBigQueryTable(),
+			"google_bigquery_table_iam_binding":               ResourceIamBindingWithImport(IamBigQueryTableSchema, NewBigQueryTableIamUpdater, BigQueryTableIdParseFunc, IamWithConditions()),
+			"google_bigquery_table_iam_member":                ResourceIamMemberWithImport(IamBigQueryTableSchema, NewBigQueryTableIamUpdater, BigQueryTableIdParseFunc, IamWithConditions()),
+			"google_bigquery_table_iam_policy":                ResourceIamPolicyWithImport(IamBigQueryTableSchema, NewBigQueryTableIamUpdater, BigQueryTableIdParseFunc, IamWithConditions()),
+			"google_bigquery_routine_iam_binding":             ResourceIamBindingWithImport(IamBigQueryRoutineSchema, NewBigQueryRoutineIamUpdater, BigQueryRoutineIdParseFunc),
+			"google_bigquery_routine_iam_member":              ResourceIamMemberWithImport(IamBigQueryRoutineSchema, NewBigQueryRoutineIamUpdater, BigQueryRoutineIdParseFunc),
+			"google_bigquery_routine_iam_policy":              ResourceIamPolicyWithImport(IamBigQueryRoutineSchema, NewBigQueryRoutineIamUpdater, BigQueryRoutineIdParseFunc),
+			"google_bigtable_gc_policy":                       resourceBigtableGCPolicy(),
+			"google_bigtable_instance":                        resourceBigtableInstance(),
+			"google_bigtable_table":                           resourceBigtableTable(),
+			"google_billing_account_iam_binding":              ResourceIamBindingWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+			"google_billing_account_iam_member":               ResourceIamMemberWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+			"google_billing_account_iam_policy":               ResourceIamPolicyWithImport(IamBillingAccountSchema, NewBillingAccountIamUpdater, BillingAccountIdParseFunc),
+			"google_cloud_run_domain_mapping":                 resourceCloudRunDomainMapping(),
+			"google_cloud_run_service":                        resourceCloudRunService(),
+			"google_cloud_run_service_iam_binding":            ResourceIamBindingWithImport(IamCloudRunServiceSchema, NewCloudRunServiceIamUpdater, CloudRunServiceIdParseFunc),
+			"google_cloud_run_service_iam_member":             ResourceIamMemberWithImport(IamCloudRunServiceSchema, NewCloudRunServiceIamUpdater, CloudRunServiceIdParseFunc),
+			"google_cloud_run_service_iam_policy":             ResourceIamPolicyWithImport(IamCloudRunServiceSchema, NewCloudRunServiceIamUpdater, CloudRunServiceIdParseFunc),
+			"google_cloud_run_v2_job":                         resourceCloudRunV2Job(),
+			"google_cloud_run_v2_service":                     resourceCloudRunV2Service(),
+			"google_cloud_tasks_queue":                        resourceCloudTasksQueue(),
+			"google_cloud_tasks_queue_iam_binding":            ResourceIamBindingWithImport(IamCloudTasksQueueSchema, NewCloudTasksQueueIamUpdater, CloudTasksQueueIdParseFunc),
+			"google_cloud_tasks_queue_iam_member":             ResourceIamMemberWithImport(IamCloudTasksQueueSchema, NewCloudTasksQueueIamUpdater, CloudTasksQueueIdParseFunc),
+			"google_cloud_tasks_queue_iam_policy":             ResourceIamPolicyWithImport(IamCloudTasksQueueSchema, NewCloudTasksQueueIamUpdater, CloudTasksQueueIdParseFunc),
+			"google_cloudbuild_worker_pool":                   resourceCloudBuildWorkerPool(),
+			"google_cloudfunctions_function":                  resourceCloudFunctionsFunction(),
+			"google_cloudfunctions2_function":                 resourceCloudfunctions2function(),
+			"google_cloudiot_registry":                        resourceCloudIoTRegistry(),
+			"google_composer_environment":                     resourceComposerEnvironment(),
+			"google_compute_attached_disk":                    resourceComputeAttachedDisk(),
+			"google_compute_future_reservation":               resourceComputeFutureReservation(),
+			"google_compute_global_forwarding_rule":           resourceComputeGlobalForwardingRule(),
+			"google_compute_instance":                         resourceComputeInstance(),
+			"google_compute_instance_from_template":           resourceComputeInstanceFromTemplate(),
+			"google_compute_instance_group":                   resourceComputeInstanceGroup(),
+			"google_compute_instance_group_manager":           resourceComputeInstanceGroupManager(),
+			"google_compute_instance_settings":                resourceComputeInstanceSettings(),
+			"google_compute_instance_template":                resourceComputeInstanceTemplate(),
+			"google_compute_interconnect":                     resourceComputeInterconnect(),
+			"google_compute_network_peering":                  resourceComputeNetworkPeering(),
+			"google_compute_project_metadata":                 resourceComputeProjectMetadata(),
+			"google_compute_project_metadata_item":            resourceComputeProjectMetadataItem(),
+			"google_compute_region_backend_service":           resourceComputeRegionBackendService(),
+			"google_compute_region_commitment":                resourceComputeRegionCommitment(),
+			"google_compute_region_instance_group_manager":    resourceComputeRegionInstanceGroupManager(),
+			"google_compute_router_interface":                 resourceComputeRouterInterface(),
+			"google_compute_router_nat":                       resourceComputeRouterNat(),
+			"google_compute_router_peer":                      resourceComputeRouterPeer(),
+			"google_compute_security_policy":                  resourceComputeSecurityPolicy(),
+			"google_compute_shared_vpc_host_project":          resourceComputeSharedVpcHostProject(),
+			"google_compute_shared_vpc_service_project":       resourceComputeSharedVpcServiceProject(),
+			"google_compute_target_pool":                      resourceComputeTargetPool(),
+			"google_container_cluster":                        resourceContainerCluster(),
+			"google_container_node_pool":                      resourceContainerNodePool(),
+			"google_container_registry":                       resourceContainerRegistry(),
+			"google_dataflow_job":                             resourceDataflowJob(),
+			"google_dataplex_datascan":                        resourceDataplexDatascan(),
+			"google_dataproc_autoscaling_policy":              resourceDataprocAutoscalingPolicy(),
+			"google_dataproc_cluster":                         resourceDataprocCluster(),
+			"google_dataproc_job":                             resourceDataprocJob(),
+			"google_dataproc_workflow_template":               resourceDataprocWorkflowTemplate(),
+			"google_datastore_index":                          resourceDatastoreIndex(),
+			"google_dns_record_set":                           resourceDnsRecordSet(),
+			"google_endpoints_service":                        resourceEndpointsService(),
+			"google_filestore_backup":                         resourceFilestoreBackup(),
+			"google_filestore_instance":                       resourceFilestoreInstance(),
+			"google_filestore_snapshot":                       resourceFilestoreSnapshot(),
+			"google_firestore_database":                       resourceFirestoreDatabase(),
+			"google_firestore_index":                          resourceFirestoreIndex(),
+			"google_folder":                                   resourceGoogleFolder(),
+			"google_folder_iam_binding":                       ResourceIamBindingWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_folder_iam_member":                        ResourceIamMemberWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+			"google_folder_iam_policy":                        ResourceIamPolicyWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_folder_iam_audit_config":                  ResourceIamAuditConfigWithImport(IamFolderSchema, NewFolderIamUpdater, FolderIdParseFunc),
+			"google_folder_organization_policy":               resourceGoogleFolderOrganizationPolicy(),
+			"google_logging_billing_account_sink":             resourceLoggingBillingAccountSink(),
+			"google_logging_billing_account_exclusion":        ResourceLoggingExclusion(BillingAccountLoggingExclusionSchema, NewBillingAccountLoggingExclusionUpdater, billingAccountLoggingExclusionIdParseFunc),
+			"google_logging_organization_sink":                resourceLoggingOrganizationSink(),
+			"google_logging_organization_exclusion":           ResourceLoggingExclusion(OrganizationLoggingExclusionSchema, NewOrganizationLoggingExclusionUpdater, organizationLoggingExclusionIdParseFunc),
+			"google_logging_folder_sink":                      resourceLoggingFolderSink(),
+			"google_logging_folder_exclusion":                 ResourceLoggingExclusion(FolderLoggingExclusionSchema, NewFolderLoggingExclusionUpdater, folderLoggingExclusionIdParseFunc),
+			"google_logging_project_sink":                     resourceLoggingProjectSink(),
+			"google_logging_project_exclusion":                ResourceLoggingExclusion(ProjectLoggingExclusionSchema, NewProjectLoggingExclusionUpdater, projectLoggingExclusionIdParseFunc),
+			"google_logging_log_view":                         resourceLoggingLogView(),
+			"google_logging_metric":                           resourceLoggingMetric(),
+			"google_logging_project_bucket_config":            resourceLoggingProjectBucketConfig(),
+			"google_monitoring_custom_service":                resourceMonitoringService(),
+			"google_monitoring_dashboard":                     resourceMonitoringDashboard(),
+			"google_monitoring_monitored_project":             resourceMonitoringMonitoredProject(),
+			"google_monitoring_slo":                           resourceMonitoringSlo(),
+			"google_iap_brand":                                resourceIapBrand(),
+			"google_iap_client":                               resourceIapClient(),
+			"google_iap_tunnel_instance_iam_binding":          ResourceIamBindingWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
+			"google_iap_tunnel_instance_iam_member":           ResourceIamMemberWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
+			"google_iap_tunnel_instance_iam_policy":           ResourceIamPolicyWithImport(IamIapTunnelInstanceSchema, NewIapTunnelInstanceIamUpdater, IapTunnelInstanceIdParseFunc),
+			"google_iap_web_backend_service_iam_binding":      ResourceIamBindingWithImport(IamIapWebBackendServiceSchema, NewIapWebBackendServiceIamUpdater, IapWebBackendServiceIdParseFunc),
+			"google_iap_web_backend_service_iam_member":       ResourceIamMemberWithImport(IamIapWebBackendServiceSchema, NewIapWebBackendServiceIamUpdater, IapWebBackendServiceIdParseFunc),
+			"google_iap_web_backend_service_iam_policy":       ResourceIamPolicyWithImport(IamIapWebBackendServiceSchema, NewIapWebBackendServiceIamUpdater, IapWebBackendServiceIdParseFunc),
+			"google_kms_key_ring":                             resourceKmsKeyRing(),
+			"google_kms_key_ring_iam_binding":                 ResourceIamBindingWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+			"google_kms_key_ring_iam_member":                  ResourceIamMemberWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+			"google_kms_key_ring_iam_policy":                  ResourceIamPolicyWithImport(IamKmsKeyRingSchema, NewKmsKeyRingIamUpdater, KeyRingIdParseFunc),
+			"google_kms_crypto_key":                           resourceKmsCryptoKey(),
+			"google_kms_crypto_key_iam_binding":               ResourceIamBindingWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+			"google_kms_crypto_key_iam_member":                ResourceIamMemberWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+			"google_kms_crypto_key_iam_policy":                ResourceIamPolicyWithImport(IamKmsCryptoKeySchema, NewKmsCryptoKeyIamUpdater, CryptoIdParseFunc),
+			"google_kms_secret_ciphertext":                    resourceKmsSecretCiphertext(),
+			"google_memcache_instance":                        resourceMemcacheInstance(),
+			"google_memorystore_instance":                     resourceMemorystoreInstance(),
+			"google_sourcerepo_repository_iam_binding":        ResourceIamBindingWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
+			"google_sourcerepo_repository_iam_member":         ResourceIamMemberWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
+			"google_sourcerepo_repository_iam_policy":         ResourceIamPolicyWithImport(IamSourceRepoRepositorySchema, NewSourceRepoRepositoryIamUpdater, SourceRepoRepositoryIdParseFunc),
+			"google_spanner_instance_iam_binding":             ResourceIamBindingWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+			"google_spanner_instance_iam_member":              ResourceIamMemberWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+			"google_spanner_instance_iam_policy":              ResourceIamPolicyWithImport(IamSpannerInstanceSchema, NewSpannerInstanceIamUpdater, SpannerInstanceIdParseFunc),
+			"google_spanner_database_iam_binding":             ResourceIamBindingWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+			"google_spanner_database_iam_member":              ResourceIamMemberWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+			"google_spanner_database_iam_policy":              ResourceIamPolicyWithImport(IamSpannerDatabaseSchema, NewSpannerDatabaseIamUpdater, SpannerDatabaseIdParseFunc),
+			"google_sql_database":                             resourceSqlDatabase(),
+			"google_sql_database_instance":                    resourceSqlDatabaseInstance(),
+			"google_sql_ssl_cert":                             resourceSqlSslCert(),
+			"google_sql_user":                                 resourceSqlUser(),
+			"google_organization_iam_binding":                 ResourceIamBindingWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_organization_iam_custom_role":             resourceGoogleOrganizationIamCustomRole(),
+			"google_organization_iam_member":                  ResourceIamMemberWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+			"google_organization_iam_policy":                  ResourceIamPolicyWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_organization_iam_audit_config":            ResourceIamAuditConfigWithImport(IamOrganizationSchema, NewOrganizationIamUpdater, OrgIdParseFunc),
+			"google_organization_policy":                      resourceGoogleOrganizationPolicy(),
+			"google_project":                                  resourceGoogleProject(),
+			"google_project_iam_policy":                       resourceGoogleProjectIamPolicy(),
+			"google_project_iam_binding":                      ResourceIamBindingWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc, IamWithConditions(), IamWithDeletedMemberPruning()),
+			"google_project_iam_member":                       ResourceIamMemberWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc, IamWithConditions()),
+			"google_project_iam_audit_config":                 ResourceIamAuditConfigWithImport(IamProjectSchema, NewProjectIamUpdater, ProjectIdParseFunc),
+			"google_project_service":                          resourceGoogleProjectService(),
+			"google_project_service_identity":                 resourceProjectServiceIdentity(),
+			"google_project_iam_custom_role":                  resourceGoogleProjectIamCustomRole(),
+			"google_project_organization_policy":              resourceGoogleProjectOrganizationPolicy(),
+			"google_project_usage_export_bucket":              resourceProjectUsageBucket(),
+			"google_project_services":                         resourceGoogleProjectServices(),
+			"google_pubsub_topic_iam_binding":                 ResourceIamBindingWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+			"google_pubsub_topic_iam_member":                  ResourceIamMemberWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+			"google_pubsub_topic_iam_policy":                  ResourceIamPolicyWithImport(IamPubsubTopicSchema, NewPubsubTopicIamUpdater, PubsubTopicIdParseFunc),
+			"google_pubsub_subscription_iam_binding":          ResourceIamBindingWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+			"google_pubsub_subscription_iam_member":           ResourceIamMemberWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+			"google_pubsub_subscription_iam_policy":           ResourceIamPolicyWithImport(IamPubsubSubscriptionSchema, NewPubsubSubscriptionIamUpdater, PubsubSubscriptionIdParseFunc),
+			"google_pubsub_schema":                            resourcePubsubSchema(),
+			"google_redis_cluster":                            resourceRedisCluster(),
+			"google_runtimeconfig_config":                     resourceRuntimeconfigConfig(),
+			"google_runtimeconfig_variable":                   resourceRuntimeconfigVariable(),
+			"google_secret_manager_secret":                    resourceSecretManagerSecret(),
+			"google_secret_manager_secret_version":            resourceSecretManagerSecretVersion(),
+			"google_service_account":                          resourceGoogleServiceAccount(),
+			"google_service_account_iam_binding":              ResourceIamBindingWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_service_account_iam_member":               ResourceIamMemberWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc),
+			"google_service_account_iam_policy":               ResourceIamPolicyWithImport(IamServiceAccountSchema, NewServiceAccountIamUpdater, ServiceAccountIdParseFunc, IamWithDeletedMemberPruning()),
+			"google_service_account_key":                      resourceGoogleServiceAccountKey(),
+			"google_service_networking_connection":            resourceServiceNetworkingConnection(),
+			"google_storage_bucket":                           resourceStorageBucket(),
+			"google_storage_bucket_acl":                       resourceStorageBucketAcl(),
 			// Legacy roles such as roles/storage.legacyBucketReader are automatically added
 			// when creating a bucket. For this reason, it is better not to add the authoritative
 			// google_storage_bucket_iam_policy resource.
-			"google_storage_bucket_iam_binding": ResourceIamBinding(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-			"google_storage_bucket_iam_member":  ResourceIamMember(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-			"google_storage_bucket_iam_policy":  ResourceIamPolicy(IamStorageBucketSchema, NewStorageBucketIamUpdater),
-			"google_storage_bucket_object":      resourceStorageBucketObject(),
-			"google_storage_object_acl":         resourceStorageObjectAcl(),
-			"google_storage_default_object_acl": resourceStorageDefaultObjectAcl(),
-			"google_storage_notification":       resourceStorageNotification(),
-			"google_storage_transfer_job":       resourceStorageTransferJob(),
+			"google_storage_bucket_iam_binding":         ResourceIamBindingWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc, IamWithConditions()),
+			"google_storage_bucket_iam_member":          ResourceIamMemberWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc, IamWithConditions()),
+			"google_storage_bucket_iam_policy":          ResourceIamPolicyWithImport(IamStorageBucketSchema, NewStorageBucketIamUpdater, StorageBucketIdParseFunc, IamWithConditions()),
+			"google_storage_bucket_object":              resourceStorageBucketObject(),
+			"google_storage_managed_folder":             resourceStorageManagedFolder(),
+			"google_storage_managed_folder_iam_binding": ResourceIamBindingWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc, IamWithConditions()),
+			"google_storage_managed_folder_iam_member":  ResourceIamMemberWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc, IamWithConditions()),
+			"google_storage_managed_folder_iam_policy":  ResourceIamPolicyWithImport(IamStorageManagedFolderSchema, NewStorageManagedFolderIamUpdater, StorageManagedFolderIdParseFunc, IamWithConditions()),
+			"google_storage_object_acl":                 resourceStorageObjectAcl(),
+			"google_storage_default_object_acl":         resourceStorageDefaultObjectAcl(),
+			"google_storage_hmac_key":                   resourceStorageHmacKey(),
+			"google_storage_notification":               resourceStorageNotification(),
+			"google_storage_transfer_job":               resourceStorageTransferJob(),
+
+			"google_workstations_workstation_cluster":     resourceWorkstationsWorkstationCluster(),
+			"google_workstations_workstation_config":      resourceWorkstationsWorkstationConfig(),
+			"google_workstations_workstation":             resourceWorkstationsWorkstation(),
+			"google_workstations_workstation_iam_binding": ResourceIamBindingWithImport(IamWorkstationsWorkstationSchema, NewWorkstationsWorkstationIamUpdater, WorkstationsWorkstationIdParseFunc),
+			"google_workstations_workstation_iam_member":  ResourceIamMemberWithImport(IamWorkstationsWorkstationSchema, NewWorkstationsWorkstationIamUpdater, WorkstationsWorkstationIdParseFunc),
+			"google_workstations_workstation_iam_policy":  ResourceIamPolicyWithImport(IamWorkstationsWorkstationSchema, NewWorkstationsWorkstationIamUpdater, WorkstationsWorkstationIdParseFunc),
+
+			"google_oracle_database_autonomous_database":          resourceOracleDatabaseAutonomousDatabase(),
+			"google_oracle_database_cloud_exadata_infrastructure": resourceOracleDatabaseCloudExadataInfrastructure(),
+			"google_oracle_database_cloud_vm_cluster":             resourceOracleDatabaseCloudVmCluster(),
+
+			"google_network_connectivity_policy_based_route": resourceNetworkConnectivityPolicyBasedRoute(),
+
+			"google_identity_platform_config":                       resourceIdentityPlatformConfig(),
+			"google_identity_platform_default_supported_idp_config": resourceIdentityPlatformDefaultSupportedIdpConfig(),
+
+			"google_firebase_app_distribution_group":  resourceFirebaseAppDistributionGroup(),
+			"google_firebase_app_distribution_tester": resourceFirebaseAppDistributionTester(),
+
+			"google_gke_hub_feature":                 resourceGKEHubFeature(),
+			"google_gke_hub_feature_membership":      resourceGKEHubFeatureMembership(),
+			"google_gke_hub_membership":              resourceGKEHubMembership(),
+			"google_gke_hub_namespace":               resourceGKEHubNamespace(),
+			"google_gke_hub_scope":                   resourceGKEHubScope(),
+			"google_gke_hub_scope_rbac_role_binding": resourceGKEHubScopeRBACRoleBinding(),
+
+			"google_org_policy_policy": resourceOrgPolicyPolicy(),
+
+			"google_essential_contacts_contact": resourceEssentialContactsContact(),
 		},
 	)
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{}, error) {
 	config := Config{
 		Project: d.Get("project").(string),
 		Region:  d.Get("region").(string),
 		Zone:    d.Get("zone").(string),
+
+		StorageLocation: d.Get("storage_location").(string),
+		KmsLocation:     d.Get("kms_location").(string),
+
+		DefaultResourceTags: convertStringMap(d.Get("default_resource_tags").(map[string]interface{})),
+
+		// Terraform cancels the stop context when it's interrupted, which
+		// stops long running requests and operation waits.
+		context: p.StopContext(),
 	}
 
 	// Add credential source
@@ -288,6 +478,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Scopes[i] = scope.(string)
 	}
 
+	if v, ok := d.GetOk("read_cache_ttl"); ok {
+		ttl, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid read_cache_ttl: %s", err)
+		}
+		config.ReadCacheTTL = ttl
+	}
+
+	config.UniverseDomain = d.Get("universe_domain").(string)
+
 	if err := config.LoadAndValidate(); err != nil {
 		return nil, err
 	}
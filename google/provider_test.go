@@ -16,31 +16,6 @@ var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 var testAccRandomProvider *schema.Provider
 
-var credsEnvVars = []string{
-	"GOOGLE_CREDENTIALS",
-	"GOOGLE_CLOUD_KEYFILE_JSON",
-	"GCLOUD_KEYFILE_JSON",
-	"GOOGLE_USE_DEFAULT_CREDENTIALS",
-}
-
-var projectEnvVars = []string{
-	"GOOGLE_PROJECT",
-	"GCLOUD_PROJECT",
-	"CLOUDSDK_CORE_PROJECT",
-}
-
-var regionEnvVars = []string{
-	"GOOGLE_REGION",
-	"GCLOUD_REGION",
-	"CLOUDSDK_COMPUTE_REGION",
-}
-
-var zoneEnvVars = []string{
-	"GOOGLE_ZONE",
-	"GCLOUD_ZONE",
-	"CLOUDSDK_COMPUTE_ZONE",
-}
-
 var orgEnvVars = []string{
 	"GOOGLE_ORG",
 }
@@ -57,6 +32,10 @@ var billingAccountEnvVars = []string{
 	"GOOGLE_BILLING_ACCOUNT",
 }
 
+var verifiedDomainEnvVars = []string{
+	"GOOGLE_VERIFIED_DOMAIN",
+}
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccRandomProvider = random.Provider().(*schema.Provider)
@@ -163,25 +142,6 @@ func getTestProject(is *terraform.InstanceState, config *Config) (string, error)
 	return "", fmt.Errorf("%q: required field is not set", "project")
 }
 
-// testAccPreCheck ensures at least one of the project env variables is set.
-func getTestProjectFromEnv() string {
-	return multiEnvSearch(projectEnvVars)
-}
-
-// testAccPreCheck ensures at least one of the credentials env variables is set.
-func getTestCredsFromEnv() string {
-	return multiEnvSearch(credsEnvVars)
-}
-
-// testAccPreCheck ensures at least one of the region env variables is set.
-func getTestRegionFromEnv() string {
-	return multiEnvSearch(regionEnvVars)
-}
-
-func getTestZoneFromEnv() string {
-	return multiEnvSearch(zoneEnvVars)
-}
-
 func getTestOrgFromEnv(t *testing.T) string {
 	skipIfEnvNotSet(t, orgEnvVars...)
 	return multiEnvSearch(orgEnvVars)
@@ -202,11 +162,8 @@ func getTestServiceAccountFromEnv(t *testing.T) string {
 	return multiEnvSearch(serviceAccountEnvVars)
 }
 
-func multiEnvSearch(ks []string) string {
-	for _, k := range ks {
-		if v := os.Getenv(k); v != "" {
-			return v
-		}
-	}
-	return ""
+// Domain mappings can only be created for domains verified by the test account.
+func getTestVerifiedDomainFromEnv(t *testing.T) string {
+	skipIfEnvNotSet(t, verifiedDomainEnvVars...)
+	return multiEnvSearch(verifiedDomainEnvVars)
 }
@@ -0,0 +1,230 @@
+package google
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/oauth2"
+)
+
+// readCacheTransport is an http.RoundTripper that serves identical GET requests
+// from memory for a short time. During a refresh many resources read the same
+// parent objects, such as networks and projects, and this avoids sending the
+// same request over and over.
+//
+// Responses are keyed by URL and access token, so callers using different
+// credentials never share entries. Any other request, such as a POST, PATCH or
+// DELETE, may change what a GET returns and so empties the cache, unless it
+// calls a read-only method such as getIamPolicy.
+//
+// Only clients used by Read functions send requests through the cache, see
+// cacheReads. Everything else, such as polling a resource until it's ready,
+// goes through uncached so that it always sees fresh results.
+type readCacheTransport struct {
+	transport   http.RoundTripper
+	tokenSource oauth2.TokenSource
+	ttl         time.Duration
+	now         func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*readCacheEntry
+	// generation is bumped on every write, so that a GET which was sent
+	// before a write but returned after it isn't cached.
+	generation uint64
+}
+
+type readCacheEntry struct {
+	expires time.Time
+	status  string
+	code    int
+	header  http.Header
+	body    []byte
+}
+
+func newReadCacheTransport(transport http.RoundTripper, tokenSource oauth2.TokenSource, ttl time.Duration) *readCacheTransport {
+	return &readCacheTransport{
+		transport:   transport,
+		tokenSource: tokenSource,
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     make(map[string]*readCacheEntry),
+	}
+}
+
+// readOnlyMethods are custom methods that are sent as a POST but don't change
+// anything, so they don't need to empty the cache.
+var readOnlyMethods = []string{
+	":getIamPolicy",
+	":testIamPermissions",
+	":getAncestry",
+	":getOrgPolicy",
+	":getEffectiveOrgPolicy",
+	":listOrgPolicies",
+	":listAvailableOrgPolicyConstraints",
+}
+
+func isReadOnlyRequest(req *http.Request) bool {
+	if req.Method == "GET" || req.Method == "HEAD" {
+		return true
+	}
+	if req.Method != "POST" {
+		return false
+	}
+	for _, m := range readOnlyMethods {
+		if strings.HasSuffix(req.URL.Path, m) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *readCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		if !isReadOnlyRequest(req) {
+			t.invalidate()
+		}
+		return t.transport.RoundTrip(req)
+	}
+
+	key, ok := t.cacheKey(req)
+	if !ok {
+		return t.transport.RoundTrip(req)
+	}
+
+	e, generation := t.get(key)
+	if e != nil {
+		log.Printf("[DEBUG] Serving GET %s from the read cache", req.URL)
+		return e.response(req), nil
+	}
+
+	res, err := t.transport.RoundTrip(req)
+	if err != nil || res.StatusCode != http.StatusOK {
+		return res, err
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	t.put(key, generation, &readCacheEntry{
+		expires: t.now().Add(t.ttl),
+		status:  res.Status,
+		code:    res.StatusCode,
+		header:  res.Header.Clone(),
+		body:    body,
+	})
+	return res, nil
+}
+
+// cacheKey returns the key for the given GET request, or false if its
+// response mustn't be cached.
+func (t *readCacheTransport) cacheKey(req *http.Request) (string, bool) {
+	// Operations are polled until they change, so serving them from the cache
+	// would only slow down waiting for them.
+	if strings.Contains(req.URL.Path, "/operations/") {
+		return "", false
+	}
+
+	token, err := t.tokenSource.Token()
+	if err != nil {
+		return "", false
+	}
+	return token.AccessToken + " " + req.URL.String(), true
+}
+
+func (t *readCacheTransport) get(key string) (*readCacheEntry, uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return nil, t.generation
+	}
+	if !t.now().Before(e.expires) {
+		delete(t.entries, key)
+		return nil, t.generation
+	}
+	return e, t.generation
+}
+
+func (t *readCacheTransport) put(key string, generation uint64, e *readCacheEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if generation != t.generation {
+		return
+	}
+
+	// Drop expired entries so the cache doesn't grow over a long apply.
+	now := t.now()
+	for k, v := range t.entries {
+		if !now.Before(v.expires) {
+			delete(t.entries, k)
+		}
+	}
+	t.entries[key] = e
+}
+
+func (t *readCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries = make(map[string]*readCacheEntry)
+	t.generation++
+}
+
+// uncached returns a transport that never serves requests from the cache, but
+// still empties it when they may change what a GET returns.
+func (t *readCacheTransport) uncached() http.RoundTripper {
+	return &readCacheBypassTransport{cache: t}
+}
+
+type readCacheBypassTransport struct {
+	cache *readCacheTransport
+}
+
+func (t *readCacheBypassTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isReadOnlyRequest(req) {
+		t.cache.invalidate()
+	}
+	return t.cache.transport.RoundTrip(req)
+}
+
+// cacheReads makes r's Read function use the clients that share the read
+// cache. Create and Update call Read functions directly rather than through
+// the resource, so they keep using the uncached clients.
+func cacheReads(r *schema.Resource) {
+	read := r.Read
+	if read == nil {
+		return
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if config, ok := meta.(*Config); ok && config.readConfig != nil {
+			meta = config.readConfig
+		}
+		return read(d, meta)
+	}
+}
+
+func (e *readCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        e.status,
+		StatusCode:    e.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
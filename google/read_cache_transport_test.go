@@ -0,0 +1,210 @@
+package google
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"golang.org/x/oauth2"
+)
+
+type countingTransport struct {
+	calls int
+	code  int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	code := t.code
+	if code == 0 {
+		code = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: code,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(req.Method + " " + req.URL.String())),
+		Request:    req,
+	}, nil
+}
+
+type staticTokenSource struct {
+	token string
+}
+
+func (s *staticTokenSource) Token() (*oauth2.Token, error) {
+	return &oauth2.Token{AccessToken: s.token}, nil
+}
+
+func doReadCacheRequest(t *testing.T, rt http.RoundTripper, method, url string) string {
+	req, err := http.NewRequest(method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestReadCacheTransport(t *testing.T) {
+	networkUrl := "https://www.googleapis.com/compute/v1/projects/p/global/networks/n"
+	operationUrl := "https://www.googleapis.com/compute/v1/projects/p/global/operations/op"
+
+	cases := map[string]struct {
+		Requests      [][2]string
+		Code          int
+		ExpectedCalls int
+	}{
+		"identical GETs are cached": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"GET", networkUrl}, {"GET", networkUrl}},
+			ExpectedCalls: 1,
+		},
+		"different URLs are not shared": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"GET", networkUrl + "2"}},
+			ExpectedCalls: 2,
+		},
+		"writes invalidate the cache": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"PATCH", networkUrl}, {"GET", networkUrl}},
+			ExpectedCalls: 3,
+		},
+		"read-only POSTs don't invalidate the cache": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"POST", networkUrl + ":getIamPolicy"}, {"GET", networkUrl}},
+			ExpectedCalls: 2,
+		},
+		"other POSTs invalidate the cache": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"POST", networkUrl + "/addPeering"}, {"GET", networkUrl}},
+			ExpectedCalls: 3,
+		},
+		"operations are not cached": {
+			Requests:      [][2]string{{"GET", operationUrl}, {"GET", operationUrl}},
+			ExpectedCalls: 2,
+		},
+		"errors are not cached": {
+			Requests:      [][2]string{{"GET", networkUrl}, {"GET", networkUrl}},
+			Code:          http.StatusNotFound,
+			ExpectedCalls: 2,
+		},
+	}
+
+	for tn, tc := range cases {
+		base := &countingTransport{code: tc.Code}
+		rt := newReadCacheTransport(base, &staticTokenSource{token: "token"}, time.Minute)
+
+		for _, r := range tc.Requests {
+			if body := doReadCacheRequest(t, rt, r[0], r[1]); body != r[0]+" "+r[1] {
+				t.Errorf("bad: %s; expected body %q, got %q", tn, r[0]+" "+r[1], body)
+			}
+		}
+
+		if base.calls != tc.ExpectedCalls {
+			t.Errorf("bad: %s; expected %d requests to be sent, got %d", tn, tc.ExpectedCalls, base.calls)
+		}
+	}
+}
+
+func TestReadCacheTransport_expires(t *testing.T) {
+	url := "https://www.googleapis.com/compute/v1/projects/p"
+	now := time.Now()
+
+	base := &countingTransport{}
+	rt := newReadCacheTransport(base, &staticTokenSource{token: "token"}, 5*time.Second)
+	rt.now = func() time.Time { return now }
+
+	doReadCacheRequest(t, rt, "GET", url)
+	now = now.Add(4 * time.Second)
+	doReadCacheRequest(t, rt, "GET", url)
+	if base.calls != 1 {
+		t.Errorf("expected the response to be cached before the ttl, got %d requests", base.calls)
+	}
+
+	now = now.Add(2 * time.Second)
+	doReadCacheRequest(t, rt, "GET", url)
+	if base.calls != 2 {
+		t.Errorf("expected the response to expire after the ttl, got %d requests", base.calls)
+	}
+}
+
+func TestReadCacheTransport_keyedByToken(t *testing.T) {
+	url := "https://www.googleapis.com/compute/v1/projects/p"
+
+	base := &countingTransport{}
+	ts := &staticTokenSource{token: "first"}
+	rt := newReadCacheTransport(base, ts, time.Minute)
+
+	doReadCacheRequest(t, rt, "GET", url)
+	ts.token = "second"
+	doReadCacheRequest(t, rt, "GET", url)
+
+	if base.calls != 2 {
+		t.Errorf("expected responses not to be shared between tokens, got %d requests", base.calls)
+	}
+}
+
+func TestReadCacheTransport_uncached(t *testing.T) {
+	url := "https://www.googleapis.com/compute/v1/projects/p"
+
+	base := &countingTransport{}
+	rt := newReadCacheTransport(base, &staticTokenSource{token: "token"}, time.Minute)
+	uncached := rt.uncached()
+
+	// Polling through the uncached transport always sends the request.
+	doReadCacheRequest(t, rt, "GET", url)
+	doReadCacheRequest(t, uncached, "GET", url)
+	doReadCacheRequest(t, uncached, "GET", url)
+	if base.calls != 3 {
+		t.Errorf("expected uncached GETs to be sent, got %d requests", base.calls)
+	}
+
+	// Writes through the uncached transport still empty the cache.
+	doReadCacheRequest(t, uncached, "PATCH", url)
+	doReadCacheRequest(t, rt, "GET", url)
+	if base.calls != 5 {
+		t.Errorf("expected an uncached write to empty the cache, got %d requests", base.calls)
+	}
+}
+
+func TestCacheReads(t *testing.T) {
+	readConfig := &Config{}
+	config := &Config{readConfig: readConfig}
+
+	var got interface{}
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			got = meta
+			return nil
+		},
+	}
+	read := r.Read
+	cacheReads(r)
+
+	if err := r.Read(nil, config); err != nil {
+		t.Fatal(err)
+	}
+	if got != readConfig {
+		t.Errorf("expected Read to be called with the read cache config")
+	}
+
+	// Calling the Read function directly, as Create does, doesn't use the cache.
+	if err := read(nil, config); err != nil {
+		t.Fatal(err)
+	}
+	if got != config {
+		t.Errorf("expected a direct call to use the uncached config")
+	}
+
+	if err := r.Read(nil, readConfig); err != nil {
+		t.Fatal(err)
+	}
+	if got != readConfig {
+		t.Errorf("expected a config without a read cache to be passed through")
+	}
+}
@@ -221,8 +221,10 @@ func resourceAccessContextManagerAccessLevelCreate(d *schema.ResourceData, meta
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create AccessLevel: %s", waitErr)
 	}
 
@@ -107,8 +107,10 @@ func resourceAccessContextManagerAccessPolicyCreate(d *schema.ResourceData, meta
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create AccessPolicy: %s", waitErr)
 	}
 
@@ -179,8 +179,10 @@ func resourceAccessContextManagerServicePerimeterCreate(d *schema.ResourceData,
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create ServicePerimeter: %s", waitErr)
 	}
 
@@ -0,0 +1,322 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceApiGatewayApi() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApiGatewayApiCreate,
+		Read:   resourceApiGatewayApiRead,
+		Update: resourceApiGatewayApiUpdate,
+		Delete: resourceApiGatewayApiDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceApiGatewayApiImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(360 * time.Second),
+			Delete: schema.DefaultTimeout(360 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"managed_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApiGatewayApiCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandApiGatewayApiDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	managedServiceProp, err := expandApiGatewayApiManagedService(d.Get("managed_service"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("managed_service"); !isEmptyValue(reflect.ValueOf(managedServiceProp)) && (ok || !reflect.DeepEqual(v, managedServiceProp)) {
+		obj["managedService"] = managedServiceProp
+	}
+	labelsProp, err := expandApiGatewayApiLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis?apiId={{api_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Api: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Api: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/apis/{{api_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := apiGatewayOperationWaitTime(
+		config, res, project, "Creating Api",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Api: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Api %q: %#v", d.Id(), res)
+
+	return resourceApiGatewayApiRead(d, meta)
+}
+
+func resourceApiGatewayApiRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis/{{api_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ApiGatewayApi %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+
+	// The api id is only part of the name in API responses.
+	if err := d.Set("api_id", NameFromSelfLinkStateFunc(res["name"])); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+
+	if err := d.Set("name", flattenApiGatewayApiName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+	if err := d.Set("display_name", flattenApiGatewayApiDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+	if err := d.Set("managed_service", flattenApiGatewayApiManagedService(res["managedService"], d)); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+	if err := d.Set("create_time", flattenApiGatewayApiCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+	if err := d.Set("labels", flattenApiGatewayApiLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Api: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApiGatewayApiUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandApiGatewayApiDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandApiGatewayApiLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis/{{api_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Api %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Api %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = apiGatewayOperationWaitTime(
+		config, res, project, "Updating Api",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceApiGatewayApiRead(d, meta)
+}
+
+func resourceApiGatewayApiDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis/{{api_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Api %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Api")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = apiGatewayOperationWaitTime(
+		config, res, project, "Deleting Api",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Api %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceApiGatewayApiImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/global/apis/(?P<api_id>[^/]+)", "(?P<project>[^/]+)/(?P<api_id>[^/]+)", "(?P<api_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/apis/{{api_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenApiGatewayApiName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayApiDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayApiManagedService(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayApiCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayApiLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandApiGatewayApiDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandApiGatewayApiManagedService(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandApiGatewayApiLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
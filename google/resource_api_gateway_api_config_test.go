@@ -0,0 +1,141 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccApiGatewayApiConfig_apigatewayApiConfigBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayApiConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayApiConfig_apigatewayApiConfigBasicExample(context),
+			},
+			{
+				ResourceName:      "google_api_gateway_api_config.api_cfg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayApiConfig_apigatewayApiConfigBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_api_gateway_api" "api_cfg" {
+  api_id = "tf-test-api-cfg%{random_suffix}"
+}
+
+resource "google_api_gateway_api_config" "api_cfg" {
+  api           = "${google_api_gateway_api.api_cfg.api_id}"
+  api_config_id = "tf-test-config%{random_suffix}"
+
+  openapi_documents {
+    document {
+      path     = "spec.yaml"
+      contents = "${base64encode(file("test-fixtures/apigateway/openapi.yaml"))}"
+    }
+  }
+}
+`, context)
+}
+
+func TestAccApiGatewayApiConfig_prefixAndUpdate(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayApiConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayApiConfig_prefix(suffix, "pre-update"),
+			},
+			{
+				ResourceName:            "google_api_gateway_api_config.api_cfg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_config_id_prefix"},
+			},
+			{
+				Config: testAccApiGatewayApiConfig_prefix(suffix, "post-update"),
+			},
+			{
+				ResourceName:            "google_api_gateway_api_config.api_cfg",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_config_id_prefix"},
+			},
+		},
+	})
+}
+
+func testAccApiGatewayApiConfig_prefix(suffix, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_api_gateway_api" "api_cfg" {
+  api_id = "tf-test-api-cfg%s"
+}
+
+resource "google_api_gateway_api_config" "api_cfg" {
+  api                  = "${google_api_gateway_api.api_cfg.api_id}"
+  api_config_id_prefix = "tf-test-"
+  display_name         = "%s"
+
+  labels = {
+    stage = "%s"
+  }
+
+  openapi_documents {
+    document {
+      path     = "spec.yaml"
+      contents = "${base64encode(file("test-fixtures/apigateway/openapi.yaml"))}"
+    }
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, suffix, displayName, displayName)
+}
+
+func testAccCheckApiGatewayApiConfigDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_api_gateway_api_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis/{{api}}/configs/{{api_config_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ApiGatewayApiConfig still exists at %s", url)
+		}
+	}
+
+	return nil
+}
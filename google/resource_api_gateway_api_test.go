@@ -0,0 +1,111 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccApiGatewayApi_apigatewayApiBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayApi_apigatewayApiBasicExample(context),
+			},
+			{
+				ResourceName:      "google_api_gateway_api.api",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayApi_apigatewayApiBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_api_gateway_api" "api" {
+  api_id = "tf-test-api%{random_suffix}"
+}
+`, context)
+}
+
+func TestAccApiGatewayApi_update(t *testing.T) {
+	t.Parallel()
+
+	apiID := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayApiDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayApi_update(apiID, "pre-update", "my_val"),
+			},
+			{
+				ResourceName:      "google_api_gateway_api.api",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApiGatewayApi_update(apiID, "post-update", "new_val"),
+			},
+			{
+				ResourceName:      "google_api_gateway_api.api",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayApi_update(apiID, displayName, labelValue string) string {
+	return fmt.Sprintf(`
+resource "google_api_gateway_api" "api" {
+  api_id       = "%s"
+  display_name = "%s"
+
+  labels = {
+    my_key = "%s"
+  }
+}
+`, apiID, displayName, labelValue)
+}
+
+func testAccCheckApiGatewayApiDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_api_gateway_api" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/global/apis/{{api_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ApiGatewayApi still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,340 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceApiGatewayGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceApiGatewayGatewayCreate,
+		Read:   resourceApiGatewayGatewayRead,
+		Update: resourceApiGatewayGatewayUpdate,
+		Delete: resourceApiGatewayGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceApiGatewayGatewayImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(900 * time.Second),
+			Update: schema.DefaultTimeout(900 * time.Second),
+			Delete: schema.DefaultTimeout(900 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"api_config": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_hostname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceApiGatewayGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandApiGatewayGatewayDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	apiConfigProp, err := expandApiGatewayGatewayApiConfig(d.Get("api_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("api_config"); !isEmptyValue(reflect.ValueOf(apiConfigProp)) && (ok || !reflect.DeepEqual(v, apiConfigProp)) {
+		obj["apiConfig"] = apiConfigProp
+	}
+	labelsProp, err := expandApiGatewayGatewayLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/{{region}}/gateways?gatewayId={{gateway_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Gateway: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Gateway: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := apiGatewayOperationWaitTime(
+		config, res, project, "Creating Gateway",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Gateway: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Gateway %q: %#v", d.Id(), res)
+
+	return resourceApiGatewayGatewayRead(d, meta)
+}
+
+func resourceApiGatewayGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ApiGatewayGateway %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+
+	// The region and gateway id are only part of the name in API responses.
+	nameParts := strings.Split(res["name"].(string), "/")
+	if err := d.Set("region", nameParts[3]); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("gateway_id", nameParts[5]); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+
+	if err := d.Set("name", flattenApiGatewayGatewayName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("display_name", flattenApiGatewayGatewayDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("api_config", flattenApiGatewayGatewayApiConfig(res["apiConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("default_hostname", flattenApiGatewayGatewayDefaultHostname(res["defaultHostname"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+	if err := d.Set("labels", flattenApiGatewayGatewayLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Gateway: %s", err)
+	}
+
+	return nil
+}
+
+func resourceApiGatewayGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandApiGatewayGatewayDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	apiConfigProp, err := expandApiGatewayGatewayApiConfig(d.Get("api_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("api_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, apiConfigProp)) {
+		obj["apiConfig"] = apiConfigProp
+	}
+	labelsProp, err := expandApiGatewayGatewayLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Gateway %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("api_config") {
+		updateMask = append(updateMask, "apiConfig")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Gateway %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = apiGatewayOperationWaitTime(
+		config, res, project, "Updating Gateway",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceApiGatewayGatewayRead(d, meta)
+}
+
+func resourceApiGatewayGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Gateway %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Gateway")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = apiGatewayOperationWaitTime(
+		config, res, project, "Deleting Gateway",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Gateway %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceApiGatewayGatewayImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<region>[^/]+)/gateways/(?P<gateway_id>[^/]+)", "(?P<project>[^/]+)/(?P<region>[^/]+)/(?P<gateway_id>[^/]+)", "(?P<region>[^/]+)/(?P<gateway_id>[^/]+)", "(?P<gateway_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenApiGatewayGatewayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayGatewayDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayGatewayApiConfig(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayGatewayDefaultHostname(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenApiGatewayGatewayLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandApiGatewayGatewayDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandApiGatewayGatewayApiConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandApiGatewayGatewayLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
@@ -0,0 +1,159 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccApiGatewayGateway_apigatewayGatewayBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayGateway_apigatewayGatewayBasicExample(context),
+			},
+			{
+				ResourceName:      "google_api_gateway_gateway.api_gw",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayGateway_apigatewayGatewayBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_api_gateway_api" "api_gw" {
+  api_id = "tf-test-api-gw%{random_suffix}"
+}
+
+resource "google_api_gateway_api_config" "api_gw" {
+  api           = "${google_api_gateway_api.api_gw.api_id}"
+  api_config_id = "tf-test-config%{random_suffix}"
+
+  openapi_documents {
+    document {
+      path     = "spec.yaml"
+      contents = "${base64encode(file("test-fixtures/apigateway/openapi.yaml"))}"
+    }
+  }
+}
+
+resource "google_api_gateway_gateway" "api_gw" {
+  api_config = "${google_api_gateway_api_config.api_gw.name}"
+  gateway_id = "tf-test-gateway%{random_suffix}"
+  region     = "us-central1"
+}
+`, context)
+}
+
+func TestAccApiGatewayGateway_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckApiGatewayGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApiGatewayGateway_update(suffix, "first", "pre-update"),
+			},
+			{
+				ResourceName:      "google_api_gateway_gateway.api_gw",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccApiGatewayGateway_update(suffix, "second", "post-update"),
+			},
+			{
+				ResourceName:      "google_api_gateway_gateway.api_gw",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccApiGatewayGateway_update(suffix, configName, displayName string) string {
+	return fmt.Sprintf(`
+resource "google_api_gateway_api" "api_gw" {
+  api_id = "tf-test-api-gw%s"
+}
+
+resource "google_api_gateway_api_config" "first" {
+  api           = "${google_api_gateway_api.api_gw.api_id}"
+  api_config_id = "tf-test-first-%s"
+
+  openapi_documents {
+    document {
+      path     = "spec.yaml"
+      contents = "${base64encode(file("test-fixtures/apigateway/openapi.yaml"))}"
+    }
+  }
+}
+
+resource "google_api_gateway_api_config" "second" {
+  api           = "${google_api_gateway_api.api_gw.api_id}"
+  api_config_id = "tf-test-second-%s"
+
+  openapi_documents {
+    document {
+      path     = "spec.yaml"
+      contents = "${base64encode(file("test-fixtures/apigateway/openapi.yaml"))}"
+    }
+  }
+}
+
+resource "google_api_gateway_gateway" "api_gw" {
+  api_config   = "${google_api_gateway_api_config.%s.name}"
+  gateway_id   = "tf-test-gateway%s"
+  region       = "us-central1"
+  display_name = "%s"
+
+  labels = {
+    stage = "%s"
+  }
+}
+`, suffix, suffix, suffix, configName, suffix, displayName, displayName)
+}
+
+func testAccCheckApiGatewayGatewayDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_api_gateway_gateway" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://apigateway.googleapis.com/v1/projects/{{project}}/locations/{{region}}/gateways/{{gateway_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ApiGatewayGateway still exists at %s", url)
+		}
+	}
+
+	return nil
+}
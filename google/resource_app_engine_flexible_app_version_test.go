@@ -0,0 +1,127 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAppEngineFlexibleAppVersion_appEngineFlexibleAppVersionExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppEngineFlexibleAppVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEngineFlexibleAppVersion_appEngineFlexibleAppVersionExample(context),
+			},
+			{
+				ResourceName:            "google_app_engine_flexible_app_version.myapp_v1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env_variables", "deployment", "entrypoint", "service", "noop_on_destroy", "delete_service_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccAppEngineFlexibleAppVersion_appEngineFlexibleAppVersionExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "my_project" {
+  name            = "tf-test-appeng-flx"
+  project_id      = "tf-test-appeng-flx%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_app_engine_application" "app" {
+  project     = "${google_project.my_project.project_id}"
+  location_id = "us-central"
+}
+
+resource "google_project_service" "service" {
+  project = "${google_project.my_project.project_id}"
+  service = "appengineflex.googleapis.com"
+
+  disable_dependent_services = false
+}
+
+resource "google_project_iam_member" "gae_api" {
+  project = "${google_project_service.service.project}"
+  role    = "roles/compute.networkUser"
+  member  = "serviceAccount:service-${google_project.my_project.number}@gae-api-prod.google.com.iam.gserviceaccount.com"
+}
+
+resource "google_app_engine_flexible_app_version" "myapp_v1" {
+  project    = "${google_project_iam_member.gae_api.project}"
+  version_id = "v1"
+  service    = "default"
+  runtime    = "custom"
+
+  deployment {
+    container {
+      image = "gcr.io/google-samples/hello-app:1.0"
+    }
+  }
+
+  liveness_check {
+    path = "/"
+  }
+
+  readiness_check {
+    path = "/"
+  }
+
+  env_variables = {
+    port = "8080"
+  }
+
+  automatic_scaling {
+    cool_down_period = "120s"
+
+    cpu_utilization {
+      target_utilization = 0.5
+    }
+  }
+
+  noop_on_destroy = true
+}
+`, context)
+}
+
+func testAccCheckAppEngineFlexibleAppVersionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_app_engine_flexible_app_version" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://appengine.googleapis.com/v1/apps/{{project}}/services/{{service}}/versions/{{version_id}}?view=FULL")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("AppEngineFlexibleAppVersion still exists at %s", url)
+		}
+	}
+
+	return nil
+}
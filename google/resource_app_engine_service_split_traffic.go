@@ -0,0 +1,316 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAppEngineServiceSplitTraffic() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAppEngineServiceSplitTrafficCreate,
+		Read:   resourceAppEngineServiceSplitTrafficRead,
+		Update: resourceAppEngineServiceSplitTrafficUpdate,
+		Delete: resourceAppEngineServiceSplitTrafficDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceAppEngineServiceSplitTrafficImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"split": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allocations": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"shard_by": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"UNSPECIFIED", "COOKIE", "IP", "RANDOM", ""}, false),
+						},
+					},
+				},
+			},
+			"migrate_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAppEngineServiceSplitTrafficCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	splitProp, err := expandAppEngineServiceSplitTrafficSplit(d.Get("split"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("split"); !isEmptyValue(reflect.ValueOf(splitProp)) && (ok || !reflect.DeepEqual(v, splitProp)) {
+		obj["split"] = splitProp
+	}
+
+	lockName, err := replaceVars(d, config, "apps/{{project}}")
+	if err != nil {
+		return err
+	}
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://appengine.googleapis.com/v1/apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return err
+	}
+	// Only the split is written, so the rest of the service is left as it is.
+	url, err = addQueryParams(url, map[string]string{
+		"migrateTraffic": strconv.FormatBool(d.Get("migrate_traffic").(bool)),
+		"updateMask":     "split",
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ServiceSplitTraffic: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ServiceSplitTraffic: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := appEngineOperationWaitTimeFromResponse(
+		config, res, project, "Creating ServiceSplitTraffic",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create ServiceSplitTraffic: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ServiceSplitTraffic %q: %#v", d.Id(), res)
+
+	return resourceAppEngineServiceSplitTrafficRead(d, meta)
+}
+
+func resourceAppEngineServiceSplitTrafficRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://appengine.googleapis.com/v1/apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("AppEngineServiceSplitTraffic %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ServiceSplitTraffic: %s", err)
+	}
+
+	if err := d.Set("split", flattenAppEngineServiceSplitTrafficSplit(res["split"], d)); err != nil {
+		return fmt.Errorf("Error reading ServiceSplitTraffic: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAppEngineServiceSplitTrafficUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	splitProp, err := expandAppEngineServiceSplitTrafficSplit(d.Get("split"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("split"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, splitProp)) {
+		obj["split"] = splitProp
+	}
+
+	lockName, err := replaceVars(d, config, "apps/{{project}}")
+	if err != nil {
+		return err
+	}
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	url, err := replaceVars(d, config, "https://appengine.googleapis.com/v1/apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return err
+	}
+	// Only the split is written, so the rest of the service is left as it is.
+	url, err = addQueryParams(url, map[string]string{
+		"migrateTraffic": strconv.FormatBool(d.Get("migrate_traffic").(bool)),
+		"updateMask":     "split",
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ServiceSplitTraffic %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating ServiceSplitTraffic %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = appEngineOperationWaitTimeFromResponse(
+		config, res, project, "Updating ServiceSplitTraffic",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceAppEngineServiceSplitTrafficRead(d, meta)
+}
+
+func resourceAppEngineServiceSplitTrafficDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARNING] AppEngine ServiceSplitTraffic resources"+
+		" cannot be deleted from GCP. The resource %s will be removed from Terraform"+
+		" state, but will still be present on the server.", d.Id())
+	d.SetId("")
+
+	return nil
+}
+
+func resourceAppEngineServiceSplitTrafficImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"apps/(?P<project>[^/]+)/services/(?P<service>[^/]+)", "(?P<project>[^/]+)/(?P<service>[^/]+)", "(?P<service>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "apps/{{project}}/services/{{service}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAppEngineServiceSplitTrafficSplit(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["shard_by"] =
+		flattenAppEngineServiceSplitTrafficSplitShardBy(original["shardBy"], d)
+	transformed["allocations"] =
+		flattenAppEngineServiceSplitTrafficSplitAllocations(original["allocations"], d)
+	return []interface{}{transformed}
+}
+
+func flattenAppEngineServiceSplitTrafficSplitShardBy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenAppEngineServiceSplitTrafficSplitAllocations(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	// The API returns allocations as numbers, but they are configured as strings.
+	transformed := make(map[string]interface{})
+	for k, val := range v.(map[string]interface{}) {
+		if f, ok := val.(float64); ok {
+			transformed[k] = strconv.FormatFloat(f, 'f', -1, 64)
+			continue
+		}
+		transformed[k] = val
+	}
+	return transformed
+}
+
+func expandAppEngineServiceSplitTrafficSplit(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedShardBy, err := expandAppEngineServiceSplitTrafficSplitShardBy(original["shard_by"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedShardBy); val.IsValid() && !isEmptyValue(val) {
+		transformed["shardBy"] = transformedShardBy
+	}
+
+	transformedAllocations, err := expandAppEngineServiceSplitTrafficSplitAllocations(original["allocations"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAllocations); val.IsValid() && !isEmptyValue(val) {
+		transformed["allocations"] = transformedAllocations
+	}
+
+	return transformed, nil
+}
+
+func expandAppEngineServiceSplitTrafficSplitShardBy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandAppEngineServiceSplitTrafficSplitAllocations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
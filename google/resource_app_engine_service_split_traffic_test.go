@@ -0,0 +1,133 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAppEngineServiceSplitTraffic_appEngineServiceSplitTrafficExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEngineServiceSplitTraffic_appEngineServiceSplitTrafficExample(context),
+			},
+			{
+				ResourceName:            "google_app_engine_service_split_traffic.liveapp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"migrate_traffic"},
+			},
+		},
+	})
+}
+
+func testAccAppEngineServiceSplitTraffic_appEngineServiceSplitTrafficExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "my_project" {
+  name            = "tf-test-appeng-split"
+  project_id      = "tf-test-appeng-split%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_app_engine_application" "app" {
+  project     = "${google_project.my_project.project_id}"
+  location_id = "us-central"
+}
+
+resource "google_storage_bucket" "bucket" {
+  project = "${google_project.my_project.project_id}"
+  name    = "tf-test-appengine-static-content%{random_suffix}"
+}
+
+resource "google_storage_bucket_object" "main" {
+  name   = "main.py"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "./test-fixtures/appengine/hello-world-python/main.py"
+}
+
+resource "google_storage_bucket_object" "requirements" {
+  name   = "requirements.txt"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "./test-fixtures/appengine/hello-world-python/requirements.txt"
+}
+
+resource "google_app_engine_standard_app_version" "liveapp_v1" {
+  project    = "${google_app_engine_application.app.project}"
+  version_id = "v1"
+  service    = "liveapp"
+  runtime    = "python39"
+
+  entrypoint {
+    shell = "gunicorn -b :$PORT main:app"
+  }
+
+  deployment {
+    files {
+      name       = "main.py"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.main.name}"
+    }
+
+    files {
+      name       = "requirements.txt"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.requirements.name}"
+    }
+  }
+
+  delete_service_on_destroy = true
+}
+
+resource "google_app_engine_standard_app_version" "liveapp_v2" {
+  project    = "${google_app_engine_application.app.project}"
+  version_id = "v2"
+  service    = "liveapp"
+  runtime    = "python39"
+
+  entrypoint {
+    shell = "gunicorn -b :$PORT main:app"
+  }
+
+  deployment {
+    files {
+      name       = "main.py"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.main.name}"
+    }
+
+    files {
+      name       = "requirements.txt"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.requirements.name}"
+    }
+  }
+
+  noop_on_destroy = true
+}
+
+resource "google_app_engine_service_split_traffic" "liveapp" {
+  project = "${google_app_engine_standard_app_version.liveapp_v2.project}"
+  service = "${google_app_engine_standard_app_version.liveapp_v2.service}"
+
+  migrate_traffic = false
+
+  split {
+    shard_by = "IP"
+
+    allocations = {
+      "${google_app_engine_standard_app_version.liveapp_v1.version_id}" = 0.75
+      "${google_app_engine_standard_app_version.liveapp_v2.version_id}" = 0.25
+    }
+  }
+}
+`, context)
+}
@@ -0,0 +1,140 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAppEngineStandardAppVersion_appEngineStandardAppVersionExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":          getTestOrgFromEnv(t),
+		"billing_account": getTestBillingAccountFromEnv(t),
+		"random_suffix":   acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAppEngineStandardAppVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppEngineStandardAppVersion_appEngineStandardAppVersionExample(context),
+			},
+			{
+				ResourceName:            "google_app_engine_standard_app_version.myapp_v1",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env_variables", "deployment", "entrypoint", "service", "noop_on_destroy", "delete_service_on_destroy"},
+			},
+		},
+	})
+}
+
+func testAccAppEngineStandardAppVersion_appEngineStandardAppVersionExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_project" "my_project" {
+  name            = "tf-test-appeng-std"
+  project_id      = "tf-test-appeng-std%{random_suffix}"
+  org_id          = "%{org_id}"
+  billing_account = "%{billing_account}"
+}
+
+resource "google_app_engine_application" "app" {
+  project     = "${google_project.my_project.project_id}"
+  location_id = "us-central"
+}
+
+resource "google_storage_bucket" "bucket" {
+  project = "${google_project.my_project.project_id}"
+  name    = "tf-test-appengine-static-content%{random_suffix}"
+}
+
+resource "google_storage_bucket_object" "main" {
+  name   = "main.py"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "./test-fixtures/appengine/hello-world-python/main.py"
+}
+
+resource "google_storage_bucket_object" "requirements" {
+  name   = "requirements.txt"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "./test-fixtures/appengine/hello-world-python/requirements.txt"
+}
+
+resource "google_app_engine_standard_app_version" "myapp_v1" {
+  project    = "${google_app_engine_application.app.project}"
+  version_id = "v1"
+  service    = "myapp"
+  runtime    = "python39"
+
+  entrypoint {
+    shell = "gunicorn -b :$PORT main:app"
+  }
+
+  deployment {
+    files {
+      name       = "main.py"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.main.name}"
+    }
+
+    files {
+      name       = "requirements.txt"
+      source_url = "https://storage.googleapis.com/${google_storage_bucket.bucket.name}/${google_storage_bucket_object.requirements.name}"
+    }
+  }
+
+  env_variables = {
+    port = "8080"
+  }
+
+  automatic_scaling {
+    max_concurrent_requests = 10
+    min_idle_instances      = 1
+    max_idle_instances      = 3
+    min_pending_latency     = "1s"
+    max_pending_latency     = "5s"
+
+    standard_scheduler_settings {
+      target_cpu_utilization        = 0.5
+      target_throughput_utilization = 0.75
+      min_instances                 = 2
+      max_instances                 = 10
+    }
+  }
+
+  delete_service_on_destroy = true
+}
+`, context)
+}
+
+func testAccCheckAppEngineStandardAppVersionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_app_engine_standard_app_version" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://appengine.googleapis.com/v1/apps/{{project}}/services/{{service}}/versions/{{version_id}}?view=FULL")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("AppEngineStandardAppVersion still exists at %s", url)
+		}
+	}
+
+	return nil
+}
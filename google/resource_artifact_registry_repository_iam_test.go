@@ -0,0 +1,132 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccArtifactRegistryRepositoryIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-ar-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactRegistryRepositoryIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_artifact_registry_repository_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/repositories/%s roles/artifactregistry.reader", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccArtifactRegistryRepositoryIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-ar-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactRegistryRepositoryIamMember_basic(name, account),
+			},
+			{
+				ResourceName:      "google_artifact_registry_repository_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/repositories/%s roles/artifactregistry.reader serviceAccount:%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), name, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccArtifactRegistryRepositoryIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-ar-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArtifactRegistryRepositoryIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_artifact_registry_repository_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/repositories/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccArtifactRegistryRepositoryIam_base(name, account string) string {
+	return fmt.Sprintf(`
+resource "google_artifact_registry_repository" "default" {
+  repository_id = "%s"
+  location      = "us-central1"
+  format        = "DOCKER"
+}
+
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Artifact Registry IAM Testing Account"
+}
+`, name, account)
+}
+
+func testAccArtifactRegistryRepositoryIamBinding_basic(name, account string) string {
+	return testAccArtifactRegistryRepositoryIam_base(name, account) + `
+resource "google_artifact_registry_repository_iam_binding" "foo" {
+  location   = "${google_artifact_registry_repository.default.location}"
+  repository = "${google_artifact_registry_repository.default.repository_id}"
+  role       = "roles/artifactregistry.reader"
+  members    = ["serviceAccount:${google_service_account.test.email}"]
+}
+`
+}
+
+func testAccArtifactRegistryRepositoryIamMember_basic(name, account string) string {
+	return testAccArtifactRegistryRepositoryIam_base(name, account) + `
+resource "google_artifact_registry_repository_iam_member" "foo" {
+  location   = "${google_artifact_registry_repository.default.location}"
+  repository = "${google_artifact_registry_repository.default.repository_id}"
+  role       = "roles/artifactregistry.reader"
+  member     = "serviceAccount:${google_service_account.test.email}"
+}
+`
+}
+
+func testAccArtifactRegistryRepositoryIamPolicy_basic(name, account string) string {
+	return testAccArtifactRegistryRepositoryIam_base(name, account) + `
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/artifactregistry.reader"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_artifact_registry_repository_iam_policy" "foo" {
+  location    = "${google_artifact_registry_repository.default.location}"
+  repository  = "${google_artifact_registry_repository.default.repository_id}"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`
+}
@@ -0,0 +1,348 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBigqueryReservationBiReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigqueryReservationBiReservationCreate,
+		Read:   resourceBigqueryReservationBiReservationRead,
+		Update: resourceBigqueryReservationBiReservationUpdate,
+		Delete: resourceBigqueryReservationBiReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigqueryReservationBiReservationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"preferred_tables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dataset_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"table_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigqueryReservationBiReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	sizeProp, err := expandBigqueryReservationBiReservationSize(d.Get("size"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("size"); !isEmptyValue(reflect.ValueOf(sizeProp)) && (ok || !reflect.DeepEqual(v, sizeProp)) {
+		obj["size"] = sizeProp
+	}
+	preferredTablesProp, err := expandBigqueryReservationBiReservationPreferredTables(d.Get("preferred_tables"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("preferred_tables"); !isEmptyValue(reflect.ValueOf(preferredTablesProp)) && (ok || !reflect.DeepEqual(v, preferredTablesProp)) {
+		obj["preferredTables"] = preferredTablesProp
+	}
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new BiReservation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating BiReservation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating BiReservation %q: %#v", d.Id(), res)
+
+	return resourceBigqueryReservationBiReservationRead(d, meta)
+}
+
+func resourceBigqueryReservationBiReservationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BigqueryReservationBiReservation %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading BiReservation: %s", err)
+	}
+
+	if err := d.Set("size", flattenBigqueryReservationBiReservationSize(res["size"], d)); err != nil {
+		return fmt.Errorf("Error reading BiReservation: %s", err)
+	}
+	if err := d.Set("preferred_tables", flattenBigqueryReservationBiReservationPreferredTables(res["preferredTables"], d)); err != nil {
+		return fmt.Errorf("Error reading BiReservation: %s", err)
+	}
+	if err := d.Set("name", flattenBigqueryReservationBiReservationName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading BiReservation: %s", err)
+	}
+	if err := d.Set("update_time", flattenBigqueryReservationBiReservationUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading BiReservation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBigqueryReservationBiReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	sizeProp, err := expandBigqueryReservationBiReservationSize(d.Get("size"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("size"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, sizeProp)) {
+		obj["size"] = sizeProp
+	}
+	preferredTablesProp, err := expandBigqueryReservationBiReservationPreferredTables(d.Get("preferred_tables"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("preferred_tables"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, preferredTablesProp)) {
+		obj["preferredTables"] = preferredTablesProp
+	}
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating BiReservation %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("size") {
+		updateMask = append(updateMask, "size")
+	}
+
+	if d.HasChange("preferred_tables") {
+		updateMask = append(updateMask, "preferredTables")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating BiReservation %q: %s", d.Id(), err)
+	}
+
+	return resourceBigqueryReservationBiReservationRead(d, meta)
+}
+
+func resourceBigqueryReservationBiReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// Every project location has a single BI Engine reservation, it's released
+	// by setting its size to zero.
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return err
+	}
+	url, err = addQueryParams(url, map[string]string{"updateMask": "size,preferredTables"})
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"size":            0,
+		"preferredTables": []interface{}{},
+	}
+	log.Printf("[DEBUG] Deleting BiReservation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "BiReservation")
+	}
+
+	log.Printf("[DEBUG] Finished deleting BiReservation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBigqueryReservationBiReservationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/biReservation", "(?P<project>[^/]+)/(?P<location>[^/]+)", "(?P<location>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/biReservation")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBigqueryReservationBiReservationSize(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenBigqueryReservationBiReservationPreferredTables(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"project_id": flattenBigqueryReservationBiReservationPreferredTablesProjectId(original["projectId"], d),
+			"dataset_id": flattenBigqueryReservationBiReservationPreferredTablesDatasetId(original["datasetId"], d),
+			"table_id":   flattenBigqueryReservationBiReservationPreferredTablesTableId(original["tableId"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenBigqueryReservationBiReservationPreferredTablesProjectId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationBiReservationPreferredTablesDatasetId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationBiReservationPreferredTablesTableId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationBiReservationName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationBiReservationUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandBigqueryReservationBiReservationSize(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationBiReservationPreferredTables(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedProjectId, err := expandBigqueryReservationBiReservationPreferredTablesProjectId(original["project_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedProjectId); val.IsValid() && !isEmptyValue(val) {
+			transformed["projectId"] = transformedProjectId
+		}
+
+		transformedDatasetId, err := expandBigqueryReservationBiReservationPreferredTablesDatasetId(original["dataset_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDatasetId); val.IsValid() && !isEmptyValue(val) {
+			transformed["datasetId"] = transformedDatasetId
+		}
+
+		transformedTableId, err := expandBigqueryReservationBiReservationPreferredTablesTableId(original["table_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedTableId); val.IsValid() && !isEmptyValue(val) {
+			transformed["tableId"] = transformedTableId
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandBigqueryReservationBiReservationPreferredTablesProjectId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationBiReservationPreferredTablesDatasetId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationBiReservationPreferredTablesTableId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,85 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryReservationBiReservation_bigqueryReservationBiReservationBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationBiReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationBiReservation_bigqueryReservationBiReservationBasicExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_bi_reservation.reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationBiReservation_bigqueryReservationBiReservationBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_dataset" "dataset" {
+  dataset_id = "tf_test_bi_dataset%{random_suffix}"
+  location   = "us-west2"
+}
+
+resource "google_bigquery_table" "table" {
+  dataset_id = "${google_bigquery_dataset.dataset.dataset_id}"
+  table_id   = "tf_test_bi_table%{random_suffix}"
+}
+
+resource "google_bigquery_bi_reservation" "reservation" {
+  location = "us-west2"
+  size     = 3000000000
+
+  preferred_tables {
+    project_id = "${google_bigquery_table.table.project}"
+    dataset_id = "${google_bigquery_dataset.dataset.dataset_id}"
+    table_id   = "${google_bigquery_table.table.table_id}"
+  }
+}
+`, context)
+}
+
+func testAccCheckBigqueryReservationBiReservationDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_bi_reservation" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://bigqueryreservation.googleapis.com/v1/projects/{{project}}/locations/{{location}}/biReservation")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryReservationBiReservation still exists at %s", url)
+		}
+	}
+
+	return nil
+}
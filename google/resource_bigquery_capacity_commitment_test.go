@@ -0,0 +1,74 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationCapacityCommitmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentExample(context),
+			},
+			{
+				ResourceName:            "google_bigquery_capacity_commitment.commitment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"capacity_commitment_id", "location"},
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationCapacityCommitment_bigqueryReservationCapacityCommitmentExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_capacity_commitment" "commitment" {
+  capacity_commitment_id = "tf-test-capacity-commitment%{random_suffix}"
+
+  location             = "us-west2"
+  slot_count           = 100
+  plan                 = "FLEX_FLAT_RATE"
+  edition              = "ENTERPRISE"
+}
+`, context)
+}
+
+func testAccCheckBigqueryReservationCapacityCommitmentDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_capacity_commitment" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://bigqueryreservation.googleapis.com/v1/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryReservationCapacityCommitment still exists at %s", url)
+		}
+	}
+
+	return nil
+}
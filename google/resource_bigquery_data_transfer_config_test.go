@@ -0,0 +1,98 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryDataTransferConfig_bigquerydatatransferConfigScheduledQueryExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryDataTransferConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryDataTransferConfig_bigquerydatatransferConfigScheduledQueryExample(context),
+			},
+			{
+				ResourceName:            "google_bigquery_data_transfer_config.query_config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"location"},
+			},
+		},
+	})
+}
+
+func testAccBigqueryDataTransferConfig_bigquerydatatransferConfigScheduledQueryExample(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project_service_agent" "bigquerydatatransfer" {
+  service = "bigquerydatatransfer.googleapis.com"
+}
+
+resource "google_project_iam_member" "permissions" {
+  role   = "roles/iam.serviceAccountShortTermTokenMinter"
+  member = "${data.google_project_service_agent.bigquerydatatransfer.member}"
+}
+
+resource "google_bigquery_dataset" "my_dataset" {
+  depends_on = ["google_project_iam_member.permissions"]
+
+  dataset_id    = "tf_test_my_dataset%{random_suffix}"
+  friendly_name = "foo"
+  description   = "bar"
+  location      = "asia-northeast1"
+}
+
+resource "google_bigquery_data_transfer_config" "query_config" {
+  depends_on = ["google_project_iam_member.permissions"]
+
+  display_name           = "tf-test-my-query-%{random_suffix}"
+  location               = "asia-northeast1"
+  data_source_id         = "scheduled_query"
+  schedule               = "first sunday of quarter 00:00"
+  destination_dataset_id = "${google_bigquery_dataset.my_dataset.dataset_id}"
+  params = {
+    destination_table_name_template = "my_table"
+    write_disposition               = "WRITE_APPEND"
+    query                           = "SELECT name FROM tabl WHERE x = 'y'"
+  }
+}
+`, context)
+}
+
+func testAccCheckBigqueryDataTransferConfigDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_data_transfer_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://bigquerydatatransfer.googleapis.com/v1/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryDataTransferConfig still exists at %s", url)
+		}
+	}
+
+	return nil
+}
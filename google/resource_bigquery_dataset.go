@@ -12,6 +12,10 @@ import (
 
 const datasetIdRegexp = `[0-9A-Za-z_]+`
 
+// Fields that the vendored BigQuery client doesn't know about, such as a
+// dataset's default encryption, are read and written through the REST API.
+const bigQueryBasePath = "https://bigquery.googleapis.com/bigquery/v2/"
+
 func resourceBigQueryDataset() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigQueryDatasetCreate,
@@ -184,6 +188,25 @@ func resourceBigQueryDataset() *schema.Resource {
 				},
 			},
 
+			// DefaultEncryptionConfiguration: [Optional] The default encryption key
+			// for all tables in the dataset. Once this property is set, all
+			// newly-created partitioned tables in the dataset will have encryption
+			// key set to this value, unless table creation request (or query)
+			// overrides the key.
+			"default_encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			// Delete Contents on Destroy: [Optional] If True, delete all the tables in the dataset.
 			// If False and the dataset contains tables, the request will fail.
 			// Default is False.
@@ -225,7 +248,7 @@ func resourceBigQueryDataset() *schema.Resource {
 	}
 }
 
-func resourceDataset(d *schema.ResourceData, meta interface{}) (*bigquery.Dataset, error) {
+func resourceDataset(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -313,7 +336,19 @@ func resourceDataset(d *schema.ResourceData, meta interface{}) (*bigquery.Datase
 		dataset.Access = access
 	}
 
-	return dataset, nil
+	obj, err := ConvertToMap(dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	if v, ok := d.GetOk("default_encryption_configuration"); ok {
+		raw := v.([]interface{})[0].(map[string]interface{})
+		obj["defaultEncryptionConfiguration"] = map[string]interface{}{
+			"kmsKeyName": raw["kms_key_name"],
+		}
+	}
+
+	return obj, nil
 }
 
 func resourceBigQueryDatasetCreate(d *schema.ResourceData, meta interface{}) error {
@@ -329,13 +364,18 @@ func resourceBigQueryDatasetCreate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	log.Printf("[INFO] Creating BigQuery dataset: %s", dataset.DatasetReference.DatasetId)
+	log.Printf("[INFO] Creating BigQuery dataset: %s", d.Get("dataset_id").(string))
 
-	res, err := config.clientBigQuery.Datasets.Insert(project, dataset).Do()
+	raw, err := sendRequest(config, "POST", bigQueryBasePath+"projects/"+project+"/datasets", dataset)
 	if err != nil {
 		return err
 	}
 
+	res := &bigquery.Dataset{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] BigQuery dataset %s has been created", res.Id)
 
 	d.SetId(res.Id)
@@ -353,11 +393,16 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	res, err := config.clientBigQuery.Datasets.Get(id.Project, id.DatasetId).Do()
+	raw, err := sendRequest(config, "GET", bigQueryBasePath+"projects/"+id.Project+"/datasets/"+id.DatasetId, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("BigQuery dataset %q", id.DatasetId))
 	}
 
+	res := &bigquery.Dataset{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
+
 	d.Set("project", id.Project)
 	d.Set("etag", res.Etag)
 	d.Set("labels", res.Labels)
@@ -372,6 +417,9 @@ func resourceBigQueryDatasetRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("dataset_id", res.DatasetReference.DatasetId)
 	d.Set("default_partition_expiration_ms", res.DefaultPartitionExpirationMs)
 	d.Set("default_table_expiration_ms", res.DefaultTableExpirationMs)
+	if err := d.Set("default_encryption_configuration", flattenBigQueryDatasetDefaultEncryptionConfiguration(raw["defaultEncryptionConfiguration"])); err != nil {
+		return err
+	}
 
 	// Older Tables in BigQuery have no Location set in the API response. This may be an issue when importing
 	// tables created before BigQuery was available in multiple zones. We can safely assume that these tables
@@ -400,7 +448,7 @@ func resourceBigQueryDatasetUpdate(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	if _, err = config.clientBigQuery.Datasets.Update(id.Project, id.DatasetId, dataset).Do(); err != nil {
+	if _, err = sendRequest(config, "PUT", bigQueryBasePath+"projects/"+id.Project+"/datasets/"+id.DatasetId, dataset); err != nil {
 		return err
 	}
 
@@ -466,3 +514,11 @@ func flattenAccess(a []*bigquery.DatasetAccess) []map[string]interface{} {
 	}
 	return access
 }
+
+func flattenBigQueryDatasetDefaultEncryptionConfiguration(v interface{}) []map[string]interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{{"kms_key_name": original["kmsKeyName"]}}
+}
@@ -0,0 +1,454 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Datasets report access entries granted with a predefined BigQuery role as
+// the equivalent legacy role.
+var bigqueryAccessRoleToPrimitiveMap = map[string]string{
+	"roles/bigquery.dataOwner":  "OWNER",
+	"roles/bigquery.dataEditor": "WRITER",
+	"roles/bigquery.dataViewer": "READER",
+}
+
+var bigqueryDatasetAccessMemberKeys = []string{
+	"user_by_email",
+	"group_by_email",
+	"domain",
+	"special_group",
+	"iam_member",
+	"view",
+}
+
+// bigqueryDatasetAccessApiMemberKeys maps the access entry fields used in
+// resource ids to their schema keys.
+var bigqueryDatasetAccessApiMemberKeys = map[string]string{
+	"userByEmail":  "user_by_email",
+	"groupByEmail": "group_by_email",
+	"domain":       "domain",
+	"specialGroup": "special_group",
+	"iamMember":    "iam_member",
+	"view":         "view",
+}
+
+func resourceBigQueryDatasetAccess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigQueryDatasetAccessCreate,
+		Read:   resourceBigQueryDatasetAccessRead,
+		Delete: resourceBigQueryDatasetAccessDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigQueryDatasetAccessImport,
+		},
+
+		CustomizeDiff: resourceBigQueryDatasetAccessMemberCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"view"},
+				DiffSuppressFunc: resourceBigQueryDatasetAccessRoleDiffSuppress,
+			},
+			"user_by_email": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"group_by_email", "domain", "special_group", "iam_member", "view"},
+				DiffSuppressFunc: caseDiffSuppress,
+			},
+			"group_by_email": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"user_by_email", "domain", "special_group", "iam_member", "view"},
+				DiffSuppressFunc: caseDiffSuppress,
+			},
+			"domain": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_by_email", "group_by_email", "special_group", "iam_member", "view"},
+			},
+			"special_group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_by_email", "group_by_email", "domain", "iam_member", "view"},
+			},
+			"iam_member": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user_by_email", "group_by_email", "domain", "special_group", "view"},
+			},
+			"view": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"user_by_email", "group_by_email", "domain", "special_group", "iam_member"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"dataset_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"table_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigQueryDatasetAccessRoleDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if primitiveRole, ok := bigqueryAccessRoleToPrimitiveMap[new]; ok {
+		return primitiveRole == old
+	}
+	return false
+}
+
+// resourceBigQueryDatasetAccessMemberCustomizeDiff requires one of the member
+// fields to be set. ConflictsWith already prevents setting more than one.
+func resourceBigQueryDatasetAccessMemberCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range bigqueryDatasetAccessMemberKeys {
+		// A value that isn't known yet will be set once it is.
+		if _, ok := d.GetOk(k); ok || !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	return fmt.Errorf("One of %s must be set", strings.Join(bigqueryDatasetAccessMemberKeys, ", "))
+}
+
+func resourceBigQueryDatasetAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	lockName := bigQueryDatasetAccessMutexKey(project, d.Get("dataset_id").(string))
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	entry := expandBigQueryDatasetAccessEntry(d)
+	err = bigQueryDatasetAccessReadModifyWrite(d, config, project, func(access []interface{}) ([]interface{}, error) {
+		if findBigQueryDatasetAccessEntry(access, entry) >= 0 {
+			return nil, fmt.Errorf("Access entry %v already exists on dataset %s", entry, d.Get("dataset_id").(string))
+		}
+		return append(access, entry), nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating DatasetAccess: %s", err)
+	}
+
+	d.SetId(bigQueryDatasetAccessId(project, d.Get("dataset_id").(string), entry))
+
+	return resourceBigQueryDatasetAccessRead(d, meta)
+}
+
+func resourceBigQueryDatasetAccessRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/bigquery/v2/projects/%s/datasets/%s", project, d.Get("dataset_id").(string))
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BigQueryDatasetAccess %q", d.Id()))
+	}
+
+	access, _ := res["access"].([]interface{})
+	if findBigQueryDatasetAccessEntry(access, expandBigQueryDatasetAccessEntry(d)) < 0 {
+		// Object isn't there any more - remove it from the state.
+		log.Printf("[DEBUG] Removing BigQueryDatasetAccess because it couldn't be matched.")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	return nil
+}
+
+func resourceBigQueryDatasetAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	lockName := bigQueryDatasetAccessMutexKey(project, d.Get("dataset_id").(string))
+	mutexKV.Lock(lockName)
+	defer mutexKV.Unlock(lockName)
+
+	entry := expandBigQueryDatasetAccessEntry(d)
+	log.Printf("[DEBUG] Deleting DatasetAccess %q", d.Id())
+	err = bigQueryDatasetAccessReadModifyWrite(d, config, project, func(access []interface{}) ([]interface{}, error) {
+		i := findBigQueryDatasetAccessEntry(access, entry)
+		if i < 0 {
+			return access, nil
+		}
+		return append(access[:i], access[i+1:]...), nil
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, "DatasetAccess")
+	}
+
+	log.Printf("[DEBUG] Finished deleting DatasetAccess %q", d.Id())
+	return nil
+}
+
+// The id is parsed back into the fields bigQueryDatasetAccessId built it
+// from. Roles and iam members may contain slashes, and custom role ids may
+// look like a member type, so a member type only matches when what precedes
+// it is a valid role and what follows it has the member's number of segments.
+func resourceBigQueryDatasetAccessImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := regexp.MustCompile(`^projects/([^/]+)/datasets/([^/]+)/(.+)$`).FindStringSubmatch(d.Id())
+	if parts == nil {
+		return nil, fmt.Errorf("Invalid dataset access specifier. Expecting projects/{project}/datasets/{dataset_id}[/{role}]/{member_type}/{member}, got %q", d.Id())
+	}
+	project, datasetId := parts[1], parts[2]
+
+	segments := strings.Split(parts[3], "/")
+	for i, segment := range segments {
+		field, ok := bigqueryDatasetAccessApiMemberKeys[segment]
+		if !ok || !isBigQueryDatasetAccessRole(segments[:i]) || !isBigQueryDatasetAccessMemberLength(field, len(segments)-i-1) {
+			continue
+		}
+
+		member := strings.Join(segments[i+1:], "/")
+		if member == "" {
+			continue
+		}
+		if field == "view" {
+			view := strings.Split(member, "/")
+			d.Set("view", []interface{}{
+				map[string]interface{}{
+					"project_id": view[0],
+					"dataset_id": view[1],
+					"table_id":   view[2],
+				},
+			})
+		} else {
+			d.Set(field, member)
+		}
+
+		if role := strings.Join(segments[:i], "/"); role != "" {
+			d.Set("role", role)
+		}
+		d.Set("project", project)
+		d.Set("dataset_id", datasetId)
+		d.SetId(bigQueryDatasetAccessId(project, datasetId, expandBigQueryDatasetAccessEntry(d)))
+
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("Invalid dataset access specifier %q, no member type found", d.Id())
+}
+
+// isBigQueryDatasetAccessRole reports whether segments form a role in an access
+// id: none for views, a legacy role, roles/{role} or a custom role.
+func isBigQueryDatasetAccessRole(segments []string) bool {
+	switch len(segments) {
+	case 0, 1:
+		return true
+	case 2:
+		return segments[0] == "roles"
+	case 4:
+		return (segments[0] == "projects" || segments[0] == "organizations") && segments[2] == "roles"
+	}
+	return false
+}
+
+// isBigQueryDatasetAccessMemberLength reports whether a member of the given
+// type can span n segments of an access id.
+func isBigQueryDatasetAccessMemberLength(field string, n int) bool {
+	switch field {
+	case "view":
+		return n == 3
+	case "iam_member":
+		return n >= 1
+	}
+	return n == 1
+}
+
+func bigQueryDatasetAccessMutexKey(project, datasetId string) string {
+	return fmt.Sprintf("bigquery-dataset-access-%s/%s", project, datasetId)
+}
+
+func bigQueryDatasetAccessId(project, datasetId string, entry map[string]interface{}) string {
+	id := fmt.Sprintf("projects/%s/datasets/%s", project, datasetId)
+	if role, ok := entry["role"]; ok {
+		id = id + "/" + role.(string)
+	}
+	for k, v := range entry {
+		switch k {
+		case "role":
+		case "view":
+			view := v.(map[string]interface{})
+			id = fmt.Sprintf("%s/view/%s/%s/%s", id, view["projectId"], view["datasetId"], view["tableId"])
+		default:
+			id = fmt.Sprintf("%s/%s/%s", id, k, v)
+		}
+	}
+	return id
+}
+
+// bigQueryDatasetAccessReadModifyWrite fetches the dataset's access list, applies
+// modify to it and writes it back. The write is conditional on the dataset's
+// etag, and is retried from the read if the dataset changed in between.
+func bigQueryDatasetAccessReadModifyWrite(d *schema.ResourceData, config *Config, project string, modify func([]interface{}) ([]interface{}, error)) error {
+	url := fmt.Sprintf("https://www.googleapis.com/bigquery/v2/projects/%s/datasets/%s", project, d.Get("dataset_id").(string))
+
+	backoff := time.Second
+	for {
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return err
+		}
+
+		access, _ := res["access"].([]interface{})
+		access, err = modify(access)
+		if err != nil {
+			return err
+		}
+
+		obj := map[string]interface{}{
+			"access": access,
+		}
+		headers := make(http.Header)
+		if etag, ok := res["etag"].(string); ok && etag != "" {
+			headers.Set("If-Match", etag)
+		}
+		_, err = sendRequestWithHeaders(config, "PATCH", url, obj, headers, DefaultRequestTimeout)
+		if isGoogleApiErrorWithCode(err, 412) {
+			log.Printf("[DEBUG]: Concurrent access changes on dataset %s, restarting read-modify-write after %s\n", d.Get("dataset_id").(string), backoff)
+			time.Sleep(backoff)
+			backoff = backoff * 2
+			if backoff > 30*time.Second {
+				return errwrap.Wrapf(fmt.Sprintf("Error updating access of dataset %s: Too many conflicts.  Latest error: {{err}}", d.Get("dataset_id").(string)), err)
+			}
+			continue
+		}
+		return err
+	}
+}
+
+func expandBigQueryDatasetAccessEntry(d *schema.ResourceData) map[string]interface{} {
+	entry := make(map[string]interface{})
+	if v, ok := d.GetOk("role"); ok {
+		entry["role"] = v.(string)
+	}
+	if v, ok := d.GetOk("user_by_email"); ok {
+		entry["userByEmail"] = v.(string)
+	}
+	if v, ok := d.GetOk("group_by_email"); ok {
+		entry["groupByEmail"] = v.(string)
+	}
+	if v, ok := d.GetOk("domain"); ok {
+		entry["domain"] = v.(string)
+	}
+	if v, ok := d.GetOk("special_group"); ok {
+		entry["specialGroup"] = v.(string)
+	}
+	if v, ok := d.GetOk("iam_member"); ok {
+		entry["iamMember"] = v.(string)
+	}
+	if v, ok := d.GetOk("view"); ok {
+		view := v.([]interface{})[0].(map[string]interface{})
+		entry["view"] = map[string]interface{}{
+			"projectId": view["project_id"],
+			"datasetId": view["dataset_id"],
+			"tableId":   view["table_id"],
+		}
+	}
+	return entry
+}
+
+// findBigQueryDatasetAccessEntry returns the index of want in access, or -1.
+// Emails are compared case-insensitively and predefined roles match their
+// legacy equivalent, as that's how the API reports them back.
+func findBigQueryDatasetAccessEntry(access []interface{}, want map[string]interface{}) int {
+	for i, raw := range access {
+		item, ok := raw.(map[string]interface{})
+		if !ok || len(item) != len(want) {
+			continue
+		}
+		if bigQueryDatasetAccessEntriesEqual(item, want) {
+			return i
+		}
+	}
+	return -1
+}
+
+func bigQueryDatasetAccessEntriesEqual(item, want map[string]interface{}) bool {
+	for k, wantV := range want {
+		itemV, ok := item[k]
+		if !ok {
+			return false
+		}
+		switch k {
+		case "role":
+			wantRole := wantV.(string)
+			if primitiveRole, ok := bigqueryAccessRoleToPrimitiveMap[wantRole]; ok {
+				wantRole = primitiveRole
+			}
+			if itemV != wantRole {
+				return false
+			}
+		case "view":
+			itemView, ok := itemV.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			for _, f := range []string{"projectId", "datasetId", "tableId"} {
+				if itemView[f] != wantV.(map[string]interface{})[f] {
+					return false
+				}
+			}
+		default:
+			itemS, _ := itemV.(string)
+			if !strings.EqualFold(itemS, wantV.(string)) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,408 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestBigQueryDatasetAccessEntriesEqual(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		item, want map[string]interface{}
+		expected   bool
+	}{
+		"email case": {
+			item:     map[string]interface{}{"role": "READER", "userByEmail": "Someone@example.com"},
+			want:     map[string]interface{}{"role": "READER", "userByEmail": "someone@example.com"},
+			expected: true,
+		},
+		"predefined role": {
+			item:     map[string]interface{}{"role": "WRITER", "groupByEmail": "team@example.com"},
+			want:     map[string]interface{}{"role": "roles/bigquery.dataEditor", "groupByEmail": "team@example.com"},
+			expected: true,
+		},
+		"different role": {
+			item:     map[string]interface{}{"role": "OWNER", "domain": "example.com"},
+			want:     map[string]interface{}{"role": "READER", "domain": "example.com"},
+			expected: false,
+		},
+		"view": {
+			item:     map[string]interface{}{"view": map[string]interface{}{"projectId": "p", "datasetId": "d", "tableId": "t"}},
+			want:     map[string]interface{}{"view": map[string]interface{}{"projectId": "p", "datasetId": "d", "tableId": "t"}},
+			expected: true,
+		},
+		"different view": {
+			item:     map[string]interface{}{"view": map[string]interface{}{"projectId": "p", "datasetId": "d", "tableId": "t"}},
+			want:     map[string]interface{}{"view": map[string]interface{}{"projectId": "p", "datasetId": "d", "tableId": "other"}},
+			expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := bigQueryDatasetAccessEntriesEqual(tc.item, tc.want); got != tc.expected {
+			t.Errorf("bad: %s, expected %t, got %t", tn, tc.expected, got)
+		}
+	}
+}
+
+func TestBigQueryDatasetAccess_memberValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Config        map[string]interface{}
+		ExpectedError bool
+	}{
+		"one member": {
+			Config: map[string]interface{}{
+				"dataset_id":    "my_dataset",
+				"role":          "READER",
+				"user_by_email": "jane@example.com",
+			},
+		},
+		"no member": {
+			Config: map[string]interface{}{
+				"dataset_id": "my_dataset",
+				"role":       "READER",
+			},
+			ExpectedError: true,
+		},
+		"two members": {
+			Config: map[string]interface{}{
+				"dataset_id":    "my_dataset",
+				"role":          "READER",
+				"user_by_email": "jane@example.com",
+				"domain":        "example.com",
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%s: %s", tn, err)
+		}
+		c := terraform.NewResourceConfig(raw)
+
+		r := resourceBigQueryDatasetAccess()
+		_, errs := r.Validate(c)
+		if len(errs) == 0 {
+			_, err = r.Diff(nil, c, nil)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if len(errs) > 0 && !tc.ExpectedError {
+			t.Errorf("%s: unexpected errors %v", tn, errs)
+		}
+		if len(errs) == 0 && tc.ExpectedError {
+			t.Errorf("%s: expected an error", tn)
+		}
+	}
+}
+
+func TestBigQueryDatasetAccessImport(t *testing.T) {
+	cases := map[string]struct {
+		Id       string
+		Expected map[string]interface{}
+	}{
+		"user with predefined role": {
+			Id: "projects/my-project/datasets/my_dataset/roles/bigquery.dataViewer/userByEmail/jane@example.com",
+			Expected: map[string]interface{}{
+				"role":        "roles/bigquery.dataViewer",
+				"userByEmail": "jane@example.com",
+			},
+		},
+		"user with predefined role in another project": {
+			Id: "projects/p/datasets/d/roles/bigquery.dataViewer/userByEmail/a@b.com",
+			Expected: map[string]interface{}{
+				"role":        "roles/bigquery.dataViewer",
+				"userByEmail": "a@b.com",
+			},
+		},
+		"user with custom role named like a member type": {
+			Id: "projects/my-project/datasets/my_dataset/projects/my-project/roles/domain/userByEmail/jane@example.com",
+			Expected: map[string]interface{}{
+				"role":        "projects/my-project/roles/domain",
+				"userByEmail": "jane@example.com",
+			},
+		},
+		"domain with organization custom role": {
+			Id: "projects/my-project/datasets/my_dataset/organizations/123/roles/view/domain/example.com",
+			Expected: map[string]interface{}{
+				"role":   "organizations/123/roles/view",
+				"domain": "example.com",
+			},
+		},
+		"iam member containing slashes": {
+			Id: "projects/my-project/datasets/my_dataset/READER/iamMember/principal://iam.googleapis.com/locations/global/workforcePools/pool/subject/jane",
+			Expected: map[string]interface{}{
+				"role":      "READER",
+				"iamMember": "principal://iam.googleapis.com/locations/global/workforcePools/pool/subject/jane",
+			},
+		},
+		"view": {
+			Id: "projects/my-project/datasets/my_dataset/view/other-project/other_dataset/my_view",
+			Expected: map[string]interface{}{
+				"view": map[string]interface{}{
+					"projectId": "other-project",
+					"datasetId": "other_dataset",
+					"tableId":   "my_view",
+				},
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceBigQueryDatasetAccess().Schema, map[string]interface{}{})
+		d.SetId(tc.Id)
+
+		if _, err := resourceBigQueryDatasetAccessImport(d, nil); err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+			continue
+		}
+		if prefix := fmt.Sprintf("projects/%s/datasets/%s/", d.Get("project"), d.Get("dataset_id")); !strings.HasPrefix(tc.Id, prefix) {
+			t.Errorf("%s: expected project and dataset from %q, got %q and %q", tn, tc.Id, d.Get("project"), d.Get("dataset_id"))
+		}
+		if entry := expandBigQueryDatasetAccessEntry(d); !reflect.DeepEqual(entry, tc.Expected) {
+			t.Errorf("%s: expected entry %v, got %v", tn, tc.Expected, entry)
+		}
+		if d.Id() != tc.Id {
+			t.Errorf("%s: expected id %q, got %q", tn, tc.Id, d.Id())
+		}
+	}
+
+	for _, id := range []string{
+		"my_dataset/READER/userByEmail/jane@example.com",
+		"projects/my-project/datasets/my_dataset/READER/jane@example.com",
+		"projects/my-project/datasets/my_dataset/view/other-project/my_view",
+		"projects/my-project/datasets/my_dataset/roles/bigquery.dataViewer/extra/userByEmail/jane@example.com",
+		"projects/my-project/datasets/my_dataset/READER/userByEmail/",
+	} {
+		d := schema.TestResourceDataRaw(t, resourceBigQueryDatasetAccess().Schema, map[string]interface{}{})
+		d.SetId(id)
+		if _, err := resourceBigQueryDatasetAccessImport(d, nil); err == nil {
+			t.Errorf("expected an error importing %q", id)
+		}
+	}
+}
+
+// datasetAccessRoundTripper serves a dataset whose etag changes on every
+// write, and fails writes whose If-Match doesn't match the current etag.
+type datasetAccessRoundTripper struct {
+	etag     int
+	conflict bool
+	ifMatch  []string
+}
+
+func (rt *datasetAccessRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	status, body := http.StatusOK, fmt.Sprintf(`{"etag": "etag-%d", "access": []}`, rt.etag)
+	if req.Method == "PATCH" {
+		rt.ifMatch = append(rt.ifMatch, req.Header.Get("If-Match"))
+		if rt.conflict {
+			// Someone else wrote to the dataset since it was read.
+			rt.conflict = false
+			rt.etag++
+		}
+		if req.Header.Get("If-Match") != fmt.Sprintf("etag-%d", rt.etag) {
+			status, body = http.StatusPreconditionFailed, `{"error": {"code": 412, "message": "Precondition check failed."}}`
+		} else {
+			rt.etag++
+		}
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestBigQueryDatasetAccessReadModifyWrite_retriesOnEtagMismatch(t *testing.T) {
+	rt := &datasetAccessRoundTripper{conflict: true}
+	c := &Config{
+		client:  &http.Client{Transport: rt},
+		context: context.Background(),
+	}
+	d := schema.TestResourceDataRaw(t, resourceBigQueryDatasetAccess().Schema, map[string]interface{}{
+		"dataset_id": "my_dataset",
+	})
+
+	modified := 0
+	err := bigQueryDatasetAccessReadModifyWrite(d, c, "my-project", func(access []interface{}) ([]interface{}, error) {
+		modified++
+		return access, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := []string{"etag-0", "etag-1"}; !reflect.DeepEqual(rt.ifMatch, expected) {
+		t.Errorf("expected writes with If-Match %q, got %q", expected, rt.ifMatch)
+	}
+	if modified != 2 {
+		t.Errorf("expected the access list to be modified again after a conflict, modified %d times", modified)
+	}
+}
+
+func TestAccBigQueryDatasetAccess_basic(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	saID := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	expected := map[string]interface{}{
+		"role":        "OWNER",
+		"userByEmail": fmt.Sprintf("%s@%s.iam.gserviceaccount.com", saID, getTestProjectFromEnv()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryDatasetAccess_basic(datasetID, saID),
+				Check:  testAccCheckBigQueryDatasetAccessPresent("google_bigquery_dataset.dataset", expected, true),
+			},
+			{
+				ResourceName:      "google_bigquery_dataset_access.access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Destroying the access entry must leave the dataset in place.
+				Config: testAccBigQueryDatasetAccess_destroy(datasetID, saID),
+				Check:  testAccCheckBigQueryDatasetAccessPresent("google_bigquery_dataset.dataset", expected, false),
+			},
+		},
+	})
+}
+
+func TestAccBigQueryDatasetAccess_view(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	datasetID2 := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	expected := map[string]interface{}{
+		"view": map[string]interface{}{
+			"projectId": getTestProjectFromEnv(),
+			"datasetId": datasetID2,
+			"tableId":   tableID,
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryDatasetAccess_view(datasetID, datasetID2, tableID),
+				Check:  testAccCheckBigQueryDatasetAccessPresent("google_bigquery_dataset.private", expected, true),
+			},
+			{
+				ResourceName:      "google_bigquery_dataset_access.access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBigQueryDatasetAccessPresent(n string, expected map[string]interface{}, present bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		url := fmt.Sprintf("https://www.googleapis.com/bigquery/v2/projects/%s/datasets/%s", rs.Primary.Attributes["project"], rs.Primary.Attributes["dataset_id"])
+		ds, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return err
+		}
+
+		access, _ := ds["access"].([]interface{})
+		if found := findBigQueryDatasetAccessEntry(access, expected) >= 0; found != present {
+			return fmt.Errorf("Expected access entry %v presence to be %t on dataset %s", expected, present, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccBigQueryDatasetAccess_basic(datasetID, saID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset_access" "access" {
+  dataset_id    = "${google_bigquery_dataset.dataset.dataset_id}"
+  role          = "OWNER"
+  user_by_email = "${google_service_account.bqowner.email}"
+}
+
+resource "google_bigquery_dataset" "dataset" {
+  dataset_id = "%s"
+}
+
+resource "google_service_account" "bqowner" {
+  account_id = "%s"
+}
+`, datasetID, saID)
+}
+
+func testAccBigQueryDatasetAccess_destroy(datasetID, saID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "dataset" {
+  dataset_id = "%s"
+}
+
+resource "google_service_account" "bqowner" {
+  account_id = "%s"
+}
+`, datasetID, saID)
+}
+
+func testAccBigQueryDatasetAccess_view(datasetID, datasetID2, tableID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset_access" "access" {
+  dataset_id = "${google_bigquery_dataset.private.dataset_id}"
+
+  view {
+    project_id = "${google_bigquery_table.public.project}"
+    dataset_id = "${google_bigquery_dataset.public.dataset_id}"
+    table_id   = "${google_bigquery_table.public.table_id}"
+  }
+}
+
+resource "google_bigquery_dataset" "private" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_dataset" "public" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "public" {
+  dataset_id = "${google_bigquery_dataset.public.dataset_id}"
+  table_id   = "%s"
+
+  view {
+    query          = "SELECT state FROM [lookerdata:cdc.project_tycho_reports]"
+    use_legacy_sql = true
+  }
+}
+`, datasetID, datasetID2, tableID)
+}
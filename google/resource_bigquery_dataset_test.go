@@ -189,6 +189,29 @@ func TestAccBigQueryDataset_regionalLocation(t *testing.T) {
 	})
 }
 
+func TestAccBigQueryDataset_cmek(t *testing.T) {
+	t.Parallel()
+
+	kms := BootstrapKMSKey(t)
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryDatasetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryDatasetWithDefaultEncryption(datasetID, kms.CryptoKey.Name),
+			},
+			{
+				ResourceName:      "google_bigquery_dataset.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBigQueryDatasetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -374,3 +397,25 @@ resource "google_bigquery_dataset" "access_test" {
   }
 }`, otherDatasetID, otherTableID, datasetID)
 }
+
+func testAccBigQueryDatasetWithDefaultEncryption(datasetID, kmsKey string) string {
+	return fmt.Sprintf(`
+data "google_project_service_agent" "bigquery" {
+  service = "bigquery.googleapis.com"
+}
+
+resource "google_kms_crypto_key_iam_member" "bigquery" {
+  crypto_key_id = "%s"
+  role          = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+  member        = "${data.google_project_service_agent.bigquery.member}"
+}
+
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+  location   = "US"
+
+  default_encryption_configuration {
+    kms_key_name = "${google_kms_crypto_key_iam_member.bigquery.crypto_key_id}"
+  }
+}`, kmsKey, datasetID)
+}
@@ -0,0 +1,205 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBigqueryReservationReservationAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigqueryReservationReservationAssignmentCreate,
+		Read:   resourceBigqueryReservationReservationAssignmentRead,
+		Delete: resourceBigqueryReservationReservationAssignmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigqueryReservationReservationAssignmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"assignee": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PIPELINE", "QUERY", "ML_EXTERNAL"}, false),
+			},
+			"reservation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigqueryReservationReservationAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	assigneeProp, err := expandBigqueryReservationReservationAssignmentAssignee(d.Get("assignee"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("assignee"); !isEmptyValue(reflect.ValueOf(assigneeProp)) && (ok || !reflect.DeepEqual(v, assigneeProp)) {
+		obj["assignee"] = assigneeProp
+	}
+	jobTypeProp, err := expandBigqueryReservationReservationAssignmentJobType(d.Get("job_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("job_type"); !isEmptyValue(reflect.ValueOf(jobTypeProp)) && (ok || !reflect.DeepEqual(v, jobTypeProp)) {
+		obj["jobType"] = jobTypeProp
+	}
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/{{reservation}}/assignments")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ReservationAssignment: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ReservationAssignment: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating ReservationAssignment %q: %#v", d.Id(), res)
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.Set("name", name.(string))
+	d.SetId(name.(string))
+
+	return resourceBigqueryReservationReservationAssignmentRead(d, meta)
+}
+
+func resourceBigqueryReservationReservationAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BigqueryReservationReservationAssignment %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ReservationAssignment: %s", err)
+	}
+
+	if err := d.Set("name", flattenBigqueryReservationReservationAssignmentName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading ReservationAssignment: %s", err)
+	}
+	if err := d.Set("assignee", flattenBigqueryReservationReservationAssignmentAssignee(res["assignee"], d)); err != nil {
+		return fmt.Errorf("Error reading ReservationAssignment: %s", err)
+	}
+	if err := d.Set("job_type", flattenBigqueryReservationReservationAssignmentJobType(res["jobType"], d)); err != nil {
+		return fmt.Errorf("Error reading ReservationAssignment: %s", err)
+	}
+	if err := d.Set("state", flattenBigqueryReservationReservationAssignmentState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading ReservationAssignment: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBigqueryReservationReservationAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://bigqueryreservation.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ReservationAssignment %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ReservationAssignment")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ReservationAssignment %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBigqueryReservationReservationAssignmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<name>(?P<reservation>projects/(?P<project>[^/]+)/locations/[^/]+/reservations/[^/]+)/assignments/[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBigqueryReservationReservationAssignmentName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationReservationAssignmentAssignee(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationReservationAssignmentJobType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigqueryReservationReservationAssignmentState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandBigqueryReservationReservationAssignmentAssignee(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigqueryReservationReservationAssignmentJobType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
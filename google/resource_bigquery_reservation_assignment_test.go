@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigqueryReservationReservationAssignment_bigqueryReservationAssignmentBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigqueryReservationReservationAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigqueryReservationReservationAssignment_bigqueryReservationAssignmentBasicExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_reservation_assignment.assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigqueryReservationReservationAssignment_bigqueryReservationAssignmentBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_reservation" "basic" {
+  name     = "tf-test-my-reservation%{random_suffix}"
+  location = "us-central1"
+
+  slot_capacity     = 0
+  ignore_idle_slots = false
+}
+
+resource "google_bigquery_reservation_assignment" "assignment" {
+  assignee    = "projects/%{project}"
+  job_type    = "PIPELINE"
+  reservation = "${google_bigquery_reservation.basic.id}"
+}
+`, context)
+}
+
+func testAccCheckBigqueryReservationReservationAssignmentDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_reservation_assignment" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://bigqueryreservation.googleapis.com/v1/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigqueryReservationReservationAssignment still exists at %s", url)
+		}
+	}
+
+	return nil
+}
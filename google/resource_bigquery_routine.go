@@ -0,0 +1,597 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceBigQueryRoutine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigQueryRoutineCreate,
+		Read:   resourceBigQueryRoutineRead,
+		Update: resourceBigQueryRoutineUpdate,
+		Delete: resourceBigQueryRoutineDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceBigQueryRoutineImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dataset_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"definition_body": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"routine_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"routine_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SCALAR_FUNCTION", "PROCEDURE", "TABLE_VALUED_FUNCTION"}, false),
+			},
+			"arguments": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"argument_kind": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"FIXED_TYPE", "ANY_TYPE", ""}, false),
+							Default:      "FIXED_TYPE",
+						},
+						"data_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							StateFunc:    bigQueryRoutineNormalizeJson,
+							ValidateFunc: validation.ValidateJsonString,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IN", "OUT", "INOUT", ""}, false),
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"determinism_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DETERMINISM_LEVEL_UNSPECIFIED", "DETERMINISTIC", "NOT_DETERMINISTIC", ""}, false),
+			},
+			"imported_libraries": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"language": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SQL", "JAVASCRIPT", ""}, false),
+			},
+			"return_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    bigQueryRoutineNormalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+			"creation_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_modified_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigQueryRoutineCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	routineTypeProp, err := expandBigQueryRoutineRoutineType(d.Get("routine_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("routine_type"); !isEmptyValue(reflect.ValueOf(routineTypeProp)) && (ok || !reflect.DeepEqual(v, routineTypeProp)) {
+		obj["routineType"] = routineTypeProp
+	}
+	languageProp, err := expandBigQueryRoutineLanguage(d.Get("language"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("language"); !isEmptyValue(reflect.ValueOf(languageProp)) && (ok || !reflect.DeepEqual(v, languageProp)) {
+		obj["language"] = languageProp
+	}
+	argumentsProp, err := expandBigQueryRoutineArguments(d.Get("arguments"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("arguments"); !isEmptyValue(reflect.ValueOf(argumentsProp)) && (ok || !reflect.DeepEqual(v, argumentsProp)) {
+		obj["arguments"] = argumentsProp
+	}
+	importedLibrariesProp, err := expandBigQueryRoutineImportedLibraries(d.Get("imported_libraries"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("imported_libraries"); !isEmptyValue(reflect.ValueOf(importedLibrariesProp)) && (ok || !reflect.DeepEqual(v, importedLibrariesProp)) {
+		obj["importedLibraries"] = importedLibrariesProp
+	}
+	returnTypeProp, err := expandBigQueryRoutineReturnType(d.Get("return_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("return_type"); !isEmptyValue(reflect.ValueOf(returnTypeProp)) && (ok || !reflect.DeepEqual(v, returnTypeProp)) {
+		obj["returnType"] = returnTypeProp
+	}
+	definitionBodyProp, err := expandBigQueryRoutineDefinitionBody(d.Get("definition_body"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("definition_body"); !isEmptyValue(reflect.ValueOf(definitionBodyProp)) && (ok || !reflect.DeepEqual(v, definitionBodyProp)) {
+		obj["definitionBody"] = definitionBodyProp
+	}
+	descriptionProp, err := expandBigQueryRoutineDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	determinismLevelProp, err := expandBigQueryRoutineDeterminismLevel(d.Get("determinism_level"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("determinism_level"); !isEmptyValue(reflect.ValueOf(determinismLevelProp)) && (ok || !reflect.DeepEqual(v, determinismLevelProp)) {
+		obj["determinismLevel"] = determinismLevelProp
+	}
+	obj, err = resourceBigQueryRoutineEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://bigquery.googleapis.com/bigquery/v2/projects/{{project}}/datasets/{{dataset_id}}/routines")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Routine: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Routine: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Routine %q: %#v", d.Id(), res)
+
+	return resourceBigQueryRoutineRead(d, meta)
+}
+
+func resourceBigQueryRoutineRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://bigquery.googleapis.com/bigquery/v2/projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("BigQueryRoutine %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+
+	if err := d.Set("routine_type", flattenBigQueryRoutineRoutineType(res["routineType"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("language", flattenBigQueryRoutineLanguage(res["language"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("arguments", flattenBigQueryRoutineArguments(res["arguments"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("imported_libraries", flattenBigQueryRoutineImportedLibraries(res["importedLibraries"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("return_type", flattenBigQueryRoutineReturnType(res["returnType"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("definition_body", flattenBigQueryRoutineDefinitionBody(res["definitionBody"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("description", flattenBigQueryRoutineDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("determinism_level", flattenBigQueryRoutineDeterminismLevel(res["determinismLevel"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("creation_time", flattenBigQueryRoutineCreationTime(res["creationTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+	if err := d.Set("last_modified_time", flattenBigQueryRoutineLastModifiedTime(res["lastModifiedTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Routine: %s", err)
+	}
+
+	return nil
+}
+
+func resourceBigQueryRoutineUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	routineTypeProp, err := expandBigQueryRoutineRoutineType(d.Get("routine_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("routine_type"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, routineTypeProp)) {
+		obj["routineType"] = routineTypeProp
+	}
+	languageProp, err := expandBigQueryRoutineLanguage(d.Get("language"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("language"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, languageProp)) {
+		obj["language"] = languageProp
+	}
+	argumentsProp, err := expandBigQueryRoutineArguments(d.Get("arguments"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("arguments"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, argumentsProp)) {
+		obj["arguments"] = argumentsProp
+	}
+	importedLibrariesProp, err := expandBigQueryRoutineImportedLibraries(d.Get("imported_libraries"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("imported_libraries"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, importedLibrariesProp)) {
+		obj["importedLibraries"] = importedLibrariesProp
+	}
+	returnTypeProp, err := expandBigQueryRoutineReturnType(d.Get("return_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("return_type"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, returnTypeProp)) {
+		obj["returnType"] = returnTypeProp
+	}
+	definitionBodyProp, err := expandBigQueryRoutineDefinitionBody(d.Get("definition_body"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("definition_body"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, definitionBodyProp)) {
+		obj["definitionBody"] = definitionBodyProp
+	}
+	descriptionProp, err := expandBigQueryRoutineDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	determinismLevelProp, err := expandBigQueryRoutineDeterminismLevel(d.Get("determinism_level"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("determinism_level"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, determinismLevelProp)) {
+		obj["determinismLevel"] = determinismLevelProp
+	}
+	obj, err = resourceBigQueryRoutineEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://bigquery.googleapis.com/bigquery/v2/projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Routine %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Routine %q: %s", d.Id(), err)
+	}
+
+	return resourceBigQueryRoutineRead(d, meta)
+}
+
+func resourceBigQueryRoutineDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://bigquery.googleapis.com/bigquery/v2/projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Routine %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Routine")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Routine %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceBigQueryRoutineImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/datasets/(?P<dataset_id>[^/]+)/routines/(?P<routine_id>[^/]+)", "(?P<project>[^/]+)/(?P<dataset_id>[^/]+)/(?P<routine_id>[^/]+)", "(?P<dataset_id>[^/]+)/(?P<routine_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenBigQueryRoutineRoutineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineLanguage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineArguments(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":          flattenBigQueryRoutineArgumentsName(original["name"], d),
+			"argument_kind": flattenBigQueryRoutineArgumentsArgumentKind(original["argumentKind"], d),
+			"mode":          flattenBigQueryRoutineArgumentsMode(original["mode"], d),
+			"data_type":     flattenBigQueryRoutineArgumentsDataType(original["dataType"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenBigQueryRoutineArgumentsName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineArgumentsArgumentKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineArgumentsMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineArgumentsDataType(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("[ERROR] failed to marshal %#v to JSON: %s", v, err)
+		return nil
+	}
+	return string(b)
+}
+
+func flattenBigQueryRoutineImportedLibraries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineReturnType(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.Printf("[ERROR] failed to marshal %#v to JSON: %s", v, err)
+		return nil
+	}
+	return string(b)
+}
+
+func flattenBigQueryRoutineDefinitionBody(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineDeterminismLevel(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenBigQueryRoutineCreationTime(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenBigQueryRoutineLastModifiedTime(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func expandBigQueryRoutineRoutineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineLanguage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineArguments(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandBigQueryRoutineArgumentsName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedArgumentKind, err := expandBigQueryRoutineArgumentsArgumentKind(original["argument_kind"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedArgumentKind); val.IsValid() && !isEmptyValue(val) {
+			transformed["argumentKind"] = transformedArgumentKind
+		}
+
+		transformedMode, err := expandBigQueryRoutineArgumentsMode(original["mode"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMode); val.IsValid() && !isEmptyValue(val) {
+			transformed["mode"] = transformedMode
+		}
+
+		transformedDataType, err := expandBigQueryRoutineArgumentsDataType(original["data_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDataType); val.IsValid() && !isEmptyValue(val) {
+			transformed["dataType"] = transformedDataType
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandBigQueryRoutineArgumentsName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineArgumentsArgumentKind(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineArgumentsMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineArgumentsDataType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	b := []byte(v.(string))
+	if len(b) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func expandBigQueryRoutineImportedLibraries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineReturnType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	b := []byte(v.(string))
+	if len(b) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func expandBigQueryRoutineDefinitionBody(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandBigQueryRoutineDeterminismLevel(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+// The dataset and routine ids aren't part of the schema's body fields, but
+// the API expects them in a routineReference.
+func resourceBigQueryRoutineEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	obj["routineReference"] = map[string]interface{}{
+		"projectId": project,
+		"datasetId": d.Get("dataset_id"),
+		"routineId": d.Get("routine_id"),
+	}
+	return obj, nil
+}
+
+func bigQueryRoutineNormalizeJson(v interface{}) string {
+	json, _ := structure.NormalizeJsonString(v)
+	return json
+}
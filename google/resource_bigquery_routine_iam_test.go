@@ -0,0 +1,182 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBigQueryRoutineIamBinding(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	routineID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	account := "tf-test-bq-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryRoutineIamDataset(datasetID),
+			},
+			{
+				PreConfig: testAccBigQueryRoutineCreate(t, datasetID, routineID),
+				Config:    testAccBigQueryRoutineIamBinding_basic(datasetID, routineID, account),
+			},
+			{
+				ResourceName:      "google_bigquery_routine_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/datasets/%s/routines/%s roles/bigquery.dataViewer", getTestProjectFromEnv(), datasetID, routineID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigQueryRoutineIamMember(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	routineID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	account := "tf-test-bq-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryRoutineIamDataset(datasetID),
+			},
+			{
+				PreConfig: testAccBigQueryRoutineCreate(t, datasetID, routineID),
+				Config:    testAccBigQueryRoutineIamMember_basic(datasetID, routineID, account),
+			},
+			{
+				ResourceName: "google_bigquery_routine_iam_member.foo",
+				ImportStateId: fmt.Sprintf("projects/%s/datasets/%s/routines/%s roles/bigquery.dataViewer serviceAccount:%s@%s.iam.gserviceaccount.com",
+					getTestProjectFromEnv(), datasetID, routineID, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigQueryRoutineIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	routineID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	account := "tf-test-bq-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryRoutineIamDataset(datasetID),
+			},
+			{
+				PreConfig: testAccBigQueryRoutineCreate(t, datasetID, routineID),
+				Config:    testAccBigQueryRoutineIamPolicy_basic(datasetID, routineID, account),
+			},
+			{
+				ResourceName:      "google_bigquery_routine_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/datasets/%s/routines/%s", getTestProjectFromEnv(), datasetID, routineID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// There is no routine resource yet, so the routine is created through the API
+// once the dataset exists. The dataset deletes its contents on destroy.
+func testAccBigQueryRoutineCreate(t *testing.T, datasetID, routineID string) func() {
+	return func() {
+		config := testAccProvider.Meta().(*Config)
+		project := getTestProjectFromEnv()
+
+		obj := map[string]interface{}{
+			"routineReference": map[string]interface{}{
+				"projectId": project,
+				"datasetId": datasetID,
+				"routineId": routineID,
+			},
+			"routineType":    "SCALAR_FUNCTION",
+			"language":       "SQL",
+			"definitionBody": "CAST(3 AS INT64)",
+		}
+
+		url := fmt.Sprintf("https://bigquery.googleapis.com/bigquery/v2/projects/%s/datasets/%s/routines", project, datasetID)
+		if _, err := sendRequest(config, "POST", url, obj); err != nil {
+			t.Fatalf("Error creating routine %q: %s", routineID, err)
+		}
+	}
+}
+
+func testAccBigQueryRoutineIamDataset(datasetID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id                 = "%s"
+  delete_contents_on_destroy = true
+}
+`, datasetID)
+}
+
+func testAccBigQueryRoutineIamBinding_basic(datasetID, routineID, account string) string {
+	return testAccBigQueryRoutineIamDataset(datasetID) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "BigQuery IAM Testing Account"
+}
+
+resource "google_bigquery_routine_iam_binding" "foo" {
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+  routine_id = "%s"
+  role       = "roles/bigquery.dataViewer"
+  members    = ["serviceAccount:${google_service_account.test.email}"]
+}
+`, account, routineID)
+}
+
+func testAccBigQueryRoutineIamMember_basic(datasetID, routineID, account string) string {
+	return testAccBigQueryRoutineIamDataset(datasetID) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "BigQuery IAM Testing Account"
+}
+
+resource "google_bigquery_routine_iam_member" "foo" {
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+  routine_id = "%s"
+  role       = "roles/bigquery.dataViewer"
+  member     = "serviceAccount:${google_service_account.test.email}"
+}
+`, account, routineID)
+}
+
+func testAccBigQueryRoutineIamPolicy_basic(datasetID, routineID, account string) string {
+	return testAccBigQueryRoutineIamDataset(datasetID) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "BigQuery IAM Testing Account"
+}
+
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/bigquery.dataViewer"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_bigquery_routine_iam_policy" "foo" {
+  dataset_id  = "${google_bigquery_dataset.test.dataset_id}"
+  routine_id  = "%s"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`, account, routineID)
+}
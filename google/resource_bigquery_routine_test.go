@@ -0,0 +1,127 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigQueryRoutine_bigQueryRoutineBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryRoutineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryRoutine_bigQueryRoutineBasicExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_routine.sproc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigQueryRoutine_bigQueryRoutineBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "tf_test_dataset_id%{random_suffix}"
+}
+
+resource "google_bigquery_routine" "sproc" {
+  dataset_id      = "${google_bigquery_dataset.test.dataset_id}"
+  routine_id      = "tf_test_routine_id%{random_suffix}"
+  routine_type    = "PROCEDURE"
+  language        = "SQL"
+  definition_body = "CREATE FUNCTION Add(x FLOAT64, y FLOAT64) RETURNS FLOAT64 AS (x + y);"
+}
+`, context)
+}
+
+func TestAccBigQueryRoutine_bigQueryRoutineJsonExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryRoutineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryRoutine_bigQueryRoutineJsonExample(context),
+			},
+			{
+				ResourceName:      "google_bigquery_routine.sproc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigQueryRoutine_bigQueryRoutineJsonExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "tf_test_dataset_id%{random_suffix}"
+}
+
+resource "google_bigquery_routine" "sproc" {
+  dataset_id      = "${google_bigquery_dataset.test.dataset_id}"
+  routine_id      = "tf_test_routine_id%{random_suffix}"
+  routine_type    = "SCALAR_FUNCTION"
+  language        = "JAVASCRIPT"
+  definition_body = "CREATE FUNCTION multiplyInputs return x*y;"
+
+  arguments {
+    name      = "x"
+    data_type = "{\"typeKind\" :  \"FLOAT64\"}"
+  }
+  arguments {
+    name      = "y"
+    data_type = "{\"typeKind\" :  \"FLOAT64\"}"
+  }
+
+  return_type = "{\"typeKind\" :  \"FLOAT64\"}"
+}
+`, context)
+}
+
+func testAccCheckBigQueryRoutineDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigquery_routine" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://bigquery.googleapis.com/bigquery/v2/projects/{{project}}/datasets/{{dataset_id}}/routines/{{routine_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("BigQueryRoutine still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -95,9 +96,10 @@ func resourceBigQueryTable() *schema.Resource {
 
 			// View: [Optional] If specified, configures this table as a view.
 			"view": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"materialized_view", "external_data_configuration"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						// Query: [Required] A query that BigQuery executes when the view is
@@ -119,6 +121,175 @@ func resourceBigQueryTable() *schema.Resource {
 				},
 			},
 
+			// MaterializedView: [Optional] If specified, configures this table as
+			// a materialized view, whose results are precomputed and refreshed
+			// periodically.
+			"materialized_view": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"view", "external_data_configuration"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Query: [Required] A query whose result is persisted.
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// EnableRefresh: [Optional] Enable automatic refresh of the
+						// materialized view when the base table is updated.
+						"enable_refresh": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						// RefreshIntervalMs: [Optional] The maximum frequency at which
+						// this materialized view will be refreshed.
+						"refresh_interval_ms": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1800000,
+						},
+					},
+				},
+			},
+
+			// ExternalDataConfiguration: [Optional] Describes the data format,
+			// location, and other properties of a table stored outside of BigQuery.
+			// By defining these properties, the data source can then be queried as
+			// if it were a standard BigQuery table.
+			"external_data_configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"view", "materialized_view"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Autodetect : [Required] If true, let BigQuery try to autodetect the
+						// schema and format of the table.
+						"autodetect": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						// SourceFormat [Required] The data format.
+						"source_format": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"CSV", "GOOGLE_SHEETS", "NEWLINE_DELIMITED_JSON", "AVRO", "DATASTORE_BACKUP", "PARQUET", "ORC",
+							}, false),
+						},
+						// SourceURIs [Required] The fully-qualified URIs that point to your data in Google Cloud.
+						"source_uris": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						// Compression: [Optional] The compression type of the data source.
+						"compression": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"NONE", "GZIP"}, false),
+							Default:      "NONE",
+						},
+						// CsvOptions: [Optional] Additional properties to set if
+						// sourceFormat is set to CSV.
+						"csv_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Quote: [Required] The value that is used to quote data
+									// sections in a CSV file.
+									"quote": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									// AllowJaggedRows: [Optional] Indicates if BigQuery should
+									// accept rows that are missing trailing optional columns.
+									"allow_jagged_rows": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									// AllowQuotedNewlines: [Optional] Indicates if BigQuery
+									// should allow quoted data sections that contain newline
+									// characters in a CSV file. The default value is false.
+									"allow_quoted_newlines": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									// Encoding: [Optional] The character encoding of the data.
+									// The supported values are UTF-8 or ISO-8859-1.
+									"encoding": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ISO-8859-1", "UTF-8"}, false),
+										Default:      "UTF-8",
+									},
+									// FieldDelimiter: [Optional] The separator for fields in a CSV file.
+									"field_delimiter": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  ",",
+									},
+									// SkipLeadingRows: [Optional] The number of rows at the top
+									// of a CSV file that BigQuery will skip when reading the data.
+									"skip_leading_rows": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Default:  0,
+									},
+								},
+							},
+						},
+						// GoogleSheetsOptions: [Optional] Additional options if sourceFormat is set to GOOGLE_SHEETS.
+						"google_sheets_options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Range: [Optional] Range of a sheet to query from. Only used when non-empty.
+									// Typical format: !:
+									"range": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									// SkipLeadingRows: [Optional] The number of rows at the top
+									// of the scheet that BigQuery will skip when reading the data.
+									"skip_leading_rows": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						// IgnoreUnknownValues: [Optional] Indicates if BigQuery should
+						// allow extra values that are not represented in the table schema.
+						// If true, the extra values are ignored. If false, records with
+						// extra columns are treated as bad records, and if there are too
+						// many bad records, an invalid error is returned in the job result.
+						// The default value is false.
+						"ignore_unknown_values": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						// MaxBadRecords: [Optional] The maximum number of bad records that
+						// BigQuery can ignore when reading data.
+						"max_bad_records": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+
 			// TimePartitioning: [Experimental] If specified, configures time-based
 			// partitioning for this table.
 			"time_partitioning": {
@@ -134,12 +305,13 @@ func resourceBigQueryTable() *schema.Resource {
 							Optional: true,
 						},
 
-						// Type: [Required] The only type supported is DAY, which will generate
-						// one partition per day based on data loading time.
+						// Type: [Required] The supported types are DAY, HOUR, MONTH and
+						// YEAR, which will generate one partition per day, hour, month or
+						// year respectively.
 						"type": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: validation.StringInSlice([]string{"DAY"}, false),
+							ValidateFunc: validation.StringInSlice([]string{"DAY", "HOUR", "MONTH", "YEAR"}, false),
 						},
 
 						// Field: [Optional] The field used to determine how to create a time-based
@@ -162,6 +334,88 @@ func resourceBigQueryTable() *schema.Resource {
 				},
 			},
 
+			// RangePartitioning: [Optional] If specified, configures range-based
+			// partitioning for this table.
+			"range_partitioning": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// Field: [Required] The field used to determine how to create a range-based
+						// partition.
+						"field": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+
+						// Range: [Required] Information required to partition based on ranges.
+						"range": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									// Start: [Required] Start of the range partitioning, inclusive.
+									"start": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+
+									// End: [Required] End of the range partitioning, exclusive.
+									"end": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+
+									// Interval: [Required] The width of each range within the partition.
+									"interval": {
+										Type:     schema.TypeInt,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Clustering: [Optional] Specifies column names to use for data clustering.
+			// Up to four top-level columns are allowed, and should be specified in
+			// descending priority order.
+			"clustering": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 4,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			// EncryptionConfiguration: [Optional] Custom encryption configuration
+			// (e.g., Cloud KMS keys).
+			"encryption_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// KmsKeyName: [Required] Describes the Cloud KMS encryption key
+						// that will be used to protect the destination BigQuery table.
+						"kms_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
 			// CreationTime: [Output-only] The time when this table was created, in
 			// milliseconds since the epoch.
 			"creation_time": {
@@ -230,7 +484,7 @@ func resourceBigQueryTable() *schema.Resource {
 	}
 }
 
-func resourceTable(d *schema.ResourceData, meta interface{}) (*bigquery.Table, error) {
+func resourceTable(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
 	config := meta.(*Config)
 
 	project, err := getProject(d, config)
@@ -285,7 +539,35 @@ func resourceTable(d *schema.ResourceData, meta interface{}) (*bigquery.Table, e
 		table.TimePartitioning = expandTimePartitioning(v)
 	}
 
-	return table, nil
+	if v, ok := d.GetOk("range_partitioning"); ok {
+		table.RangePartitioning = expandRangePartitioning(v)
+	}
+
+	if v, ok := d.GetOk("clustering"); ok {
+		table.Clustering = &bigquery.Clustering{Fields: convertStringArr(v.([]interface{}))}
+	}
+
+	if v, ok := d.GetOk("encryption_configuration"); ok {
+		raw := v.([]interface{})[0].(map[string]interface{})
+		table.EncryptionConfiguration = &bigquery.EncryptionConfiguration{KmsKeyName: raw["kms_key_name"].(string)}
+	}
+
+	if v, ok := d.GetOk("external_data_configuration"); ok {
+		table.ExternalDataConfiguration = expandExternalDataConfiguration(v)
+	}
+
+	obj, err := ConvertToMap(table)
+	if err != nil {
+		return nil, err
+	}
+
+	// The vendored client doesn't know about the refresh settings of
+	// materialized views, so they're added to the request directly.
+	if v, ok := d.GetOk("materialized_view"); ok {
+		obj["materializedView"] = expandMaterializedView(v)
+	}
+
+	return obj, nil
 }
 
 func resourceBigQueryTableCreate(d *schema.ResourceData, meta interface{}) error {
@@ -303,13 +585,18 @@ func resourceBigQueryTableCreate(d *schema.ResourceData, meta interface{}) error
 
 	datasetID := d.Get("dataset_id").(string)
 
-	log.Printf("[INFO] Creating BigQuery table: %s", table.TableReference.TableId)
+	log.Printf("[INFO] Creating BigQuery table: %s", d.Get("table_id").(string))
 
-	res, err := config.clientBigQuery.Tables.Insert(project, datasetID, table).Do()
+	raw, err := sendRequest(config, "POST", bigQueryBasePath+"projects/"+project+"/datasets/"+datasetID+"/tables", table)
 	if err != nil {
 		return err
 	}
 
+	res := &bigquery.Table{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
+
 	log.Printf("[INFO] BigQuery table %s has been created", res.Id)
 
 	d.SetId(fmt.Sprintf("%s:%s.%s", res.TableReference.ProjectId, res.TableReference.DatasetId, res.TableReference.TableId))
@@ -327,11 +614,16 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	res, err := config.clientBigQuery.Tables.Get(id.Project, id.DatasetId, id.TableId).Do()
+	raw, err := sendRequest(config, "GET", bigQueryTableUrl(id), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("BigQuery table %q", id.TableId))
 	}
 
+	res := &bigquery.Table{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
+
 	d.Set("project", id.Project)
 	d.Set("description", res.Description)
 	d.Set("expiration_time", res.ExpirationTime)
@@ -355,6 +647,26 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if res.RangePartitioning != nil {
+		if err := d.Set("range_partitioning", flattenRangePartitioning(res.RangePartitioning)); err != nil {
+			return err
+		}
+	}
+
+	if res.Clustering != nil {
+		d.Set("clustering", res.Clustering.Fields)
+	}
+
+	if res.EncryptionConfiguration != nil {
+		d.Set("encryption_configuration", []map[string]interface{}{{"kms_key_name": res.EncryptionConfiguration.KmsKeyName}})
+	}
+
+	if res.ExternalDataConfiguration != nil {
+		if err := d.Set("external_data_configuration", flattenExternalDataConfiguration(res.ExternalDataConfiguration)); err != nil {
+			return err
+		}
+	}
+
 	if res.Schema != nil {
 		schema, err := flattenSchema(res.Schema)
 		if err != nil {
@@ -369,6 +681,12 @@ func resourceBigQueryTableRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("view", view)
 	}
 
+	if mv, ok := raw["materializedView"].(map[string]interface{}); ok {
+		if err := d.Set("materialized_view", flattenMaterializedView(mv)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -387,7 +705,7 @@ func resourceBigQueryTableUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	if _, err = config.clientBigQuery.Tables.Update(id.Project, id.DatasetId, id.TableId, table).Do(); err != nil {
+	if _, err = sendRequest(config, "PUT", bigQueryTableUrl(id), table); err != nil {
 		return err
 	}
 
@@ -469,6 +787,136 @@ func flattenTimePartitioning(tp *bigquery.TimePartitioning) []map[string]interfa
 	return []map[string]interface{}{result}
 }
 
+func expandRangePartitioning(configured interface{}) *bigquery.RangePartitioning {
+	raw := configured.([]interface{})[0].(map[string]interface{})
+	rp := &bigquery.RangePartitioning{Field: raw["field"].(string)}
+
+	if v, ok := raw["range"].([]interface{}); ok && len(v) > 0 {
+		r := v[0].(map[string]interface{})
+		rp.Range = &bigquery.RangePartitioningRange{
+			Start:    int64(r["start"].(int)),
+			End:      int64(r["end"].(int)),
+			Interval: int64(r["interval"].(int)),
+			// A range may start at 0, which would otherwise be left out.
+			ForceSendFields: []string{"Start", "End", "Interval"},
+		}
+	}
+
+	return rp
+}
+
+func flattenRangePartitioning(rp *bigquery.RangePartitioning) []map[string]interface{} {
+	result := map[string]interface{}{"field": rp.Field}
+
+	if rp.Range != nil {
+		result["range"] = []map[string]interface{}{{
+			"start":    rp.Range.Start,
+			"end":      rp.Range.End,
+			"interval": rp.Range.Interval,
+		}}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandExternalDataConfiguration(configured interface{}) *bigquery.ExternalDataConfiguration {
+	raw := configured.([]interface{})[0].(map[string]interface{})
+	edc := &bigquery.ExternalDataConfiguration{
+		Autodetect:          raw["autodetect"].(bool),
+		SourceFormat:        raw["source_format"].(string),
+		SourceUris:          convertStringArr(raw["source_uris"].([]interface{})),
+		Compression:         raw["compression"].(string),
+		IgnoreUnknownValues: raw["ignore_unknown_values"].(bool),
+		MaxBadRecords:       int64(raw["max_bad_records"].(int)),
+	}
+
+	if v, ok := raw["csv_options"].([]interface{}); ok && len(v) > 0 {
+		opts := v[0].(map[string]interface{})
+		quote := opts["quote"].(string)
+		edc.CsvOptions = &bigquery.CsvOptions{
+			Quote:               &quote,
+			AllowJaggedRows:     opts["allow_jagged_rows"].(bool),
+			AllowQuotedNewlines: opts["allow_quoted_newlines"].(bool),
+			Encoding:            opts["encoding"].(string),
+			FieldDelimiter:      opts["field_delimiter"].(string),
+			SkipLeadingRows:     int64(opts["skip_leading_rows"].(int)),
+		}
+	}
+
+	if v, ok := raw["google_sheets_options"].([]interface{}); ok && len(v) > 0 {
+		opts := v[0].(map[string]interface{})
+		edc.GoogleSheetsOptions = &bigquery.GoogleSheetsOptions{
+			Range:           opts["range"].(string),
+			SkipLeadingRows: int64(opts["skip_leading_rows"].(int)),
+		}
+	}
+
+	return edc
+}
+
+func flattenExternalDataConfiguration(edc *bigquery.ExternalDataConfiguration) []map[string]interface{} {
+	result := map[string]interface{}{
+		"autodetect":            edc.Autodetect,
+		"source_format":         edc.SourceFormat,
+		"source_uris":           edc.SourceUris,
+		"compression":           edc.Compression,
+		"ignore_unknown_values": edc.IgnoreUnknownValues,
+		"max_bad_records":       edc.MaxBadRecords,
+	}
+
+	if edc.Compression == "" {
+		result["compression"] = "NONE"
+	}
+
+	if opts := edc.CsvOptions; opts != nil {
+		csv := map[string]interface{}{
+			"allow_jagged_rows":     opts.AllowJaggedRows,
+			"allow_quoted_newlines": opts.AllowQuotedNewlines,
+			"encoding":              opts.Encoding,
+			"field_delimiter":       opts.FieldDelimiter,
+			"skip_leading_rows":     opts.SkipLeadingRows,
+		}
+		if opts.Quote != nil {
+			csv["quote"] = *opts.Quote
+		}
+		result["csv_options"] = []map[string]interface{}{csv}
+	}
+
+	if opts := edc.GoogleSheetsOptions; opts != nil {
+		result["google_sheets_options"] = []map[string]interface{}{{
+			"range":             opts.Range,
+			"skip_leading_rows": opts.SkipLeadingRows,
+		}}
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandMaterializedView(configured interface{}) map[string]interface{} {
+	raw := configured.([]interface{})[0].(map[string]interface{})
+	return map[string]interface{}{
+		"query":             raw["query"],
+		"enableRefresh":     raw["enable_refresh"],
+		"refreshIntervalMs": strconv.Itoa(raw["refresh_interval_ms"].(int)),
+	}
+}
+
+func flattenMaterializedView(mv map[string]interface{}) []map[string]interface{} {
+	result := map[string]interface{}{
+		"query":          mv["query"],
+		"enable_refresh": mv["enableRefresh"],
+	}
+
+	// The interval is an int64, which is sent as a string.
+	if v, ok := mv["refreshIntervalMs"].(string); ok {
+		if i, err := strconv.Atoi(v); err == nil {
+			result["refresh_interval_ms"] = i
+		}
+	}
+
+	return []map[string]interface{}{result}
+}
+
 func expandView(configured interface{}) *bigquery.ViewDefinition {
 	raw := configured.([]interface{})[0].(map[string]interface{})
 	vd := &bigquery.ViewDefinition{Query: raw["query"].(string)}
@@ -492,6 +940,10 @@ type bigQueryTableId struct {
 	Project, DatasetId, TableId string
 }
 
+func bigQueryTableUrl(id *bigQueryTableId) string {
+	return fmt.Sprintf("%sprojects/%s/datasets/%s/tables/%s", bigQueryBasePath, id.Project, id.DatasetId, id.TableId)
+}
+
 func parseBigQueryTableId(id string) (*bigQueryTableId, error) {
 	parts := strings.FieldsFunc(id, func(r rune) bool { return r == ':' || r == '.' })
 
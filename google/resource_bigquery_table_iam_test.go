@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccBigQueryTableIamBinding(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	account := "tf-test-bq-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableIamBinding_basic(datasetID, tableID, account),
+			},
+			{
+				ResourceName:      "google_bigquery_table_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/datasets/%s/tables/%s roles/bigquery.dataViewer", getTestProjectFromEnv(), datasetID, tableID),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigQueryTableIamMember(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	account := "tf-test-bq-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableIamMember_basic(datasetID, tableID, account),
+			},
+			{
+				ResourceName: "google_bigquery_table_iam_member.foo",
+				ImportStateId: fmt.Sprintf("projects/%s/datasets/%s/tables/%s roles/bigquery.dataViewer serviceAccount:%s@%s.iam.gserviceaccount.com",
+					getTestProjectFromEnv(), datasetID, tableID, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBigQueryTableIamBinding_basic(datasetID, tableID, account string) string {
+	return testAccBigQueryTable(datasetID, tableID) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "BigQuery IAM Testing Account"
+}
+
+resource "google_bigquery_table_iam_binding" "foo" {
+  dataset_id = "${google_bigquery_table.test.dataset_id}"
+  table_id   = "${google_bigquery_table.test.table_id}"
+  role       = "roles/bigquery.dataViewer"
+  members    = ["serviceAccount:${google_service_account.test.email}"]
+}
+`, account)
+}
+
+func testAccBigQueryTableIamMember_basic(datasetID, tableID, account string) string {
+	return testAccBigQueryTable(datasetID, tableID) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "BigQuery IAM Testing Account"
+}
+
+resource "google_bigquery_table_iam_member" "foo" {
+  dataset_id = "${google_bigquery_table.test.dataset_id}"
+  table_id   = "${google_bigquery_table.test.table_id}"
+  role       = "roles/bigquery.dataViewer"
+  member     = "serviceAccount:${google_service_account.test.email}"
+}
+`, account)
+}
@@ -94,6 +94,85 @@ func TestAccBigQueryTable_ViewWithLegacySQL(t *testing.T) {
 	})
 }
 
+func TestAccBigQueryTable_RangePartitioningAndClustering(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableRangePartitioning(datasetID, tableID),
+			},
+			{
+				ResourceName:      "google_bigquery_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigQueryTable_MaterializedView(t *testing.T) {
+	t.Parallel()
+
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	materializedViewID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableWithMaterializedView(datasetID, tableID, materializedViewID, 1800000),
+			},
+			{
+				ResourceName:      "google_bigquery_table.mv",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBigQueryTableWithMaterializedView(datasetID, tableID, materializedViewID, 3600000),
+			},
+			{
+				ResourceName:      "google_bigquery_table.mv",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBigQueryTable_ExternalDataCsv(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-bq-%s", acctest.RandString(10))
+	datasetID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	tableID := fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigQueryTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigQueryTableFromGCS(bucketName, datasetID, tableID),
+			},
+			{
+				ResourceName:      "google_bigquery_table.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckBigQueryTableDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "google_bigquery_table" {
@@ -257,3 +336,115 @@ resource "google_bigquery_table" "test" {
 EOH
 }`, datasetID, tableID)
 }
+
+func testAccBigQueryTableRangePartitioning(datasetID, tableID string) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+  table_id   = "%s"
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+
+  range_partitioning {
+    field = "id"
+    range {
+      start    = 0
+      end      = 10000
+      interval = 100
+    }
+  }
+
+  clustering = ["name"]
+
+  schema = <<EOH
+[
+  {
+    "name": "id",
+    "type": "INTEGER"
+  },
+  {
+    "name": "name",
+    "type": "STRING"
+  }
+]
+EOH
+}`, datasetID, tableID)
+}
+
+func testAccBigQueryTableWithMaterializedView(datasetID, tableID, materializedViewID string, refreshIntervalMs int) string {
+	return fmt.Sprintf(`
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+  table_id   = "%s"
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+
+  time_partitioning {
+    type = "DAY"
+  }
+
+  schema = <<EOH
+[
+  {
+    "name": "ts",
+    "type": "TIMESTAMP"
+  },
+  {
+    "name": "city",
+    "type": "STRING"
+  }
+]
+EOH
+}
+
+resource "google_bigquery_table" "mv" {
+  table_id   = "%s"
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+
+  materialized_view {
+    query               = "SELECT city, COUNT(*) AS count FROM ${google_bigquery_dataset.test.dataset_id}.${google_bigquery_table.test.table_id} GROUP BY city"
+    refresh_interval_ms = %d
+  }
+}`, datasetID, tableID, materializedViewID, refreshIntervalMs)
+}
+
+func testAccBigQueryTableFromGCS(bucketName, datasetID, tableID string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "test" {
+  name          = "%s"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_object" "test" {
+  name    = "data.csv"
+  content = "city,population\nZurich,400000\n"
+  bucket  = "${google_storage_bucket.test.name}"
+}
+
+resource "google_bigquery_dataset" "test" {
+  dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+  table_id   = "%s"
+  dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+
+  external_data_configuration {
+    autodetect    = true
+    source_format = "CSV"
+
+    csv_options {
+      quote             = "\""
+      skip_leading_rows = 1
+    }
+
+    source_uris = [
+      "gs://${google_storage_bucket.test.name}/${google_storage_bucket_object.test.name}",
+    ]
+  }
+}`, bucketName, datasetID, tableID)
+}
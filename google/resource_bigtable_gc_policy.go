@@ -0,0 +1,233 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"cloud.google.com/go/bigtable"
+)
+
+const (
+	GCPolicyModeIntersection = "INTERSECTION"
+	GCPolicyModeUnion        = "UNION"
+)
+
+func resourceBigtableGCPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBigtableGCPolicyCreate,
+		Read:   resourceBigtableGCPolicyRead,
+		Update: resourceBigtableGCPolicyUpdate,
+		Delete: resourceBigtableGCPolicyDestroy,
+
+		Schema: map[string]*schema.Schema{
+			"instance_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"column_family": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{GCPolicyModeIntersection, GCPolicyModeUnion}, false),
+			},
+
+			"max_age": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"max_version": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBigtableGCPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := setBigtableGCPolicy(d, meta); err != nil {
+		return err
+	}
+
+	d.SetId(d.Get("column_family").(string))
+
+	return resourceBigtableGCPolicyRead(d, meta)
+}
+
+func resourceBigtableGCPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := context.Background()
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instanceName := d.Get("instance_name").(string)
+	c, err := config.bigtableClientFactory.NewAdminClient(project, instanceName)
+	if err != nil {
+		return fmt.Errorf("Error starting admin client. %s", err)
+	}
+
+	defer c.Close()
+
+	name := d.Get("table").(string)
+	table, err := c.TableInfo(ctx, name)
+	if err != nil {
+		log.Printf("[WARN] Removing %s because it's gone", name)
+		d.SetId("")
+		return fmt.Errorf("Error retrieving table. Could not find %s in %s. %s", name, instanceName, err)
+	}
+
+	for _, fi := range table.FamilyInfos {
+		if fi.Name == d.Id() {
+			d.Set("project", project)
+			return nil
+		}
+	}
+
+	log.Printf("[WARN] Removing GC policy for column family %q because the family is gone", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceBigtableGCPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := setBigtableGCPolicy(d, meta); err != nil {
+		return err
+	}
+
+	return resourceBigtableGCPolicyRead(d, meta)
+}
+
+func resourceBigtableGCPolicyDestroy(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := context.Background()
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instanceName := d.Get("instance_name").(string)
+	c, err := config.bigtableClientFactory.NewAdminClient(project, instanceName)
+	if err != nil {
+		return fmt.Errorf("Error starting admin client. %s", err)
+	}
+
+	defer c.Close()
+
+	// Column families can't be left without a policy, deleting one means
+	// the family keeps every version of its cells again.
+	err = c.SetGCPolicy(ctx, d.Get("table").(string), d.Get("column_family").(string), bigtable.NoGcPolicy())
+	if err != nil {
+		return fmt.Errorf("Error removing GC policy. %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func setBigtableGCPolicy(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := context.Background()
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	gcPolicy, err := generateBigtableGCPolicy(d)
+	if err != nil {
+		return err
+	}
+
+	instanceName := d.Get("instance_name").(string)
+	c, err := config.bigtableClientFactory.NewAdminClient(project, instanceName)
+	if err != nil {
+		return fmt.Errorf("Error starting admin client. %s", err)
+	}
+
+	defer c.Close()
+
+	columnFamily := d.Get("column_family").(string)
+	log.Printf("[DEBUG] Setting GC policy of column family %q to %q", columnFamily, gcPolicy)
+	err = c.SetGCPolicy(ctx, d.Get("table").(string), columnFamily, gcPolicy)
+	if err != nil {
+		return fmt.Errorf("Error setting GC policy. %s", err)
+	}
+
+	return nil
+}
+
+func generateBigtableGCPolicy(d *schema.ResourceData) (bigtable.GCPolicy, error) {
+	policies := []bigtable.GCPolicy{}
+
+	if v, ok := d.GetOk("max_age"); ok {
+		days := v.([]interface{})[0].(map[string]interface{})["days"].(int)
+		policies = append(policies, bigtable.MaxAgePolicy(time.Duration(days)*24*time.Hour))
+	}
+
+	if v, ok := d.GetOk("max_version"); ok {
+		number := v.([]interface{})[0].(map[string]interface{})["number"].(int)
+		policies = append(policies, bigtable.MaxVersionsPolicy(number))
+	}
+
+	switch d.Get("mode").(string) {
+	case GCPolicyModeUnion:
+		return bigtable.UnionPolicy(policies...), nil
+	case GCPolicyModeIntersection:
+		return bigtable.IntersectionPolicy(policies...), nil
+	}
+
+	if len(policies) != 1 {
+		return nil, fmt.Errorf("exactly one of max_age or max_version must be set when mode is not set")
+	}
+
+	return policies[0], nil
+}
@@ -0,0 +1,208 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBigtableGCPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tableName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	familyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigtableGCPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableGCPolicy(instanceName, tableName, familyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableGCPolicyExists(
+						"google_bigtable_gc_policy.policy", "age() > 3d"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBigtableGCPolicy_union(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tableName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	familyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigtableGCPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableGCPolicy(instanceName, tableName, familyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableGCPolicyExists(
+						"google_bigtable_gc_policy.policy", "age() > 3d"),
+				),
+			},
+			{
+				Config: testAccBigtableGCPolicy_union(instanceName, tableName, familyName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableGCPolicyExists(
+						"google_bigtable_gc_policy.policy", "(age() > 7d || versions() > 10)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBigtableGCPolicyDestroy(s *terraform.State) error {
+	var ctx = context.Background()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_bigtable_gc_policy" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		c, err := config.bigtableClientFactory.NewAdminClient(config.Project, rs.Primary.Attributes["instance_name"])
+		if err != nil {
+			// The instance is already gone
+			return nil
+		}
+
+		table, err := c.TableInfo(ctx, rs.Primary.Attributes["table"])
+		if err != nil {
+			// The table is already gone
+			c.Close()
+			return nil
+		}
+
+		for _, fi := range table.FamilyInfos {
+			if fi.Name == rs.Primary.ID && fi.GCPolicy != "" && fi.GCPolicy != "<never>" {
+				return fmt.Errorf("GC policy still present. Found %q on %s.", fi.GCPolicy, rs.Primary.ID)
+			}
+		}
+
+		c.Close()
+	}
+
+	return nil
+}
+
+func testAccBigtableGCPolicyExists(n, gcPolicy string) resource.TestCheckFunc {
+	var ctx = context.Background()
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+		config := testAccProvider.Meta().(*Config)
+		c, err := config.bigtableClientFactory.NewAdminClient(config.Project, rs.Primary.Attributes["instance_name"])
+		if err != nil {
+			return fmt.Errorf("Error starting admin client. %s", err)
+		}
+
+		defer c.Close()
+
+		table, err := c.TableInfo(ctx, rs.Primary.Attributes["table"])
+		if err != nil {
+			return fmt.Errorf("Error retrieving table. Could not find %s in %s.", rs.Primary.Attributes["table"], rs.Primary.Attributes["instance_name"])
+		}
+
+		for _, fi := range table.FamilyInfos {
+			if fi.Name == rs.Primary.ID {
+				if fi.GCPolicy != gcPolicy {
+					return fmt.Errorf("Expected GC policy %q on %s, got %q", gcPolicy, rs.Primary.ID, fi.GCPolicy)
+				}
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Error retrieving column family %s in table %s.", rs.Primary.ID, rs.Primary.Attributes["table"])
+	}
+}
+
+func testAccBigtableGCPolicy(instanceName, tableName, family string) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+  name = "%s"
+
+  cluster {
+    cluster_id = "%s"
+    zone       = "us-central1-b"
+  }
+
+  instance_type = "DEVELOPMENT"
+}
+
+resource "google_bigtable_table" "table" {
+  name          = "%s"
+  instance_name = "${google_bigtable_instance.instance.name}"
+
+  column_family {
+    family = "%s"
+  }
+}
+
+resource "google_bigtable_gc_policy" "policy" {
+  instance_name = "${google_bigtable_instance.instance.name}"
+  table         = "${google_bigtable_table.table.name}"
+  column_family = "%s"
+
+  max_age {
+    days = 3
+  }
+}
+`, instanceName, instanceName, tableName, family, family)
+}
+
+func testAccBigtableGCPolicy_union(instanceName, tableName, family string) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+  name = "%s"
+
+  cluster {
+    cluster_id = "%s"
+    zone       = "us-central1-b"
+  }
+
+  instance_type = "DEVELOPMENT"
+}
+
+resource "google_bigtable_table" "table" {
+  name          = "%s"
+  instance_name = "${google_bigtable_instance.instance.name}"
+
+  column_family {
+    family = "%s"
+  }
+}
+
+resource "google_bigtable_gc_policy" "policy" {
+  instance_name = "${google_bigtable_instance.instance.name}"
+  table         = "${google_bigtable_table.table.name}"
+  column_family = "%s"
+
+  mode = "UNION"
+
+  max_age {
+    days = 7
+  }
+
+  max_version {
+    number = 10
+  }
+}
+`, instanceName, instanceName, tableName, family, family)
+}
@@ -1,10 +1,14 @@
 package google
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -15,8 +19,16 @@ func resourceBigtableInstance() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigtableInstanceCreate,
 		Read:   resourceBigtableInstanceRead,
+		Update: resourceBigtableInstanceUpdate,
 		Delete: resourceBigtableInstanceDestroy,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceBigtableInstanceClusterCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -27,33 +39,54 @@ func resourceBigtableInstance() *schema.Resource {
 			"cluster": {
 				Type:     schema.TypeSet,
 				Required: true,
-				ForceNew: true,
-				MaxItems: 2,
+				MaxItems: 8,
+				Set:      resourceBigtableInstanceClusterHash,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cluster_id": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"zone": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"num_nodes": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							ForceNew:     true,
+							Computed:     true,
 							ValidateFunc: validation.IntAtLeast(3),
 						},
 						"storage_type": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Default:      "SSD",
-							ForceNew:     true,
 							ValidateFunc: validation.StringInSlice([]string{"SSD", "HDD"}, false),
 						},
+						"autoscaling_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min_nodes": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"max_nodes": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"cpu_target": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(10, 80),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -153,6 +186,19 @@ func resourceBigtableInstanceCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(conf.InstanceID)
 
+	// Clusters are created with a fixed number of nodes, autoscaling is
+	// enabled on them afterwards.
+	for _, cl := range d.Get("cluster").(*schema.Set).List() {
+		cluster := cl.(map[string]interface{})
+		if len(cluster["autoscaling_config"].([]interface{})) == 0 {
+			continue
+		}
+		err = updateBigtableClusterAutoscaling(config, project, conf.InstanceID, cluster, int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		if err != nil {
+			return err
+		}
+	}
+
 	return resourceBigtableInstanceRead(d, meta)
 }
 
@@ -193,7 +239,11 @@ func resourceBigtableInstanceRead(d *schema.ResourceData, meta interface{}) erro
 			}
 			return fmt.Errorf("Error retrieving cluster %q: %s", cluster["cluster_id"].(string), err.Error())
 		}
-		clusterState = append(clusterState, flattenBigtableCluster(clus, cluster["storage_type"].(string)))
+		autoscaling, err := getBigtableClusterAutoscaling(config, project, instance.Name, clus.Name)
+		if err != nil {
+			return err
+		}
+		clusterState = append(clusterState, flattenBigtableCluster(clus, cluster["storage_type"].(string), autoscaling))
 	}
 
 	err = d.Set("cluster", clusterState)
@@ -207,6 +257,81 @@ func resourceBigtableInstanceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceBigtableInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := context.Background()
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	c, err := config.bigtableClientFactory.NewInstanceAdminClient(project)
+	if err != nil {
+		return fmt.Errorf("Error starting instance admin client. %s", err)
+	}
+
+	defer c.Close()
+
+	if d.HasChange("cluster") {
+		timeout := int(d.Timeout(schema.TimeoutUpdate).Minutes())
+		o, n := d.GetChange("cluster")
+		oldClusters := bigtableClustersByID(o.(*schema.Set).List())
+		newClusters := bigtableClustersByID(n.(*schema.Set).List())
+
+		// Add clusters before removing any so the instance always keeps
+		// at least one replica.
+		for id, cluster := range newClusters {
+			if _, ok := oldClusters[id]; ok {
+				continue
+			}
+			conf := expandBigtableCluster(cluster, d.Id())
+			log.Printf("[DEBUG] Adding cluster %q to Bigtable instance %q", id, d.Id())
+			if err := c.CreateCluster(ctx, &conf); err != nil {
+				return fmt.Errorf("Error creating cluster %q: %s", id, err)
+			}
+			if len(cluster["autoscaling_config"].([]interface{})) > 0 {
+				if err := updateBigtableClusterAutoscaling(config, project, d.Id(), cluster, timeout); err != nil {
+					return err
+				}
+			}
+		}
+
+		for id := range oldClusters {
+			if _, ok := newClusters[id]; ok {
+				continue
+			}
+			log.Printf("[DEBUG] Removing cluster %q from Bigtable instance %q", id, d.Id())
+			if err := c.DeleteCluster(ctx, d.Id(), id); err != nil {
+				return fmt.Errorf("Error deleting cluster %q: %s", id, err)
+			}
+		}
+
+		for id, cluster := range newClusters {
+			old, ok := oldClusters[id]
+			if !ok {
+				continue
+			}
+			oldAutoscaling := old["autoscaling_config"].([]interface{})
+			newAutoscaling := cluster["autoscaling_config"].([]interface{})
+			if len(newAutoscaling) > 0 || len(oldAutoscaling) > 0 {
+				if err := updateBigtableClusterAutoscaling(config, project, d.Id(), cluster, timeout); err != nil {
+					return err
+				}
+				continue
+			}
+			if old["num_nodes"].(int) != cluster["num_nodes"].(int) {
+				log.Printf("[DEBUG] Resizing cluster %q to %d nodes", id, cluster["num_nodes"].(int))
+				if err := c.UpdateCluster(ctx, d.Id(), id, int32(cluster["num_nodes"].(int))); err != nil {
+					return fmt.Errorf("Error updating cluster %q: %s", id, err)
+				}
+			}
+		}
+	}
+
+	return resourceBigtableInstanceRead(d, meta)
+}
+
 func resourceBigtableInstanceDestroy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	ctx := context.Background()
@@ -234,34 +359,166 @@ func resourceBigtableInstanceDestroy(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func flattenBigtableCluster(c *bigtable.ClusterInfo, storageType string) map[string]interface{} {
+// resourceBigtableInstanceClusterHash leaves num_nodes out of the hash of
+// autoscaled clusters, since their size is managed by Bigtable.
+func resourceBigtableInstanceClusterHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["cluster_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["zone"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["storage_type"].(string)))
+
+	if v, ok := m["autoscaling_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		autoscaling := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("%d-", autoscaling["min_nodes"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", autoscaling["max_nodes"].(int)))
+		buf.WriteString(fmt.Sprintf("%d-", autoscaling["cpu_target"].(int)))
+	} else if v, ok := m["num_nodes"].(int); ok {
+		buf.WriteString(fmt.Sprintf("%d-", v))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+// resourceBigtableInstanceClusterCustomizeDiff recreates the instance when an
+// existing cluster is moved to another zone or storage type. Clusters can
+// otherwise be added, removed and resized in place.
+func resourceBigtableInstanceClusterCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("cluster") {
+		return nil
+	}
+
+	o, n := d.GetChange("cluster")
+	oldClusters := bigtableClustersByID(o.(*schema.Set).List())
+	for id, cluster := range bigtableClustersByID(n.(*schema.Set).List()) {
+		old, ok := oldClusters[id]
+		if !ok {
+			continue
+		}
+		if old["zone"] != cluster["zone"] || old["storage_type"] != cluster["storage_type"] {
+			log.Printf("[DEBUG] Cluster %q changed zone or storage type, recreating instance", id)
+			return d.ForceNew("cluster")
+		}
+	}
+
+	return nil
+}
+
+func bigtableClustersByID(clusters []interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{}, len(clusters))
+	for _, c := range clusters {
+		cluster := c.(map[string]interface{})
+		result[cluster["cluster_id"].(string)] = cluster
+	}
+	return result
+}
+
+func flattenBigtableCluster(c *bigtable.ClusterInfo, storageType string, autoscaling []map[string]interface{}) map[string]interface{} {
 	return map[string]interface{}{
-		"zone":         c.Zone,
-		"num_nodes":    c.ServeNodes,
-		"cluster_id":   c.Name,
-		"storage_type": storageType,
+		"zone":               c.Zone,
+		"num_nodes":          c.ServeNodes,
+		"cluster_id":         c.Name,
+		"storage_type":       storageType,
+		"autoscaling_config": autoscaling,
 	}
 }
 
 func expandBigtableClusters(clusters []interface{}, instanceID string) []bigtable.ClusterConfig {
 	results := make([]bigtable.ClusterConfig, 0, len(clusters))
 	for _, c := range clusters {
-		cluster := c.(map[string]interface{})
-		zone := cluster["zone"].(string)
-		var storageType bigtable.StorageType
-		switch cluster["storage_type"].(string) {
-		case "SSD":
-			storageType = bigtable.SSD
-		case "HDD":
-			storageType = bigtable.HDD
-		}
-		results = append(results, bigtable.ClusterConfig{
-			InstanceID:  instanceID,
-			Zone:        zone,
-			ClusterID:   cluster["cluster_id"].(string),
-			NumNodes:    int32(cluster["num_nodes"].(int)),
-			StorageType: storageType,
-		})
+		results = append(results, expandBigtableCluster(c.(map[string]interface{}), instanceID))
 	}
 	return results
 }
+
+func expandBigtableCluster(cluster map[string]interface{}, instanceID string) bigtable.ClusterConfig {
+	var storageType bigtable.StorageType
+	switch cluster["storage_type"].(string) {
+	case "SSD":
+		storageType = bigtable.SSD
+	case "HDD":
+		storageType = bigtable.HDD
+	}
+
+	numNodes := cluster["num_nodes"].(int)
+	if v := cluster["autoscaling_config"].([]interface{}); len(v) > 0 && v[0] != nil && numNodes == 0 {
+		// Autoscaled clusters start at their minimum size.
+		numNodes = v[0].(map[string]interface{})["min_nodes"].(int)
+	}
+
+	return bigtable.ClusterConfig{
+		InstanceID:  instanceID,
+		Zone:        cluster["zone"].(string),
+		ClusterID:   cluster["cluster_id"].(string),
+		NumNodes:    int32(numNodes),
+		StorageType: storageType,
+	}
+}
+
+// The vendored Bigtable client predates cluster autoscaling, so it's managed
+// through the REST API.
+const bigtableAdminBasePath = "https://bigtableadmin.googleapis.com/v2/"
+
+func getBigtableClusterAutoscaling(config *Config, project, instance, cluster string) ([]map[string]interface{}, error) {
+	url := fmt.Sprintf("%sprojects/%s/instances/%s/clusters/%s", bigtableAdminBasePath, project, instance, cluster)
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading autoscaling config of cluster %q: %s", cluster, err)
+	}
+
+	clusterConfig, _ := res["clusterConfig"].(map[string]interface{})
+	autoscaling, ok := clusterConfig["clusterAutoscalingConfig"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	limits, _ := autoscaling["autoscalingLimits"].(map[string]interface{})
+	targets, _ := autoscaling["autoscalingTargets"].(map[string]interface{})
+
+	return []map[string]interface{}{{
+		"min_nodes":  flattenBigtableAutoscalingInt(limits["minServeNodes"]),
+		"max_nodes":  flattenBigtableAutoscalingInt(limits["maxServeNodes"]),
+		"cpu_target": flattenBigtableAutoscalingInt(targets["cpuUtilizationPercent"]),
+	}}, nil
+}
+
+func flattenBigtableAutoscalingInt(v interface{}) int {
+	if f, ok := v.(float64); ok {
+		return int(f)
+	}
+	return 0
+}
+
+// updateBigtableClusterAutoscaling enables autoscaling on a cluster, or
+// returns it to num_nodes when autoscaling_config has been removed.
+func updateBigtableClusterAutoscaling(config *Config, project, instance string, cluster map[string]interface{}, timeoutMinutes int) error {
+	clusterID := cluster["cluster_id"].(string)
+	obj := map[string]interface{}{}
+	updateMask := []string{"cluster_config.cluster_autoscaling_config"}
+
+	if v := cluster["autoscaling_config"].([]interface{}); len(v) > 0 && v[0] != nil {
+		autoscaling := v[0].(map[string]interface{})
+		obj["clusterConfig"] = map[string]interface{}{
+			"clusterAutoscalingConfig": map[string]interface{}{
+				"autoscalingLimits": map[string]interface{}{
+					"minServeNodes": autoscaling["min_nodes"],
+					"maxServeNodes": autoscaling["max_nodes"],
+				},
+				"autoscalingTargets": map[string]interface{}{
+					"cpuUtilizationPercent": autoscaling["cpu_target"],
+				},
+			},
+		}
+	} else {
+		obj["serveNodes"] = cluster["num_nodes"]
+		updateMask = append(updateMask, "serve_nodes")
+	}
+
+	url := fmt.Sprintf("%sprojects/%s/instances/%s/clusters/%s?updateMask=%s", bigtableAdminBasePath, project, instance, clusterID, strings.Join(updateMask, ","))
+	log.Printf("[DEBUG] Updating autoscaling of Bigtable cluster %q: %#v", clusterID, obj)
+	res, err := sendRequest(config, "PATCH", url, obj)
+	if err != nil {
+		return fmt.Errorf("Error updating autoscaling of cluster %q: %s", clusterID, err)
+	}
+
+	return bigtableAdminOperationWaitTime(config, res, project, "Updating Bigtable cluster autoscaling", timeoutMinutes)
+}
@@ -80,6 +80,44 @@ func TestAccBigtableInstance_development(t *testing.T) {
 	})
 }
 
+func TestAccBigtableInstance_clusterUpdate(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigtableInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableInstance(instanceName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableInstanceExists(
+						"google_bigtable_instance.instance"),
+					resource.TestCheckResourceAttr("google_bigtable_instance.instance", "cluster.#", "1"),
+				),
+			},
+			{
+				Config: testAccBigtableInstance_clusterAutoscaling(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableInstanceExists(
+						"google_bigtable_instance.instance"),
+					resource.TestCheckResourceAttr("google_bigtable_instance.instance", "cluster.#", "2"),
+				),
+			},
+			{
+				Config: testAccBigtableInstance(instanceName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccBigtableInstanceExists(
+						"google_bigtable_instance.instance"),
+					resource.TestCheckResourceAttr("google_bigtable_instance.instance", "cluster.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBigtableInstanceDestroy(s *terraform.State) error {
 	var ctx = context.Background()
 	for _, rs := range s.RootModule().Resources {
@@ -166,6 +204,31 @@ resource "google_bigtable_instance" "instance" {
 `, instanceName, instanceName, instanceName)
 }
 
+func testAccBigtableInstance_clusterAutoscaling(instanceName string) string {
+	return fmt.Sprintf(`
+resource "google_bigtable_instance" "instance" {
+	name = "%s"
+	cluster {
+		cluster_id   = "%s"
+		zone         = "us-central1-b"
+		storage_type = "HDD"
+
+		autoscaling_config {
+			min_nodes  = 3
+			max_nodes  = 5
+			cpu_target = 60
+		}
+	}
+	cluster {
+		cluster_id   = "%s-c"
+		zone         = "us-central1-c"
+		num_nodes    = 3
+		storage_type = "HDD"
+	}
+}
+`, instanceName, instanceName, instanceName)
+}
+
 func testAccBigtableInstance_development(instanceName string) string {
 	return fmt.Sprintf(`
 resource "google_bigtable_instance" "instance" {
@@ -12,6 +12,7 @@ func resourceBigtableTable() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceBigtableTableCreate,
 		Read:   resourceBigtableTableRead,
+		Update: resourceBigtableTableUpdate,
 		Delete: resourceBigtableTableDestroy,
 
 		Schema: map[string]*schema.Schema{
@@ -24,7 +25,6 @@ func resourceBigtableTable() *schema.Resource {
 			"column_family": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"family": {
@@ -143,6 +143,47 @@ func resourceBigtableTableRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func resourceBigtableTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := context.Background()
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	instanceName := d.Get("instance_name").(string)
+	c, err := config.bigtableClientFactory.NewAdminClient(project, instanceName)
+	if err != nil {
+		return fmt.Errorf("Error starting admin client. %s", err)
+	}
+
+	defer c.Close()
+
+	name := d.Id()
+	o, n := d.GetChange("column_family")
+	oldFamilies := o.(*schema.Set)
+	newFamilies := n.(*schema.Set)
+
+	for _, co := range newFamilies.Difference(oldFamilies).List() {
+		family := co.(map[string]interface{})["family"].(string)
+		log.Printf("[DEBUG] Creating column family %q in table %q", family, name)
+		if err := c.CreateColumnFamily(ctx, name, family); err != nil {
+			return fmt.Errorf("Error creating column family %s. %s", family, err)
+		}
+	}
+
+	for _, co := range oldFamilies.Difference(newFamilies).List() {
+		family := co.(map[string]interface{})["family"].(string)
+		log.Printf("[DEBUG] Deleting column family %q from table %q", family, name)
+		if err := c.DeleteColumnFamily(ctx, name, family); err != nil {
+			return fmt.Errorf("Error deleting column family %s. %s", family, err)
+		}
+	}
+
+	return resourceBigtableTableRead(d, meta)
+}
+
 func resourceBigtableTableDestroy(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	ctx := context.Background()
@@ -100,6 +100,40 @@ func TestAccBigtableTable_familyMany(t *testing.T) {
 	})
 }
 
+func TestAccBigtableTable_familyUpdate(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	tableName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	family := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBigtableTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBigtableTable_family(instanceName, tableName, family),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigtable_table.table", "column_family.#", "1"),
+				),
+			},
+			{
+				Config: testAccBigtableTable_familyMany(instanceName, tableName, family),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigtable_table.table", "column_family.#", "2"),
+				),
+			},
+			{
+				Config: testAccBigtableTable_family(instanceName, tableName, family),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_bigtable_table.table", "column_family.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBigtableTableDestroy(s *terraform.State) error {
 	var ctx = context.Background()
 	for _, rs := range s.RootModule().Resources {
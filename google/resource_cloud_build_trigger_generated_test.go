@@ -66,6 +66,55 @@ resource "google_cloudbuild_trigger" "filename-trigger" {
 `, context)
 }
 
+func TestAccCloudBuildTrigger_cloudbuildTriggerGithubExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudBuildTriggerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildTrigger_cloudbuildTriggerGithubExample(context),
+			},
+			{
+				ResourceName:      "google_cloudbuild_trigger.github-trigger",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudBuildTrigger_cloudbuildTriggerGithubExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloudbuild_trigger" "github-trigger" {
+  name = "github-trigger%{random_suffix}"
+
+  github {
+    owner = "hashicorp"
+    name  = "terraform-provider-google"
+    push {
+      branch = "^master$"
+    }
+  }
+
+  included_files = ["google/**"]
+  ignored_files  = ["website/**"]
+
+  approval_config {
+    approval_required = true
+  }
+
+  filename = "cloudbuild.yaml"
+}
+`, context)
+}
+
 func testAccCheckCloudBuildTriggerDestroy(s *terraform.State) error {
 	for name, rs := range s.RootModule().Resources {
 		if rs.Type != "google_cloudbuild_trigger" {
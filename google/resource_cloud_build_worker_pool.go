@@ -0,0 +1,594 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudBuildWorkerPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudBuildWorkerPoolCreate,
+		Read:   resourceCloudBuildWorkerPoolRead,
+		Update: resourceCloudBuildWorkerPoolUpdate,
+		Delete: resourceCloudBuildWorkerPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudBuildWorkerPoolImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"peered_network": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: compareSelfLinkOrResourceName,
+						},
+						"peered_network_ip_range": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"worker_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk_size_gb": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"machine_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"no_external_ip": {
+							Type:     schema.TypeBool,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudBuildWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandCloudBuildWorkerPoolDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	annotationsProp, err := expandCloudBuildWorkerPoolAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(annotationsProp)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	workerConfigProp, err := expandCloudBuildWorkerPoolWorkerConfig(d.Get("worker_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("worker_config"); !isEmptyValue(reflect.ValueOf(workerConfigProp)) && (ok || !reflect.DeepEqual(v, workerConfigProp)) {
+		obj["workerConfig"] = workerConfigProp
+	}
+	networkConfigProp, err := expandCloudBuildWorkerPoolNetworkConfig(d.Get("network_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network_config"); !isEmptyValue(reflect.ValueOf(networkConfigProp)) && (ok || !reflect.DeepEqual(v, networkConfigProp)) {
+		obj["networkConfig"] = networkConfigProp
+	}
+	obj, err = resourceCloudBuildWorkerPoolEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://cloudbuild.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workerPools?workerPoolId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new WorkerPool: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating WorkerPool: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := cloudBuildOperationWaitTime(
+		config, res, project, "Creating WorkerPool",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create WorkerPool: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating WorkerPool %q: %#v", d.Id(), res)
+
+	return resourceCloudBuildWorkerPoolRead(d, meta)
+}
+
+func resourceCloudBuildWorkerPoolRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://cloudbuild.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudBuildWorkerPool %q", d.Id()))
+	}
+
+	res, err = resourceCloudBuildWorkerPoolDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+
+	// The location and pool id are only part of the name in API responses.
+	nameParts := strings.Split(res["name"].(string), "/")
+	if err := d.Set("location", nameParts[3]); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("name", nameParts[5]); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenCloudBuildWorkerPoolDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("annotations", flattenCloudBuildWorkerPoolAnnotations(res["annotations"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("worker_config", flattenCloudBuildWorkerPoolWorkerConfig(res["workerConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("network_config", flattenCloudBuildWorkerPoolNetworkConfig(res["networkConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("state", flattenCloudBuildWorkerPoolState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("uid", flattenCloudBuildWorkerPoolUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("create_time", flattenCloudBuildWorkerPoolCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("update_time", flattenCloudBuildWorkerPoolUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+	if err := d.Set("delete_time", flattenCloudBuildWorkerPoolDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkerPool: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudBuildWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandCloudBuildWorkerPoolDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	annotationsProp, err := expandCloudBuildWorkerPoolAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	workerConfigProp, err := expandCloudBuildWorkerPoolWorkerConfig(d.Get("worker_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("worker_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, workerConfigProp)) {
+		obj["workerConfig"] = workerConfigProp
+	}
+	obj, err = resourceCloudBuildWorkerPoolEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://cloudbuild.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating WorkerPool %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("annotations") {
+		updateMask = append(updateMask, "annotations")
+	}
+
+	if d.HasChange("worker_config") {
+		updateMask = append(updateMask, "privatePoolV1Config.workerConfig")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating WorkerPool %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = cloudBuildOperationWaitTime(
+		config, res, project, "Updating WorkerPool",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceCloudBuildWorkerPoolRead(d, meta)
+}
+
+func resourceCloudBuildWorkerPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://cloudbuild.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting WorkerPool %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "WorkerPool")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = cloudBuildOperationWaitTime(
+		config, res, project, "Deleting WorkerPool",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting WorkerPool %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudBuildWorkerPoolImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workerPools/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)", "(?P<location>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenCloudBuildWorkerPoolDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolWorkerConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["disk_size_gb"] =
+		flattenCloudBuildWorkerPoolWorkerConfigDiskSizeGb(original["diskSizeGb"], d)
+	transformed["machine_type"] =
+		flattenCloudBuildWorkerPoolWorkerConfigMachineType(original["machineType"], d)
+	transformed["no_external_ip"] =
+		flattenCloudBuildWorkerPoolWorkerConfigNoExternalIp(original["noExternalIp"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudBuildWorkerPoolWorkerConfigDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudBuildWorkerPoolWorkerConfigMachineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolWorkerConfigNoExternalIp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolNetworkConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["peered_network"] =
+		flattenCloudBuildWorkerPoolNetworkConfigPeeredNetwork(original["peeredNetwork"], d)
+	transformed["peered_network_ip_range"] =
+		flattenCloudBuildWorkerPoolNetworkConfigPeeredNetworkIpRange(original["peeredNetworkIpRange"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudBuildWorkerPoolNetworkConfigPeeredNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolNetworkConfigPeeredNetworkIpRange(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudBuildWorkerPoolDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandCloudBuildWorkerPoolDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudBuildWorkerPoolAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudBuildWorkerPoolWorkerConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDiskSizeGb, err := expandCloudBuildWorkerPoolWorkerConfigDiskSizeGb(original["disk_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiskSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["diskSizeGb"] = transformedDiskSizeGb
+	}
+
+	transformedMachineType, err := expandCloudBuildWorkerPoolWorkerConfigMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !isEmptyValue(val) {
+		transformed["machineType"] = transformedMachineType
+	}
+
+	transformedNoExternalIp, err := expandCloudBuildWorkerPoolWorkerConfigNoExternalIp(original["no_external_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNoExternalIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["noExternalIp"] = transformedNoExternalIp
+	}
+
+	return transformed, nil
+}
+
+func expandCloudBuildWorkerPoolWorkerConfigDiskSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudBuildWorkerPoolWorkerConfigMachineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudBuildWorkerPoolWorkerConfigNoExternalIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudBuildWorkerPoolNetworkConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedPeeredNetwork, err := expandCloudBuildWorkerPoolNetworkConfigPeeredNetwork(original["peered_network"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPeeredNetwork); val.IsValid() && !isEmptyValue(val) {
+		transformed["peeredNetwork"] = transformedPeeredNetwork
+	}
+
+	transformedPeeredNetworkIpRange, err := expandCloudBuildWorkerPoolNetworkConfigPeeredNetworkIpRange(original["peered_network_ip_range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPeeredNetworkIpRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["peeredNetworkIpRange"] = transformedPeeredNetworkIpRange
+	}
+
+	return transformed, nil
+}
+
+func expandCloudBuildWorkerPoolNetworkConfigPeeredNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	fv, err := ParseNetworkFieldValue(v.(string), d, config)
+	if err != nil {
+		return nil, err
+	}
+	return fv.RelativeLink(), nil
+}
+
+func expandCloudBuildWorkerPoolNetworkConfigPeeredNetworkIpRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceCloudBuildWorkerPoolEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// The API nests the worker and network configs in privatePoolV1Config.
+	poolConfig := make(map[string]interface{})
+	if v, ok := obj["workerConfig"]; ok {
+		poolConfig["workerConfig"] = v
+		delete(obj, "workerConfig")
+	}
+	if v, ok := obj["networkConfig"]; ok {
+		poolConfig["networkConfig"] = v
+		delete(obj, "networkConfig")
+	}
+	if len(poolConfig) > 0 {
+		obj["privatePoolV1Config"] = poolConfig
+	}
+	return obj, nil
+}
+
+func resourceCloudBuildWorkerPoolDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	if poolConfig, ok := res["privatePoolV1Config"].(map[string]interface{}); ok {
+		res["workerConfig"] = poolConfig["workerConfig"]
+		res["networkConfig"] = poolConfig["networkConfig"]
+	}
+	return res, nil
+}
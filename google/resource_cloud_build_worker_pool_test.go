@@ -0,0 +1,138 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudBuildWorkerPool_cloudbuildWorkerPoolBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudBuildWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildWorkerPool_cloudbuildWorkerPoolBasicExample(context),
+			},
+			{
+				ResourceName:      "google_cloudbuild_worker_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudBuildWorkerPool_cloudbuildWorkerPoolBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloudbuild_worker_pool" "pool" {
+  name     = "my-pool%{random_suffix}"
+  location = "europe-west1"
+
+  worker_config {
+    disk_size_gb   = 100
+    machine_type   = "e2-standard-4"
+    no_external_ip = false
+  }
+}
+`, context)
+}
+
+func TestAccCloudBuildWorkerPool_cloudbuildWorkerPoolNetworkConfigExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudBuildWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudBuildWorkerPool_cloudbuildWorkerPoolNetworkConfigExample(context),
+			},
+			{
+				ResourceName:      "google_cloudbuild_worker_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudBuildWorkerPool_cloudbuildWorkerPoolNetworkConfigExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "network" {
+  name                    = "my-network%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_global_address" "worker_range" {
+  name          = "worker-pool-range%{random_suffix}"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = "${google_compute_network.network.self_link}"
+}
+
+resource "google_service_networking_connection" "worker_pool_conn" {
+  network                 = "${google_compute_network.network.self_link}"
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = ["${google_compute_global_address.worker_range.name}"]
+}
+
+resource "google_cloudbuild_worker_pool" "pool" {
+  name     = "my-pool%{random_suffix}"
+  location = "europe-west1"
+
+  worker_config {
+    disk_size_gb   = 100
+    machine_type   = "e2-standard-4"
+    no_external_ip = false
+  }
+
+  network_config {
+    peered_network          = "${google_service_networking_connection.worker_pool_conn.network}"
+    peered_network_ip_range = "/29"
+  }
+}
+`, context)
+}
+
+func testAccCheckCloudBuildWorkerPoolDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloudbuild_worker_pool" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://cloudbuild.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workerPools/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudBuildWorkerPool still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,2066 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceCloudRunService() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCloudRunServiceCreate,
+		Read:   resourceCloudRunServiceRead,
+		Update: resourceCloudRunServiceUpdate,
+		Delete: resourceCloudRunServiceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceCloudRunServiceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(360 * time.Second),
+			Update: schema.DefaultTimeout(900 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"autogenerate_revision_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"annotations": {
+							Type:             schema.TypeMap,
+							Computed:         true,
+							Optional:         true,
+							DiffSuppressFunc: cloudrunAnnotationDiffSuppress,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+						},
+						"labels": {
+							Type:             schema.TypeMap,
+							Computed:         true,
+							Optional:         true,
+							DiffSuppressFunc: cloudrunLabelDiffSuppress,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+						"generation": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"self_link": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"template": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"annotations": {
+										Type:             schema.TypeMap,
+										Computed:         true,
+										Optional:         true,
+										DiffSuppressFunc: cloudrunTemplateAnnotationDiffSuppress,
+										Elem:             &schema.Schema{Type: schema.TypeString},
+									},
+									"labels": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+									},
+									"namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+									},
+									"generation": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"resource_version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"self_link": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"uid": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"spec": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"containers": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"image": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"args": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"command": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+												"env": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"value": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"value_from": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"secret_key_ref": {
+																			Type:     schema.TypeList,
+																			Required: true,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"key": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																					"name": {
+																						Type:     schema.TypeString,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"ports": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"container_port": {
+																Type:     schema.TypeInt,
+																Computed: true,
+																Optional: true,
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Computed: true,
+																Optional: true,
+															},
+															"protocol": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+												},
+												"resources": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"limits": {
+																Type:     schema.TypeMap,
+																Computed: true,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"requests": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"volume_mounts": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"mount_path": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+														},
+													},
+												},
+												"working_dir": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"container_concurrency": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+									},
+									"service_account_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+									},
+									"timeout_seconds": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+									},
+									"volumes": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"secret": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"secret_name": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"default_mode": {
+																Type:     schema.TypeInt,
+																Optional: true,
+															},
+															"items": {
+																Type:     schema.TypeList,
+																Optional: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"key": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"path": {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"mode": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"serving_state": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"traffic": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"latest_revision": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"revision_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"conditions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"message": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"reason": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"latest_created_revision_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latest_ready_revision_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"observed_generation": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceCloudRunServiceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	templateProp, err := expandCloudRunServiceTemplate(d.Get("template"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("template"); !isEmptyValue(reflect.ValueOf(templateProp)) && (ok || !reflect.DeepEqual(v, templateProp)) {
+		obj["template"] = templateProp
+	}
+	trafficProp, err := expandCloudRunServiceTraffic(d.Get("traffic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("traffic"); !isEmptyValue(reflect.ValueOf(trafficProp)) && (ok || !reflect.DeepEqual(v, trafficProp)) {
+		obj["traffic"] = trafficProp
+	}
+	metadataProp, err := expandCloudRunServiceMetadata(d.Get("metadata"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("metadata"); !isEmptyValue(reflect.ValueOf(metadataProp)) && (ok || !reflect.DeepEqual(v, metadataProp)) {
+		obj["metadata"] = metadataProp
+	}
+
+	obj, err = resourceCloudRunServiceEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Service: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Service: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/{{location}}/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	if err := cloudRunPollReady(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}", d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting to create Service: %s", err)
+	}
+
+	log.Printf("[DEBUG] Finished creating Service %q: %#v", d.Id(), res)
+
+	return resourceCloudRunServiceRead(d, meta)
+}
+
+func resourceCloudRunServiceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("CloudRunService %q", d.Id()))
+	}
+
+	res, err = resourceCloudRunServiceDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+
+	if err := d.Set("template", flattenCloudRunServiceTemplate(res["template"], d)); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("traffic", flattenCloudRunServiceTraffic(res["traffic"], d)); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("metadata", flattenCloudRunServiceMetadata(res["metadata"], d)); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+	if err := d.Set("status", flattenCloudRunServiceStatus(res["status"], d)); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
+
+	return nil
+}
+
+func resourceCloudRunServiceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	templateProp, err := expandCloudRunServiceTemplate(d.Get("template"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("template"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, templateProp)) {
+		obj["template"] = templateProp
+	}
+	trafficProp, err := expandCloudRunServiceTraffic(d.Get("traffic"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("traffic"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, trafficProp)) {
+		obj["traffic"] = trafficProp
+	}
+	metadataProp, err := expandCloudRunServiceMetadata(d.Get("metadata"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("metadata"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, metadataProp)) {
+		obj["metadata"] = metadataProp
+	}
+
+	obj, err = resourceCloudRunServiceEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Service %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PUT", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Service %q: %s", d.Id(), err)
+	}
+
+	if err := cloudRunPollReady(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}", d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting to update Service %q: %s", d.Id(), err)
+	}
+
+	return resourceCloudRunServiceRead(d, meta)
+}
+
+func resourceCloudRunServiceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Service %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Service")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Service %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceCloudRunServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"locations/(?P<location>[^/]+)/namespaces/(?P<project>[^/]+)/services/(?P<name>[^/]+)", "(?P<location>[^/]+)/(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<location>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/{{location}}/namespaces/{{project}}/services/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// autogenerate_revision_name is a client-side setting only
+	d.Set("autogenerate_revision_name", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenCloudRunServiceTemplate(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["metadata"] =
+		flattenCloudRunServiceTemplateMetadata(original["metadata"], d)
+	transformed["spec"] =
+		flattenCloudRunServiceTemplateSpec(original["spec"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateMetadata(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["labels"] =
+		flattenCloudRunServiceTemplateMetadataLabels(original["labels"], d)
+	transformed["generation"] =
+		flattenCloudRunServiceTemplateMetadataGeneration(original["generation"], d)
+	transformed["resource_version"] =
+		flattenCloudRunServiceTemplateMetadataResourceVersion(original["resourceVersion"], d)
+	transformed["self_link"] =
+		flattenCloudRunServiceTemplateMetadataSelfLink(original["selfLink"], d)
+	transformed["uid"] =
+		flattenCloudRunServiceTemplateMetadataUid(original["uid"], d)
+	transformed["namespace"] =
+		flattenCloudRunServiceTemplateMetadataNamespace(original["namespace"], d)
+	transformed["annotations"] =
+		flattenCloudRunServiceTemplateMetadataAnnotations(original["annotations"], d)
+	transformed["name"] =
+		flattenCloudRunServiceTemplateMetadataName(original["name"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateMetadataLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataGeneration(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataResourceVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataSelfLink(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataNamespace(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateMetadataName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["containers"] =
+		flattenCloudRunServiceTemplateSpecContainers(original["containers"], d)
+	transformed["container_concurrency"] =
+		flattenCloudRunServiceTemplateSpecContainerConcurrency(original["containerConcurrency"], d)
+	transformed["timeout_seconds"] =
+		flattenCloudRunServiceTemplateSpecTimeoutSeconds(original["timeoutSeconds"], d)
+	transformed["service_account_name"] =
+		flattenCloudRunServiceTemplateSpecServiceAccountName(original["serviceAccountName"], d)
+	transformed["volumes"] =
+		flattenCloudRunServiceTemplateSpecVolumes(original["volumes"], d)
+	transformed["serving_state"] =
+		flattenCloudRunServiceTemplateSpecServingState(original["servingState"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateSpecContainers(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"working_dir":   flattenCloudRunServiceTemplateSpecContainersWorkingDir(original["workingDir"], d),
+			"args":          flattenCloudRunServiceTemplateSpecContainersArgs(original["args"], d),
+			"env":           flattenCloudRunServiceTemplateSpecContainersEnv(original["env"], d),
+			"command":       flattenCloudRunServiceTemplateSpecContainersCommand(original["command"], d),
+			"image":         flattenCloudRunServiceTemplateSpecContainersImage(original["image"], d),
+			"ports":         flattenCloudRunServiceTemplateSpecContainersPorts(original["ports"], d),
+			"resources":     flattenCloudRunServiceTemplateSpecContainersResources(original["resources"], d),
+			"volume_mounts": flattenCloudRunServiceTemplateSpecContainersVolumeMounts(original["volumeMounts"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecContainersWorkingDir(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersArgs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnv(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":       flattenCloudRunServiceTemplateSpecContainersEnvName(original["name"], d),
+			"value":      flattenCloudRunServiceTemplateSpecContainersEnvValue(original["value"], d),
+			"value_from": flattenCloudRunServiceTemplateSpecContainersEnvValueFrom(original["valueFrom"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvValueFrom(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["secret_key_ref"] =
+		flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRef(original["secretKeyRef"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRef(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["key"] =
+		flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefKey(original["key"], d)
+	transformed["name"] =
+		flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefName(original["name"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefKey(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersCommand(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersImage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersPorts(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":           flattenCloudRunServiceTemplateSpecContainersPortsName(original["name"], d),
+			"protocol":       flattenCloudRunServiceTemplateSpecContainersPortsProtocol(original["protocol"], d),
+			"container_port": flattenCloudRunServiceTemplateSpecContainersPortsContainerPort(original["containerPort"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecContainersPortsName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersPortsProtocol(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersPortsContainerPort(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersResources(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["limits"] =
+		flattenCloudRunServiceTemplateSpecContainersResourcesLimits(original["limits"], d)
+	transformed["requests"] =
+		flattenCloudRunServiceTemplateSpecContainersResourcesRequests(original["requests"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateSpecContainersResourcesLimits(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersResourcesRequests(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersVolumeMounts(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"mount_path": flattenCloudRunServiceTemplateSpecContainersVolumeMountsMountPath(original["mountPath"], d),
+			"name":       flattenCloudRunServiceTemplateSpecContainersVolumeMountsName(original["name"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecContainersVolumeMountsMountPath(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainersVolumeMountsName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecContainerConcurrency(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecTimeoutSeconds(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecServiceAccountName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumes(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":   flattenCloudRunServiceTemplateSpecVolumesName(original["name"], d),
+			"secret": flattenCloudRunServiceTemplateSpecVolumesSecret(original["secret"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecret(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["secret_name"] =
+		flattenCloudRunServiceTemplateSpecVolumesSecretSecretName(original["secretName"], d)
+	transformed["default_mode"] =
+		flattenCloudRunServiceTemplateSpecVolumesSecretDefaultMode(original["defaultMode"], d)
+	transformed["items"] =
+		flattenCloudRunServiceTemplateSpecVolumesSecretItems(original["items"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretSecretName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretDefaultMode(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretItems(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"key":  flattenCloudRunServiceTemplateSpecVolumesSecretItemsKey(original["key"], d),
+			"path": flattenCloudRunServiceTemplateSpecVolumesSecretItemsPath(original["path"], d),
+			"mode": flattenCloudRunServiceTemplateSpecVolumesSecretItemsMode(original["mode"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretItemsKey(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretItemsPath(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecVolumesSecretItemsMode(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTemplateSpecServingState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTraffic(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"revision_name":   flattenCloudRunServiceTrafficRevisionName(original["revisionName"], d),
+			"percent":         flattenCloudRunServiceTrafficPercent(original["percent"], d),
+			"latest_revision": flattenCloudRunServiceTrafficLatestRevision(original["latestRevision"], d),
+			"tag":             flattenCloudRunServiceTrafficTag(original["tag"], d),
+			"url":             flattenCloudRunServiceTrafficUrl(original["url"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceTrafficRevisionName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTrafficPercent(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceTrafficLatestRevision(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTrafficTag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceTrafficUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadata(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["labels"] =
+		flattenCloudRunServiceMetadataLabels(original["labels"], d)
+	transformed["generation"] =
+		flattenCloudRunServiceMetadataGeneration(original["generation"], d)
+	transformed["resource_version"] =
+		flattenCloudRunServiceMetadataResourceVersion(original["resourceVersion"], d)
+	transformed["self_link"] =
+		flattenCloudRunServiceMetadataSelfLink(original["selfLink"], d)
+	transformed["uid"] =
+		flattenCloudRunServiceMetadataUid(original["uid"], d)
+	transformed["namespace"] =
+		flattenCloudRunServiceMetadataNamespace(original["namespace"], d)
+	transformed["annotations"] =
+		flattenCloudRunServiceMetadataAnnotations(original["annotations"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceMetadataLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadataGeneration(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceMetadataResourceVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadataSelfLink(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadataUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadataNamespace(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceMetadataAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatus(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["conditions"] =
+		flattenCloudRunServiceStatusConditions(original["conditions"], d)
+	transformed["url"] =
+		flattenCloudRunServiceStatusUrl(original["url"], d)
+	transformed["observed_generation"] =
+		flattenCloudRunServiceStatusObservedGeneration(original["observedGeneration"], d)
+	transformed["latest_created_revision_name"] =
+		flattenCloudRunServiceStatusLatestCreatedRevisionName(original["latestCreatedRevisionName"], d)
+	transformed["latest_ready_revision_name"] =
+		flattenCloudRunServiceStatusLatestReadyRevisionName(original["latestReadyRevisionName"], d)
+	return []interface{}{transformed}
+}
+
+func flattenCloudRunServiceStatusConditions(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"message": flattenCloudRunServiceStatusConditionsMessage(original["message"], d),
+			"status":  flattenCloudRunServiceStatusConditionsStatus(original["status"], d),
+			"reason":  flattenCloudRunServiceStatusConditionsReason(original["reason"], d),
+			"type":    flattenCloudRunServiceStatusConditionsType(original["type"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenCloudRunServiceStatusConditionsMessage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusConditionsStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusConditionsReason(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusConditionsType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusObservedGeneration(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenCloudRunServiceStatusLatestCreatedRevisionName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenCloudRunServiceStatusLatestReadyRevisionName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandCloudRunServiceTemplate(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMetadata, err := expandCloudRunServiceTemplateMetadata(original["metadata"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetadata); val.IsValid() && !isEmptyValue(val) {
+		transformed["metadata"] = transformedMetadata
+	}
+
+	transformedSpec, err := expandCloudRunServiceTemplateSpec(original["spec"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSpec); val.IsValid() && !isEmptyValue(val) {
+		transformed["spec"] = transformedSpec
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateMetadata(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLabels, err := expandCloudRunServiceTemplateMetadataLabels(original["labels"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLabels); val.IsValid() && !isEmptyValue(val) {
+		transformed["labels"] = transformedLabels
+	}
+
+	transformedNamespace, err := expandCloudRunServiceTemplateMetadataNamespace(original["namespace"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNamespace); val.IsValid() && !isEmptyValue(val) {
+		transformed["namespace"] = transformedNamespace
+	}
+
+	transformedAnnotations, err := expandCloudRunServiceTemplateMetadataAnnotations(original["annotations"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAnnotations); val.IsValid() && !isEmptyValue(val) {
+		transformed["annotations"] = transformedAnnotations
+	}
+
+	transformedName, err := expandCloudRunServiceTemplateMetadataName(original["name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+		transformed["name"] = transformedName
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateMetadataLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudRunServiceTemplateMetadataNamespace(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	// The namespace of a Cloud Run resource is always its project.
+	if v == nil || v.(string) == "" {
+		return getProject(d, config)
+	}
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateMetadataAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudRunServiceTemplateMetadataName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedContainers, err := expandCloudRunServiceTemplateSpecContainers(original["containers"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContainers); val.IsValid() && !isEmptyValue(val) {
+		transformed["containers"] = transformedContainers
+	}
+
+	transformedContainerConcurrency, err := expandCloudRunServiceTemplateSpecContainerConcurrency(original["container_concurrency"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContainerConcurrency); val.IsValid() && !isEmptyValue(val) {
+		transformed["containerConcurrency"] = transformedContainerConcurrency
+	}
+
+	transformedTimeoutSeconds, err := expandCloudRunServiceTemplateSpecTimeoutSeconds(original["timeout_seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTimeoutSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["timeoutSeconds"] = transformedTimeoutSeconds
+	}
+
+	transformedServiceAccountName, err := expandCloudRunServiceTemplateSpecServiceAccountName(original["service_account_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedServiceAccountName); val.IsValid() && !isEmptyValue(val) {
+		transformed["serviceAccountName"] = transformedServiceAccountName
+	}
+
+	transformedVolumes, err := expandCloudRunServiceTemplateSpecVolumes(original["volumes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedVolumes); val.IsValid() && !isEmptyValue(val) {
+		transformed["volumes"] = transformedVolumes
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainers(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedWorkingDir, err := expandCloudRunServiceTemplateSpecContainersWorkingDir(original["working_dir"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedWorkingDir); val.IsValid() && !isEmptyValue(val) {
+			transformed["workingDir"] = transformedWorkingDir
+		}
+
+		transformedArgs, err := expandCloudRunServiceTemplateSpecContainersArgs(original["args"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedArgs); val.IsValid() && !isEmptyValue(val) {
+			transformed["args"] = transformedArgs
+		}
+
+		transformedEnv, err := expandCloudRunServiceTemplateSpecContainersEnv(original["env"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedEnv); val.IsValid() && !isEmptyValue(val) {
+			transformed["env"] = transformedEnv
+		}
+
+		transformedCommand, err := expandCloudRunServiceTemplateSpecContainersCommand(original["command"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedCommand); val.IsValid() && !isEmptyValue(val) {
+			transformed["command"] = transformedCommand
+		}
+
+		transformedImage, err := expandCloudRunServiceTemplateSpecContainersImage(original["image"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedImage); val.IsValid() && !isEmptyValue(val) {
+			transformed["image"] = transformedImage
+		}
+
+		transformedPorts, err := expandCloudRunServiceTemplateSpecContainersPorts(original["ports"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPorts); val.IsValid() && !isEmptyValue(val) {
+			transformed["ports"] = transformedPorts
+		}
+
+		transformedResources, err := expandCloudRunServiceTemplateSpecContainersResources(original["resources"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedResources); val.IsValid() && !isEmptyValue(val) {
+			transformed["resources"] = transformedResources
+		}
+
+		transformedVolumeMounts, err := expandCloudRunServiceTemplateSpecContainersVolumeMounts(original["volume_mounts"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedVolumeMounts); val.IsValid() && !isEmptyValue(val) {
+			transformed["volumeMounts"] = transformedVolumeMounts
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersWorkingDir(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersArgs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnv(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandCloudRunServiceTemplateSpecContainersEnvName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedValue, err := expandCloudRunServiceTemplateSpecContainersEnvValue(original["value"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["value"] = transformedValue
+		}
+
+		transformedValueFrom, err := expandCloudRunServiceTemplateSpecContainersEnvValueFrom(original["value_from"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedValueFrom); val.IsValid() && !isEmptyValue(val) {
+			transformed["valueFrom"] = transformedValueFrom
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvValueFrom(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSecretKeyRef, err := expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRef(original["secret_key_ref"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSecretKeyRef); val.IsValid() && !isEmptyValue(val) {
+		transformed["secretKeyRef"] = transformedSecretKeyRef
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRef(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedKey, err := expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefKey(original["key"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKey); val.IsValid() && !isEmptyValue(val) {
+		transformed["key"] = transformedKey
+	}
+
+	transformedName, err := expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefName(original["name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+		transformed["name"] = transformedName
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefKey(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersEnvValueFromSecretKeyRefName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersCommand(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersImage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersPorts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandCloudRunServiceTemplateSpecContainersPortsName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedProtocol, err := expandCloudRunServiceTemplateSpecContainersPortsProtocol(original["protocol"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedProtocol); val.IsValid() && !isEmptyValue(val) {
+			transformed["protocol"] = transformedProtocol
+		}
+
+		transformedContainerPort, err := expandCloudRunServiceTemplateSpecContainersPortsContainerPort(original["container_port"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedContainerPort); val.IsValid() && !isEmptyValue(val) {
+			transformed["containerPort"] = transformedContainerPort
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersPortsName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersPortsProtocol(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersPortsContainerPort(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersResources(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLimits, err := expandCloudRunServiceTemplateSpecContainersResourcesLimits(original["limits"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLimits); val.IsValid() && !isEmptyValue(val) {
+		transformed["limits"] = transformedLimits
+	}
+
+	transformedRequests, err := expandCloudRunServiceTemplateSpecContainersResourcesRequests(original["requests"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequests); val.IsValid() && !isEmptyValue(val) {
+		transformed["requests"] = transformedRequests
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersResourcesLimits(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersResourcesRequests(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersVolumeMounts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedMountPath, err := expandCloudRunServiceTemplateSpecContainersVolumeMountsMountPath(original["mount_path"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMountPath); val.IsValid() && !isEmptyValue(val) {
+			transformed["mountPath"] = transformedMountPath
+		}
+
+		transformedName, err := expandCloudRunServiceTemplateSpecContainersVolumeMountsName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersVolumeMountsMountPath(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainersVolumeMountsName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecContainerConcurrency(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecTimeoutSeconds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecServiceAccountName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandCloudRunServiceTemplateSpecVolumesName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedSecret, err := expandCloudRunServiceTemplateSpecVolumesSecret(original["secret"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSecret); val.IsValid() && !isEmptyValue(val) {
+			transformed["secret"] = transformedSecret
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecret(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSecretName, err := expandCloudRunServiceTemplateSpecVolumesSecretSecretName(original["secret_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSecretName); val.IsValid() && !isEmptyValue(val) {
+		transformed["secretName"] = transformedSecretName
+	}
+
+	transformedDefaultMode, err := expandCloudRunServiceTemplateSpecVolumesSecretDefaultMode(original["default_mode"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDefaultMode); val.IsValid() && !isEmptyValue(val) {
+		transformed["defaultMode"] = transformedDefaultMode
+	}
+
+	transformedItems, err := expandCloudRunServiceTemplateSpecVolumesSecretItems(original["items"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedItems); val.IsValid() && !isEmptyValue(val) {
+		transformed["items"] = transformedItems
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretSecretName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretDefaultMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretItems(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedKey, err := expandCloudRunServiceTemplateSpecVolumesSecretItemsKey(original["key"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedKey); val.IsValid() && !isEmptyValue(val) {
+			transformed["key"] = transformedKey
+		}
+
+		transformedPath, err := expandCloudRunServiceTemplateSpecVolumesSecretItemsPath(original["path"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPath); val.IsValid() && !isEmptyValue(val) {
+			transformed["path"] = transformedPath
+		}
+
+		transformedMode, err := expandCloudRunServiceTemplateSpecVolumesSecretItemsMode(original["mode"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMode); val.IsValid() && !isEmptyValue(val) {
+			transformed["mode"] = transformedMode
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretItemsKey(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretItemsPath(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTemplateSpecVolumesSecretItemsMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTraffic(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedRevisionName, err := expandCloudRunServiceTrafficRevisionName(original["revision_name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRevisionName); val.IsValid() && !isEmptyValue(val) {
+			transformed["revisionName"] = transformedRevisionName
+		}
+
+		transformedPercent, err := expandCloudRunServiceTrafficPercent(original["percent"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPercent); val.IsValid() && !isEmptyValue(val) {
+			transformed["percent"] = transformedPercent
+		}
+
+		transformedLatestRevision, err := expandCloudRunServiceTrafficLatestRevision(original["latest_revision"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedLatestRevision); val.IsValid() && !isEmptyValue(val) {
+			transformed["latestRevision"] = transformedLatestRevision
+		}
+
+		transformedTag, err := expandCloudRunServiceTrafficTag(original["tag"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedTag); val.IsValid() && !isEmptyValue(val) {
+			transformed["tag"] = transformedTag
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandCloudRunServiceTrafficRevisionName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTrafficPercent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTrafficLatestRevision(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceTrafficTag(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandCloudRunServiceMetadata(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLabels, err := expandCloudRunServiceMetadataLabels(original["labels"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLabels); val.IsValid() && !isEmptyValue(val) {
+		transformed["labels"] = transformedLabels
+	}
+
+	transformedNamespace, err := expandCloudRunServiceMetadataNamespace(original["namespace"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNamespace); val.IsValid() && !isEmptyValue(val) {
+		transformed["namespace"] = transformedNamespace
+	}
+
+	transformedAnnotations, err := expandCloudRunServiceMetadataAnnotations(original["annotations"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAnnotations); val.IsValid() && !isEmptyValue(val) {
+		transformed["annotations"] = transformedAnnotations
+	}
+
+	return transformed, nil
+}
+
+func expandCloudRunServiceMetadataLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandCloudRunServiceMetadataNamespace(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	// The namespace of a Cloud Run resource is always its project.
+	if v == nil || v.(string) == "" {
+		return getProject(d, config)
+	}
+	return v, nil
+}
+
+func expandCloudRunServiceMetadataAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func resourceCloudRunServiceEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	name := d.Get("name").(string)
+	if obj["metadata"] == nil {
+		obj["metadata"] = make(map[string]interface{})
+	}
+	metadata := obj["metadata"].(map[string]interface{})
+	metadata["name"] = name
+
+	// The revision name is left for Cloud Run to generate, otherwise every
+	// change to the template would need a new name in configuration.
+	if d.Get("autogenerate_revision_name").(bool) {
+		if template, ok := obj["template"].(map[string]interface{}); ok {
+			if templateMetadata, ok := template["metadata"].(map[string]interface{}); ok {
+				delete(templateMetadata, "name")
+			}
+		}
+	}
+
+	// template and traffic are part of the Knative spec, but are flattened
+	// to the top level of the resource.
+	spec := make(map[string]interface{})
+	if v, ok := obj["template"]; ok {
+		spec["template"] = v
+		delete(obj, "template")
+	}
+	if v, ok := obj["traffic"]; ok {
+		spec["traffic"] = v
+		delete(obj, "traffic")
+	}
+	obj["spec"] = spec
+
+	// The only acceptable version/kind right now
+	obj["apiVersion"] = "serving.knative.dev/v1"
+	obj["kind"] = "Service"
+	return obj, nil
+}
+
+func resourceCloudRunServiceDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	if spec, ok := res["spec"].(map[string]interface{}); ok {
+		res["template"] = spec["template"]
+		res["traffic"] = spec["traffic"]
+	}
+	return res, nil
+}
+
+var cloudRunGoogleProvidedAnnotations = regexp.MustCompile(`serving\.knative\.dev/(?:(?:creator)|(?:lastModifier))$|run\.googleapis\.com/(?:(?:ingress-status)|(?:operation-id))$|cloud\.googleapis\.com/(?:(?:location))`)
+
+func cloudrunAnnotationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	// Suppress diffs for the annotations provided by Google
+	if cloudRunGoogleProvidedAnnotations.MatchString(k) && new == "" {
+		return true
+	}
+
+	// Let diff be determined by annotations (above)
+	if strings.Contains(k, "annotations.%") {
+		return true
+	}
+
+	// For other keys, don't suppress diff.
+	return false
+}
+
+var cloudRunGoogleProvidedTemplateAnnotations = regexp.MustCompile(`template\.0\.metadata\.0\.annotations\.autoscaling\.knative\.dev/maxScale$|run\.googleapis\.com/startupProbeType$`)
+
+func cloudrunTemplateAnnotationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	// Suppress diffs for the annotations provided by Google
+	if cloudRunGoogleProvidedTemplateAnnotations.MatchString(k) && new == "" {
+		return true
+	}
+
+	// Let diff be determined by annotations (above)
+	if strings.Contains(k, "annotations.%") {
+		return true
+	}
+
+	// For other keys, don't suppress diff.
+	return false
+}
+
+var cloudRunGoogleProvidedLabels = regexp.MustCompile(`cloud\.googleapis\.com/(?:(?:location))`)
+
+func cloudrunLabelDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	// Suppress diffs for the labels provided by Google
+	if cloudRunGoogleProvidedLabels.MatchString(k) && new == "" {
+		return true
+	}
+
+	// Let diff be determined by labels (above)
+	if strings.Contains(k, "labels.%") {
+		return true
+	}
+
+	// For other keys, don't suppress diff.
+	return false
+}
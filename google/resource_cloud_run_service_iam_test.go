@@ -0,0 +1,145 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudRunServiceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-cloudrun-" + acctest.RandString(10)
+	account := "tf-test-run-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunServiceIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_cloud_run_service_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/services/%s roles/run.invoker", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudRunServiceIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-cloudrun-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunServiceIamMember_public(name),
+			},
+			{
+				ResourceName:      "google_cloud_run_service_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/services/%s roles/run.invoker allUsers", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudRunServiceIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-cloudrun-" + acctest.RandString(10)
+	account := "tf-test-run-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunServiceIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_cloud_run_service_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/services/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRunServiceIam_base(name string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "%s"
+  location = "us-central1"
+
+  template {
+    spec {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+
+  autogenerate_revision_name = true
+}
+`, name)
+}
+
+func testAccCloudRunServiceIamBinding_basic(name, account string) string {
+	return testAccCloudRunServiceIam_base(name) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Cloud Run IAM Testing Account"
+}
+
+resource "google_cloud_run_service_iam_binding" "foo" {
+  location = "${google_cloud_run_service.default.location}"
+  service  = "${google_cloud_run_service.default.name}"
+  role     = "roles/run.invoker"
+  members  = ["serviceAccount:${google_service_account.test.email}"]
+}
+`, account)
+}
+
+func testAccCloudRunServiceIamMember_public(name string) string {
+	return testAccCloudRunServiceIam_base(name) + `
+resource "google_cloud_run_service_iam_member" "foo" {
+  location = "${google_cloud_run_service.default.location}"
+  service  = "${google_cloud_run_service.default.name}"
+  role     = "roles/run.invoker"
+  member   = "allUsers"
+}
+`
+}
+
+func testAccCloudRunServiceIamPolicy_basic(name, account string) string {
+	return testAccCloudRunServiceIam_base(name) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Cloud Run IAM Testing Account"
+}
+
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/run.invoker"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_cloud_run_service_iam_policy" "foo" {
+  location    = "${google_cloud_run_service.default.location}"
+  service     = "${google_cloud_run_service.default.name}"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`, account)
+}
@@ -0,0 +1,269 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudRunService_cloudRunServiceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunService_cloudRunServiceBasicExample(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunService_cloudRunServiceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "tf-test-cloudrun-srv%{random_suffix}"
+  location = "us-central1"
+
+  template {
+    spec {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+
+  traffic {
+    percent         = 100
+    latest_revision = true
+  }
+}
+`, context)
+}
+
+func TestAccCloudRunService_cloudRunServiceConfigExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunService_cloudRunServiceConfigExample(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunService_cloudRunServiceConfigExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "tf-test-cloudrun-srv%{random_suffix}"
+  location = "us-central1"
+
+  template {
+    metadata {
+      annotations = {
+        "autoscaling.knative.dev/minScale" = "1"
+        "autoscaling.knative.dev/maxScale" = "5"
+      }
+    }
+
+    spec {
+      container_concurrency = 50
+      timeout_seconds       = 120
+
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+        args  = ["arrgs"]
+
+        env {
+          name  = "SOURCE"
+          value = "remote"
+        }
+
+        env {
+          name  = "TARGET"
+          value = "home"
+        }
+
+        resources {
+          limits = {
+            cpu    = "1000m"
+            memory = "512Mi"
+          }
+        }
+      }
+    }
+  }
+
+  metadata {
+    annotations = {
+      "run.googleapis.com/ingress" = "all"
+    }
+  }
+
+  traffic {
+    percent         = 100
+    latest_revision = true
+  }
+
+  autogenerate_revision_name = true
+}
+`, context)
+}
+
+func TestAccCloudRunService_trafficSplit(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-cloudrun-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunService_revision(name, "blue"),
+			},
+			{
+				ResourceName:            "google_cloud_run_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name"},
+			},
+			{
+				Config: testAccCloudRunService_trafficSplit(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_cloud_run_service.default", "traffic.#", "3"),
+					resource.TestCheckResourceAttr("google_cloud_run_service.default", "status.0.latest_ready_revision_name", name+"-green"),
+				),
+			},
+			{
+				ResourceName:            "google_cloud_run_service.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autogenerate_revision_name"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunService_revision(name, revision string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "%s"
+  location = "us-central1"
+
+  template {
+    metadata {
+      name = "%s-%s"
+    }
+
+    spec {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+      }
+    }
+  }
+
+  traffic {
+    percent         = 100
+    latest_revision = true
+  }
+}
+`, name, name, revision)
+}
+
+func testAccCloudRunService_trafficSplit(name string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_run_service" "default" {
+  name     = "%s"
+  location = "us-central1"
+
+  template {
+    metadata {
+      name = "%s-green"
+    }
+
+    spec {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/hello"
+        env {
+          name  = "COLOR"
+          value = "green"
+        }
+      }
+    }
+  }
+
+  traffic {
+    percent       = 25
+    revision_name = "%s-green"
+  }
+
+  traffic {
+    percent       = 75
+    revision_name = "%s-blue"
+  }
+
+  traffic {
+    percent       = 0
+    revision_name = "%s-blue"
+    tag           = "blue"
+  }
+}
+`, name, name, name, name, name)
+}
+
+func testAccCheckCloudRunServiceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_run_service" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://{{location}}-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/{{project}}/services/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudRunService still exists at %s", url)
+		}
+	}
+
+	return nil
+}
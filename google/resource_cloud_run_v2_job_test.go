@@ -0,0 +1,191 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudRunV2Job_cloudrunv2JobBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Job_cloudrunv2JobBasicExample(context),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_job.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Job_cloudrunv2JobBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name     = "tf-test-cloudrun-job%{random_suffix}"
+  location = "us-central1"
+
+  template {
+    template {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/job"
+      }
+    }
+  }
+}
+`, context)
+}
+
+func TestAccCloudRunV2Job_cloudrunv2JobSqlExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Job_cloudrunv2JobSqlExample(context),
+			},
+			{
+				ResourceName:      "google_cloud_run_v2_job.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Job_cloudrunv2JobSqlExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name     = "tf-test-cloudrun-job%{random_suffix}"
+  location = "us-central1"
+
+  template {
+    task_count = 1
+
+    template {
+      max_retries = 1
+
+      volumes {
+        name = "cloudsql"
+        cloud_sql_instance {
+          instances = ["${google_sql_database_instance.instance.connection_name}"]
+        }
+      }
+
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/job"
+
+        env {
+          name  = "FOO"
+          value = "bar"
+        }
+        volume_mounts {
+          name       = "cloudsql"
+          mount_path = "/cloudsql"
+        }
+      }
+    }
+  }
+}
+
+resource "google_sql_database_instance" "instance" {
+  name             = "tf-test-cloudrun-sql%{random_suffix}"
+  region           = "us-central1"
+  database_version = "MYSQL_5_7"
+
+  settings {
+    tier = "db-f1-micro"
+  }
+}
+`, context)
+}
+
+func TestAccCloudRunV2Job_cloudrunv2JobRunOnApplyExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudRunV2JobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRunV2Job_cloudrunv2JobRunOnApplyExample(context),
+			},
+			{
+				ResourceName:            "google_cloud_run_v2_job.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"run_on_apply"},
+			},
+		},
+	})
+}
+
+func testAccCloudRunV2Job_cloudrunv2JobRunOnApplyExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_run_v2_job" "default" {
+  name         = "tf-test-cloudrun-job%{random_suffix}"
+  location     = "us-central1"
+  run_on_apply = true
+
+  template {
+    template {
+      containers {
+        image = "us-docker.pkg.dev/cloudrun/container/job"
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckCloudRunV2JobDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_run_v2_job" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://run.googleapis.com/v2/projects/{{project}}/locations/{{location}}/jobs/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudRunV2Job still exists at %s", url)
+		}
+	}
+
+	return nil
+}
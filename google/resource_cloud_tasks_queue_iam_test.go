@@ -0,0 +1,131 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccCloudTasksQueueIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-queue-" + acctest.RandString(10)
+	account := "tf-test-tasks-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTasksQueueIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_cloud_tasks_queue_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/queues/%s roles/cloudtasks.enqueuer", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudTasksQueueIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-queue-" + acctest.RandString(10)
+	account := "tf-test-tasks-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTasksQueueIamMember_basic(name, account),
+			},
+			{
+				ResourceName:      "google_cloud_tasks_queue_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/queues/%s roles/cloudtasks.enqueuer serviceAccount:%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), name, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudTasksQueueIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-queue-" + acctest.RandString(10)
+	account := "tf-test-tasks-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTasksQueueIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_cloud_tasks_queue_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/locations/us-central1/queues/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudTasksQueueIam_base(name, account string) string {
+	return fmt.Sprintf(`
+resource "google_cloud_tasks_queue" "default" {
+  name     = "%s"
+  location = "us-central1"
+}
+
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Cloud Tasks IAM Testing Account"
+}
+`, name, account)
+}
+
+func testAccCloudTasksQueueIamBinding_basic(name, account string) string {
+	return testAccCloudTasksQueueIam_base(name, account) + `
+resource "google_cloud_tasks_queue_iam_binding" "foo" {
+  location = "${google_cloud_tasks_queue.default.location}"
+  name     = "${google_cloud_tasks_queue.default.name}"
+  role     = "roles/cloudtasks.enqueuer"
+  members  = ["serviceAccount:${google_service_account.test.email}"]
+}
+`
+}
+
+func testAccCloudTasksQueueIamMember_basic(name, account string) string {
+	return testAccCloudTasksQueueIam_base(name, account) + `
+resource "google_cloud_tasks_queue_iam_member" "foo" {
+  location = "${google_cloud_tasks_queue.default.location}"
+  name     = "${google_cloud_tasks_queue.default.name}"
+  role     = "roles/cloudtasks.enqueuer"
+  member   = "serviceAccount:${google_service_account.test.email}"
+}
+`
+}
+
+func testAccCloudTasksQueueIamPolicy_basic(name, account string) string {
+	return testAccCloudTasksQueueIam_base(name, account) + `
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/cloudtasks.enqueuer"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_cloud_tasks_queue_iam_policy" "foo" {
+  location    = "${google_cloud_tasks_queue.default.location}"
+  name        = "${google_cloud_tasks_queue.default.name}"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`
+}
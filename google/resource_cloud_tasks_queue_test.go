@@ -0,0 +1,125 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudTasksQueue_queueBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudTasksQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTasksQueue_queueBasicExample(context),
+			},
+			{
+				ResourceName:      "google_cloud_tasks_queue.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudTasksQueue_queueBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_tasks_queue" "default" {
+  name     = "cloud-tasks-queue-test%{random_suffix}"
+  location = "us-central1"
+}
+`, context)
+}
+
+func TestAccCloudTasksQueue_cloudTasksQueueAdvancedExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudTasksQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudTasksQueue_cloudTasksQueueAdvancedExample(context),
+			},
+			{
+				ResourceName:      "google_cloud_tasks_queue.advanced_configuration",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudTasksQueue_cloudTasksQueueAdvancedExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_cloud_tasks_queue" "advanced_configuration" {
+  name     = "instance-name%{random_suffix}"
+  location = "us-central1"
+
+  app_engine_routing_override {
+    service  = "worker"
+    version  = "1.0"
+    instance = "test"
+  }
+
+  rate_limits {
+    max_concurrent_dispatches = 3
+    max_dispatches_per_second = 2
+  }
+
+  retry_config {
+    max_attempts       = 5
+    max_retry_duration = "4s"
+    max_backoff        = "3s"
+    min_backoff        = "2s"
+    max_doublings      = 1
+  }
+
+  stackdriver_logging_config {
+    sampling_ratio = 0.9
+  }
+}
+`, context)
+}
+
+func testAccCheckCloudTasksQueueDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloud_tasks_queue" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://cloudtasks.googleapis.com/v2/projects/{{project}}/locations/{{location}}/queues/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("CloudTasksQueue still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccCloudfunctions2function_cloudfunctions2BasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"zip_path":      "./test-fixtures/cloudfunctions2/function-source.zip",
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudfunctions2functionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudfunctions2function_cloudfunctions2BasicExample(context),
+			},
+			{
+				ResourceName:            "google_cloudfunctions2_function.function",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"build_config.0.source.0.storage_source.0.object", "build_config.0.source.0.storage_source.0.bucket"},
+			},
+		},
+	})
+}
+
+func testAccCloudfunctions2function_cloudfunctions2BasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name     = "tf-test-gcf-source%{random_suffix}"
+  location = "US"
+}
+
+resource "google_storage_bucket_object" "object" {
+  name   = "function-source.zip"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "%{zip_path}"
+}
+
+resource "google_cloudfunctions2_function" "function" {
+  name        = "tf-test-function-v2%{random_suffix}"
+  location    = "us-central1"
+  description = "a new function"
+
+  build_config {
+    runtime     = "nodejs16"
+    entry_point = "helloHttp"
+
+    source {
+      storage_source {
+        bucket = "${google_storage_bucket.bucket.name}"
+        object = "${google_storage_bucket_object.object.name}"
+      }
+    }
+  }
+
+  service_config {
+    max_instance_count = 1
+    available_memory   = "256M"
+    timeout_seconds    = 60
+  }
+}
+`, context)
+}
+
+func TestAccCloudfunctions2function_cloudfunctions2FullExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"zip_path":      "./test-fixtures/cloudfunctions2/function-source.zip",
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudfunctions2functionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudfunctions2function_cloudfunctions2FullExample(context),
+			},
+			{
+				ResourceName:            "google_cloudfunctions2_function.function",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"build_config.0.source.0.storage_source.0.object", "build_config.0.source.0.storage_source.0.bucket"},
+			},
+		},
+	})
+}
+
+func testAccCloudfunctions2function_cloudfunctions2FullExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_service_account" "account" {
+  account_id   = "tf-test-gcf-sa%{random_suffix}"
+  display_name = "Test Service Account"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "tf-test-functions2-topic%{random_suffix}"
+}
+
+resource "google_secret_manager_secret" "secret" {
+  secret_id = "tf-test-secret%{random_suffix}"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret_version" "secret" {
+  secret      = "${google_secret_manager_secret.secret.name}"
+  secret_data = "secret"
+}
+
+resource "google_project_iam_member" "secret_accessor" {
+  role   = "roles/secretmanager.secretAccessor"
+  member = "serviceAccount:${google_service_account.account.email}"
+}
+
+resource "google_storage_bucket" "bucket" {
+  name     = "tf-test-gcf-source%{random_suffix}"
+  location = "US"
+}
+
+resource "google_storage_bucket_object" "object" {
+  name   = "function-source.zip"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "%{zip_path}"
+}
+
+resource "google_cloudfunctions2_function" "function" {
+  name        = "tf-test-gcf-function%{random_suffix}"
+  location    = "us-central1"
+  description = "a new function"
+
+  build_config {
+    runtime     = "nodejs16"
+    entry_point = "helloPubSub"
+
+    environment_variables = {
+      BUILD_CONFIG_TEST = "build_test"
+    }
+
+    source {
+      storage_source {
+        bucket = "${google_storage_bucket.bucket.name}"
+        object = "${google_storage_bucket_object.object.name}"
+      }
+    }
+  }
+
+  service_config {
+    max_instance_count               = 3
+    min_instance_count               = 1
+    available_memory                 = "4Gi"
+    available_cpu                    = "4"
+    max_instance_request_concurrency = 80
+    timeout_seconds                  = 60
+
+    environment_variables = {
+      SERVICE_CONFIG_TEST = "config_test"
+    }
+
+    ingress_settings               = "ALLOW_INTERNAL_ONLY"
+    all_traffic_on_latest_revision = true
+    service_account_email          = "${google_service_account.account.email}"
+
+    secret_environment_variables {
+      key        = "TEST"
+      project_id = "${google_secret_manager_secret.secret.project}"
+      secret     = "${google_secret_manager_secret.secret.secret_id}"
+      version    = "latest"
+    }
+
+    secret_volumes {
+      mount_path = "/etc/secrets"
+      project_id = "${google_secret_manager_secret.secret.project}"
+      secret     = "${google_secret_manager_secret.secret.secret_id}"
+    }
+  }
+
+  event_trigger {
+    trigger_region        = "us-central1"
+    event_type            = "google.cloud.pubsub.topic.v1.messagePublished"
+    pubsub_topic          = "${google_pubsub_topic.topic.id}"
+    retry_policy          = "RETRY_POLICY_RETRY"
+    service_account_email = "${google_service_account.account.email}"
+  }
+
+  depends_on = ["google_project_iam_member.secret_accessor", "google_secret_manager_secret_version.secret"]
+}
+`, context)
+}
+
+func testAccCheckCloudfunctions2functionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_cloudfunctions2_function" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://cloudfunctions.googleapis.com/v2/projects/{{project}}/locations/{{location}}/functions/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("Cloudfunctions2function still exists at %s", url)
+		}
+	}
+
+	return nil
+}
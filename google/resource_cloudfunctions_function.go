@@ -33,6 +33,11 @@ var validCloudFunctionRegion = validation.StringInSlice([]string{"us-central1",
 
 const functionDefaultAllowedMemoryMb = 256
 
+// The vendored Cloud Functions client predates secrets, minimum instances and
+// VPC connector egress settings, so functions are read and written as plain
+// JSON and only converted to the client's types for the fields it knows.
+const cloudFunctionsBasePath = "https://cloudfunctions.googleapis.com/v1/"
+
 type cloudFunctionId struct {
 	Project string
 	Region  string
@@ -275,6 +280,97 @@ func resourceCloudFunctionsFunction() *schema.Resource {
 				Removed:  "This field is removed. Use `event_trigger.failure_policy.retry` instead.",
 			},
 
+			"vpc_connector": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"vpc_connector_egress_settings": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ALL_TRAFFIC", "PRIVATE_RANGES_ONLY"}, false),
+			},
+
+			"max_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"min_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"secret_environment_variables": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"secret": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSecretManagerSecretVersion,
+						},
+					},
+				},
+			},
+
+			"secret_volumes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mount_path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"secret": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"versions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"path": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateSecretManagerSecretVersion,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -373,9 +469,36 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 		function.EnvironmentVariables = expandEnvironmentVariables(d)
 	}
 
+	if v, ok := d.GetOk("vpc_connector"); ok {
+		function.VpcConnector = v.(string)
+	}
+
+	if v, ok := d.GetOk("max_instances"); ok {
+		function.MaxInstances = int64(v.(int))
+	}
+
+	obj, err := ConvertToMap(function)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("vpc_connector_egress_settings"); ok {
+		obj["vpcConnectorEgressSettings"] = v.(string)
+	}
+	if v, ok := d.GetOk("min_instances"); ok {
+		obj["minInstances"] = v.(int)
+	}
+	if v, ok := d.GetOk("secret_environment_variables"); ok {
+		obj["secretEnvironmentVariables"] = expandSecretEnvironmentVariables(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("secret_volumes"); ok {
+		obj["secretVolumes"] = expandSecretVolumes(v.([]interface{}))
+	}
+	if err := checkSecretManagerReferences(d, config, cloudFunctionsSecretReferences); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Creating cloud function: %s", function.Name)
-	op, err := config.clientCloudFunctions.Projects.Locations.Functions.Create(
-		cloudFuncId.locationId(), function).Do()
+	res, err := sendRequest(config, "POST", cloudFunctionsBasePath+cloudFuncId.locationId()+"/functions", obj)
 	if err != nil {
 		return err
 	}
@@ -383,7 +506,11 @@ func resourceCloudFunctionsCreate(d *schema.ResourceData, meta interface{}) erro
 	// Name of function should be unique
 	d.SetId(cloudFuncId.terraformId())
 
-	err = cloudFunctionsOperationWait(config.clientCloudFunctions, op, "Creating CloudFunctions Function",
+	op := &cloudfunctions.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+	err = cloudFunctionsOperationWait(config, op, "Creating CloudFunctions Function",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 	if err != nil {
 		return err
@@ -400,10 +527,14 @@ func resourceCloudFunctionsRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	function, err := config.clientCloudFunctions.Projects.Locations.Functions.Get(cloudFuncId.cloudFunctionId()).Do()
+	res, err := sendRequest(config, "GET", cloudFunctionsBasePath+cloudFuncId.cloudFunctionId(), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Target CloudFunctions Function %q", cloudFuncId.Name))
 	}
+	function := &cloudfunctions.CloudFunction{}
+	if err := Convert(res, function); err != nil {
+		return err
+	}
 
 	d.Set("name", cloudFuncId.Name)
 	d.Set("description", function.Description)
@@ -440,6 +571,21 @@ func resourceCloudFunctionsRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("event_trigger", flattenEventTrigger(function.EventTrigger))
 
+	d.Set("vpc_connector", function.VpcConnector)
+	d.Set("vpc_connector_egress_settings", res["vpcConnectorEgressSettings"])
+	d.Set("max_instances", function.MaxInstances)
+	if v, ok := res["minInstances"].(float64); ok {
+		d.Set("min_instances", int(v))
+	} else {
+		d.Set("min_instances", 0)
+	}
+	if err := d.Set("secret_environment_variables", flattenSecretEnvironmentVariables(res["secretEnvironmentVariables"])); err != nil {
+		return fmt.Errorf("Error setting secret_environment_variables: %s", err)
+	}
+	if err := d.Set("secret_volumes", flattenSecretVolumes(res["secretVolumes"])); err != nil {
+		return fmt.Errorf("Error setting secret_volumes: %s", err)
+	}
+
 	d.Set("region", cloudFuncId.Region)
 	d.Set("project", cloudFuncId.Project)
 
@@ -515,17 +661,60 @@ func resourceCloudFunctionsUpdate(d *schema.ResourceData, meta interface{}) erro
 		updateMaskArr = append(updateMaskArr, "eventTrigger", "eventTrigger.failurePolicy.retry")
 	}
 
+	if d.HasChange("vpc_connector") {
+		function.VpcConnector = d.Get("vpc_connector").(string)
+		updateMaskArr = append(updateMaskArr, "vpcConnector")
+	}
+
+	if d.HasChange("max_instances") {
+		function.MaxInstances = int64(d.Get("max_instances").(int))
+		updateMaskArr = append(updateMaskArr, "maxInstances")
+	}
+
+	obj, err := ConvertToMap(&function)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("vpc_connector_egress_settings") {
+		obj["vpcConnectorEgressSettings"] = d.Get("vpc_connector_egress_settings").(string)
+		updateMaskArr = append(updateMaskArr, "vpcConnectorEgressSettings")
+	}
+
+	if d.HasChange("min_instances") {
+		obj["minInstances"] = d.Get("min_instances").(int)
+		updateMaskArr = append(updateMaskArr, "minInstances")
+	}
+
+	if d.HasChange("secret_environment_variables") {
+		obj["secretEnvironmentVariables"] = expandSecretEnvironmentVariables(d.Get("secret_environment_variables").([]interface{}))
+		updateMaskArr = append(updateMaskArr, "secretEnvironmentVariables")
+	}
+
+	if d.HasChange("secret_volumes") {
+		obj["secretVolumes"] = expandSecretVolumes(d.Get("secret_volumes").([]interface{}))
+		updateMaskArr = append(updateMaskArr, "secretVolumes")
+	}
+
+	if d.HasChange("secret_environment_variables") || d.HasChange("secret_volumes") {
+		if err := checkSecretManagerReferences(d, config, cloudFunctionsSecretReferences); err != nil {
+			return err
+		}
+	}
+
 	if len(updateMaskArr) > 0 {
-		log.Printf("[DEBUG] Send Patch CloudFunction Configuration request: %#v", function)
+		log.Printf("[DEBUG] Send Patch CloudFunction Configuration request: %#v", obj)
 		updateMask := strings.Join(updateMaskArr, ",")
-		op, err := config.clientCloudFunctions.Projects.Locations.Functions.Patch(function.Name, &function).
-			UpdateMask(updateMask).Do()
-
+		res, err := sendRequest(config, "PATCH", cloudFunctionsBasePath+function.Name+"?updateMask="+url.QueryEscape(updateMask), obj)
 		if err != nil {
 			return fmt.Errorf("Error while updating cloudfunction configuration: %s", err)
 		}
 
-		err = cloudFunctionsOperationWait(config.clientCloudFunctions, op, "Updating CloudFunctions Function",
+		op := &cloudfunctions.Operation{}
+		if err := Convert(res, op); err != nil {
+			return err
+		}
+		err = cloudFunctionsOperationWait(config, op, "Updating CloudFunctions Function",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 		if err != nil {
 			return err
@@ -548,7 +737,7 @@ func resourceCloudFunctionsDestroy(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return err
 	}
-	err = cloudFunctionsOperationWait(config.clientCloudFunctions, op, "Deleting CloudFunctions Function",
+	err = cloudFunctionsOperationWait(config, op, "Deleting CloudFunctions Function",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 	if err != nil {
 		return err
@@ -675,3 +864,85 @@ func flattenSourceRepository(sourceRepo *cloudfunctions.SourceRepository) []map[
 
 	return result
 }
+
+func expandSecretEnvironmentVariables(configured []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		secretEnv := map[string]interface{}{
+			"key":     data["key"],
+			"secret":  data["secret"],
+			"version": data["version"],
+		}
+		// The project of the function is used when unset.
+		if v := data["project_id"].(string); v != "" {
+			secretEnv["projectId"] = v
+		}
+		result = append(result, secretEnv)
+	}
+	return result
+}
+
+func flattenSecretEnvironmentVariables(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+	result := make([]map[string]interface{}, 0, len(l))
+	for _, raw := range l {
+		data := raw.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"key":        data["key"],
+			"project_id": data["projectId"],
+			"secret":     data["secret"],
+			"version":    data["version"],
+		})
+	}
+	return result
+}
+
+func expandSecretVolumes(configured []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(configured))
+	for _, raw := range configured {
+		data := raw.(map[string]interface{})
+		versions := make([]interface{}, 0)
+		for _, rawVersion := range data["versions"].([]interface{}) {
+			version := rawVersion.(map[string]interface{})
+			versions = append(versions, map[string]interface{}{
+				"path":    version["path"],
+				"version": version["version"],
+			})
+		}
+		volume := map[string]interface{}{
+			"mountPath": data["mount_path"],
+			"secret":    data["secret"],
+			"versions":  versions,
+		}
+		if v := data["project_id"].(string); v != "" {
+			volume["projectId"] = v
+		}
+		result = append(result, volume)
+	}
+	return result
+}
+
+func flattenSecretVolumes(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+	result := make([]map[string]interface{}, 0, len(l))
+	for _, raw := range l {
+		data := raw.(map[string]interface{})
+		rawVersions, _ := data["versions"].([]interface{})
+		versions := make([]map[string]interface{}, 0, len(rawVersions))
+		for _, rawVersion := range rawVersions {
+			version := rawVersion.(map[string]interface{})
+			versions = append(versions, map[string]interface{}{
+				"path":    version["path"],
+				"version": version["version"],
+			})
+		}
+		result = append(result, map[string]interface{}{
+			"mount_path": data["mountPath"],
+			"project_id": data["projectId"],
+			"secret":     data["secret"],
+			"versions":   versions,
+		})
+	}
+	return result
+}
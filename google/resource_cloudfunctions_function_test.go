@@ -322,6 +322,77 @@ func TestAccCloudFunctionsFunction_serviceAccountEmail(t *testing.T) {
 	})
 }
 
+func TestAccCloudFunctionsFunction_instances(t *testing.T) {
+	t.Parallel()
+
+	funcResourceName := "google_cloudfunctions_function.function"
+	functionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	bucketName := fmt.Sprintf("tf-test-bucket-%d", acctest.RandInt())
+	zipFilePath, err := createZIPArchiveForIndexJs(testHTTPTriggerPath)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.Remove(zipFilePath) // clean up
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCloudFunctionsFunctionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudFunctionsFunction_instances(functionName, bucketName, zipFilePath, 1, 3),
+			},
+			{
+				ResourceName:      funcResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCloudFunctionsFunction_instances(functionName, bucketName, zipFilePath, 0, 5),
+			},
+			{
+				ResourceName:      funcResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCloudFunctionsFunction_secretsRoundTrip(t *testing.T) {
+	envs := []interface{}{
+		map[string]interface{}{"key": "API_KEY", "project_id": "", "secret": "api-key", "version": "latest"},
+	}
+	volumes := []interface{}{
+		map[string]interface{}{
+			"mount_path": "/etc/secrets",
+			"project_id": "my-project",
+			"secret":     "certs",
+			"versions": []interface{}{
+				map[string]interface{}{"path": "/tls.crt", "version": "2"},
+			},
+		},
+	}
+
+	expandedEnvs := expandSecretEnvironmentVariables(envs)
+	if _, ok := expandedEnvs[0].(map[string]interface{})["projectId"]; ok {
+		t.Errorf("expected an empty project_id to be omitted, got %v", expandedEnvs[0])
+	}
+	flattenedEnvs := flattenSecretEnvironmentVariables(expandedEnvs)
+	if flattenedEnvs[0]["key"] != "API_KEY" || flattenedEnvs[0]["secret"] != "api-key" || flattenedEnvs[0]["version"] != "latest" {
+		t.Errorf("unexpected secret environment variable %v", flattenedEnvs[0])
+	}
+
+	flattenedVolumes := flattenSecretVolumes(expandSecretVolumes(volumes))
+	if flattenedVolumes[0]["mount_path"] != "/etc/secrets" || flattenedVolumes[0]["project_id"] != "my-project" {
+		t.Errorf("unexpected secret volume %v", flattenedVolumes[0])
+	}
+	versions := flattenedVolumes[0]["versions"].([]map[string]interface{})
+	if len(versions) != 1 || versions[0]["path"] != "/tls.crt" || versions[0]["version"] != "2" {
+		t.Errorf("unexpected secret volume versions %v", versions)
+	}
+}
+
 func testAccCheckCloudFunctionsFunctionDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -698,3 +769,28 @@ resource "google_cloudfunctions_function" "function" {
   entry_point  = "helloGET"
 }`, bucketName, zipFilePath, functionName)
 }
+
+func testAccCloudFunctionsFunction_instances(functionName, bucketName, zipFilePath string, minInstances, maxInstances int) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name = "%s"
+}
+
+resource "google_storage_bucket_object" "archive" {
+  name   = "index.zip"
+  bucket = "${google_storage_bucket.bucket.name}"
+  source = "%s"
+}
+
+resource "google_cloudfunctions_function" "function" {
+  name = "%s"
+
+  source_archive_bucket = "${google_storage_bucket.bucket.name}"
+  source_archive_object = "${google_storage_bucket_object.archive.name}"
+
+  trigger_http  = true
+  entry_point   = "helloGET"
+  min_instances = %d
+  max_instances = %d
+}`, bucketName, zipFilePath, functionName, minInstances, maxInstances)
+}
@@ -12,6 +12,8 @@ import (
 	composer "google.golang.org/api/composer/v1beta1"
 )
 
+const composerBasePath = "https://composer.googleapis.com/v1beta1/"
+
 const (
 	composerEnvironmentEnvVariablesRegexp          = "[a-zA-Z_][a-zA-Z0-9_]*."
 	composerEnvironmentReservedAirflowEnvVarRegexp = "AIRFLOW__[A-Z0-9_]+__[A-Z0-9_]+"
@@ -148,6 +150,46 @@ func resourceComposerEnvironment() *schema.Resource {
 										},
 										Set: schema.HashString,
 									},
+									"ip_allocation_policy": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"use_ip_aliases": {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"cluster_secondary_range_name": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ForceNew:      true,
+													ConflictsWith: []string{"config.0.node_config.0.ip_allocation_policy.0.cluster_ipv4_cidr_block"},
+												},
+												"services_secondary_range_name": {
+													Type:          schema.TypeString,
+													Optional:      true,
+													ForceNew:      true,
+													ConflictsWith: []string{"config.0.node_config.0.ip_allocation_policy.0.services_ipv4_cidr_block"},
+												},
+												"cluster_ipv4_cidr_block": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													DiffSuppressFunc: cidrOrSizeDiffSuppress,
+												},
+												"services_ipv4_cidr_block": {
+													Type:             schema.TypeString,
+													Optional:         true,
+													ForceNew:         true,
+													DiffSuppressFunc: cidrOrSizeDiffSuppress,
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -182,6 +224,50 @@ func resourceComposerEnvironment() *schema.Resource {
 								},
 							},
 						},
+						"private_environment_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_private_endpoint": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+										Default:  true,
+									},
+									"master_ipv4_cidr_block": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.CIDRNetwork(28, 28),
+									},
+									"cloud_sql_ipv4_cidr_block": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validateIpCidrRange,
+									},
+									"web_server_ipv4_cidr_block": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.CIDRNetwork(29, 29),
+									},
+								},
+							},
+						},
+						"environment_size": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ENVIRONMENT_SIZE_SMALL", "ENVIRONMENT_SIZE_MEDIUM", "ENVIRONMENT_SIZE_LARGE"}, false),
+						},
 						"airflow_uri": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -228,12 +314,23 @@ func resourceComposerEnvironmentCreate(d *schema.ResourceData, meta interface{})
 	// Some fields cannot be specified during create and must be updated post-creation.
 	updateOnlyEnv := getComposerEnvironmentPostCreateUpdateObj(env)
 
+	obj, err := expandComposerEnvironmentRaw(d, env)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Creating new Environment %q", envName.parentName())
-	op, err := config.clientComposer.Projects.Locations.Environments.Create(envName.parentName(), env).Do()
+	url := fmt.Sprintf("%s%s/environments", composerBasePath, envName.parentName())
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return err
 	}
 
+	op := &composer.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
 	// Store the ID now
 	id, err := replaceVars(d, config, "{{project}}/{{region}}/{{name}}")
 	if err != nil {
@@ -242,9 +339,12 @@ func resourceComposerEnvironmentCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(id)
 
 	waitErr := composerOperationWaitTime(
-		config.clientComposer, op, envName.Project, "Creating Environment",
+		config, op, envName.Project, "Creating Environment",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
+	if isOperationCancelledError(waitErr) {
+		return waitErr
+	}
 	if waitErr != nil {
 		// The resource didn't actually get created, remove from state.
 		d.SetId("")
@@ -276,11 +376,18 @@ func resourceComposerEnvironmentRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	res, err := config.clientComposer.Projects.Locations.Environments.Get(envName.resourceName()).Do()
+	url := fmt.Sprintf("%s%s", composerBasePath, envName.resourceName())
+	raw, err := sendRequest(config, "GET", url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("ComposerEnvironment %q", d.Id()))
 	}
 
+	res := &composer.Environment{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
+	rawConfig, _ := raw["config"].(map[string]interface{})
+
 	// Set from getProject(d)
 	if err := d.Set("project", envName.Project); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
@@ -292,7 +399,7 @@ func resourceComposerEnvironmentRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("name", GetResourceNameFromSelfLink(res.Name)); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
 	}
-	if err := d.Set("config", flattenComposerEnvironmentConfig(res.Config)); err != nil {
+	if err := d.Set("config", flattenComposerEnvironmentConfig(res.Config, rawConfig)); err != nil {
 		return fmt.Errorf("Error reading Environment: %s", err)
 	}
 	if err := d.Set("labels", res.Labels); err != nil {
@@ -375,6 +482,19 @@ func resourceComposerEnvironmentUpdate(d *schema.ResourceData, meta interface{})
 			d.SetPartial("config")
 		}
 
+		if d.HasChange("config.0.environment_size") {
+			patchObj := map[string]interface{}{
+				"config": map[string]interface{}{
+					"environmentSize": d.Get("config.0.environment_size"),
+				},
+			}
+			err = resourceComposerEnvironmentPatchFieldRaw("config.environmentSize", patchObj, d, tfConfig)
+			if err != nil {
+				return err
+			}
+			d.SetPartial("config")
+		}
+
 		if d.HasChange("config.0.node_count") {
 			patchObj := &composer.Environment{Config: &composer.EnvironmentConfig{}}
 			if config != nil {
@@ -423,23 +543,36 @@ func resourceComposerEnvironmentPostCreateUpdate(updateEnv *composer.Environment
 }
 
 func resourceComposerEnvironmentPatchField(updateMask string, env *composer.Environment, d *schema.ResourceData, config *Config) error {
-	envJson, _ := env.MarshalJSON()
-	log.Printf("[DEBUG] Updating Environment %q (updateMask = %q): %s", d.Id(), updateMask, string(envJson))
+	obj, err := ConvertToMap(env)
+	if err != nil {
+		return err
+	}
+	return resourceComposerEnvironmentPatchFieldRaw(updateMask, obj, d, config)
+}
+
+// resourceComposerEnvironmentPatchFieldRaw PATCHes the Environment with a raw
+// request body, so fields unknown to the vendored client can be updated too.
+func resourceComposerEnvironmentPatchFieldRaw(updateMask string, obj map[string]interface{}, d *schema.ResourceData, config *Config) error {
+	log.Printf("[DEBUG] Updating Environment %q (updateMask = %q): %#v", d.Id(), updateMask, obj)
 	envName, err := resourceComposerEnvironmentName(d, config)
 	if err != nil {
 		return err
 	}
 
-	op, err := config.clientComposer.Projects.Locations.Environments.
-		Patch(envName.resourceName(), env).
-		UpdateMask(updateMask).Do()
+	url := fmt.Sprintf("%s%s?updateMask=%s", composerBasePath, envName.resourceName(), updateMask)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
 		return err
 	}
 
+	op := &composer.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
 	waitErr := composerOperationWaitTime(
-		config.clientComposer, op, envName.Project, "Updating newly created Environment",
-		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+		config, op, envName.Project, "Updating Environment",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 	if waitErr != nil {
 		// The resource didn't actually update.
 		return fmt.Errorf("Error waiting to update Environment: %s", waitErr)
@@ -464,7 +597,7 @@ func resourceComposerEnvironmentDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	err = composerOperationWaitTime(
-		config.clientComposer, op, envName.Project, "Deleting Environment",
+		config, op, envName.Project, "Deleting Environment",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 	if err != nil {
 		return err
@@ -490,7 +623,7 @@ func resourceComposerEnvironmentImport(d *schema.ResourceData, meta interface{})
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig) interface{} {
+func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig, raw map[string]interface{}) interface{} {
 	if envCfg == nil {
 		return nil
 	}
@@ -499,13 +632,44 @@ func flattenComposerEnvironmentConfig(envCfg *composer.EnvironmentConfig) interf
 	transformed["dag_gcs_prefix"] = envCfg.DagGcsPrefix
 	transformed["node_count"] = envCfg.NodeCount
 	transformed["airflow_uri"] = envCfg.AirflowUri
-	transformed["node_config"] = flattenComposerEnvironmentConfigNodeConfig(envCfg.NodeConfig)
+	transformed["environment_size"] = raw["environmentSize"]
+	transformed["node_config"] = flattenComposerEnvironmentConfigNodeConfig(envCfg.NodeConfig, raw["nodeConfig"])
 	transformed["software_config"] = flattenComposerEnvironmentConfigSoftwareConfig(envCfg.SoftwareConfig)
+	transformed["private_environment_config"] = flattenComposerEnvironmentConfigPrivateEnvironmentConfig(raw["privateEnvironmentConfig"])
 
 	return []interface{}{transformed}
 }
 
-func flattenComposerEnvironmentConfigNodeConfig(nodeCfg *composer.NodeConfig) interface{} {
+func flattenComposerEnvironmentConfigPrivateEnvironmentConfig(v interface{}) interface{} {
+	privateEnvCfg, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["cloud_sql_ipv4_cidr_block"] = privateEnvCfg["cloudSqlIpv4CidrBlock"]
+	transformed["web_server_ipv4_cidr_block"] = privateEnvCfg["webServerIpv4CidrBlock"]
+	if clusterCfg, ok := privateEnvCfg["privateClusterConfig"].(map[string]interface{}); ok {
+		transformed["enable_private_endpoint"] = clusterCfg["enablePrivateEndpoint"]
+		transformed["master_ipv4_cidr_block"] = clusterCfg["masterIpv4CidrBlock"]
+	}
+	return []interface{}{transformed}
+}
+
+func flattenComposerEnvironmentConfigNodeConfigIPAllocationPolicy(v interface{}) interface{} {
+	policy, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["use_ip_aliases"] = policy["useIpAliases"]
+	transformed["cluster_secondary_range_name"] = policy["clusterSecondaryRangeName"]
+	transformed["services_secondary_range_name"] = policy["servicesSecondaryRangeName"]
+	transformed["cluster_ipv4_cidr_block"] = policy["clusterIpv4CidrBlock"]
+	transformed["services_ipv4_cidr_block"] = policy["servicesIpv4CidrBlock"]
+	return []interface{}{transformed}
+}
+
+func flattenComposerEnvironmentConfigNodeConfig(nodeCfg *composer.NodeConfig, raw interface{}) interface{} {
 	if nodeCfg == nil {
 		return nil
 	}
@@ -518,6 +682,9 @@ func flattenComposerEnvironmentConfigNodeConfig(nodeCfg *composer.NodeConfig) in
 	transformed["service_account"] = nodeCfg.ServiceAccount
 	transformed["oauth_scopes"] = flattenComposerEnvironmentConfigNodeConfigOauthScopes(nodeCfg.OauthScopes)
 	transformed["tags"] = flattenComposerEnvironmentConfigNodeConfigTags(nodeCfg.Tags)
+	if rawNodeCfg, ok := raw.(map[string]interface{}); ok {
+		transformed["ip_allocation_policy"] = flattenComposerEnvironmentConfigNodeConfigIPAllocationPolicy(rawNodeCfg["ipAllocationPolicy"])
+	}
 	return []interface{}{transformed}
 }
 
@@ -577,6 +744,56 @@ func expandComposerEnvironmentConfig(v interface{}, d *schema.ResourceData, conf
 	return transformed, nil
 }
 
+// expandComposerEnvironmentRaw converts the Environment into a request body,
+// adding the fields that the vendored Composer client doesn't support yet.
+func expandComposerEnvironmentRaw(d *schema.ResourceData, env *composer.Environment) (map[string]interface{}, error) {
+	obj, err := ConvertToMap(env)
+	if err != nil {
+		return nil, err
+	}
+
+	envCfg, ok := obj["config"].(map[string]interface{})
+	if !ok {
+		envCfg = make(map[string]interface{})
+		obj["config"] = envCfg
+	}
+
+	if v, ok := d.GetOk("config.0.environment_size"); ok {
+		envCfg["environmentSize"] = v
+	}
+
+	if v, ok := d.GetOk("config.0.private_environment_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		original := v.([]interface{})[0].(map[string]interface{})
+		envCfg["privateEnvironmentConfig"] = map[string]interface{}{
+			"enablePrivateEnvironment": true,
+			"privateClusterConfig": map[string]interface{}{
+				"enablePrivateEndpoint": original["enable_private_endpoint"],
+				"masterIpv4CidrBlock":   original["master_ipv4_cidr_block"],
+			},
+			"cloudSqlIpv4CidrBlock":  original["cloud_sql_ipv4_cidr_block"],
+			"webServerIpv4CidrBlock": original["web_server_ipv4_cidr_block"],
+		}
+	}
+
+	if v, ok := d.GetOk("config.0.node_config.0.ip_allocation_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		original := v.([]interface{})[0].(map[string]interface{})
+		nodeCfg, ok := envCfg["nodeConfig"].(map[string]interface{})
+		if !ok {
+			nodeCfg = make(map[string]interface{})
+			envCfg["nodeConfig"] = nodeCfg
+		}
+		nodeCfg["ipAllocationPolicy"] = map[string]interface{}{
+			"useIpAliases":               original["use_ip_aliases"],
+			"clusterSecondaryRangeName":  original["cluster_secondary_range_name"],
+			"servicesSecondaryRangeName": original["services_secondary_range_name"],
+			"clusterIpv4CidrBlock":       original["cluster_ipv4_cidr_block"],
+			"servicesIpv4CidrBlock":      original["services_ipv4_cidr_block"],
+		}
+	}
+
+	return obj, nil
+}
+
 func expandComposerEnvironmentConfigNodeCount(v interface{}, d *schema.ResourceData, config *Config) (int64, error) {
 	if v == nil {
 		return 0, nil
@@ -820,7 +1037,7 @@ func handleComposerEnvironmentCreationOpFailure(id string, envName *composerEnvi
 	}
 
 	waitErr := composerOperationWaitTime(
-		config.clientComposer, op, envName.Project,
+		config, op, envName.Project,
 		fmt.Sprintf("Deleting invalid created Environment with state %q", env.State),
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 	if waitErr != nil {
@@ -132,6 +132,43 @@ func TestAccComposerEnvironment_withNodeConfig(t *testing.T) {
 	})
 }
 
+// Checks a private IP environment, which requires VPC-native node networking.
+func TestAccComposerEnvironment_withPrivateEnvironmentConfig(t *testing.T) {
+	t.Parallel()
+
+	envName := acctest.RandomWithPrefix(testComposerEnvironmentPrefix)
+	network := acctest.RandomWithPrefix(testComposerNetworkPrefix)
+	subnetwork := network + "-1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccComposerEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComposerEnvironment_privateEnvironmentConfig(envName, network, subnetwork),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_composer_environment.test", "config.0.private_environment_config.0.enable_private_endpoint", "true"),
+					resource.TestCheckResourceAttr("google_composer_environment.test", "config.0.node_config.0.ip_allocation_policy.0.use_ip_aliases", "true"),
+					resource.TestCheckResourceAttrSet("google_composer_environment.test", "config.0.private_environment_config.0.cloud_sql_ipv4_cidr_block"),
+				),
+			},
+			{
+				ResourceName:      "google_composer_environment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Clean up the firewall rules left behind by the environment, see withNodeConfig.
+			{
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+				Config:             testAccComposerEnvironment_privateEnvironmentConfig(envName, network, subnetwork),
+				Check:              testAccCheckClearComposerEnvironmentFirewalls(network),
+			},
+		},
+	})
+}
+
 // Checks behavior of config for creation for attributes that must
 // be updated during create.
 func TestAccComposerEnvironment_withUpdateOnCreate(t *testing.T) {
@@ -242,6 +279,7 @@ resource "google_composer_environment" "test" {
 
 	config {
 		node_count = 4
+		environment_size = "ENVIRONMENT_SIZE_MEDIUM"
 
 		software_config {
 			airflow_config_overrides = {
@@ -308,6 +346,46 @@ resource "google_project_iam_member" "composer-worker" {
 `, environment, network, subnetwork, serviceAccount)
 }
 
+func testAccComposerEnvironment_privateEnvironmentConfig(environment, network, subnetwork string) string {
+	return fmt.Sprintf(`
+resource "google_composer_environment" "test" {
+	name = "%s"
+	region = "us-central1"
+
+	config {
+		node_config {
+			network = "${google_compute_network.test.self_link}"
+			subnetwork =  "${google_compute_subnetwork.test.self_link}"
+			zone = "us-central1-a"
+
+			ip_allocation_policy {
+				use_ip_aliases = true
+				cluster_ipv4_cidr_block = "10.0.0.0/16"
+			}
+		}
+
+		private_environment_config {
+			enable_private_endpoint = true
+			master_ipv4_cidr_block = "172.16.0.0/28"
+		}
+	}
+}
+
+resource "google_compute_network" "test" {
+	name 					= "%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "test" {
+	name          = "%s"
+	ip_cidr_range = "10.2.0.0/16"
+	region        = "us-central1"
+	network       = "${google_compute_network.test.self_link}"
+	private_ip_google_access = true
+}
+`, environment, network, subnetwork)
+}
+
 func testAccComposerEnvironment_updateOnlyFields(name string) string {
 	return fmt.Sprintf(`
 resource "google_composer_environment" "test" {
@@ -391,7 +469,7 @@ func testSweepComposerEnvironments(config *Config) error {
 				allErrors = multierror.Append(allErrors, fmt.Errorf("Unable to delete environment %q: %s", e.Name, deleteErr))
 				continue
 			}
-			waitErr := composerOperationWaitTime(config.clientComposer, op, config.Project, "Sweeping old test environments", 10)
+			waitErr := composerOperationWaitTime(config, op, config.Project, "Sweeping old test environments", 10)
 			if waitErr != nil {
 				allErrors = multierror.Append(allErrors, fmt.Errorf("Unable to delete environment %q: %s", e.Name, waitErr))
 			}
@@ -492,7 +570,7 @@ func testAccCheckClearComposerEnvironmentFirewalls(networkName string) resource.
 				continue
 			}
 
-			waitErr := computeOperationWaitTime(config.clientCompute, op, config.Project,
+			waitErr := computeOperationWaitTime(config, op, config.Project,
 				"Sweeping test composer environment firewalls", 10)
 			if waitErr != nil {
 				allErrors = multierror.Append(allErrors,
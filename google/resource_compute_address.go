@@ -25,6 +25,23 @@ import (
 	"google.golang.org/api/compute/v1"
 )
 
+// The network and purpose fields and computeAddressNetworkCustomizeDiff were
+// added to this file by hand, see .github/GENERATED_FILE_CHANGES.md.
+func computeAddressNetworkCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// The API only uses the network of INTERNAL addresses reserved for VPC
+	// peering, and would silently ignore it for any other address.
+	if _, ok := diff.GetOk("network"); !ok {
+		return nil
+	}
+	if !diff.NewValueKnown("address_type") || !diff.NewValueKnown("purpose") {
+		return nil
+	}
+	if diff.Get("address_type").(string) != "INTERNAL" || diff.Get("purpose").(string) != "VPC_PEERING" {
+		return fmt.Errorf("Error in Address %s: network can only be set on INTERNAL addresses with purpose VPC_PEERING.", diff.Get("name"))
+	}
+	return nil
+}
+
 func resourceComputeAddress() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeAddressCreate,
@@ -35,6 +52,8 @@ func resourceComputeAddress() *schema.Resource {
 			State: resourceComputeAddressImport,
 		},
 
+		CustomizeDiff: computeAddressNetworkCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(240 * time.Second),
 			Delete: schema.DefaultTimeout(240 * time.Second),
@@ -65,6 +84,13 @@ func resourceComputeAddress() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"network": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+				ConflictsWith:    []string{"subnetwork"},
+			},
 			"network_tier": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -72,6 +98,13 @@ func resourceComputeAddress() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"PREMIUM", "STANDARD", ""}, false),
 			},
+			"purpose": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"GCE_ENDPOINT", "VPC_PEERING", ""}, false),
+				DiffSuppressFunc: emptyOrDefaultStringSuppress("GCE_ENDPOINT"),
+			},
 			"region": {
 				Type:             schema.TypeString,
 				Computed:         true,
@@ -145,6 +178,18 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	} else if v, ok := d.GetOkExists("network_tier"); !isEmptyValue(reflect.ValueOf(networkTierProp)) && (ok || !reflect.DeepEqual(v, networkTierProp)) {
 		obj["networkTier"] = networkTierProp
 	}
+	networkProp, err := expandComputeAddressNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	purposeProp, err := expandComputeAddressPurpose(d.Get("purpose"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("purpose"); !isEmptyValue(reflect.ValueOf(purposeProp)) && (ok || !reflect.DeepEqual(v, purposeProp)) {
+		obj["purpose"] = purposeProp
+	}
 	subnetworkProp, err := expandComputeAddressSubnetwork(d.Get("subnetwork"), d, config)
 	if err != nil {
 		return err
@@ -187,12 +232,14 @@ func resourceComputeAddressCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Address",
+		config, op, project, "Creating Address",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Address: %s", waitErr)
 	}
 
@@ -240,6 +287,12 @@ func resourceComputeAddressRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("network_tier", flattenComputeAddressNetworkTier(res["networkTier"], d)); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
+	if err := d.Set("network", flattenComputeAddressNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading Address: %s", err)
+	}
+	if err := d.Set("purpose", flattenComputeAddressPurpose(res["purpose"], d)); err != nil {
+		return fmt.Errorf("Error reading Address: %s", err)
+	}
 	if err := d.Set("subnetwork", flattenComputeAddressSubnetwork(res["subnetwork"], d)); err != nil {
 		return fmt.Errorf("Error reading Address: %s", err)
 	}
@@ -266,7 +319,7 @@ func resourceComputeAddressDelete(d *schema.ResourceData, meta interface{}) erro
 
 	var obj map[string]interface{}
 	log.Printf("[DEBUG] Deleting Address %q", d.Id())
-	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete), isResourceInUseByAnotherResourceError)
 	if err != nil {
 		return handleNotFoundError(err, d, "Address")
 	}
@@ -282,7 +335,7 @@ func resourceComputeAddressDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Address",
+		config, op, project, "Deleting Address",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -336,6 +389,17 @@ func flattenComputeAddressNetworkTier(v interface{}, d *schema.ResourceData) int
 	return v
 }
 
+func flattenComputeAddressNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeAddressPurpose(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenComputeAddressSubnetwork(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return v
@@ -374,6 +438,18 @@ func expandComputeAddressNetworkTier(v interface{}, d TerraformResourceData, con
 	return v, nil
 }
 
+func expandComputeAddressNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeAddressPurpose(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandComputeAddressSubnetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseRegionalFieldValue("subnetworks", v.(string), "project", "region", "zone", d, config, true)
 	if err != nil {
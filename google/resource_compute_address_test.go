@@ -2,10 +2,13 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestAccComputeAddress_networkTier(t *testing.T) {
@@ -58,6 +61,92 @@ func TestAccComputeAddress_internal(t *testing.T) {
 	})
 }
 
+func TestAccComputeAddress_vpcPeering(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAddress_vpcPeering(suffix),
+				Check: resource.TestMatchResourceAttr(
+					"google_compute_address.peering", "network", regexp.MustCompile("/global/networks/network-test-"+suffix+"$")),
+			},
+			{
+				ResourceName:      "google_compute_address.peering",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestComputeAddress_networkValidation(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Config        map[string]interface{}
+		ExpectedError bool
+	}{
+		"internal vpc peering": {
+			Config: map[string]interface{}{
+				"name":         "address",
+				"address_type": "INTERNAL",
+				"purpose":      "VPC_PEERING",
+				"network":      "default",
+			},
+		},
+		"internal without purpose": {
+			Config: map[string]interface{}{
+				"name":         "address",
+				"address_type": "INTERNAL",
+				"network":      "default",
+			},
+			ExpectedError: true,
+		},
+		"internal gce endpoint": {
+			Config: map[string]interface{}{
+				"name":         "address",
+				"address_type": "INTERNAL",
+				"purpose":      "GCE_ENDPOINT",
+				"network":      "default",
+			},
+			ExpectedError: true,
+		},
+		"external": {
+			Config: map[string]interface{}{
+				"name":    "address",
+				"network": "default",
+			},
+			ExpectedError: true,
+		},
+		"no network": {
+			Config: map[string]interface{}{
+				"name": "address",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%s: %s", tn, err)
+		}
+
+		_, err = resourceComputeAddress().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if err != nil && !tc.ExpectedError {
+			t.Errorf("%s: unexpected error %s", tn, err)
+		}
+		if err == nil && tc.ExpectedError {
+			t.Errorf("%s: expected an error", tn)
+		}
+	}
+}
+
 func testAccComputeAddress_internal(i string) string {
 	return fmt.Sprintf(`
 resource "google_compute_address" "internal" {
@@ -108,3 +197,19 @@ resource "google_compute_address" "foobar" {
 	network_tier = "STANDARD"
 }`, i)
 }
+
+func testAccComputeAddress_vpcPeering(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "default" {
+  name = "network-test-%s"
+}
+
+resource "google_compute_address" "peering" {
+  name         = "address-test-peering-%s"
+  network      = "${google_compute_network.default.self_link}"
+  address_type = "INTERNAL"
+  purpose      = "VPC_PEERING"
+  region       = "us-east1"
+}
+`, suffix, suffix)
+}
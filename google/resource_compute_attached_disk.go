@@ -102,10 +102,13 @@ func resourceAttachedDiskCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(fmt.Sprintf("%s:%s", zv.Name, diskName))
 
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, zv.Project,
+	waitErr := computeSharedOperationWaitTime(config, op, zv.Project,
 		int(d.Timeout(schema.TimeoutCreate).Minutes()), "disk to attach")
 	if waitErr != nil {
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return waitErr
 	}
 
@@ -183,7 +186,7 @@ func resourceAttachedDiskDelete(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	waitErr := computeSharedOperationWaitTime(config.clientCompute, op, zv.Project,
+	waitErr := computeSharedOperationWaitTime(config, op, zv.Project,
 		int(d.Timeout(schema.TimeoutDelete).Minutes()), fmt.Sprintf("Detaching disk from %s", zv.Name))
 	if waitErr != nil {
 		return waitErr
@@ -72,6 +72,39 @@ func TestAccComputeAutoscaler_multicondition(t *testing.T) {
 	})
 }
 
+func TestAccComputeAutoscaler_scalingSchedule(t *testing.T) {
+	t.Parallel()
+
+	var it_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var tp_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var igm_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+	var autoscaler_name = fmt.Sprintf("autoscaler-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeAutoscalerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name, "ONLY_SCALE_OUT", "NONE", 2),
+			},
+			{
+				ResourceName:      "google_compute_autoscaler.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name, "ON", "OPTIMIZE_AVAILABILITY", 4),
+			},
+			{
+				ResourceName:      "google_compute_autoscaler.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeAutoscalerExists(n string, ascaler *compute.Autoscaler) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -285,3 +318,38 @@ resource "google_compute_autoscaler" "foobar" {
 }
 `, autoscaler_name)
 }
+
+func testAccComputeAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name, mode, predictiveMethod string, minRequired int) string {
+	return testAccComputeAutoscaler_scaffolding(it_name, tp_name, igm_name) + fmt.Sprintf(`
+resource "google_compute_autoscaler" "foobar" {
+	description = "Resource created for Terraform acceptance testing"
+	name = "%s"
+	zone = "us-central1-a"
+	target = "${google_compute_instance_group_manager.foobar.self_link}"
+	autoscaling_policy {
+		max_replicas = 5
+		min_replicas = 0
+		cooldown_period = 60
+		mode = "%s"
+		cpu_utilization {
+			target = 0.5
+			predictive_method = "%s"
+		}
+		scale_in_control {
+			max_scaled_in_replicas {
+				percent = 50
+			}
+			time_window_sec = 300
+		}
+		scaling_schedules {
+			name = "business-hours"
+			min_required_replicas = %d
+			schedule = "0 8 * * MON-FRI"
+			time_zone = "America/New_York"
+			duration_sec = 36000
+			description = "Keep capacity during business hours"
+		}
+	}
+}
+`, autoscaler_name, mode, predictiveMethod, minRequired)
+}
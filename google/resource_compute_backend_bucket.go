@@ -158,12 +158,14 @@ func resourceComputeBackendBucketCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating BackendBucket",
+		config, op, project, "Creating BackendBucket",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create BackendBucket: %s", waitErr)
 	}
 
@@ -276,7 +278,7 @@ func resourceComputeBackendBucketUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating BackendBucket",
+		config, op, project, "Updating BackendBucket",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -312,7 +314,7 @@ func resourceComputeBackendBucketDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting BackendBucket",
+		config, op, project, "Deleting BackendBucket",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
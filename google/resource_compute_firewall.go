@@ -345,12 +345,14 @@ func resourceComputeFirewallCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Firewall",
+		config, op, project, "Creating Firewall",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Firewall: %s", waitErr)
 	}
 
@@ -532,7 +534,7 @@ func resourceComputeFirewallUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating Firewall",
+		config, op, project, "Updating Firewall",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -568,7 +570,7 @@ func resourceComputeFirewallDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Firewall",
+		config, op, project, "Deleting Firewall",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -117,6 +117,64 @@ func TestAccComputeForwardingRule_internalLoadBalancing(t *testing.T) {
 	})
 }
 
+func TestAccComputeForwardingRule_allowGlobalAccess(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	ruleName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeForwardingRule_allowGlobalAccess(serviceName, checkName, networkName, ruleName, true),
+			},
+			{
+				ResourceName:      "google_compute_forwarding_rule.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeForwardingRule_allowGlobalAccess(serviceName, checkName, networkName, ruleName, false),
+			},
+			{
+				ResourceName:      "google_compute_forwarding_rule.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeForwardingRule_mirroringCollector(t *testing.T) {
+	t.Parallel()
+
+	serviceName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	checkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	networkName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+	ruleName := fmt.Sprintf("tf-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeForwardingRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeForwardingRule_mirroringCollector(serviceName, checkName, networkName, ruleName),
+			},
+			{
+				ResourceName:      "google_compute_forwarding_rule.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccComputeForwardingRule_networkTier(t *testing.T) {
 	t.Parallel()
 
@@ -279,3 +337,72 @@ resource "google_compute_forwarding_rule" "foobar" {
 }
 `, poolName, ruleName)
 }
+
+func testAccComputeForwardingRule_allowGlobalAccess(serviceName, checkName, networkName, ruleName string, allowGlobalAccess bool) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar-bs" {
+  name          = "%s"
+  description   = "Resource created for Terraform acceptance testing"
+  health_checks = ["${google_compute_health_check.zero.self_link}"]
+  region        = "us-central1"
+}
+resource "google_compute_health_check" "zero" {
+  name               = "%s"
+  description        = "Resource created for Terraform acceptance testing"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+resource "google_compute_network" "foobar" {
+  name                    = "%s"
+  auto_create_subnetworks = true
+}
+resource "google_compute_forwarding_rule" "foobar" {
+  description           = "Resource created for Terraform acceptance testing"
+  name                  = "%s"
+  load_balancing_scheme = "INTERNAL"
+  backend_service       = "${google_compute_region_backend_service.foobar-bs.self_link}"
+  all_ports             = true
+  network               = "${google_compute_network.foobar.self_link}"
+  allow_global_access   = %t
+  service_label         = "foobar"
+}
+`, serviceName, checkName, networkName, ruleName, allowGlobalAccess)
+}
+
+func testAccComputeForwardingRule_mirroringCollector(serviceName, checkName, networkName, ruleName string) string {
+	return fmt.Sprintf(`
+resource "google_compute_region_backend_service" "foobar-bs" {
+  name          = "%s"
+  description   = "Resource created for Terraform acceptance testing"
+  health_checks = ["${google_compute_health_check.zero.self_link}"]
+  region        = "us-central1"
+}
+resource "google_compute_health_check" "zero" {
+  name               = "%s"
+  description        = "Resource created for Terraform acceptance testing"
+  check_interval_sec = 1
+  timeout_sec        = 1
+
+  tcp_health_check {
+    port = "80"
+  }
+}
+resource "google_compute_network" "foobar" {
+  name                    = "%s"
+  auto_create_subnetworks = true
+}
+resource "google_compute_forwarding_rule" "foobar" {
+  description            = "Resource created for Terraform acceptance testing"
+  name                   = "%s"
+  load_balancing_scheme  = "INTERNAL"
+  backend_service        = "${google_compute_region_backend_service.foobar-bs.self_link}"
+  all_ports              = true
+  network                = "${google_compute_network.foobar.self_link}"
+  is_mirroring_collector = true
+}
+`, serviceName, checkName, networkName, ruleName)
+}
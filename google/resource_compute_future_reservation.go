@@ -0,0 +1,1286 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/compute/v1"
+)
+
+func resourceComputeFutureReservation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeFutureReservationCreate,
+		Read:   resourceComputeFutureReservationRead,
+		Update: resourceComputeFutureReservationUpdate,
+		Delete: resourceComputeFutureReservationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeFutureReservationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"time_window": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"duration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"seconds": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"nanos": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"auto_created_reservations_delete_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auto_created_reservations_duration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"seconds": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"nanos": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"auto_delete_auto_created_reservations": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"planning_status": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DRAFT", "SUBMITTED", ""}, false),
+			},
+			"share_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"projects": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"share_type": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"LOCAL", "SPECIFIC_PROJECTS", ""}, false),
+						},
+					},
+				},
+			},
+			"specific_reservation_required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"specific_sku_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_properties": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"guest_accelerators": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"accelerator_count": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"accelerator_type": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"local_ssds": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"disk_size_gb": {
+													Type:     schema.TypeInt,
+													Optional: true,
+												},
+												"interface": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice([]string{"SCSI", "NVME", ""}, false),
+												},
+											},
+										},
+									},
+									"machine_type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"min_cpu_platform": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"source_instance_template": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"total_count": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"zone": {
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"creation_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_created_reservations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"fulfilled_count": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lock_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"procurement_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeFutureReservationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandComputeFutureReservationName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+	zoneProp, err := expandComputeFutureReservationZone(d.Get("zone"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("zone"); !isEmptyValue(reflect.ValueOf(zoneProp)) && (ok || !reflect.DeepEqual(v, zoneProp)) {
+		obj["zone"] = zoneProp
+	}
+	descriptionProp, err := expandComputeFutureReservationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	timeWindowProp, err := expandComputeFutureReservationTimeWindow(d.Get("time_window"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("time_window"); !isEmptyValue(reflect.ValueOf(timeWindowProp)) && (ok || !reflect.DeepEqual(v, timeWindowProp)) {
+		obj["timeWindow"] = timeWindowProp
+	}
+	specificSkuPropertiesProp, err := expandComputeFutureReservationSpecificSkuProperties(d.Get("specific_sku_properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("specific_sku_properties"); !isEmptyValue(reflect.ValueOf(specificSkuPropertiesProp)) && (ok || !reflect.DeepEqual(v, specificSkuPropertiesProp)) {
+		obj["specificSkuProperties"] = specificSkuPropertiesProp
+	}
+	shareSettingsProp, err := expandComputeFutureReservationShareSettings(d.Get("share_settings"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_settings"); !isEmptyValue(reflect.ValueOf(shareSettingsProp)) && (ok || !reflect.DeepEqual(v, shareSettingsProp)) {
+		obj["shareSettings"] = shareSettingsProp
+	}
+	namePrefixProp, err := expandComputeFutureReservationNamePrefix(d.Get("name_prefix"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name_prefix"); !isEmptyValue(reflect.ValueOf(namePrefixProp)) && (ok || !reflect.DeepEqual(v, namePrefixProp)) {
+		obj["namePrefix"] = namePrefixProp
+	}
+	planningStatusProp, err := expandComputeFutureReservationPlanningStatus(d.Get("planning_status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("planning_status"); !isEmptyValue(reflect.ValueOf(planningStatusProp)) && (ok || !reflect.DeepEqual(v, planningStatusProp)) {
+		obj["planningStatus"] = planningStatusProp
+	}
+	autoCreatedReservationsDeleteTimeProp, err := expandComputeFutureReservationAutoCreatedReservationsDeleteTime(d.Get("auto_created_reservations_delete_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_delete_time"); !isEmptyValue(reflect.ValueOf(autoCreatedReservationsDeleteTimeProp)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDeleteTimeProp)) {
+		obj["autoCreatedReservationsDeleteTime"] = autoCreatedReservationsDeleteTimeProp
+	}
+	autoCreatedReservationsDurationProp, err := expandComputeFutureReservationAutoCreatedReservationsDuration(d.Get("auto_created_reservations_duration"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_duration"); !isEmptyValue(reflect.ValueOf(autoCreatedReservationsDurationProp)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDurationProp)) {
+		obj["autoCreatedReservationsDuration"] = autoCreatedReservationsDurationProp
+	}
+	autoDeleteAutoCreatedReservationsProp, err := expandComputeFutureReservationAutoDeleteAutoCreatedReservations(d.Get("auto_delete_auto_created_reservations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_delete_auto_created_reservations"); !isEmptyValue(reflect.ValueOf(autoDeleteAutoCreatedReservationsProp)) && (ok || !reflect.DeepEqual(v, autoDeleteAutoCreatedReservationsProp)) {
+		obj["autoDeleteAutoCreatedReservations"] = autoDeleteAutoCreatedReservationsProp
+	}
+	specificReservationRequiredProp, err := expandComputeFutureReservationSpecificReservationRequired(d.Get("specific_reservation_required"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("specific_reservation_required"); !isEmptyValue(reflect.ValueOf(specificReservationRequiredProp)) && (ok || !reflect.DeepEqual(v, specificReservationRequiredProp)) {
+		obj["specificReservationRequired"] = specificReservationRequiredProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/futureReservations")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new FutureReservation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating FutureReservation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	waitErr := computeOperationWaitTime(
+		config, op, project, "Creating FutureReservation",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create FutureReservation: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating FutureReservation %q: %#v", d.Id(), res)
+
+	return resourceComputeFutureReservationRead(d, meta)
+}
+
+func resourceComputeFutureReservationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeFutureReservation %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+
+	if err := d.Set("name", flattenComputeFutureReservationName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("zone", flattenComputeFutureReservationZone(res["zone"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("description", flattenComputeFutureReservationDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("time_window", flattenComputeFutureReservationTimeWindow(res["timeWindow"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("specific_sku_properties", flattenComputeFutureReservationSpecificSkuProperties(res["specificSkuProperties"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("share_settings", flattenComputeFutureReservationShareSettings(res["shareSettings"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("name_prefix", flattenComputeFutureReservationNamePrefix(res["namePrefix"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("planning_status", flattenComputeFutureReservationPlanningStatus(res["planningStatus"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("auto_created_reservations_delete_time", flattenComputeFutureReservationAutoCreatedReservationsDeleteTime(res["autoCreatedReservationsDeleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("auto_created_reservations_duration", flattenComputeFutureReservationAutoCreatedReservationsDuration(res["autoCreatedReservationsDuration"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("auto_delete_auto_created_reservations", flattenComputeFutureReservationAutoDeleteAutoCreatedReservations(res["autoDeleteAutoCreatedReservations"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("specific_reservation_required", flattenComputeFutureReservationSpecificReservationRequired(res["specificReservationRequired"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("creation_timestamp", flattenComputeFutureReservationCreationTimestamp(res["creationTimestamp"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("status", flattenComputeFutureReservationStatus(res["status"], d)); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading FutureReservation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeFutureReservationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandComputeFutureReservationDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	timeWindowProp, err := expandComputeFutureReservationTimeWindow(d.Get("time_window"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("time_window"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, timeWindowProp)) {
+		obj["timeWindow"] = timeWindowProp
+	}
+	specificSkuPropertiesProp, err := expandComputeFutureReservationSpecificSkuProperties(d.Get("specific_sku_properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("specific_sku_properties"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, specificSkuPropertiesProp)) {
+		obj["specificSkuProperties"] = specificSkuPropertiesProp
+	}
+	shareSettingsProp, err := expandComputeFutureReservationShareSettings(d.Get("share_settings"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("share_settings"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, shareSettingsProp)) {
+		obj["shareSettings"] = shareSettingsProp
+	}
+	namePrefixProp, err := expandComputeFutureReservationNamePrefix(d.Get("name_prefix"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name_prefix"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, namePrefixProp)) {
+		obj["namePrefix"] = namePrefixProp
+	}
+	planningStatusProp, err := expandComputeFutureReservationPlanningStatus(d.Get("planning_status"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("planning_status"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, planningStatusProp)) {
+		obj["planningStatus"] = planningStatusProp
+	}
+	autoCreatedReservationsDeleteTimeProp, err := expandComputeFutureReservationAutoCreatedReservationsDeleteTime(d.Get("auto_created_reservations_delete_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_delete_time"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDeleteTimeProp)) {
+		obj["autoCreatedReservationsDeleteTime"] = autoCreatedReservationsDeleteTimeProp
+	}
+	autoCreatedReservationsDurationProp, err := expandComputeFutureReservationAutoCreatedReservationsDuration(d.Get("auto_created_reservations_duration"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_created_reservations_duration"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoCreatedReservationsDurationProp)) {
+		obj["autoCreatedReservationsDuration"] = autoCreatedReservationsDurationProp
+	}
+	autoDeleteAutoCreatedReservationsProp, err := expandComputeFutureReservationAutoDeleteAutoCreatedReservations(d.Get("auto_delete_auto_created_reservations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("auto_delete_auto_created_reservations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, autoDeleteAutoCreatedReservationsProp)) {
+		obj["autoDeleteAutoCreatedReservations"] = autoDeleteAutoCreatedReservationsProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating FutureReservation %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("time_window") {
+		updateMask = append(updateMask, "timeWindow")
+	}
+
+	if d.HasChange("specific_sku_properties") {
+		updateMask = append(updateMask, "specificSkuProperties")
+	}
+
+	if d.HasChange("share_settings") {
+		updateMask = append(updateMask, "shareSettings")
+	}
+
+	if d.HasChange("name_prefix") {
+		updateMask = append(updateMask, "namePrefix")
+	}
+
+	if d.HasChange("planning_status") {
+		updateMask = append(updateMask, "planningStatus")
+	}
+
+	if d.HasChange("auto_created_reservations_delete_time") {
+		updateMask = append(updateMask, "autoCreatedReservationsDeleteTime")
+	}
+
+	if d.HasChange("auto_created_reservations_duration") {
+		updateMask = append(updateMask, "autoCreatedReservationsDuration")
+	}
+
+	if d.HasChange("auto_delete_auto_created_reservations") {
+		updateMask = append(updateMask, "autoDeleteAutoCreatedReservations")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating FutureReservation %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Updating FutureReservation",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceComputeFutureReservationRead(d, meta)
+}
+
+func resourceComputeFutureReservationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting FutureReservation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "FutureReservation")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	op := &compute.Operation{}
+	err = Convert(res, op)
+	if err != nil {
+		return err
+	}
+
+	err = computeOperationWaitTime(
+		config, op, project, "Deleting FutureReservation",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting FutureReservation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceComputeFutureReservationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/futureReservations/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<zone>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenComputeFutureReservationName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationZone(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenComputeFutureReservationDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindow(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["start_time"] =
+		flattenComputeFutureReservationTimeWindowStartTime(original["startTime"], d)
+	transformed["end_time"] =
+		flattenComputeFutureReservationTimeWindowEndTime(original["endTime"], d)
+	transformed["duration"] =
+		flattenComputeFutureReservationTimeWindowDuration(original["duration"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationTimeWindowStartTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindowEndTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindowDuration(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["seconds"] =
+		flattenComputeFutureReservationTimeWindowDurationSeconds(original["seconds"], d)
+	transformed["nanos"] =
+		flattenComputeFutureReservationTimeWindowDurationNanos(original["nanos"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationTimeWindowDurationSeconds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationTimeWindowDurationNanos(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuProperties(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["instance_properties"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstanceProperties(original["instanceProperties"], d)
+	transformed["total_count"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesTotalCount(original["totalCount"], d)
+	transformed["source_instance_template"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(original["sourceInstanceTemplate"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstanceProperties(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["machine_type"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(original["machineType"], d)
+	transformed["min_cpu_platform"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(original["minCpuPlatform"], d)
+	transformed["guest_accelerators"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(original["guestAccelerators"], d)
+	transformed["local_ssds"] =
+		flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(original["localSsds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"accelerator_type":  flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(original["acceleratorType"], d),
+			"accelerator_count": flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(original["acceleratorCount"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"disk_size_gb": flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(original["diskSizeGb"], d),
+			"interface":    flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(original["interface"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesTotalCount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationShareSettings(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["share_type"] =
+		flattenComputeFutureReservationShareSettingsShareType(original["shareType"], d)
+	transformed["projects"] =
+		flattenComputeFutureReservationShareSettingsProjects(original["projects"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationShareSettingsShareType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationShareSettingsProjects(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationNamePrefix(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationPlanningStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationAutoCreatedReservationsDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationAutoCreatedReservationsDuration(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["seconds"] =
+		flattenComputeFutureReservationAutoCreatedReservationsDurationSeconds(original["seconds"], d)
+	transformed["nanos"] =
+		flattenComputeFutureReservationAutoCreatedReservationsDurationNanos(original["nanos"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationAutoCreatedReservationsDurationSeconds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationAutoCreatedReservationsDurationNanos(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenComputeFutureReservationAutoDeleteAutoCreatedReservations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationSpecificReservationRequired(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationCreationTimestamp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationStatus(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["procurement_status"] =
+		flattenComputeFutureReservationStatusProcurementStatus(original["procurementStatus"], d)
+	transformed["lock_time"] =
+		flattenComputeFutureReservationStatusLockTime(original["lockTime"], d)
+	transformed["auto_created_reservations"] =
+		flattenComputeFutureReservationStatusAutoCreatedReservations(original["autoCreatedReservations"], d)
+	transformed["fulfilled_count"] =
+		flattenComputeFutureReservationStatusFulfilledCount(original["fulfilledCount"], d)
+	return []interface{}{transformed}
+}
+
+func flattenComputeFutureReservationStatusProcurementStatus(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationStatusLockTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationStatusAutoCreatedReservations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenComputeFutureReservationStatusFulfilledCount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandComputeFutureReservationName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("zones", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for zone: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandComputeFutureReservationDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindow(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedStartTime, err := expandComputeFutureReservationTimeWindowStartTime(original["start_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStartTime); val.IsValid() && !isEmptyValue(val) {
+		transformed["startTime"] = transformedStartTime
+	}
+
+	transformedEndTime, err := expandComputeFutureReservationTimeWindowEndTime(original["end_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEndTime); val.IsValid() && !isEmptyValue(val) {
+		transformed["endTime"] = transformedEndTime
+	}
+
+	transformedDuration, err := expandComputeFutureReservationTimeWindowDuration(original["duration"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDuration); val.IsValid() && !isEmptyValue(val) {
+		transformed["duration"] = transformedDuration
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationTimeWindowStartTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowEndTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowDuration(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSeconds, err := expandComputeFutureReservationTimeWindowDurationSeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	transformedNanos, err := expandComputeFutureReservationTimeWindowDurationNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !isEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationTimeWindowDurationSeconds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationTimeWindowDurationNanos(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuProperties(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedInstanceProperties, err := expandComputeFutureReservationSpecificSkuPropertiesInstanceProperties(original["instance_properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInstanceProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["instanceProperties"] = transformedInstanceProperties
+	}
+
+	transformedTotalCount, err := expandComputeFutureReservationSpecificSkuPropertiesTotalCount(original["total_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTotalCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["totalCount"] = transformedTotalCount
+	}
+
+	transformedSourceInstanceTemplate, err := expandComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(original["source_instance_template"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSourceInstanceTemplate); val.IsValid() && !isEmptyValue(val) {
+		transformed["sourceInstanceTemplate"] = transformedSourceInstanceTemplate
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstanceProperties(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMachineType, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !isEmptyValue(val) {
+		transformed["machineType"] = transformedMachineType
+	}
+
+	transformedMinCpuPlatform, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(original["min_cpu_platform"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinCpuPlatform); val.IsValid() && !isEmptyValue(val) {
+		transformed["minCpuPlatform"] = transformedMinCpuPlatform
+	}
+
+	transformedGuestAccelerators, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(original["guest_accelerators"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGuestAccelerators); val.IsValid() && !isEmptyValue(val) {
+		transformed["guestAccelerators"] = transformedGuestAccelerators
+	}
+
+	transformedLocalSsds, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(original["local_ssds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocalSsds); val.IsValid() && !isEmptyValue(val) {
+		transformed["localSsds"] = transformedLocalSsds
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMachineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesMinCpuPlatform(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAccelerators(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAcceleratorType, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorType"] = transformedAcceleratorType
+		}
+
+		transformedAcceleratorCount, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(original["accelerator_count"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorCount); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorCount"] = transformedAcceleratorCount
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesGuestAcceleratorsAcceleratorCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedDiskSizeGb, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(original["disk_size_gb"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDiskSizeGb); val.IsValid() && !isEmptyValue(val) {
+			transformed["diskSizeGb"] = transformedDiskSizeGb
+		}
+
+		transformedInterface, err := expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(original["interface"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedInterface); val.IsValid() && !isEmptyValue(val) {
+			transformed["interface"] = transformedInterface
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsDiskSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesInstancePropertiesLocalSsdsInterface(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesTotalCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificSkuPropertiesSourceInstanceTemplate(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationShareSettings(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedShareType, err := expandComputeFutureReservationShareSettingsShareType(original["share_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedShareType); val.IsValid() && !isEmptyValue(val) {
+		transformed["shareType"] = transformedShareType
+	}
+
+	transformedProjects, err := expandComputeFutureReservationShareSettingsProjects(original["projects"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProjects); val.IsValid() && !isEmptyValue(val) {
+		transformed["projects"] = transformedProjects
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationShareSettingsShareType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationShareSettingsProjects(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationNamePrefix(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationPlanningStatus(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoCreatedReservationsDeleteTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoCreatedReservationsDuration(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSeconds, err := expandComputeFutureReservationAutoCreatedReservationsDurationSeconds(original["seconds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSeconds); val.IsValid() && !isEmptyValue(val) {
+		transformed["seconds"] = transformedSeconds
+	}
+
+	transformedNanos, err := expandComputeFutureReservationAutoCreatedReservationsDurationNanos(original["nanos"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNanos); val.IsValid() && !isEmptyValue(val) {
+		transformed["nanos"] = transformedNanos
+	}
+
+	return transformed, nil
+}
+
+func expandComputeFutureReservationAutoCreatedReservationsDurationSeconds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoCreatedReservationsDurationNanos(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationAutoDeleteAutoCreatedReservations(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandComputeFutureReservationSpecificReservationRequired(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,91 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeFutureReservation_futureReservationBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"start_time":    time.Now().AddDate(0, 0, 30).UTC().Format(time.RFC3339),
+		"end_time":      time.Now().AddDate(0, 0, 31).UTC().Format(time.RFC3339),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeFutureReservationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeFutureReservation_futureReservationBasicExample(context),
+			},
+			{
+				ResourceName:      "google_compute_future_reservation.gce_future_reservation",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeFutureReservation_futureReservationBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_future_reservation" "gce_future_reservation" {
+  name          = "tf-test-gce-future-reservation%{random_suffix}"
+  zone          = "us-central1-a"
+  name_prefix   = "fr-basic"
+  planning_status = "DRAFT"
+
+  time_window {
+    start_time = "%{start_time}"
+    end_time   = "%{end_time}"
+  }
+
+  specific_sku_properties {
+    total_count = "1"
+
+    instance_properties {
+      machine_type     = "e2-standard-2"
+      min_cpu_platform = "Intel Haswell"
+    }
+  }
+
+  auto_delete_auto_created_reservations = true
+  auto_created_reservations_delete_time = "%{end_time}"
+}
+`, context)
+}
+
+func testAccCheckComputeFutureReservationDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_future_reservation" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/futureReservations/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("ComputeFutureReservation still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -154,12 +154,14 @@ func resourceComputeGlobalAddressCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating GlobalAddress",
+		config, op, project, "Creating GlobalAddress",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create GlobalAddress: %s", waitErr)
 	}
 
@@ -240,7 +242,7 @@ func resourceComputeGlobalAddressDelete(d *schema.ResourceData, meta interface{}
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting GlobalAddress",
+		config, op, project, "Deleting GlobalAddress",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
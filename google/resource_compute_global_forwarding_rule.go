@@ -130,7 +130,7 @@ func resourceComputeGlobalForwardingRuleCreate(d *schema.ResourceData, meta inte
 	// It probably maybe worked, so store the ID now
 	d.SetId(frule.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Global Fowarding Rule")
+	err = computeSharedOperationWait(config, op, project, "Creating Global Fowarding Rule")
 	if err != nil {
 		return err
 	}
@@ -158,7 +158,7 @@ func resourceComputeGlobalForwardingRuleUpdate(d *schema.ResourceData, meta inte
 			return fmt.Errorf("Error updating target: %s", err)
 		}
 
-		err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Global Forwarding Rule")
+		err = computeSharedOperationWait(config, op, project, "Updating Global Forwarding Rule")
 		if err != nil {
 			return err
 		}
@@ -213,7 +213,7 @@ func resourceComputeGlobalForwardingRuleDelete(d *schema.ResourceData, meta inte
 	if err != nil {
 		return fmt.Errorf("Error deleting GlobalForwardingRule: %s", err)
 	}
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Deleting GlobalForwarding Rule")
+	err = computeSharedOperationWait(config, op, project, "Deleting GlobalForwarding Rule")
 	if err != nil {
 		return err
 	}
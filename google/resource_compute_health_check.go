@@ -320,12 +320,14 @@ func resourceComputeHealthCheckCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HealthCheck",
+		config, op, project, "Creating HealthCheck",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create HealthCheck: %s", waitErr)
 	}
 
@@ -491,7 +493,7 @@ func resourceComputeHealthCheckUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HealthCheck",
+		config, op, project, "Updating HealthCheck",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -527,7 +529,7 @@ func resourceComputeHealthCheckDelete(d *schema.ResourceData, meta interface{})
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HealthCheck",
+		config, op, project, "Deleting HealthCheck",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -192,12 +192,14 @@ func resourceComputeHttpHealthCheckCreate(d *schema.ResourceData, meta interface
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HttpHealthCheck",
+		config, op, project, "Creating HttpHealthCheck",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create HttpHealthCheck: %s", waitErr)
 	}
 
@@ -346,7 +348,7 @@ func resourceComputeHttpHealthCheckUpdate(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HttpHealthCheck",
+		config, op, project, "Updating HttpHealthCheck",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -382,7 +384,7 @@ func resourceComputeHttpHealthCheckDelete(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HttpHealthCheck",
+		config, op, project, "Deleting HttpHealthCheck",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
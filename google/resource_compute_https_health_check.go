@@ -192,12 +192,14 @@ func resourceComputeHttpsHealthCheckCreate(d *schema.ResourceData, meta interfac
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating HttpsHealthCheck",
+		config, op, project, "Creating HttpsHealthCheck",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create HttpsHealthCheck: %s", waitErr)
 	}
 
@@ -346,7 +348,7 @@ func resourceComputeHttpsHealthCheckUpdate(d *schema.ResourceData, meta interfac
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Updating HttpsHealthCheck",
+		config, op, project, "Updating HttpsHealthCheck",
 		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 	if err != nil {
@@ -382,7 +384,7 @@ func resourceComputeHttpsHealthCheckDelete(d *schema.ResourceData, meta interfac
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting HttpsHealthCheck",
+		config, op, project, "Deleting HttpsHealthCheck",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
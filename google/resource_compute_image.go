@@ -227,12 +227,14 @@ func resourceComputeImageCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Image",
+		config, op, project, "Creating Image",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Image: %s", waitErr)
 	}
 
@@ -339,7 +341,7 @@ func resourceComputeImageUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Image",
+			config, op, project, "Updating Image",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -381,7 +383,7 @@ func resourceComputeImageDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Image",
+		config, op, project, "Deleting Image",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
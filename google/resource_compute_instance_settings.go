@@ -0,0 +1,230 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	compute "google.golang.org/api/compute/v1"
+)
+
+func resourceComputeInstanceSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInstanceSettingsCreate,
+		Read:   resourceComputeInstanceSettingsRead,
+		Update: resourceComputeInstanceSettingsUpdate,
+		Delete: resourceComputeInstanceSettingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceComputeInstanceSettingsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metadata": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"items": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInstanceSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// Every zone always has instance settings, so creating them means
+	// overwriting whatever metadata defaults are already there.
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/instanceSettings")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Creating InstanceSettings %q", d.Id())
+	if err := patchComputeInstanceSettingsMetadata(d, config, expandComputeInstanceSettingsMetadata(d.Get("metadata")), "Creating InstanceSettings", d.Timeout(schema.TimeoutCreate)); err != nil {
+		if !isOperationCancelledError(err) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished creating InstanceSettings %q", d.Id())
+
+	return resourceComputeInstanceSettingsRead(d, meta)
+}
+
+func resourceComputeInstanceSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := getComputeInstanceSettings(d, config)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("ComputeInstanceSettings %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading InstanceSettings: %s", err)
+	}
+	if err := d.Set("fingerprint", res["fingerprint"]); err != nil {
+		return fmt.Errorf("Error reading InstanceSettings: %s", err)
+	}
+	if err := d.Set("metadata", flattenComputeInstanceSettingsMetadata(res["metadata"])); err != nil {
+		return fmt.Errorf("Error reading InstanceSettings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceComputeInstanceSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("metadata") {
+		log.Printf("[DEBUG] Updating InstanceSettings %q", d.Id())
+		if err := patchComputeInstanceSettingsMetadata(d, config, expandComputeInstanceSettingsMetadata(d.Get("metadata")), "Updating InstanceSettings", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceComputeInstanceSettingsRead(d, meta)
+}
+
+func resourceComputeInstanceSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// The settings themselves can't be deleted, so clear the metadata
+	// defaults instead.
+	log.Printf("[DEBUG] Deleting InstanceSettings %q", d.Id())
+	if err := patchComputeInstanceSettingsMetadata(d, config, map[string]interface{}{"items": map[string]interface{}{}}, "Deleting InstanceSettings", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return handleNotFoundError(err, d, "InstanceSettings")
+	}
+
+	log.Printf("[DEBUG] Finished deleting InstanceSettings %q", d.Id())
+	return nil
+}
+
+func resourceComputeInstanceSettingsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/zones/(?P<zone>[^/]+)/instanceSettings",
+		"(?P<project>[^/]+)/(?P<zone>[^/]+)",
+		"(?P<zone>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/zones/{{zone}}/instanceSettings")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getComputeInstanceSettings(d *schema.ResourceData, config *Config) (map[string]interface{}, error) {
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/instanceSettings")
+	if err != nil {
+		return nil, err
+	}
+	return sendRequest(config, "GET", url, nil)
+}
+
+// patchComputeInstanceSettingsMetadata replaces the metadata defaults of the
+// zone. The current fingerprint is read first, as the API rejects writes
+// without it.
+func patchComputeInstanceSettingsMetadata(d *schema.ResourceData, config *Config, metadata map[string]interface{}, activity string, timeout time.Duration) error {
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	current, err := getComputeInstanceSettings(d, config)
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"fingerprint": current["fingerprint"],
+		"metadata":    metadata,
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/zones/{{zone}}/instanceSettings?update_mask=metadata")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, timeout)
+	if err != nil {
+		return fmt.Errorf("Error updating InstanceSettings %q: %s", d.Id(), err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	return computeOperationWaitTime(config, op, project, activity, int(timeout.Minutes()))
+}
+
+func expandComputeInstanceSettingsMetadata(v interface{}) map[string]interface{} {
+	items := map[string]interface{}{}
+	l := v.([]interface{})
+	if len(l) > 0 && l[0] != nil {
+		raw := l[0].(map[string]interface{})
+		if m, ok := raw["items"].(map[string]interface{}); ok {
+			items = m
+		}
+	}
+	return map[string]interface{}{"items": items}
+}
+
+func flattenComputeInstanceSettingsMetadata(v interface{}) []interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	items, ok := m["items"].(map[string]interface{})
+	if !ok || len(items) == 0 {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"items": items,
+		},
+	}
+}
@@ -0,0 +1,74 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeInstanceSettings_update(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceSettings_basic("TRUE"),
+			},
+			{
+				ResourceName:      "google_compute_instance_settings.gce_instance_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeInstanceSettings_basic("FALSE"),
+				Check:  resource.TestCheckResourceAttr("google_compute_instance_settings.gce_instance_settings", "metadata.0.items.enable-oslogin", "FALSE"),
+			},
+			{
+				ResourceName:      "google_compute_instance_settings.gce_instance_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceSettingsDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_compute_instance_settings" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("https://www.googleapis.com/compute/v1/%s", rs.Primary.ID)
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			return err
+		}
+
+		if items := flattenComputeInstanceSettingsMetadata(res["metadata"]); len(items) > 0 {
+			return fmt.Errorf("InstanceSettings metadata still set at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccComputeInstanceSettings_basic(oslogin string) string {
+	return fmt.Sprintf(`
+resource "google_compute_instance_settings" "gce_instance_settings" {
+  zone = "us-east7-b"
+
+  metadata {
+    items = {
+      enable-oslogin = "%s"
+    }
+  }
+}
+`, oslogin)
+}
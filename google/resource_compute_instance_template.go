@@ -694,7 +694,7 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 	// Store the ID now
 	d.SetId(instanceTemplate.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Instance Template")
+	err = computeSharedOperationWait(config, op, project, "Creating Instance Template")
 	if err != nil {
 		return err
 	}
@@ -880,7 +880,7 @@ func resourceComputeInstanceTemplateDelete(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error deleting instance template: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Instance Template")
+	err = computeOperationWait(config, op, project, "Deleting Instance Template")
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package google
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -691,6 +692,24 @@ func TestAccComputeInstance_subnet_auto(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_subnet_customModeNetworkOnly(t *testing.T) {
+	t.Parallel()
+
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeInstance_subnet_customModeNetworkOnly(instanceName),
+				ExpectError: regexp.MustCompile("custom-mode network, a subnetwork must be specified"),
+			},
+		},
+	})
+}
+
 func TestAccComputeInstance_subnet_custom(t *testing.T) {
 	t.Parallel()
 
@@ -1080,7 +1099,7 @@ func testAccCheckComputeInstanceUpdateMachineType(n string) resource.TestCheckFu
 		if err != nil {
 			return fmt.Errorf("Could not stop instance: %s", err)
 		}
-		err = computeOperationWait(config.clientCompute, op, config.Project, "Waiting on stop")
+		err = computeOperationWait(config, op, config.Project, "Waiting on stop")
 		if err != nil {
 			return fmt.Errorf("Could not stop instance: %s", err)
 		}
@@ -1094,7 +1113,7 @@ func testAccCheckComputeInstanceUpdateMachineType(n string) resource.TestCheckFu
 		if err != nil {
 			return fmt.Errorf("Could not change machine type: %s", err)
 		}
-		err = computeOperationWait(config.clientCompute, op, config.Project, "Waiting machine type change")
+		err = computeOperationWait(config, op, config.Project, "Waiting machine type change")
 		if err != nil {
 			return fmt.Errorf("Could not change machine type: %s", err)
 		}
@@ -2473,6 +2492,37 @@ resource "google_compute_instance" "foobar" {
 `, acctest.RandString(10), instance)
 }
 
+func testAccComputeInstance_subnet_customModeNetworkOnly(instance string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_network" "inst-test-network" {
+	name = "inst-test-network-%s"
+
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_instance" "foobar" {
+	name         = "%s"
+	machine_type = "n1-standard-1"
+	zone         = "us-central1-a"
+
+	boot_disk {
+		initialize_params{
+			image = "${data.google_compute_image.my_image.self_link}"
+		}
+	}
+
+	network_interface {
+		network = "${google_compute_network.inst-test-network.name}"
+	}
+}
+`, acctest.RandString(10), instance)
+}
+
 func testAccComputeInstance_subnet_custom(instance string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
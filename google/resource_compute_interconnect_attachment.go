@@ -240,12 +240,14 @@ func resourceComputeInterconnectAttachmentCreate(d *schema.ResourceData, meta in
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating InterconnectAttachment",
+		config, op, project, "Creating InterconnectAttachment",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create InterconnectAttachment: %s", waitErr)
 	}
 
@@ -359,7 +361,7 @@ func resourceComputeInterconnectAttachmentDelete(d *schema.ResourceData, meta in
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting InterconnectAttachment",
+		config, op, project, "Deleting InterconnectAttachment",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -164,12 +164,14 @@ func resourceComputeNetworkCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Network",
+		config, op, project, "Creating Network",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Network: %s", waitErr)
 	}
 
@@ -193,7 +195,7 @@ func resourceComputeNetworkCreate(d *schema.ResourceData, meta interface{}) erro
 				if err != nil {
 					return fmt.Errorf("Error deleting route: %s", err)
 				}
-				err = computeSharedOperationWait(config.clientCompute, op, project, "Deleting Route")
+				err = computeSharedOperationWait(config, op, project, "Deleting Route")
 				if err != nil {
 					return err
 				}
@@ -296,7 +298,7 @@ func resourceComputeNetworkUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Network",
+			config, op, project, "Updating Network",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -337,7 +339,7 @@ func resourceComputeNetworkDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Network",
+		config, op, project, "Deleting Network",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
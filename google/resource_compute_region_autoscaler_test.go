@@ -44,6 +44,37 @@ func TestAccComputeRegionAutoscaler_update(t *testing.T) {
 	})
 }
 
+func TestAccComputeRegionAutoscaler_scalingSchedule(t *testing.T) {
+	var it_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+	var tp_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+	var igm_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+	var autoscaler_name = fmt.Sprintf("region-autoscaler-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRegionAutoscalerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name, false, 1),
+			},
+			{
+				ResourceName:      "google_compute_region_autoscaler.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name, true, 3),
+			},
+			{
+				ResourceName:      "google_compute_region_autoscaler.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckComputeRegionAutoscalerExists(n string, ascaler *compute.Autoscaler) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -223,3 +254,77 @@ resource "google_compute_region_autoscaler" "foobar" {
 }
 `, it_name, tp_name, igm_name, autoscaler_name)
 }
+
+func testAccComputeRegionAutoscaler_scalingSchedule(it_name, tp_name, igm_name, autoscaler_name string, disabled bool, fixed int) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+	family  = "debian-9"
+	project = "debian-cloud"
+}
+
+resource "google_compute_instance_template" "foobar" {
+	name = "%s"
+	machine_type = "n1-standard-1"
+	can_ip_forward = false
+	tags = ["foo", "bar"]
+
+	disk {
+		source_image = "${data.google_compute_image.my_image.self_link}"
+		auto_delete = true
+		boot = true
+	}
+
+	network_interface {
+		network = "default"
+	}
+
+	service_account {
+		scopes = ["userinfo-email", "compute-ro", "storage-ro"]
+	}
+}
+
+resource "google_compute_target_pool" "foobar" {
+	description = "Resource created for Terraform acceptance testing"
+	name = "%s"
+	session_affinity = "CLIENT_IP_PROTO"
+}
+
+resource "google_compute_region_instance_group_manager" "foobar" {
+	description = "Terraform test instance group manager"
+	name = "%s"
+	instance_template = "${google_compute_instance_template.foobar.self_link}"
+	target_pools = ["${google_compute_target_pool.foobar.self_link}"]
+	base_instance_name = "foobar"
+	region = "us-central1"
+}
+
+resource "google_compute_region_autoscaler" "foobar" {
+	description = "Resource created for Terraform acceptance testing"
+	name = "%s"
+	region = "us-central1"
+	target = "${google_compute_region_instance_group_manager.foobar.self_link}"
+	autoscaling_policy {
+		max_replicas = 10
+		min_replicas = 0
+		cooldown_period = 60
+		cpu_utilization {
+			target = 0.5
+			predictive_method = "OPTIMIZE_AVAILABILITY"
+		}
+		scale_in_control {
+			max_scaled_in_replicas {
+				fixed = %d
+			}
+			time_window_sec = 600
+		}
+		scaling_schedules {
+			name = "nightly-batch"
+			min_required_replicas = 3
+			schedule = "0 1 * * *"
+			duration_sec = 7200
+			disabled = %t
+		}
+	}
+}
+`, it_name, tp_name, igm_name, autoscaler_name, fixed, disabled)
+}
@@ -180,7 +180,7 @@ func resourceComputeRegionBackendServiceCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(service.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Creating Region Backend Service")
+	err = computeSharedOperationWait(config, op, project, "Creating Region Backend Service")
 	if err != nil {
 		return err
 	}
@@ -288,7 +288,7 @@ func resourceComputeRegionBackendServiceUpdate(d *schema.ResourceData, meta inte
 
 	d.SetId(service.Name)
 
-	err = computeSharedOperationWait(config.clientCompute, op, project, "Updating Backend Service")
+	err = computeSharedOperationWait(config, op, project, "Updating Backend Service")
 	if err != nil {
 		return err
 	}
@@ -316,7 +316,7 @@ func resourceComputeRegionBackendServiceDelete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error deleting backend service: %s", err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Deleting Backend Service")
+	err = computeOperationWait(config, op, project, "Deleting Backend Service")
 	if err != nil {
 		return err
 	}
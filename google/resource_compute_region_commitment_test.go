@@ -0,0 +1,135 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestAccComputeRegionCommitment_computeRegionCommitmentBasicExample(t *testing.T) {
+	t.Parallel()
+
+	// Commitments are billed until they end and can't be deleted, so this test
+	// never cleans up after itself.
+	t.Skip("Creating a commitment purchases it for the full term. Run manually.")
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeRegionCommitment_computeRegionCommitmentBasicExample(context, true),
+			},
+			{
+				ResourceName:      "google_compute_region_commitment.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeRegionCommitment_computeRegionCommitmentBasicExample(context, false),
+				Check:  resource.TestCheckResourceAttr("google_compute_region_commitment.foobar", "auto_renew", "false"),
+			},
+			{
+				ResourceName:      "google_compute_region_commitment.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccComputeRegionCommitment_computeRegionCommitmentBasicExample(context map[string]interface{}, autoRenew bool) string {
+	context["auto_renew"] = autoRenew
+	return Nprintf(`
+resource "google_compute_region_commitment" "foobar" {
+  name   = "my-region-commitment-%{random_suffix}"
+  plan   = "TWELVE_MONTH"
+  region = "us-central1"
+
+  resources {
+    type   = "VCPU"
+    amount = "4"
+  }
+
+  resources {
+    type   = "MEMORY"
+    amount = "9"
+  }
+
+  auto_renew = %{auto_renew}
+}
+`, context)
+}
+
+func TestComputeRegionCommitmentFixed64Flatteners(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Input    interface{}
+		Expected interface{}
+	}{
+		"string": {
+			Input:    "4",
+			Expected: int64(4),
+		},
+		"large string": {
+			Input:    "7291834093123612345",
+			Expected: int64(7291834093123612345),
+		},
+		"not a number": {
+			Input:    "abc",
+			Expected: "abc",
+		},
+		"float": {
+			Input:    float64(9),
+			Expected: float64(9),
+		},
+		"nil": {
+			Input:    nil,
+			Expected: nil,
+		},
+	}
+
+	flatteners := map[string]func(interface{}, *schema.ResourceData) interface{}{
+		"commitment_id":           flattenComputeRegionCommitmentCommitmentId,
+		"resources.amount":        flattenComputeRegionCommitmentResourcesAmount,
+		"license_resource.amount": flattenComputeRegionCommitmentLicenseResourceAmount,
+	}
+
+	for fn, flatten := range flatteners {
+		for tn, tc := range cases {
+			if got := flatten(tc.Input, nil); got != tc.Expected {
+				t.Errorf("bad: %s/%s, expected %#v, got %#v", fn, tn, tc.Expected, got)
+			}
+		}
+	}
+}
+
+func TestComputeRegionCommitmentUpdateUrl(t *testing.T) {
+	t.Parallel()
+
+	d := &ResourceDataMock{
+		FieldsInSchema: map[string]interface{}{
+			"project":    "my-project",
+			"region":     "us-central1",
+			"name":       "my-commitment",
+			"auto_renew": true,
+		},
+	}
+
+	url, err := computeRegionCommitmentUpdateUrl(d, &Config{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "https://www.googleapis.com/compute/v1/projects/my-project/regions/us-central1/commitments/my-commitment?updateMask=autoRenew"
+	if url != expected {
+		t.Errorf("bad url: expected %q, got %q", expected, url)
+	}
+}
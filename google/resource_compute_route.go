@@ -42,9 +42,10 @@ func resourceComputeRoute() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"dest_range": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateIpv4CidrRange,
 			},
 			"name": {
 				Type:         schema.TypeString,
@@ -96,8 +97,10 @@ func resourceComputeRoute() *schema.Resource {
 				Type:     schema.TypeSet,
 				Optional: true,
 				ForceNew: true,
+				MaxItems: 64,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateGCPName,
 				},
 				Set: schema.HashString,
 			},
@@ -218,12 +221,14 @@ func resourceComputeRouteCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Route",
+		config, op, project, "Creating Route",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Route: %s", waitErr)
 	}
 
@@ -324,7 +329,7 @@ func resourceComputeRouteDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Route",
+		config, op, project, "Deleting Route",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -37,6 +38,78 @@ func TestAccComputeRoute_defaultInternetGateway(t *testing.T) {
 	})
 }
 
+func TestAccComputeRoute_invalidArguments(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComputeRoute_invalidDestRange(),
+				ExpectError: regexp.MustCompile("is not a valid IPv4 CIDR range"),
+			},
+			{
+				Config:      testAccComputeRoute_invalidTag(),
+				ExpectError: regexp.MustCompile("doesn't match regexp"),
+			},
+		},
+	})
+}
+
+func TestComputeRoute_validation(t *testing.T) {
+	cases := map[string]struct {
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		"valid": {
+			Config: map[string]interface{}{"dest_range": "10.0.0.0/16", "tags": []interface{}{"web", "db-1"}},
+		},
+		"dest range without prefix": {
+			Config:      map[string]interface{}{"dest_range": "10.0.0.0"},
+			ExpectError: true,
+		},
+		"ipv6 dest range": {
+			Config:      map[string]interface{}{"dest_range": "2001:db8::/32"},
+			ExpectError: true,
+		},
+		"invalid tag": {
+			Config:      map[string]interface{}{"dest_range": "0.0.0.0/0", "tags": []interface{}{"Invalid_Tag"}},
+			ExpectError: true,
+		},
+		"too many tags": {
+			Config:      map[string]interface{}{"dest_range": "0.0.0.0/0", "tags": computeRouteTestTags(65)},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		tc.Config["name"] = "route-test"
+		tc.Config["network"] = "default"
+		raw, err := config.NewRawConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("%s: %s", tn, err)
+		}
+
+		_, es := resourceComputeRoute().Validate(terraform.NewResourceConfig(raw))
+		if tc.ExpectError && len(es) == 0 {
+			t.Errorf("%s: expected a validation error", tn)
+		}
+		if !tc.ExpectError && len(es) > 0 {
+			t.Errorf("%s: unexpected validation errors: %v", tn, es)
+		}
+	}
+}
+
+func computeRouteTestTags(n int) []interface{} {
+	tags := make([]interface{}, n)
+	for i := range tags {
+		tags[i] = fmt.Sprintf("tag-%d", i)
+	}
+	return tags
+}
+
 func TestAccComputeRoute_hopInstance(t *testing.T) {
 	var route compute.Route
 
@@ -107,6 +180,29 @@ resource "google_compute_route" "foobar" {
 }`, acctest.RandString(10))
 }
 
+func testAccComputeRoute_invalidDestRange() string {
+	return fmt.Sprintf(`
+resource "google_compute_route" "foobar" {
+	name = "route-test-%s"
+	dest_range = "0.0.0.0"
+	network = "default"
+	next_hop_gateway = "default-internet-gateway"
+	priority = 100
+}`, acctest.RandString(10))
+}
+
+func testAccComputeRoute_invalidTag() string {
+	return fmt.Sprintf(`
+resource "google_compute_route" "foobar" {
+	name = "route-test-%s"
+	dest_range = "0.0.0.0/0"
+	network = "default"
+	next_hop_gateway = "default-internet-gateway"
+	priority = 100
+	tags = ["Invalid_Tag"]
+}`, acctest.RandString(10))
+}
+
 func testAccComputeRoute_hopInstance(instanceName, zone string) string {
 	return fmt.Sprintf(`
 data "google_compute_image" "my_image" {
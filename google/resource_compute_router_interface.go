@@ -33,9 +33,17 @@ func resourceComputeRouterInterface() *schema.Resource {
 			},
 			"vpn_tunnel": {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
 				ForceNew:         true,
 				DiffSuppressFunc: linkDiffSuppress,
+				ConflictsWith:    []string{"interconnect_attachment"},
+			},
+			"interconnect_attachment": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: linkDiffSuppress,
+				ConflictsWith:    []string{"vpn_tunnel"},
 			},
 
 			"ip_range": {
@@ -102,13 +110,23 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	vpnTunnel, err := getVpnTunnelLink(config, project, region, d.Get("vpn_tunnel").(string))
-	if err != nil {
-		return err
-	}
+	iface := &compute.RouterInterface{Name: ifaceName}
 
-	iface := &compute.RouterInterface{Name: ifaceName,
-		LinkedVpnTunnel: vpnTunnel}
+	if v, ok := d.GetOk("vpn_tunnel"); ok {
+		vpnTunnel, err := getVpnTunnelLink(config, project, region, v.(string))
+		if err != nil {
+			return err
+		}
+		iface.LinkedVpnTunnel = vpnTunnel
+	} else if v, ok := d.GetOk("interconnect_attachment"); ok {
+		attachment, err := parseRegionalFieldValue("interconnectAttachments", v.(string), "project", "region", "zone", d, config, true)
+		if err != nil {
+			return fmt.Errorf("Invalid value for interconnect_attachment: %s", err)
+		}
+		iface.LinkedInterconnectAttachment = attachment.RelativeLink()
+	} else {
+		return fmt.Errorf("One of vpn_tunnel or interconnect_attachment must be set on router interface %s", ifaceName)
+	}
 
 	if v, ok := d.GetOk("ip_range"); ok {
 		iface.IpRange = v.(string)
@@ -126,9 +144,12 @@ func resourceComputeRouterInterfaceCreate(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, ifaceName))
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
+	err = computeOperationWait(config, op, project, "Patching router")
 	if err != nil {
-		d.SetId("")
+		if !isOperationCancelledError(err) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
 	}
 
@@ -170,6 +191,7 @@ func resourceComputeRouterInterfaceRead(d *schema.ResourceData, meta interface{}
 		if iface.Name == ifaceName {
 			d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, ifaceName))
 			d.Set("vpn_tunnel", iface.LinkedVpnTunnel)
+			d.Set("interconnect_attachment", iface.LinkedInterconnectAttachment)
 			d.Set("ip_range", iface.IpRange)
 			d.Set("region", region)
 			d.Set("project", project)
@@ -250,7 +272,7 @@ func resourceComputeRouterInterfaceDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
+	err = computeOperationWait(config, op, project, "Patching router")
 	if err != nil {
 		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
 	}
@@ -3,10 +3,12 @@ package google
 import (
 	"fmt"
 	"log"
-
+	"strconv"
 	"strings"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/compute/v1"
 	"google.golang.org/api/googleapi"
 )
@@ -15,6 +17,7 @@ func resourceComputeRouterPeer() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeRouterPeerCreate,
 		Read:   resourceComputeRouterPeerRead,
+		Update: resourceComputeRouterPeerUpdate,
 		Delete: resourceComputeRouterPeerDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourceComputeRouterPeerImportState,
@@ -39,23 +42,99 @@ func resourceComputeRouterPeer() *schema.Resource {
 
 			"peer_ip_address": {
 				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Required: true,
 			},
 
 			"peer_asn": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"advertised_route_priority": {
 				Type:     schema.TypeInt,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"advertise_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DEFAULT",
+				ValidateFunc: validation.StringInSlice([]string{"DEFAULT", "CUSTOM"}, false),
+			},
+
+			"advertised_groups": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"ALL_SUBNETS"}, false),
+				},
+			},
+
+			"advertised_ip_ranges": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"range": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"bfd": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"session_initialization_mode": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "DISABLED", "PASSIVE"}, false),
+						},
+						"min_transmit_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1000,
+							ValidateFunc: validation.IntBetween(1000, 30000),
+						},
+						"min_receive_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1000,
+							ValidateFunc: validation.IntBetween(1000, 30000),
+						},
+						"multiplier": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(5, 16),
+						},
+					},
+				},
+			},
+
+			"enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
 			},
 
 			"ip_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"management_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -98,8 +177,7 @@ func resourceComputeRouterPeerCreate(d *schema.ResourceData, meta interface{}) e
 	mutexKV.Lock(routerLock)
 	defer mutexKV.Unlock(routerLock)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	peers, err := getRouterBgpPeers(config, project, region, routerName)
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
@@ -111,47 +189,27 @@ func resourceComputeRouterPeerCreate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
 	}
 
-	peers := router.BgpPeers
-	for _, peer := range peers {
-		if peer.Name == peerName {
-			d.SetId("")
-			return fmt.Errorf("Router %s has peer %s already", routerName, peerName)
-		}
-	}
-
-	ifaceName := d.Get("interface").(string)
-
-	peer := &compute.RouterBgpPeer{Name: peerName,
-		InterfaceName: ifaceName}
-
-	if v, ok := d.GetOk("peer_ip_address"); ok {
-		peer.PeerIpAddress = v.(string)
-	}
-
-	if v, ok := d.GetOk("peer_asn"); ok {
-		peer.PeerAsn = int64(v.(int))
+	if findRouterBgpPeer(peers, peerName) >= 0 {
+		d.SetId("")
+		return fmt.Errorf("Router %s has peer %s already", routerName, peerName)
 	}
 
-	if v, ok := d.GetOk("advertised_route_priority"); ok {
-		peer.AdvertisedRoutePriority = int64(v.(int))
+	peer := map[string]interface{}{
+		"name":          peerName,
+		"interfaceName": d.Get("interface").(string),
 	}
+	expandRouterBgpPeer(d, peer)
 
 	log.Printf("[INFO] Adding peer %s", peerName)
 	peers = append(peers, peer)
-	patchRouter := &compute.Router{
-		BgpPeers: peers,
-	}
 
-	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, peers)
-	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
-	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
-	}
 	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, peerName))
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
-	if err != nil {
-		d.SetId("")
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+	if err := patchRouterBgpPeers(config, project, region, routerName, peers); err != nil {
+		if !isOperationCancelledError(err) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return err
 	}
 
 	return resourceComputeRouterPeerRead(d, meta)
@@ -174,8 +232,7 @@ func resourceComputeRouterPeerRead(d *schema.ResourceData, meta interface{}) err
 	routerName := d.Get("router").(string)
 	peerName := d.Get("name").(string)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	peers, err := getRouterBgpPeers(config, project, region, routerName)
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
@@ -187,26 +244,78 @@ func resourceComputeRouterPeerRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
 	}
 
-	for _, peer := range router.BgpPeers {
-
-		if peer.Name == peerName {
-			d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, peerName))
-			d.Set("interface", peer.InterfaceName)
-			d.Set("peer_ip_address", peer.PeerIpAddress)
-			d.Set("peer_asn", peer.PeerAsn)
-			d.Set("advertised_route_priority", peer.AdvertisedRoutePriority)
-			d.Set("ip_address", peer.IpAddress)
-			d.Set("region", region)
-			d.Set("project", project)
-			return nil
-		}
+	i := findRouterBgpPeer(peers, peerName)
+	if i < 0 {
+		log.Printf("[WARN] Removing router peer %s/%s/%s because it is gone", region, routerName, peerName)
+		d.SetId("")
+		return nil
 	}
+	peer := peers[i].(map[string]interface{})
 
-	log.Printf("[WARN] Removing router peer %s/%s/%s because it is gone", region, routerName, peerName)
-	d.SetId("")
+	d.SetId(fmt.Sprintf("%s/%s/%s", region, routerName, peerName))
+	d.Set("interface", peer["interfaceName"])
+	d.Set("peer_ip_address", peer["peerIpAddress"])
+	d.Set("peer_asn", flattenRouterBgpPeerInt(peer["peerAsn"]))
+	d.Set("advertised_route_priority", flattenRouterBgpPeerInt(peer["advertisedRoutePriority"]))
+	d.Set("advertise_mode", peer["advertiseMode"])
+	d.Set("ip_address", peer["ipAddress"])
+	d.Set("management_type", peer["managementType"])
+	// The API only returns enable when the peer has been disabled.
+	d.Set("enable", peer["enable"] != "FALSE")
+	if err := d.Set("advertised_groups", peer["advertisedGroups"]); err != nil {
+		return fmt.Errorf("Error setting advertised_groups: %s", err)
+	}
+	if err := d.Set("advertised_ip_ranges", flattenRouterBgpPeerAdvertisedIpRanges(peer["advertisedIpRanges"])); err != nil {
+		return fmt.Errorf("Error setting advertised_ip_ranges: %s", err)
+	}
+	if err := d.Set("bfd", flattenRouterBgpPeerBfd(peer["bfd"])); err != nil {
+		return fmt.Errorf("Error setting bfd: %s", err)
+	}
+	d.Set("region", region)
+	d.Set("project", project)
 	return nil
 }
 
+func resourceComputeRouterPeerUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	config := meta.(*Config)
+
+	region, err := getRegion(d, config)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	routerName := d.Get("router").(string)
+	peerName := d.Get("name").(string)
+
+	routerLock := getRouterLockName(region, routerName)
+	mutexKV.Lock(routerLock)
+	defer mutexKV.Unlock(routerLock)
+
+	peers, err := getRouterBgpPeers(config, project, region, routerName)
+	if err != nil {
+		return fmt.Errorf("Error Reading router %s/%s: %s", region, routerName, err)
+	}
+
+	i := findRouterBgpPeer(peers, peerName)
+	if i < 0 {
+		return fmt.Errorf("Router %s/%s has no peer %s", region, routerName, peerName)
+	}
+	expandRouterBgpPeer(d, peers[i].(map[string]interface{}))
+
+	log.Printf("[INFO] Updating peer %s", peerName)
+	if err := patchRouterBgpPeers(config, project, region, routerName, peers); err != nil {
+		return err
+	}
+
+	return resourceComputeRouterPeerRead(d, meta)
+}
+
 func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) error {
 
 	config := meta.(*Config)
@@ -228,8 +337,7 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 	mutexKV.Lock(routerLock)
 	defer mutexKV.Unlock(routerLock)
 
-	routersService := config.clientCompute.Routers
-	router, err := routersService.Get(project, region, routerName).Do()
+	peers, err := getRouterBgpPeers(config, project, region, routerName)
 	if err != nil {
 		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
 			log.Printf("[WARN] Removing router peer %s because its router %s/%s is gone", peerName, region, routerName)
@@ -240,16 +348,8 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Reading Router %s: %s", routerName, err)
 	}
 
-	var newPeers []*compute.RouterBgpPeer = make([]*compute.RouterBgpPeer, 0, len(router.BgpPeers))
-	for _, peer := range router.BgpPeers {
-		if peer.Name == peerName {
-			continue
-		} else {
-			newPeers = append(newPeers, peer)
-		}
-	}
-
-	if len(newPeers) == len(router.BgpPeers) {
+	i := findRouterBgpPeer(peers, peerName)
+	if i < 0 {
 		log.Printf("[DEBUG] Router %s/%s had no peer %s already", region, routerName, peerName)
 		d.SetId("")
 		return nil
@@ -257,23 +357,9 @@ func resourceComputeRouterPeerDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf(
 		"[INFO] Removing peer %s from router %s/%s", peerName, region, routerName)
-	patchRouter := &compute.Router{
-		BgpPeers: newPeers,
-	}
-
-	if len(newPeers) == 0 {
-		patchRouter.ForceSendFields = append(patchRouter.ForceSendFields, "BgpPeers")
-	}
-
-	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, newPeers)
-	op, err := routersService.Patch(project, region, router.Name, patchRouter).Do()
-	if err != nil {
-		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
-	}
-
-	err = computeOperationWait(config.clientCompute, op, project, "Patching router")
-	if err != nil {
-		return fmt.Errorf("Error waiting to patch router %s/%s: %s", region, routerName, err)
+	newPeers := append(peers[:i:i], peers[i+1:]...)
+	if err := patchRouterBgpPeers(config, project, region, routerName, newPeers); err != nil {
+		return err
 	}
 
 	d.SetId("")
@@ -292,3 +378,139 @@ func resourceComputeRouterPeerImportState(d *schema.ResourceData, meta interface
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// The router's peers are read and written as raw JSON rather than through the
+// client library, so that fields the library does not know about, such as
+// BFD, are kept for the peers this resource does not manage.
+func getRouterBgpPeers(config *Config, project, region, routerName string) ([]interface{}, error) {
+	url := fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/regions/%s/routers/%s", project, region, routerName)
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	peers, _ := res["bgpPeers"].([]interface{})
+	return peers, nil
+}
+
+func patchRouterBgpPeers(config *Config, project, region, routerName string, peers []interface{}) error {
+	if peers == nil {
+		peers = []interface{}{}
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/compute/v1/projects/%s/regions/%s/routers/%s", project, region, routerName)
+	log.Printf("[DEBUG] Updating router %s/%s with peers: %+v", region, routerName, peers)
+	res, err := sendRequest(config, "PATCH", url, map[string]interface{}{"bgpPeers": peers})
+	if err != nil {
+		return fmt.Errorf("Error patching router %s/%s: %s", region, routerName, err)
+	}
+
+	op := &compute.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
+	err = computeOperationWait(config, op, project, "Patching router")
+	if err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error waiting to patch router %s/%s: {{err}}", region, routerName), err)
+	}
+	return nil
+}
+
+func findRouterBgpPeer(peers []interface{}, name string) int {
+	for i, raw := range peers {
+		if peer, ok := raw.(map[string]interface{}); ok && peer["name"] == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// expandRouterBgpPeer writes the configurable fields of the peer into its API
+// representation, leaving the fields Terraform does not manage untouched.
+func expandRouterBgpPeer(d *schema.ResourceData, peer map[string]interface{}) {
+	setOrDelete := func(key string, v interface{}, ok bool) {
+		if ok {
+			peer[key] = v
+		} else {
+			delete(peer, key)
+		}
+	}
+
+	v, ok := d.GetOk("peer_ip_address")
+	setOrDelete("peerIpAddress", v, ok)
+	v, ok = d.GetOk("peer_asn")
+	setOrDelete("peerAsn", v, ok)
+	v, ok = d.GetOk("advertised_route_priority")
+	setOrDelete("advertisedRoutePriority", v, ok)
+	v, ok = d.GetOk("ip_address")
+	setOrDelete("ipAddress", v, ok)
+
+	peer["advertiseMode"] = d.Get("advertise_mode").(string)
+	peer["advertisedGroups"] = d.Get("advertised_groups").([]interface{})
+
+	ranges := make([]interface{}, 0)
+	for _, raw := range d.Get("advertised_ip_ranges").([]interface{}) {
+		r := raw.(map[string]interface{})
+		ranges = append(ranges, map[string]interface{}{
+			"range":       r["range"],
+			"description": r["description"],
+		})
+	}
+	peer["advertisedIpRanges"] = ranges
+
+	if l := d.Get("bfd").([]interface{}); len(l) > 0 && l[0] != nil {
+		bfd := l[0].(map[string]interface{})
+		peer["bfd"] = map[string]interface{}{
+			"sessionInitializationMode": bfd["session_initialization_mode"],
+			"minTransmitInterval":       bfd["min_transmit_interval"],
+			"minReceiveInterval":        bfd["min_receive_interval"],
+			"multiplier":                bfd["multiplier"],
+		}
+	}
+
+	if d.Get("enable").(bool) {
+		peer["enable"] = "TRUE"
+	} else {
+		peer["enable"] = "FALSE"
+	}
+}
+
+func flattenRouterBgpPeerInt(v interface{}) interface{} {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		// int64 fields are returned as strings
+		if i, err := strconv.Atoi(n); err == nil {
+			return i
+		}
+	}
+	return v
+}
+
+func flattenRouterBgpPeerAdvertisedIpRanges(v interface{}) []map[string]interface{} {
+	l, _ := v.([]interface{})
+	ranges := make([]map[string]interface{}, 0, len(l))
+	for _, raw := range l {
+		r := raw.(map[string]interface{})
+		ranges = append(ranges, map[string]interface{}{
+			"range":       r["range"],
+			"description": r["description"],
+		})
+	}
+	return ranges
+}
+
+func flattenRouterBgpPeerBfd(v interface{}) []map[string]interface{} {
+	bfd, ok := v.(map[string]interface{})
+	if !ok || len(bfd) == 0 {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"session_initialization_mode": bfd["sessionInitializationMode"],
+		"min_transmit_interval":       flattenRouterBgpPeerInt(bfd["minTransmitInterval"]),
+		"min_receive_interval":        flattenRouterBgpPeerInt(bfd["minReceiveInterval"]),
+		"multiplier":                  flattenRouterBgpPeerInt(bfd["multiplier"]),
+	}}
+}
@@ -37,9 +37,12 @@ func resourceComputeSharedVpcHostProjectCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(hostProject)
 
-	err = computeOperationWait(config.clientCompute, op, hostProject, "Enabling Shared VPC Host")
+	err = computeOperationWait(config, op, hostProject, "Enabling Shared VPC Host")
 	if err != nil {
-		d.SetId("")
+		if !isOperationCancelledError(err) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return err
 	}
 
@@ -75,7 +78,7 @@ func resourceComputeSharedVpcHostProjectDelete(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error disabling Shared VPC Host %q: %s", hostProject, err)
 	}
 
-	err = computeOperationWait(config.clientCompute, op, hostProject, "Disabling Shared VPC Host")
+	err = computeOperationWait(config, op, hostProject, "Disabling Shared VPC Host")
 	if err != nil {
 		return err
 	}
@@ -261,12 +261,14 @@ func resourceComputeSnapshotCreate(d *schema.ResourceData, meta interface{}) err
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Snapshot",
+		config, op, project, "Creating Snapshot",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Snapshot: %s", waitErr)
 	}
 
@@ -381,7 +383,7 @@ func resourceComputeSnapshotUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Snapshot",
+			config, op, project, "Updating Snapshot",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -423,7 +425,7 @@ func resourceComputeSnapshotDelete(d *schema.ResourceData, meta interface{}) err
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Snapshot",
+		config, op, project, "Deleting Snapshot",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
@@ -290,12 +290,14 @@ func resourceComputeSubnetworkCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating Subnetwork",
+		config, op, project, "Creating Subnetwork",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Subnetwork: %s", waitErr)
 	}
 
@@ -399,7 +401,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Subnetwork",
+			config, op, project, "Updating Subnetwork",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -449,7 +451,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Subnetwork",
+			config, op, project, "Updating Subnetwork",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -489,7 +491,7 @@ func resourceComputeSubnetworkUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating Subnetwork",
+			config, op, project, "Updating Subnetwork",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -530,7 +532,7 @@ func resourceComputeSubnetworkDelete(d *schema.ResourceData, meta interface{}) e
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting Subnetwork",
+		config, op, project, "Deleting Subnetwork",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
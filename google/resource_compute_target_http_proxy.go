@@ -132,12 +132,14 @@ func resourceComputeTargetHttpProxyCreate(d *schema.ResourceData, meta interface
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating TargetHttpProxy",
+		config, op, project, "Creating TargetHttpProxy",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create TargetHttpProxy: %s", waitErr)
 	}
 
@@ -223,7 +225,7 @@ func resourceComputeTargetHttpProxyUpdate(d *schema.ResourceData, meta interface
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpProxy",
+			config, op, project, "Updating TargetHttpProxy",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -264,7 +266,7 @@ func resourceComputeTargetHttpProxyDelete(d *schema.ResourceData, meta interface
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting TargetHttpProxy",
+		config, op, project, "Deleting TargetHttpProxy",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
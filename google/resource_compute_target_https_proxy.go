@@ -169,12 +169,14 @@ func resourceComputeTargetHttpsProxyCreate(d *schema.ResourceData, meta interfac
 	}
 
 	waitErr := computeOperationWaitTime(
-		config.clientCompute, op, project, "Creating TargetHttpsProxy",
+		config, op, project, "Creating TargetHttpsProxy",
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create TargetHttpsProxy: %s", waitErr)
 	}
 
@@ -269,7 +271,7 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpsProxy",
+			config, op, project, "Updating TargetHttpsProxy",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -307,7 +309,7 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpsProxy",
+			config, op, project, "Updating TargetHttpsProxy",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -345,7 +347,7 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpsProxy",
+			config, op, project, "Updating TargetHttpsProxy",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -383,7 +385,7 @@ func resourceComputeTargetHttpsProxyUpdate(d *schema.ResourceData, meta interfac
 		}
 
 		err = computeOperationWaitTime(
-			config.clientCompute, op, project, "Updating TargetHttpsProxy",
+			config, op, project, "Updating TargetHttpsProxy",
 			int(d.Timeout(schema.TimeoutUpdate).Minutes()))
 
 		if err != nil {
@@ -424,7 +426,7 @@ func resourceComputeTargetHttpsProxyDelete(d *schema.ResourceData, meta interfac
 	}
 
 	err = computeOperationWaitTime(
-		config.clientCompute, op, project, "Deleting TargetHttpsProxy",
+		config, op, project, "Deleting TargetHttpsProxy",
 		int(d.Timeout(schema.TimeoutDelete).Minutes()))
 
 	if err != nil {
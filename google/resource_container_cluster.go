@@ -206,9 +206,9 @@ func resourceContainerCluster() *schema.Resource {
 
 			"cluster_autoscaling": {
 				Type:     schema.TypeList,
+				Optional: true,
 				Computed: true,
 				MaxItems: 1,
-				Removed:  "This field is in beta. Use it in the the google-beta provider instead. See https://terraform.io/docs/providers/google/provider_versions.html for more details.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -235,6 +235,12 @@ func resourceContainerCluster() *schema.Resource {
 								},
 							},
 						},
+						"autoscaling_profile": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "BALANCED",
+							ValidateFunc: validation.StringInSlice([]string{"BALANCED", "OPTIMIZE_UTILIZATION"}, false),
+						},
 					},
 				},
 			},
@@ -627,11 +633,41 @@ func resourceContainerCluster() *schema.Resource {
 				Optional: true,
 			},
 
+			"release_channel": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"UNSPECIFIED", "RAPID", "REGULAR", "STABLE"}, false),
+						},
+					},
+				},
+			},
+
 			"resource_labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+
+			"workload_identity_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_namespace": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -672,6 +708,7 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		PodSecurityPolicyConfig: expandPodSecurityPolicyConfig(d.Get("pod_security_policy_config")),
 		MasterAuth:              expandMasterAuth(d.Get("master_auth")),
 		ResourceLabels:          expandStringMap(d, "resource_labels"),
+		Autoscaling:             expandClusterAutoscaling(d.Get("cluster_autoscaling")),
 	}
 
 	// Only allow setting node_version on create if it's set to the equivalent master version,
@@ -759,13 +796,20 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		Cluster: cluster,
 	}
 
+	// Release channels, workload identity and the autoscaling profile aren't
+	// exposed by the vendored client, so the request is sent as JSON.
+	obj, err := containerClusterCreateJson(req, d)
+	if err != nil {
+		return err
+	}
+
 	mutexKV.Lock(containerClusterMutexKey(project, location, clusterName))
 	defer mutexKV.Unlock(containerClusterMutexKey(project, location, clusterName))
 
 	parent := fmt.Sprintf("projects/%s/locations/%s", project, location)
 	var op *containerBeta.Operation
 	err = retry(func() error {
-		op, err = config.clientContainerBeta.Projects.Locations.Clusters.Create(parent, req).Do()
+		op, err = sendContainerRequest(config, "POST", parent+"/clusters", obj, d.Timeout(schema.TimeoutCreate))
 		return err
 	})
 	if err != nil {
@@ -777,6 +821,9 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 	// Wait until it's created
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
 	waitErr := containerOperationWait(config, op, project, location, "creating GKE cluster", timeoutInMinutes)
+	if isOperationCancelledError(waitErr) {
+		return waitErr
+	}
 	if waitErr != nil {
 		if deleteErr := cleanFailedContainerCluster(d, meta); deleteErr != nil {
 			log.Printf("[WARN] Unable to clean up cluster from failed creation: %s", deleteErr)
@@ -819,12 +866,17 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	cluster := &containerBeta.Cluster{}
+	var res map[string]interface{}
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
 		name := containerClusterFullName(project, location, d.Get("name").(string))
-		cluster, err = config.clientContainerBeta.Projects.Locations.Clusters.Get(name).Do()
+		res, err = sendRequest(config, "GET", containerBetaBasePath+name, nil)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		cluster = &containerBeta.Cluster{}
+		if err := Convert(res, cluster); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if cluster.Status != "RUNNING" {
 			return resource.RetryableError(fmt.Errorf("Cluster %q has status %q with message %q", d.Get("name"), cluster.Status, cluster.StatusMessage))
 		}
@@ -872,7 +924,7 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("monitoring_service", cluster.MonitoringService)
 	d.Set("network", cluster.NetworkConfig.Network)
 	d.Set("subnetwork", cluster.NetworkConfig.Subnetwork)
-	if err := d.Set("cluster_autoscaling", nil); err != nil {
+	if err := d.Set("cluster_autoscaling", flattenClusterAutoscaling(cluster.Autoscaling, res["autoscaling"])); err != nil {
 		return err
 	}
 	if err := d.Set("node_config", flattenNodeConfig(cluster.NodeConfig)); err != nil {
@@ -882,7 +934,7 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 	if err := d.Set("addons_config", flattenClusterAddonsConfig(cluster.AddonsConfig)); err != nil {
 		return err
 	}
-	nps, err := flattenClusterNodePools(d, config, cluster.NodePools)
+	nps, err := flattenClusterNodePools(d, config, cluster.NodePools, res["nodePools"])
 	if err != nil {
 		return err
 	}
@@ -906,6 +958,14 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	if err := d.Set("release_channel", flattenReleaseChannel(res["releaseChannel"])); err != nil {
+		return err
+	}
+
+	if err := d.Set("workload_identity_config", flattenWorkloadIdentityConfig(res["workloadIdentityConfig"])); err != nil {
+		return err
+	}
+
 	d.Set("resource_labels", cluster.ResourceLabels)
 	return nil
 }
@@ -942,6 +1002,23 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
+	// updateJsonFunc is updateFunc for desired* fields the vendored client
+	// doesn't know about.
+	updateJsonFunc := func(update map[string]interface{}, updateDescription string) func() error {
+		return func() error {
+			name := containerClusterFullName(project, location, clusterName)
+			obj := map[string]interface{}{
+				"update": update,
+			}
+			op, err := sendContainerRequest(config, "PUT", name, obj, d.Timeout(schema.TimeoutUpdate))
+			if err != nil {
+				return err
+			}
+			// Wait until it's updated
+			return containerOperationWait(config, op, project, location, updateDescription, timeoutInMinutes)
+		}
+	}
+
 	// The ClusterUpdate object that we use for most of these updates only allows updating one field at a time,
 	// so we have to make separate calls for each field that we want to update. The order here is fairly arbitrary-
 	// if the order of updating fields does matter, it is called out explicitly.
@@ -962,6 +1039,56 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		d.SetPartial("master_authorized_networks_config")
 	}
 
+	if d.HasChange("cluster_autoscaling") {
+		autoscaling, err := ConvertToMap(expandClusterAutoscaling(d.Get("cluster_autoscaling")))
+		if err != nil {
+			return err
+		}
+		if v, ok := d.GetOk("cluster_autoscaling.0.autoscaling_profile"); ok {
+			autoscaling["autoscalingProfile"] = v.(string)
+		}
+		update := map[string]interface{}{
+			"desiredClusterAutoscaling": autoscaling,
+		}
+
+		updateF := updateJsonFunc(update, "updating GKE cluster autoscaling")
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+		log.Printf("[INFO] GKE cluster %s cluster autoscaling has been updated", d.Id())
+
+		d.SetPartial("cluster_autoscaling")
+	}
+
+	if d.HasChange("release_channel") {
+		update := map[string]interface{}{
+			"desiredReleaseChannel": expandReleaseChannel(d.Get("release_channel")),
+		}
+
+		updateF := updateJsonFunc(update, "updating GKE cluster release channel")
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+		log.Printf("[INFO] GKE cluster %s release channel has been updated", d.Id())
+
+		d.SetPartial("release_channel")
+	}
+
+	if d.HasChange("workload_identity_config") {
+		// Sending an empty identity namespace disables workload identity.
+		update := map[string]interface{}{
+			"desiredWorkloadIdentityConfig": expandWorkloadIdentityConfig(d.Get("workload_identity_config")),
+		}
+
+		updateF := updateJsonFunc(update, "updating GKE cluster workload identity config")
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+		log.Printf("[INFO] GKE cluster %s workload identity config has been updated", d.Id())
+
+		d.SetPartial("workload_identity_config")
+	}
+
 	if d.HasChange("addons_config") {
 		if ac, ok := d.GetOk("addons_config"); ok {
 			req := &containerBeta.UpdateClusterRequest{
@@ -1696,6 +1823,99 @@ func expandPrivateClusterConfig(configured interface{}) *containerBeta.PrivateCl
 	}
 }
 
+func expandClusterAutoscaling(configured interface{}) *containerBeta.ClusterAutoscaling {
+	l, ok := configured.([]interface{})
+	if !ok || l == nil || len(l) == 0 || l[0] == nil {
+		return &containerBeta.ClusterAutoscaling{
+			EnableNodeAutoprovisioning: false,
+			ForceSendFields:            []string{"EnableNodeAutoprovisioning"},
+		}
+	}
+
+	config := l[0].(map[string]interface{})
+
+	var resourceLimits []*containerBeta.ResourceLimit
+	if limits, ok := config["resource_limits"]; ok {
+		for _, v := range limits.([]interface{}) {
+			limit := v.(map[string]interface{})
+			resourceLimits = append(resourceLimits, &containerBeta.ResourceLimit{
+				ResourceType: limit["resource_type"].(string),
+				Minimum:      int64(limit["minimum"].(int)),
+				Maximum:      int64(limit["maximum"].(int)),
+			})
+		}
+	}
+	return &containerBeta.ClusterAutoscaling{
+		EnableNodeAutoprovisioning: config["enabled"].(bool),
+		ResourceLimits:             resourceLimits,
+		ForceSendFields:            []string{"EnableNodeAutoprovisioning"},
+	}
+}
+
+func expandReleaseChannel(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return map[string]interface{}{
+			"channel": "UNSPECIFIED",
+		}
+	}
+	config := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"channel": config["channel"].(string),
+	}
+}
+
+func expandWorkloadIdentityConfig(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return map[string]interface{}{
+			"identityNamespace": "",
+		}
+	}
+	config := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"identityNamespace": config["identity_namespace"].(string),
+	}
+}
+
+// containerClusterCreateJson converts req to JSON and adds the cluster fields
+// the vendored client doesn't have.
+func containerClusterCreateJson(req *containerBeta.CreateClusterRequest, d *schema.ResourceData) (map[string]interface{}, error) {
+	obj, err := ConvertToMap(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, ok := obj["cluster"].(map[string]interface{})
+	if !ok {
+		return obj, nil
+	}
+
+	if v, ok := d.GetOk("release_channel"); ok {
+		cluster["releaseChannel"] = expandReleaseChannel(v)
+	}
+
+	if v, ok := d.GetOk("workload_identity_config"); ok {
+		cluster["workloadIdentityConfig"] = expandWorkloadIdentityConfig(v)
+	}
+
+	if v, ok := d.GetOk("cluster_autoscaling.0.autoscaling_profile"); ok {
+		if autoscaling, ok := cluster["autoscaling"].(map[string]interface{}); ok {
+			autoscaling["autoscalingProfile"] = v.(string)
+		}
+	}
+
+	if nodePools, ok := cluster["nodePools"].([]interface{}); ok {
+		for i, np := range nodePools {
+			if nodePool, ok := np.(map[string]interface{}); ok {
+				addNodePoolUpgradeSettings(nodePool, d, fmt.Sprintf("node_pool.%d.", i))
+			}
+		}
+	}
+
+	return obj, nil
+}
+
 func expandPodSecurityPolicyConfig(configured interface{}) *containerBeta.PodSecurityPolicyConfig {
 	// Removing lists is hard - the element count (#) will have a diff from nil -> computed
 	// If we set this to empty on Read, it will be stable.
@@ -1756,11 +1976,16 @@ func flattenClusterAddonsConfig(c *containerBeta.AddonsConfig) []map[string]inte
 	return []map[string]interface{}{result}
 }
 
-func flattenClusterNodePools(d *schema.ResourceData, config *Config, c []*containerBeta.NodePool) ([]map[string]interface{}, error) {
+func flattenClusterNodePools(d *schema.ResourceData, config *Config, c []*containerBeta.NodePool, raw interface{}) ([]map[string]interface{}, error) {
 	nodePools := make([]map[string]interface{}, 0, len(c))
+	rawNodePools, _ := raw.([]interface{})
 
 	for i, np := range c {
-		nodePool, err := flattenNodePool(d, config, np, fmt.Sprintf("node_pool.%d.", i))
+		var rawNodePool interface{}
+		if i < len(rawNodePools) {
+			rawNodePool = rawNodePools[i]
+		}
+		nodePool, err := flattenNodePool(d, config, np, rawNodePool, fmt.Sprintf("node_pool.%d.", i))
 		if err != nil {
 			return nil, err
 		}
@@ -1818,6 +2043,68 @@ func flattenIPAllocationPolicy(c *containerBeta.IPAllocationPolicy, d *schema.Re
 	}
 }
 
+func flattenClusterAutoscaling(a *containerBeta.ClusterAutoscaling, raw interface{}) []map[string]interface{} {
+	if a == nil {
+		return nil
+	}
+
+	resourceLimits := make([]interface{}, 0, len(a.ResourceLimits))
+	for _, rl := range a.ResourceLimits {
+		resourceLimits = append(resourceLimits, map[string]interface{}{
+			"resource_type": rl.ResourceType,
+			"minimum":       rl.Minimum,
+			"maximum":       rl.Maximum,
+		})
+	}
+
+	profile := "BALANCED"
+	if rawAutoscaling, ok := raw.(map[string]interface{}); ok {
+		if v, ok := rawAutoscaling["autoscalingProfile"].(string); ok && v != "" && v != "PROFILE_UNSPECIFIED" {
+			profile = v
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"enabled":             a.EnableNodeAutoprovisioning,
+			"resource_limits":     resourceLimits,
+			"autoscaling_profile": profile,
+		},
+	}
+}
+
+func flattenReleaseChannel(raw interface{}) []map[string]interface{} {
+	rc, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	channel, ok := rc["channel"].(string)
+	if !ok || channel == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"channel": channel,
+		},
+	}
+}
+
+func flattenWorkloadIdentityConfig(raw interface{}) []map[string]interface{} {
+	wic, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	namespace, ok := wic["identityNamespace"].(string)
+	if !ok || namespace == "" {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"identity_namespace": namespace,
+		},
+	}
+}
+
 func flattenMaintenancePolicy(mp *containerBeta.MaintenancePolicy) []map[string]interface{} {
 	if mp == nil {
 		return nil
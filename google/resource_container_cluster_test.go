@@ -1213,6 +1213,102 @@ func TestAccContainerCluster_withResourceLabelsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccContainerCluster_withReleaseChannel(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withReleaseChannel(clusterName, "STABLE"),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_release_channel",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withReleaseChannel(clusterName, "REGULAR"),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_release_channel",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withWorkloadIdentityConfig(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_withWorkloadIdentityConfig(clusterName),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_workload_identity_config",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_withoutWorkloadIdentityConfig(clusterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_workload_identity_config", "workload_identity_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCluster_withNodeAutoprovisioning(t *testing.T) {
+	t.Parallel()
+
+	clusterName := fmt.Sprintf("cluster-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerCluster_autoprovisioning(clusterName, true, "BALANCED"),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_autoprovisioning",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+			{
+				Config: testAccContainerCluster_autoprovisioning(clusterName, false, "OPTIMIZE_UTILIZATION"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_cluster.with_autoprovisioning", "cluster_autoscaling.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:        "google_container_cluster.with_autoprovisioning",
+				ImportStateIdPrefix: "us-central1-a/",
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
 func TestAccContainerCluster_errorCleanDanglingCluster(t *testing.T) {
 	t.Parallel()
 
@@ -2363,6 +2459,76 @@ resource "google_container_cluster" "with_resource_labels" {
 `, clusterName)
 }
 
+func testAccContainerCluster_withReleaseChannel(clusterName, channel string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_release_channel" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	release_channel {
+		channel = "%s"
+	}
+}
+`, clusterName, channel)
+}
+
+func testAccContainerCluster_withWorkloadIdentityConfig(clusterName string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_container_cluster" "with_workload_identity_config" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	workload_identity_config {
+		identity_namespace = "${data.google_project.project.project_id}.svc.id.goog"
+	}
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_withoutWorkloadIdentityConfig(clusterName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "with_workload_identity_config" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+}
+`, clusterName)
+}
+
+func testAccContainerCluster_autoprovisioning(clusterName string, autoprovisioning bool, profile string) string {
+	config := fmt.Sprintf(`
+resource "google_container_cluster" "with_autoprovisioning" {
+	name = "%s"
+	zone = "us-central1-a"
+	initial_node_count = 1
+
+	cluster_autoscaling {
+		enabled             = %t
+		autoscaling_profile = "%s"
+`, clusterName, autoprovisioning, profile)
+	if autoprovisioning {
+		config += `
+		resource_limits {
+			resource_type = "cpu"
+			maximum       = 2
+		}
+		resource_limits {
+			resource_type = "memory"
+			maximum       = 2048
+		}
+`
+	}
+	config += `
+	}
+}
+`
+	return config
+}
+
 func testAccContainerCluster_withInitialCIDR(clusterName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cidr_error_preempt" {
@@ -159,6 +159,28 @@ var schemaNodePool = map[string]*schema.Schema{
 		ValidateFunc: validation.IntAtLeast(0),
 	},
 
+	"upgrade_settings": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_surge": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+
+				"max_unavailable": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+			},
+		},
+	},
+
 	"version": {
 		Type:     schema.TypeString,
 		Optional: true,
@@ -233,13 +255,22 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 		NodePool: nodePool,
 	}
 
+	// Upgrade settings aren't exposed by the vendored client, so the request
+	// is sent as JSON.
+	obj, err := ConvertToMap(req)
+	if err != nil {
+		return err
+	}
+	if np, ok := obj["nodePool"].(map[string]interface{}); ok {
+		addNodePoolUpgradeSettings(np, d, "")
+	}
+
 	timeout := d.Timeout(schema.TimeoutCreate)
 	startTime := time.Now()
 
 	var operation *containerBeta.Operation
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		operation, err = config.clientContainerBeta.
-			Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), req).Do()
+		operation, err = sendContainerRequest(config, "POST", nodePoolInfo.parent()+"/nodePools", obj, timeout)
 
 		if err != nil {
 			if isFailedPreconditionError(err) {
@@ -263,8 +294,10 @@ func resourceContainerNodePoolCreate(d *schema.ResourceData, meta interface{}) e
 		nodePoolInfo.location, "creating GKE NodePool", int(timeout.Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return waitErr
 	}
 
@@ -284,13 +317,16 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	var nodePool = &containerBeta.NodePool{}
+	var res map[string]interface{}
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-		nodePool, err = config.clientContainerBeta.
-			Projects.Locations.Clusters.NodePools.Get(nodePoolInfo.fullyQualifiedName(name)).Do()
-
+		res, err = sendRequest(config, "GET", containerBetaBasePath+nodePoolInfo.fullyQualifiedName(name), nil)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		nodePool = &containerBeta.NodePool{}
+		if err := Convert(res, nodePool); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		if nodePool.Status != "RUNNING" {
 			return resource.RetryableError(fmt.Errorf("Nodepool %q has status %q with message %q", d.Get("name"), nodePool.Status, nodePool.StatusMessage))
 		}
@@ -301,7 +337,7 @@ func resourceContainerNodePoolRead(d *schema.ResourceData, meta interface{}) err
 		return handleNotFoundError(err, d, fmt.Sprintf("NodePool %q from cluster %q", name, nodePoolInfo.cluster))
 	}
 
-	npMap, err := flattenNodePool(d, config, nodePool, "")
+	npMap, err := flattenNodePool(d, config, nodePool, res, "")
 	if err != nil {
 		return err
 	}
@@ -505,7 +541,43 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*containerBeta.NodeP
 	return np, nil
 }
 
-func flattenNodePool(d *schema.ResourceData, config *Config, np *containerBeta.NodePool, prefix string) (map[string]interface{}, error) {
+// addNodePoolUpgradeSettings adds the configured upgrade settings to the JSON
+// form of a node pool.
+func addNodePoolUpgradeSettings(obj map[string]interface{}, d *schema.ResourceData, prefix string) {
+	if v, ok := d.GetOk(prefix + "upgrade_settings"); ok {
+		obj["upgradeSettings"] = expandUpgradeSettings(v)
+	}
+}
+
+func expandUpgradeSettings(configured interface{}) map[string]interface{} {
+	l := configured.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	upgradeSettings := l[0].(map[string]interface{})
+	return map[string]interface{}{
+		"maxSurge":       upgradeSettings["max_surge"].(int),
+		"maxUnavailable": upgradeSettings["max_unavailable"].(int),
+	}
+}
+
+func flattenUpgradeSettings(raw interface{}) []map[string]interface{} {
+	upgradeSettings, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	// Zero values are omitted from the response.
+	maxSurge, _ := upgradeSettings["maxSurge"].(float64)
+	maxUnavailable, _ := upgradeSettings["maxUnavailable"].(float64)
+	return []map[string]interface{}{
+		{
+			"max_surge":       int(maxSurge),
+			"max_unavailable": int(maxUnavailable),
+		},
+	}
+}
+
+func flattenNodePool(d *schema.ResourceData, config *Config, np *containerBeta.NodePool, raw interface{}, prefix string) (map[string]interface{}, error) {
 	// Node pools don't expose the current node count in their API, so read the
 	// instance groups instead. They should all have the same size, but in case a resize
 	// failed or something else strange happened, we'll just use the average size.
@@ -548,6 +620,10 @@ func flattenNodePool(d *schema.ResourceData, config *Config, np *containerBeta.N
 		},
 	}
 
+	if rawNodePool, ok := raw.(map[string]interface{}); ok {
+		nodePool["upgrade_settings"] = flattenUpgradeSettings(rawNodePool["upgradeSettings"])
+	}
+
 	return nodePool, nil
 }
 
@@ -709,6 +785,36 @@ func nodePoolUpdate(d *schema.ResourceData, meta interface{}, nodePoolInfo *Node
 		}
 	}
 
+	if d.HasChange(prefix + "upgrade_settings") {
+		obj := map[string]interface{}{
+			"nodePoolId":      name,
+			"upgradeSettings": expandUpgradeSettings(d.Get(prefix + "upgrade_settings")),
+		}
+		updateF := func() error {
+			op, err := sendContainerRequest(config, "PUT", nodePoolInfo.fullyQualifiedName(name), obj, time.Duration(timeoutInMinutes)*time.Minute)
+
+			if err != nil {
+				return err
+			}
+
+			// Wait until it's updated
+			return containerOperationWait(config, op,
+				nodePoolInfo.project,
+				nodePoolInfo.location, "updating GKE node pool upgrade settings", timeoutInMinutes)
+		}
+
+		// Call update serially.
+		if err := lockedCall(lockKey, updateF); err != nil {
+			return err
+		}
+
+		log.Printf("[INFO] Updated upgrade settings in Node Pool %s", name)
+
+		if prefix == "" {
+			d.SetPartial("upgrade_settings")
+		}
+	}
+
 	if d.HasChange(prefix + "version") {
 		req := &containerBeta.UpdateNodePoolRequest{
 			NodePoolId:  name,
@@ -186,6 +186,66 @@ func TestAccContainerNodePool_withManagement(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_withUpgradeSettings(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	nodePool := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_withUpgradeSettings(cluster, nodePool, 2, 1),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_upgrade_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccContainerNodePool_withUpgradeSettings(cluster, nodePool, 1, 0),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_upgrade_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccContainerNodePool_withTaintsAndWorkloadMetadata(t *testing.T) {
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+	nodePool := fmt.Sprintf("tf-nodepool-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_withTaintsAndWorkloadMetadata(cluster, nodePool),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.with_taints", "node_config.0.taint.#", "1"),
+					resource.TestCheckResourceAttr(
+						"google_container_node_pool.with_taints", "node_config.0.workload_metadata_config.0.node_metadata", "SECURE"),
+				),
+			},
+			{
+				ResourceName:      "google_container_node_pool.with_taints",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_withNodeConfigScopeAlias(t *testing.T) {
 	t.Parallel()
 
@@ -637,6 +697,59 @@ resource "google_container_node_pool" "np_with_management" {
 }`, cluster, nodePool, management)
 }
 
+func testAccContainerNodePool_withUpgradeSettings(cluster, nodePool string, maxSurge, maxUnavailable int) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name               = "%s"
+	zone               = "us-central1-a"
+	initial_node_count = 1
+}
+
+resource "google_container_node_pool" "with_upgrade_settings" {
+	name               = "%s"
+	zone               = "us-central1-a"
+	cluster            = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	upgrade_settings {
+		max_surge       = %d
+		max_unavailable = %d
+	}
+}`, cluster, nodePool, maxSurge, maxUnavailable)
+}
+
+func testAccContainerNodePool_withTaintsAndWorkloadMetadata(cluster, nodePool string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+	name               = "%s"
+	zone               = "us-central1-a"
+	initial_node_count = 1
+}
+
+resource "google_container_node_pool" "with_taints" {
+	name               = "%s"
+	zone               = "us-central1-a"
+	cluster            = "${google_container_cluster.cluster.name}"
+	initial_node_count = 1
+
+	node_config {
+		labels = {
+			team = "platform"
+		}
+
+		taint {
+			key    = "dedicated"
+			value  = "platform"
+			effect = "NO_SCHEDULE"
+		}
+
+		workload_metadata_config {
+			node_metadata = "SECURE"
+		}
+	}
+}`, cluster, nodePool)
+}
+
 func testAccContainerNodePool_withNodeConfig(cluster, nodePool string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
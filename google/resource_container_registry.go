@@ -0,0 +1,121 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerRegistryCreate,
+		Read:   resourceContainerRegistryRead,
+		Delete: resourceContainerRegistryDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				StateFunc:    upperCaseStateFunc,
+				ValidateFunc: validation.StringInSlice([]string{"ASIA", "EU", "US"}, true),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bucket_self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func upperCaseStateFunc(v interface{}) string {
+	return strings.ToUpper(v.(string))
+}
+
+// containerRegistryBucketName returns the name of the GCS bucket backing the
+// Container Registry for a project in the given multi-region. An empty location
+// refers to the default gcr.io registry.
+func containerRegistryBucketName(project, location string) string {
+	// Domain-scoped projects (example.com:my-project) are stored as my-project.example.com.a
+	if parts := strings.SplitN(project, ":", 2); len(parts) == 2 {
+		project = fmt.Sprintf("%s.%s.a", parts[1], parts[0])
+	}
+	if location == "" {
+		return fmt.Sprintf("artifacts.%s.appspot.com", project)
+	}
+	return fmt.Sprintf("%s.artifacts.%s.appspot.com", strings.ToLower(location), project)
+}
+
+func resourceContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	host := "gcr.io"
+	if location := d.Get("location").(string); location != "" {
+		host = fmt.Sprintf("%s.gcr.io", strings.ToLower(location))
+	}
+
+	// Requesting a push token from the registry creates the backing bucket if it
+	// doesn't exist yet; there is no dedicated API to initialize a registry.
+	url := fmt.Sprintf("https://%s/v2/token?service=gcr.io&scope=repository:%s/terraform:push,pull", host, strings.Replace(project, ":", "/", 1))
+	log.Printf("[DEBUG] Initializing Container Registry %q in project %q", host, project)
+	if _, err := sendRequestWithTimeout(config, "GET", url, nil, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error initializing Container Registry %q: %s", host, err)
+	}
+
+	d.SetId(containerRegistryBucketName(project, d.Get("location").(string)))
+
+	return resourceContainerRegistryRead(d, meta)
+}
+
+func resourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := containerRegistryBucketName(project, d.Get("location").(string))
+	bucket, err := config.clientStorage.Buckets.Get(name).Do()
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Container Registry bucket %q", name))
+	}
+
+	d.SetId(bucket.Name)
+	d.Set("project", project)
+	d.Set("bucket_name", bucket.Name)
+	d.Set("bucket_self_link", bucket.SelfLink)
+
+	return nil
+}
+
+func resourceContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
+	// The backing bucket holds every image pushed to the registry, so it's left in
+	// place rather than deleted along with the resource.
+	log.Printf("[WARN] Container Registry bucket %q will not be deleted; remove it manually if needed", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,68 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestContainerRegistryBucketName(t *testing.T) {
+	cases := map[string]struct {
+		Project  string
+		Location string
+		Expected string
+	}{
+		"default": {
+			Project:  "my-project",
+			Expected: "artifacts.my-project.appspot.com",
+		},
+		"multi-region": {
+			Project:  "my-project",
+			Location: "EU",
+			Expected: "eu.artifacts.my-project.appspot.com",
+		},
+		"domain-scoped": {
+			Project:  "example.com:my-project",
+			Location: "US",
+			Expected: "us.artifacts.my-project.example.com.a.appspot.com",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := containerRegistryBucketName(tc.Project, tc.Location); got != tc.Expected {
+			t.Errorf("%s: expected bucket name %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccContainerRegistry_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerRegistry_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_registry.registry", "bucket_name", fmt.Sprintf("eu.artifacts.%s.appspot.com", getTestProjectFromEnv())),
+					resource.TestCheckResourceAttrPair("data.google_container_registry.registry", "bucket_name", "google_container_registry.registry", "bucket_name"),
+					resource.TestCheckResourceAttrPair("data.google_container_registry.registry", "bucket_self_link", "google_container_registry.registry", "bucket_self_link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerRegistry_basic() string {
+	return `
+resource "google_container_registry" "registry" {
+  location = "EU"
+}
+
+data "google_container_registry" "registry" {
+  location = "${google_container_registry.registry.location}"
+}
+`
+}
@@ -13,6 +13,8 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
+const dataflowBasePath = "https://dataflow.googleapis.com/v1b3/"
+
 var dataflowTerminalStatesMap = map[string]struct{}{
 	"JOB_STATE_DONE":       {},
 	"JOB_STATE_FAILED":     {},
@@ -37,9 +39,17 @@ func resourceDataflowJob() *schema.Resource {
 			},
 
 			"template_gcs_path": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"container_spec_gcs_path"},
+			},
+
+			"container_spec_gcs_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"template_gcs_path"},
 			},
 
 			"temp_gcs_location": {
@@ -66,6 +76,25 @@ func resourceDataflowJob() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"machine_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"network": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+
+			"subnetwork": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -91,6 +120,16 @@ func resourceDataflowJob() *schema.Resource {
 				Computed: true,
 			},
 
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"service_account_email": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -125,16 +164,25 @@ func resourceDataflowJobCreate(d *schema.ResourceData, meta interface{}) error {
 		Zone:                zone,
 		MaxWorkers:          int64(d.Get("max_workers").(int)),
 		ServiceAccountEmail: d.Get("service_account_email").(string),
+		MachineType:         d.Get("machine_type").(string),
+		Network:             d.Get("network").(string),
+		Subnetwork:          d.Get("subnetwork").(string),
 	}
 
-	request := dataflow.CreateJobFromTemplateRequest{
-		JobName:     d.Get("name").(string),
-		GcsPath:     d.Get("template_gcs_path").(string),
-		Parameters:  params,
-		Environment: &env,
+	var job *dataflow.Job
+	if v, ok := d.GetOk("container_spec_gcs_path"); ok {
+		job, err = launchFlexTemplateJob(config, project, region, d.Get("name").(string), v.(string), params, &env)
+	} else if v, ok := d.GetOk("template_gcs_path"); ok {
+		request := dataflow.CreateJobFromTemplateRequest{
+			JobName:     d.Get("name").(string),
+			GcsPath:     v.(string),
+			Parameters:  params,
+			Environment: &env,
+		}
+		job, err = createJob(config, project, region, &request)
+	} else {
+		return fmt.Errorf("One of `template_gcs_path` or `container_spec_gcs_path` must be set")
 	}
-
-	job, err := createJob(config, project, region, &request)
 	if err != nil {
 		return err
 	}
@@ -165,6 +213,8 @@ func resourceDataflowJobRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("state", job.CurrentState)
 	d.Set("name", job.Name)
+	d.Set("job_id", job.Id)
+	d.Set("type", job.Type)
 	d.Set("project", project)
 
 	if _, ok := dataflowTerminalStatesMap[job.CurrentState]; ok {
@@ -253,6 +303,45 @@ func createJob(config *Config, project string, region string, request *dataflow.
 	return config.clientDataflow.Projects.Locations.Templates.Create(project, region, request).Do()
 }
 
+// launchFlexTemplateJob launches a job from a Flex Template container spec.
+// Flex Templates are always launched in a regional endpoint, and aren't
+// supported by the vendored client yet.
+func launchFlexTemplateJob(config *Config, project, region, name, containerSpecGcsPath string, params map[string]string, env *dataflow.RuntimeEnvironment) (*dataflow.Job, error) {
+	if region == "" {
+		return nil, fmt.Errorf("`region` must be set to launch a job from a Flex Template")
+	}
+
+	environment, err := ConvertToMap(env)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]interface{}{
+		"launchParameter": map[string]interface{}{
+			"jobName":              name,
+			"containerSpecGcsPath": containerSpecGcsPath,
+			"parameters":           params,
+			"environment":          environment,
+		},
+	}
+
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/flexTemplates:launch", dataflowBasePath, project, region)
+	res, err := sendRequest(config, "POST", url, body)
+	if err != nil {
+		return nil, fmt.Errorf("Error launching Flex Template job %q: %s", name, err)
+	}
+
+	job := &dataflow.Job{}
+	if err := Convert(res["job"], job); err != nil {
+		return nil, err
+	}
+	if job.Id == "" {
+		return nil, fmt.Errorf("Error launching Flex Template job %q: response did not contain a job", name)
+	}
+
+	return job, nil
+}
+
 func getJob(config *Config, project string, region string, id string) (*dataflow.Job, error) {
 	if region == "" {
 		return config.clientDataflow.Projects.Jobs.Get(project, id).Do()
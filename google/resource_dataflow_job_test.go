@@ -68,6 +68,44 @@ func TestAccDataflowJobCreateWithServiceAccount(t *testing.T) {
 	})
 }
 
+func TestAccDataflowJobCreateWithMachineTypeAndNetwork(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataflowJobRegionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowJobWithMachineTypeAndNetwork,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataflowJobRegionExists(
+						"google_dataflow_job.big_data"),
+					resource.TestCheckResourceAttr("google_dataflow_job.big_data", "type", "JOB_TYPE_BATCH"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataflowJobFlexTemplateCreate(t *testing.T) {
+	t.Parallel()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataflowJobRegionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataflowJobFlexTemplate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataflowJobRegionExists(
+						"google_dataflow_job.flex"),
+					resource.TestCheckResourceAttr("google_dataflow_job.flex", "type", "JOB_TYPE_STREAMING"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataflowJobDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "google_dataflow_job" {
@@ -286,3 +324,83 @@ resource "google_dataflow_job" "big_data" {
 
 	on_delete = "cancel"
 }`, acctest.RandString(10), acctest.RandString(10), getTestProjectFromEnv())
+
+var testAccDataflowJobWithMachineTypeAndNetwork = fmt.Sprintf(`
+resource "google_storage_bucket" "temp" {
+	name = "dfjob-test-%s-temp"
+
+	force_destroy = true
+}
+
+resource "google_compute_network" "net" {
+	name                    = "dfjob-test-%s"
+	auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "subnet" {
+	name          = "dfjob-test-%s"
+	ip_cidr_range = "10.2.0.0/16"
+	region        = "us-central1"
+	network       = "${google_compute_network.net.self_link}"
+}
+
+resource "google_dataflow_job" "big_data" {
+	name = "dfjob-test-%s"
+
+	template_gcs_path = "gs://dataflow-templates/wordcount/template_file"
+	temp_gcs_location = "${google_storage_bucket.temp.url}"
+
+	parameters = {
+		inputFile = "gs://dataflow-samples/shakespeare/kinglear.txt"
+		output    = "${google_storage_bucket.temp.url}/output"
+	}
+	region       = "us-central1"
+	zone         = "us-central1-c"
+	project      = "%s"
+	machine_type = "n1-standard-2"
+	network      = "${google_compute_network.net.name}"
+	subnetwork   = "regions/us-central1/subnetworks/${google_compute_subnetwork.subnet.name}"
+
+	on_delete = "cancel"
+}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), getTestProjectFromEnv())
+
+var testAccDataflowJobFlexTemplate = fmt.Sprintf(`
+resource "google_storage_bucket" "temp" {
+	name = "dfjob-test-%s-temp"
+
+	force_destroy = true
+}
+
+resource "google_storage_bucket_object" "schema" {
+	name    = "schema.json"
+	bucket  = "${google_storage_bucket.temp.name}"
+	content = <<EOF
+{
+	"eventId": "{{uuid()}}",
+	"eventTimestamp": {{timestamp()}},
+	"username": "{{username()}}",
+	"score": {{integer(100, 10000)}}
+}
+EOF
+}
+
+resource "google_pubsub_topic" "topic" {
+	name = "dfjob-test-%s"
+}
+
+resource "google_dataflow_job" "flex" {
+	name = "dfjob-test-%s"
+
+	container_spec_gcs_path = "gs://dataflow-templates/latest/flex/Streaming_Data_Generator"
+	temp_gcs_location       = "${google_storage_bucket.temp.url}"
+
+	parameters = {
+		schemaLocation = "${google_storage_bucket.temp.url}/${google_storage_bucket_object.schema.name}"
+		qps            = "1"
+		topic          = "${google_pubsub_topic.topic.id}"
+	}
+	region  = "us-central1"
+	project = "%s"
+
+	on_delete = "cancel"
+}`, acctest.RandString(10), acctest.RandString(10), acctest.RandString(10), getTestProjectFromEnv())
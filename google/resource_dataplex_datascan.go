@@ -0,0 +1,1962 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDataplexDatascan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataplexDatascanCreate,
+		Read:   resourceDataplexDatascanRead,
+		Update: resourceDataplexDatascanUpdate,
+		Delete: resourceDataplexDatascanDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataplexDatascanImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"data": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"resource": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"data_scan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"execution_spec": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"on_demand": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+									"schedule": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"cron": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"field": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"data_profile_spec": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"data_quality_spec"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude_fields": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"include_fields": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"post_scan_actions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bigquery_export": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"results_table": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"row_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"sampling_percent": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"data_quality_spec": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"data_profile_spec"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"post_scan_actions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bigquery_export": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"results_table": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"row_filter": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"column": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"ignore_null": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"non_null_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+									"range_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"min_value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"strict_max_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+												"strict_min_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+											},
+										},
+									},
+									"regex_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"regex": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"row_condition_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sql_expression": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"set_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"values": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"statistic_range_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"statistic": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"STATISTIC_UNDEFINED", "MEAN", "MIN", "MAX"}, false),
+												},
+												"max_value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"min_value": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"strict_max_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+												"strict_min_enabled": {
+													Type:     schema.TypeBool,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"table_condition_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"sql_expression": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+									"threshold": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+									"uniqueness_expectation": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+								},
+							},
+						},
+						"sampling_percent": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataplexDatascanCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandDataplexDatascanDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandDataplexDatascanDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandDataplexDatascanLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	dataProp, err := expandDataplexDatascanData(d.Get("data"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("data"); !isEmptyValue(reflect.ValueOf(dataProp)) && (ok || !reflect.DeepEqual(v, dataProp)) {
+		obj["data"] = dataProp
+	}
+	executionSpecProp, err := expandDataplexDatascanExecutionSpec(d.Get("execution_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("execution_spec"); !isEmptyValue(reflect.ValueOf(executionSpecProp)) && (ok || !reflect.DeepEqual(v, executionSpecProp)) {
+		obj["executionSpec"] = executionSpecProp
+	}
+	dataQualitySpecProp, err := expandDataplexDatascanDataQualitySpec(d.Get("data_quality_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("data_quality_spec"); !isEmptyValue(reflect.ValueOf(dataQualitySpecProp)) && (ok || !reflect.DeepEqual(v, dataQualitySpecProp)) {
+		obj["dataQualitySpec"] = dataQualitySpecProp
+	}
+	dataProfileSpecProp, err := expandDataplexDatascanDataProfileSpec(d.Get("data_profile_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("data_profile_spec"); ok || !reflect.DeepEqual(v, dataProfileSpecProp) {
+		obj["dataProfileSpec"] = dataProfileSpecProp
+	}
+
+	url, err := replaceVars(d, config, "https://dataplex.googleapis.com/v1/projects/{{project}}/locations/{{location}}/dataScans?dataScanId={{data_scan_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Datascan: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Datascan: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := dataplexOperationWaitTime(
+		config, res, project, "Creating Datascan",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Datascan: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Datascan %q: %#v", d.Id(), res)
+
+	return resourceDataplexDatascanRead(d, meta)
+}
+
+func resourceDataplexDatascanRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://dataplex.googleapis.com/v1/projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DataplexDatascan %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+
+	if err := d.Set("description", flattenDataplexDatascanDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("display_name", flattenDataplexDatascanDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("labels", flattenDataplexDatascanLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("data", flattenDataplexDatascanData(res["data"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("execution_spec", flattenDataplexDatascanExecutionSpec(res["executionSpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("data_quality_spec", flattenDataplexDatascanDataQualitySpec(res["dataQualitySpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("data_profile_spec", flattenDataplexDatascanDataProfileSpec(res["dataProfileSpec"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("name", flattenDataplexDatascanName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("uid", flattenDataplexDatascanUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("state", flattenDataplexDatascanState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("create_time", flattenDataplexDatascanCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("update_time", flattenDataplexDatascanUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+	if err := d.Set("type", flattenDataplexDatascanType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading Datascan: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataplexDatascanUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandDataplexDatascanDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	displayNameProp, err := expandDataplexDatascanDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandDataplexDatascanLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	executionSpecProp, err := expandDataplexDatascanExecutionSpec(d.Get("execution_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("execution_spec"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, executionSpecProp)) {
+		obj["executionSpec"] = executionSpecProp
+	}
+	dataQualitySpecProp, err := expandDataplexDatascanDataQualitySpec(d.Get("data_quality_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("data_quality_spec"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, dataQualitySpecProp)) {
+		obj["dataQualitySpec"] = dataQualitySpecProp
+	}
+	dataProfileSpecProp, err := expandDataplexDatascanDataProfileSpec(d.Get("data_profile_spec"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("data_profile_spec"); ok || !reflect.DeepEqual(v, dataProfileSpecProp) {
+		obj["dataProfileSpec"] = dataProfileSpecProp
+	}
+
+	url, err := replaceVars(d, config, "https://dataplex.googleapis.com/v1/projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Datascan %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("execution_spec") {
+		updateMask = append(updateMask, "executionSpec")
+	}
+
+	if d.HasChange("data_quality_spec") {
+		updateMask = append(updateMask, "dataQualitySpec")
+	}
+
+	if d.HasChange("data_profile_spec") {
+		updateMask = append(updateMask, "dataProfileSpec")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Datascan %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = dataplexOperationWaitTime(
+		config, res, project, "Updating Datascan",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceDataplexDatascanRead(d, meta)
+}
+
+func resourceDataplexDatascanDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://dataplex.googleapis.com/v1/projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Datascan %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Datascan")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = dataplexOperationWaitTime(
+		config, res, project, "Deleting Datascan",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Datascan %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDataplexDatascanImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/dataScans/(?P<data_scan_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<data_scan_id>[^/]+)", "(?P<location>[^/]+)/(?P<data_scan_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDataplexDatascanDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanData(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["entity"] =
+		flattenDataplexDatascanDataEntity(original["entity"], d)
+	transformed["resource"] =
+		flattenDataplexDatascanDataResource(original["resource"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataEntity(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataResource(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanExecutionSpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["trigger"] =
+		flattenDataplexDatascanExecutionSpecTrigger(original["trigger"], d)
+	transformed["field"] =
+		flattenDataplexDatascanExecutionSpecField(original["field"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanExecutionSpecTrigger(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["on_demand"] =
+		flattenDataplexDatascanExecutionSpecTriggerOnDemand(original["onDemand"], d)
+	transformed["schedule"] =
+		flattenDataplexDatascanExecutionSpecTriggerSchedule(original["schedule"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanExecutionSpecTriggerOnDemand(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenDataplexDatascanExecutionSpecTriggerSchedule(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["cron"] =
+		flattenDataplexDatascanExecutionSpecTriggerScheduleCron(original["cron"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanExecutionSpecTriggerScheduleCron(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanExecutionSpecField(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["sampling_percent"] =
+		flattenDataplexDatascanDataQualitySpecSamplingPercent(original["samplingPercent"], d)
+	transformed["row_filter"] =
+		flattenDataplexDatascanDataQualitySpecRowFilter(original["rowFilter"], d)
+	transformed["post_scan_actions"] =
+		flattenDataplexDatascanDataQualitySpecPostScanActions(original["postScanActions"], d)
+	transformed["rules"] =
+		flattenDataplexDatascanDataQualitySpecRules(original["rules"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecSamplingPercent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRowFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecPostScanActions(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["bigquery_export"] =
+		flattenDataplexDatascanDataQualitySpecPostScanActionsBigqueryExport(original["bigqueryExport"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecPostScanActionsBigqueryExport(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["results_table"] =
+		flattenDataplexDatascanDataQualitySpecPostScanActionsBigqueryExportResultsTable(original["resultsTable"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecPostScanActionsBigqueryExportResultsTable(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRules(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"column":                      flattenDataplexDatascanDataQualitySpecRulesColumn(original["column"], d),
+			"ignore_null":                 flattenDataplexDatascanDataQualitySpecRulesIgnoreNull(original["ignoreNull"], d),
+			"dimension":                   flattenDataplexDatascanDataQualitySpecRulesDimension(original["dimension"], d),
+			"threshold":                   flattenDataplexDatascanDataQualitySpecRulesThreshold(original["threshold"], d),
+			"name":                        flattenDataplexDatascanDataQualitySpecRulesName(original["name"], d),
+			"description":                 flattenDataplexDatascanDataQualitySpecRulesDescription(original["description"], d),
+			"range_expectation":           flattenDataplexDatascanDataQualitySpecRulesRangeExpectation(original["rangeExpectation"], d),
+			"non_null_expectation":        flattenDataplexDatascanDataQualitySpecRulesNonNullExpectation(original["nonNullExpectation"], d),
+			"set_expectation":             flattenDataplexDatascanDataQualitySpecRulesSetExpectation(original["setExpectation"], d),
+			"regex_expectation":           flattenDataplexDatascanDataQualitySpecRulesRegexExpectation(original["regexExpectation"], d),
+			"uniqueness_expectation":      flattenDataplexDatascanDataQualitySpecRulesUniquenessExpectation(original["uniquenessExpectation"], d),
+			"statistic_range_expectation": flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectation(original["statisticRangeExpectation"], d),
+			"row_condition_expectation":   flattenDataplexDatascanDataQualitySpecRulesRowConditionExpectation(original["rowConditionExpectation"], d),
+			"table_condition_expectation": flattenDataplexDatascanDataQualitySpecRulesTableConditionExpectation(original["tableConditionExpectation"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesColumn(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesIgnoreNull(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesDimension(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesThreshold(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRangeExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min_value"] =
+		flattenDataplexDatascanDataQualitySpecRulesRangeExpectationMinValue(original["minValue"], d)
+	transformed["max_value"] =
+		flattenDataplexDatascanDataQualitySpecRulesRangeExpectationMaxValue(original["maxValue"], d)
+	transformed["strict_min_enabled"] =
+		flattenDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMinEnabled(original["strictMinEnabled"], d)
+	transformed["strict_max_enabled"] =
+		flattenDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMaxEnabled(original["strictMaxEnabled"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRangeExpectationMinValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRangeExpectationMaxValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMinEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMaxEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesNonNullExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesSetExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["values"] =
+		flattenDataplexDatascanDataQualitySpecRulesSetExpectationValues(original["values"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesSetExpectationValues(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRegexExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["regex"] =
+		flattenDataplexDatascanDataQualitySpecRulesRegexExpectationRegex(original["regex"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRegexExpectationRegex(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesUniquenessExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["statistic"] =
+		flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStatistic(original["statistic"], d)
+	transformed["min_value"] =
+		flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMinValue(original["minValue"], d)
+	transformed["max_value"] =
+		flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMaxValue(original["maxValue"], d)
+	transformed["strict_min_enabled"] =
+		flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMinEnabled(original["strictMinEnabled"], d)
+	transformed["strict_max_enabled"] =
+		flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMaxEnabled(original["strictMaxEnabled"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStatistic(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMinValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMaxValue(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMinEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMaxEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRowConditionExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["sql_expression"] =
+		flattenDataplexDatascanDataQualitySpecRulesRowConditionExpectationSqlExpression(original["sqlExpression"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesRowConditionExpectationSqlExpression(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesTableConditionExpectation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["sql_expression"] =
+		flattenDataplexDatascanDataQualitySpecRulesTableConditionExpectationSqlExpression(original["sqlExpression"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataQualitySpecRulesTableConditionExpectationSqlExpression(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataProfileSpec(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	transformed := make(map[string]interface{})
+	transformed["sampling_percent"] =
+		flattenDataplexDatascanDataProfileSpecSamplingPercent(original["samplingPercent"], d)
+	transformed["row_filter"] =
+		flattenDataplexDatascanDataProfileSpecRowFilter(original["rowFilter"], d)
+	transformed["post_scan_actions"] =
+		flattenDataplexDatascanDataProfileSpecPostScanActions(original["postScanActions"], d)
+	transformed["include_fields"] =
+		flattenDataplexDatascanDataProfileSpecIncludeFields(original["includeFields"], d)
+	transformed["exclude_fields"] =
+		flattenDataplexDatascanDataProfileSpecExcludeFields(original["excludeFields"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataProfileSpecSamplingPercent(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataProfileSpecRowFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataProfileSpecPostScanActions(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["bigquery_export"] =
+		flattenDataplexDatascanDataProfileSpecPostScanActionsBigqueryExport(original["bigqueryExport"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataProfileSpecPostScanActionsBigqueryExport(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["results_table"] =
+		flattenDataplexDatascanDataProfileSpecPostScanActionsBigqueryExportResultsTable(original["resultsTable"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataProfileSpecPostScanActionsBigqueryExportResultsTable(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataProfileSpecIncludeFields(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["field_names"] =
+		flattenDataplexDatascanDataProfileSpecIncludeFieldsFieldNames(original["fieldNames"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataProfileSpecIncludeFieldsFieldNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanDataProfileSpecExcludeFields(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["field_names"] =
+		flattenDataplexDatascanDataProfileSpecExcludeFieldsFieldNames(original["fieldNames"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataplexDatascanDataProfileSpecExcludeFieldsFieldNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataplexDatascanType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDataplexDatascanDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataplexDatascanData(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEntity, err := expandDataplexDatascanDataEntity(original["entity"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEntity); val.IsValid() && !isEmptyValue(val) {
+		transformed["entity"] = transformedEntity
+	}
+
+	transformedResource, err := expandDataplexDatascanDataResource(original["resource"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResource); val.IsValid() && !isEmptyValue(val) {
+		transformed["resource"] = transformedResource
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataEntity(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataResource(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanExecutionSpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTrigger, err := expandDataplexDatascanExecutionSpecTrigger(original["trigger"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTrigger); val.IsValid() && !isEmptyValue(val) {
+		transformed["trigger"] = transformedTrigger
+	}
+
+	transformedField, err := expandDataplexDatascanExecutionSpecField(original["field"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedField); val.IsValid() && !isEmptyValue(val) {
+		transformed["field"] = transformedField
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanExecutionSpecTrigger(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedOnDemand, err := expandDataplexDatascanExecutionSpecTriggerOnDemand(original["on_demand"], d, config)
+	if err != nil {
+		return nil, err
+	} else {
+		transformed["onDemand"] = transformedOnDemand
+	}
+
+	transformedSchedule, err := expandDataplexDatascanExecutionSpecTriggerSchedule(original["schedule"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSchedule); val.IsValid() && !isEmptyValue(val) {
+		transformed["schedule"] = transformedSchedule
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanExecutionSpecTriggerOnDemand(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	// The block has no fields; setting it is what selects this option.
+	return map[string]interface{}{}, nil
+}
+
+func expandDataplexDatascanExecutionSpecTriggerSchedule(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedCron, err := expandDataplexDatascanExecutionSpecTriggerScheduleCron(original["cron"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCron); val.IsValid() && !isEmptyValue(val) {
+		transformed["cron"] = transformedCron
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanExecutionSpecTriggerScheduleCron(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanExecutionSpecField(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSamplingPercent, err := expandDataplexDatascanDataQualitySpecSamplingPercent(original["sampling_percent"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSamplingPercent); val.IsValid() && !isEmptyValue(val) {
+		transformed["samplingPercent"] = transformedSamplingPercent
+	}
+
+	transformedRowFilter, err := expandDataplexDatascanDataQualitySpecRowFilter(original["row_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRowFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["rowFilter"] = transformedRowFilter
+	}
+
+	transformedPostScanActions, err := expandDataplexDatascanDataQualitySpecPostScanActions(original["post_scan_actions"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPostScanActions); val.IsValid() && !isEmptyValue(val) {
+		transformed["postScanActions"] = transformedPostScanActions
+	}
+
+	transformedRules, err := expandDataplexDatascanDataQualitySpecRules(original["rules"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRules); val.IsValid() && !isEmptyValue(val) {
+		transformed["rules"] = transformedRules
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecSamplingPercent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRowFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecPostScanActions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBigqueryExport, err := expandDataplexDatascanDataQualitySpecPostScanActionsBigqueryExport(original["bigquery_export"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBigqueryExport); val.IsValid() && !isEmptyValue(val) {
+		transformed["bigqueryExport"] = transformedBigqueryExport
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecPostScanActionsBigqueryExport(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedResultsTable, err := expandDataplexDatascanDataQualitySpecPostScanActionsBigqueryExportResultsTable(original["results_table"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResultsTable); val.IsValid() && !isEmptyValue(val) {
+		transformed["resultsTable"] = transformedResultsTable
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecPostScanActionsBigqueryExportResultsTable(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRules(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedColumn, err := expandDataplexDatascanDataQualitySpecRulesColumn(original["column"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedColumn); val.IsValid() && !isEmptyValue(val) {
+			transformed["column"] = transformedColumn
+		}
+
+		transformedIgnoreNull, err := expandDataplexDatascanDataQualitySpecRulesIgnoreNull(original["ignore_null"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedIgnoreNull); val.IsValid() && !isEmptyValue(val) {
+			transformed["ignoreNull"] = transformedIgnoreNull
+		}
+
+		transformedDimension, err := expandDataplexDatascanDataQualitySpecRulesDimension(original["dimension"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDimension); val.IsValid() && !isEmptyValue(val) {
+			transformed["dimension"] = transformedDimension
+		}
+
+		transformedThreshold, err := expandDataplexDatascanDataQualitySpecRulesThreshold(original["threshold"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedThreshold); val.IsValid() && !isEmptyValue(val) {
+			transformed["threshold"] = transformedThreshold
+		}
+
+		transformedName, err := expandDataplexDatascanDataQualitySpecRulesName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedDescription, err := expandDataplexDatascanDataQualitySpecRulesDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedRangeExpectation, err := expandDataplexDatascanDataQualitySpecRulesRangeExpectation(original["range_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRangeExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["rangeExpectation"] = transformedRangeExpectation
+		}
+
+		transformedNonNullExpectation, err := expandDataplexDatascanDataQualitySpecRulesNonNullExpectation(original["non_null_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else {
+			transformed["nonNullExpectation"] = transformedNonNullExpectation
+		}
+
+		transformedSetExpectation, err := expandDataplexDatascanDataQualitySpecRulesSetExpectation(original["set_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSetExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["setExpectation"] = transformedSetExpectation
+		}
+
+		transformedRegexExpectation, err := expandDataplexDatascanDataQualitySpecRulesRegexExpectation(original["regex_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRegexExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["regexExpectation"] = transformedRegexExpectation
+		}
+
+		transformedUniquenessExpectation, err := expandDataplexDatascanDataQualitySpecRulesUniquenessExpectation(original["uniqueness_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else {
+			transformed["uniquenessExpectation"] = transformedUniquenessExpectation
+		}
+
+		transformedStatisticRangeExpectation, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectation(original["statistic_range_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedStatisticRangeExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["statisticRangeExpectation"] = transformedStatisticRangeExpectation
+		}
+
+		transformedRowConditionExpectation, err := expandDataplexDatascanDataQualitySpecRulesRowConditionExpectation(original["row_condition_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedRowConditionExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["rowConditionExpectation"] = transformedRowConditionExpectation
+		}
+
+		transformedTableConditionExpectation, err := expandDataplexDatascanDataQualitySpecRulesTableConditionExpectation(original["table_condition_expectation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedTableConditionExpectation); val.IsValid() && !isEmptyValue(val) {
+			transformed["tableConditionExpectation"] = transformedTableConditionExpectation
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesColumn(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesIgnoreNull(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesDimension(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesThreshold(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRangeExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMinValue, err := expandDataplexDatascanDataQualitySpecRulesRangeExpectationMinValue(original["min_value"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinValue); val.IsValid() && !isEmptyValue(val) {
+		transformed["minValue"] = transformedMinValue
+	}
+
+	transformedMaxValue, err := expandDataplexDatascanDataQualitySpecRulesRangeExpectationMaxValue(original["max_value"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaxValue); val.IsValid() && !isEmptyValue(val) {
+		transformed["maxValue"] = transformedMaxValue
+	}
+
+	transformedStrictMinEnabled, err := expandDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMinEnabled(original["strict_min_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStrictMinEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["strictMinEnabled"] = transformedStrictMinEnabled
+	}
+
+	transformedStrictMaxEnabled, err := expandDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMaxEnabled(original["strict_max_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStrictMaxEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["strictMaxEnabled"] = transformedStrictMaxEnabled
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRangeExpectationMinValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRangeExpectationMaxValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMinEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRangeExpectationStrictMaxEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesNonNullExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	// The block has no fields; setting it is what selects this option.
+	return map[string]interface{}{}, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesSetExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedValues, err := expandDataplexDatascanDataQualitySpecRulesSetExpectationValues(original["values"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedValues); val.IsValid() && !isEmptyValue(val) {
+		transformed["values"] = transformedValues
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesSetExpectationValues(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRegexExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRegex, err := expandDataplexDatascanDataQualitySpecRulesRegexExpectationRegex(original["regex"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRegex); val.IsValid() && !isEmptyValue(val) {
+		transformed["regex"] = transformedRegex
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRegexExpectationRegex(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesUniquenessExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	// The block has no fields; setting it is what selects this option.
+	return map[string]interface{}{}, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedStatistic, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStatistic(original["statistic"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStatistic); val.IsValid() && !isEmptyValue(val) {
+		transformed["statistic"] = transformedStatistic
+	}
+
+	transformedMinValue, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMinValue(original["min_value"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinValue); val.IsValid() && !isEmptyValue(val) {
+		transformed["minValue"] = transformedMinValue
+	}
+
+	transformedMaxValue, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMaxValue(original["max_value"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaxValue); val.IsValid() && !isEmptyValue(val) {
+		transformed["maxValue"] = transformedMaxValue
+	}
+
+	transformedStrictMinEnabled, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMinEnabled(original["strict_min_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStrictMinEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["strictMinEnabled"] = transformedStrictMinEnabled
+	}
+
+	transformedStrictMaxEnabled, err := expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMaxEnabled(original["strict_max_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStrictMaxEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["strictMaxEnabled"] = transformedStrictMaxEnabled
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStatistic(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMinValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationMaxValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMinEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesStatisticRangeExpectationStrictMaxEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRowConditionExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSqlExpression, err := expandDataplexDatascanDataQualitySpecRulesRowConditionExpectationSqlExpression(original["sql_expression"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSqlExpression); val.IsValid() && !isEmptyValue(val) {
+		transformed["sqlExpression"] = transformedSqlExpression
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesRowConditionExpectationSqlExpression(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesTableConditionExpectation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSqlExpression, err := expandDataplexDatascanDataQualitySpecRulesTableConditionExpectationSqlExpression(original["sql_expression"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSqlExpression); val.IsValid() && !isEmptyValue(val) {
+		transformed["sqlExpression"] = transformedSqlExpression
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataQualitySpecRulesTableConditionExpectationSqlExpression(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataProfileSpec(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 {
+		return nil, nil
+	}
+	if l[0] == nil {
+		return map[string]interface{}{}, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedSamplingPercent, err := expandDataplexDatascanDataProfileSpecSamplingPercent(original["sampling_percent"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSamplingPercent); val.IsValid() && !isEmptyValue(val) {
+		transformed["samplingPercent"] = transformedSamplingPercent
+	}
+
+	transformedRowFilter, err := expandDataplexDatascanDataProfileSpecRowFilter(original["row_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRowFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["rowFilter"] = transformedRowFilter
+	}
+
+	transformedPostScanActions, err := expandDataplexDatascanDataProfileSpecPostScanActions(original["post_scan_actions"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPostScanActions); val.IsValid() && !isEmptyValue(val) {
+		transformed["postScanActions"] = transformedPostScanActions
+	}
+
+	transformedIncludeFields, err := expandDataplexDatascanDataProfileSpecIncludeFields(original["include_fields"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIncludeFields); val.IsValid() && !isEmptyValue(val) {
+		transformed["includeFields"] = transformedIncludeFields
+	}
+
+	transformedExcludeFields, err := expandDataplexDatascanDataProfileSpecExcludeFields(original["exclude_fields"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedExcludeFields); val.IsValid() && !isEmptyValue(val) {
+		transformed["excludeFields"] = transformedExcludeFields
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataProfileSpecSamplingPercent(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataProfileSpecRowFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataProfileSpecPostScanActions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBigqueryExport, err := expandDataplexDatascanDataProfileSpecPostScanActionsBigqueryExport(original["bigquery_export"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBigqueryExport); val.IsValid() && !isEmptyValue(val) {
+		transformed["bigqueryExport"] = transformedBigqueryExport
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataProfileSpecPostScanActionsBigqueryExport(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedResultsTable, err := expandDataplexDatascanDataProfileSpecPostScanActionsBigqueryExportResultsTable(original["results_table"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedResultsTable); val.IsValid() && !isEmptyValue(val) {
+		transformed["resultsTable"] = transformedResultsTable
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataProfileSpecPostScanActionsBigqueryExportResultsTable(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataProfileSpecIncludeFields(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFieldNames, err := expandDataplexDatascanDataProfileSpecIncludeFieldsFieldNames(original["field_names"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFieldNames); val.IsValid() && !isEmptyValue(val) {
+		transformed["fieldNames"] = transformedFieldNames
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataProfileSpecIncludeFieldsFieldNames(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataplexDatascanDataProfileSpecExcludeFields(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedFieldNames, err := expandDataplexDatascanDataProfileSpecExcludeFieldsFieldNames(original["field_names"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFieldNames); val.IsValid() && !isEmptyValue(val) {
+		transformed["fieldNames"] = transformedFieldNames
+	}
+
+	return transformed, nil
+}
+
+func expandDataplexDatascanDataProfileSpecExcludeFieldsFieldNames(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,320 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataplexDatascan_dataplexDatascanBasicProfileExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexDatascanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexDatascan_dataplexDatascanBasicProfileExample(context),
+			},
+			{
+				ResourceName:      "google_dataplex_datascan.basic_profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexDatascan_dataplexDatascanBasicProfileExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataplex_datascan" "basic_profile" {
+  location     = "us-central1"
+  data_scan_id = "tf-test-dataprofile-basic%{random_suffix}"
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/samples/tables/shakespeare"
+  }
+
+  execution_spec {
+    trigger {
+      on_demand {}
+    }
+  }
+
+  data_profile_spec {}
+}
+`, context)
+}
+
+func TestAccDataplexDatascan_dataplexDatascanFullQualityExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexDatascanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexDatascan_dataplexDatascanFullQualityExample(context),
+			},
+			{
+				ResourceName:      "google_dataplex_datascan.full_quality",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexDatascan_dataplexDatascanFullQualityExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_bigquery_dataset" "source" {
+  dataset_id                 = "tf_test_dataplex_dataset%{random_suffix}"
+  delete_contents_on_destroy = true
+}
+
+resource "google_dataplex_datascan" "full_quality" {
+  location     = "us-central1"
+  display_name = "Full Datascan Quality"
+  data_scan_id = "tf-test-dataquality-full%{random_suffix}"
+  description  = "Example resource - Full Datascan Quality"
+
+  labels = {
+    author = "billing"
+  }
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/austin_bikeshare/tables/bikeshare_stations"
+  }
+
+  execution_spec {
+    trigger {
+      schedule {
+        cron = "TZ=America/New_York 1 1 * * *"
+      }
+    }
+    field = "modified_date"
+  }
+
+  data_quality_spec {
+    sampling_percent = 5
+    row_filter       = "station_id > 1000"
+
+    post_scan_actions {
+      bigquery_export {
+        results_table = "//bigquery.googleapis.com/projects/${google_bigquery_dataset.source.project}/datasets/${google_bigquery_dataset.source.dataset_id}/tables/dq_results"
+      }
+    }
+
+    rules {
+      column      = "address"
+      dimension   = "VALIDITY"
+      threshold   = 0.99
+      non_null_expectation {}
+    }
+
+    rules {
+      column      = "council_district"
+      dimension   = "VALIDITY"
+      ignore_null = true
+      threshold   = 0.9
+      range_expectation {
+        min_value          = 1
+        max_value          = 10
+        strict_min_enabled = true
+        strict_max_enabled = false
+      }
+    }
+
+    rules {
+      column    = "power_type"
+      dimension = "VALIDITY"
+      set_expectation {
+        values = ["Solar", "Non-solar"]
+      }
+    }
+
+    rules {
+      column    = "property_type"
+      dimension = "VALIDITY"
+      regex_expectation {
+        regex = ".*solar.*"
+      }
+    }
+
+    rules {
+      column    = "address"
+      dimension = "UNIQUENESS"
+      uniqueness_expectation {}
+    }
+
+    rules {
+      column      = "number_of_docks"
+      dimension   = "VALIDITY"
+      statistic_range_expectation {
+        statistic          = "MEAN"
+        min_value          = 5
+        max_value          = 15
+        strict_min_enabled = true
+        strict_max_enabled = true
+      }
+    }
+
+    rules {
+      column    = "footprint_length"
+      dimension = "VALIDITY"
+      row_condition_expectation {
+        sql_expression = "footprint_length > 0 AND footprint_length <= 10"
+      }
+    }
+
+    rules {
+      dimension = "VALIDITY"
+      table_condition_expectation {
+        sql_expression = "COUNT(*) > 0"
+      }
+    }
+  }
+}
+`, context)
+}
+
+func TestAccDataplexDatascan_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataplexDatascanDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataplexDatascan_quality(suffix),
+			},
+			{
+				ResourceName:      "google_dataplex_datascan.quality",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDataplexDatascan_qualityUpdated(suffix),
+			},
+			{
+				ResourceName:      "google_dataplex_datascan.quality",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataplexDatascan_quality(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_dataplex_datascan" "quality" {
+  location     = "us-central1"
+  data_scan_id = "tf-test-dataquality-%s"
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/samples/tables/shakespeare"
+  }
+
+  execution_spec {
+    trigger {
+      on_demand {}
+    }
+  }
+
+  data_quality_spec {
+    rules {
+      column    = "word"
+      dimension = "COMPLETENESS"
+      non_null_expectation {}
+    }
+  }
+}
+`, suffix)
+}
+
+func testAccDataplexDatascan_qualityUpdated(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_dataplex_datascan" "quality" {
+  location     = "us-central1"
+  data_scan_id = "tf-test-dataquality-%s"
+  description  = "updated"
+
+  labels = {
+    gate = "word-count"
+  }
+
+  data {
+    resource = "//bigquery.googleapis.com/projects/bigquery-public-data/datasets/samples/tables/shakespeare"
+  }
+
+  execution_spec {
+    trigger {
+      schedule {
+        cron = "TZ=America/New_York 1 1 * * *"
+      }
+    }
+  }
+
+  data_quality_spec {
+    sampling_percent = 50
+
+    rules {
+      column    = "word"
+      dimension = "COMPLETENESS"
+      non_null_expectation {}
+    }
+
+    rules {
+      column    = "word_count"
+      dimension = "VALIDITY"
+      threshold = 0.99
+      range_expectation {
+        min_value = 1
+      }
+    }
+  }
+}
+`, suffix)
+}
+
+func testAccCheckDataplexDatascanDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dataplex_datascan" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://dataplex.googleapis.com/v1/projects/{{project}}/locations/{{location}}/dataScans/{{data_scan_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("DataplexDatascan still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -15,6 +15,8 @@ import (
 	"google.golang.org/api/dataproc/v1"
 )
 
+const dataprocBasePath = "https://dataproc.googleapis.com/v1/"
+
 func resourceDataprocCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataprocClusterCreate,
@@ -203,6 +205,14 @@ func resourceDataprocCluster() *schema.Resource {
 										Computed: true,
 									},
 
+									"preemptibility": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"PREEMPTIBLE", "NON_PREEMPTIBLE", "SPOT"}, false),
+									},
+
 									// API does not honour this if set ...
 									// It always uses whatever is specified for the worker_config
 									// "machine_type": { ... }
@@ -323,6 +333,43 @@ func resourceDataprocCluster() *schema.Resource {
 								},
 							},
 						},
+
+						"autoscaling_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"policy_uri": {
+										Type:             schema.TypeString,
+										Required:         true,
+										DiffSuppressFunc: compareSelfLinkOrResourceName,
+									},
+								},
+							},
+						},
+
+						"endpoint_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_http_port_access": {
+										Type:     schema.TypeBool,
+										Required: true,
+										ForceNew: true,
+									},
+
+									"http_ports": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -458,20 +505,33 @@ func resourceDataprocClusterCreate(d *schema.ResourceData, meta interface{}) err
 		return errors.New("zone is mandatory when region is set to 'global'")
 	}
 
+	obj, err := expandClusterRaw(d, cluster)
+	if err != nil {
+		return err
+	}
+
 	// Create the cluster
-	op, err := config.clientDataproc.Projects.Regions.Clusters.Create(
-		project, region, cluster).Do()
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/clusters", dataprocBasePath, project, region)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error creating Dataproc cluster: %s", err)
 	}
 
+	op := &dataproc.Operation{}
+	if err := Convert(res, op); err != nil {
+		return err
+	}
+
 	d.SetId(cluster.ClusterName)
 
 	// Wait until it's created
 	timeoutInMinutes := int(d.Timeout(schema.TimeoutCreate).Minutes())
 	waitErr := dataprocClusterOperationWait(config, op, "creating Dataproc cluster", timeoutInMinutes)
+	if isOperationCancelledError(waitErr) {
+		// The cluster exists, so keep it in state to have it tainted.
+		return waitErr
+	}
 	if waitErr != nil {
-		// The resource didn't actually create
 		// Note that we do not remove the ID here - this resource tends to leave
 		// partially created clusters behind, so we'll let the next Read remove
 		// it.
@@ -532,13 +592,48 @@ func expandClusterConfig(d *schema.ResourceData, config *Config) (*dataproc.Clus
 	if cfg, ok := configOptions(d, "cluster_config.0.preemptible_worker_config"); ok {
 		log.Println("[INFO] got preemtible worker config")
 		conf.SecondaryWorkerConfig = expandPreemptibleInstanceGroupConfig(cfg)
-		if conf.SecondaryWorkerConfig.NumInstances > 0 {
+		if conf.SecondaryWorkerConfig.NumInstances > 0 && cfg["preemptibility"] != "NON_PREEMPTIBLE" {
 			conf.SecondaryWorkerConfig.IsPreemptible = true
 		}
 	}
 	return conf, nil
 }
 
+// expandClusterRaw converts the cluster into a request body, adding the
+// fields that the vendored Dataproc client doesn't support yet.
+func expandClusterRaw(d *schema.ResourceData, cluster *dataproc.Cluster) (map[string]interface{}, error) {
+	obj, err := ConvertToMap(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	conf, ok := obj["config"].(map[string]interface{})
+	if !ok {
+		conf = make(map[string]interface{})
+		obj["config"] = conf
+	}
+
+	if cfg, ok := configOptions(d, "cluster_config.0.autoscaling_config"); ok {
+		conf["autoscalingConfig"] = map[string]interface{}{
+			"policyUri": cfg["policy_uri"],
+		}
+	}
+
+	if cfg, ok := configOptions(d, "cluster_config.0.endpoint_config"); ok {
+		conf["endpointConfig"] = map[string]interface{}{
+			"enableHttpPortAccess": cfg["enable_http_port_access"],
+		}
+	}
+
+	if v, ok := d.GetOk("cluster_config.0.preemptible_worker_config.0.preemptibility"); ok {
+		if secondary, ok := conf["secondaryWorkerConfig"].(map[string]interface{}); ok {
+			secondary["preemptibility"] = v
+		}
+	}
+
+	return obj, nil
+}
+
 func expandGceClusterConfig(d *schema.ResourceData, config *Config) (*dataproc.GceClusterConfig, error) {
 	conf := &dataproc.GceClusterConfig{}
 
@@ -756,14 +851,35 @@ func resourceDataprocClusterUpdate(d *schema.ResourceData, meta interface{}) err
 		updMask = append(updMask, "config.secondary_worker_config.num_instances")
 	}
 
+	if d.HasChange("cluster_config.0.autoscaling_config") {
+		updMask = append(updMask, "config.autoscaling_config.policy_uri")
+	}
+
 	if len(updMask) > 0 {
-		patch := config.clientDataproc.Projects.Regions.Clusters.Patch(
-			project, region, clusterName, cluster)
-		op, err := patch.UpdateMask(strings.Join(updMask, ",")).Do()
+		obj, err := expandClusterRaw(d, cluster)
+		if err != nil {
+			return err
+		}
+		if !d.HasChange("cluster_config.0.autoscaling_config") {
+			delete(obj["config"].(map[string]interface{}), "autoscalingConfig")
+		}
+		// Only the updated parts of the secondary workers are sent.
+		if secondary, ok := obj["config"].(map[string]interface{})["secondaryWorkerConfig"].(map[string]interface{}); ok {
+			delete(secondary, "preemptibility")
+		}
+		delete(obj["config"].(map[string]interface{}), "endpointConfig")
+
+		url := fmt.Sprintf("%sprojects/%s/regions/%s/clusters/%s?updateMask=%s", dataprocBasePath, project, region, clusterName, strings.Join(updMask, ","))
+		res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return err
 		}
 
+		op := &dataproc.Operation{}
+		if err := Convert(res, op); err != nil {
+			return err
+		}
+
 		// Wait until it's updated
 		waitErr := dataprocClusterOperationWait(config, op, "updating Dataproc cluster ", timeoutInMinutes)
 		if waitErr != nil {
@@ -787,18 +903,24 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 	region := d.Get("region").(string)
 	clusterName := d.Get("name").(string)
 
-	cluster, err := config.clientDataproc.Projects.Regions.Clusters.Get(
-		project, region, clusterName).Do()
+	url := fmt.Sprintf("%sprojects/%s/regions/%s/clusters/%s", dataprocBasePath, project, region, clusterName)
+	res, err := sendRequest(config, "GET", url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Dataproc Cluster %q", clusterName))
 	}
 
+	cluster := &dataproc.Cluster{}
+	if err := Convert(res, cluster); err != nil {
+		return err
+	}
+
 	d.Set("name", cluster.ClusterName)
 	d.Set("project", project)
 	d.Set("region", region)
 	d.Set("labels", cluster.Labels)
 
-	cfg, err := flattenClusterConfig(d, cluster.Config)
+	rawConfig, _ := res["config"].(map[string]interface{})
+	cfg, err := flattenClusterConfig(d, cluster.Config, rawConfig)
 	if err != nil {
 		return err
 	}
@@ -810,7 +932,7 @@ func resourceDataprocClusterRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
-func flattenClusterConfig(d *schema.ResourceData, cfg *dataproc.ClusterConfig) ([]map[string]interface{}, error) {
+func flattenClusterConfig(d *schema.ResourceData, cfg *dataproc.ClusterConfig, raw map[string]interface{}) ([]map[string]interface{}, error) {
 
 	data := map[string]interface{}{
 		"staging_bucket": d.Get("cluster_config.0.staging_bucket").(string),
@@ -820,8 +942,10 @@ func flattenClusterConfig(d *schema.ResourceData, cfg *dataproc.ClusterConfig) (
 		"software_config":           flattenSoftwareConfig(d, cfg.SoftwareConfig),
 		"master_config":             flattenInstanceGroupConfig(d, cfg.MasterConfig),
 		"worker_config":             flattenInstanceGroupConfig(d, cfg.WorkerConfig),
-		"preemptible_worker_config": flattenPreemptibleInstanceGroupConfig(d, cfg.SecondaryWorkerConfig),
+		"preemptible_worker_config": flattenPreemptibleInstanceGroupConfig(d, cfg.SecondaryWorkerConfig, raw["secondaryWorkerConfig"]),
 		"encryption_config":         flattenEncryptionConfig(d, cfg.EncryptionConfig),
+		"autoscaling_config":        flattenAutoscalingConfig(raw["autoscalingConfig"]),
+		"endpoint_config":           flattenEndpointConfig(raw["endpointConfig"]),
 	}
 
 	if len(cfg.InitializationActions) > 0 {
@@ -856,6 +980,33 @@ func flattenEncryptionConfig(d *schema.ResourceData, ec *dataproc.EncryptionConf
 	return []map[string]interface{}{data}
 }
 
+func flattenAutoscalingConfig(v interface{}) []map[string]interface{} {
+	ac, ok := v.(map[string]interface{})
+	if !ok || ac["policyUri"] == nil {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"policy_uri": ac["policyUri"],
+	}
+
+	return []map[string]interface{}{data}
+}
+
+func flattenEndpointConfig(v interface{}) []map[string]interface{} {
+	ec, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	data := map[string]interface{}{
+		"enable_http_port_access": ec["enableHttpPortAccess"] == true,
+		"http_ports":              ec["httpPorts"],
+	}
+
+	return []map[string]interface{}{data}
+}
+
 func flattenAccelerators(accelerators []*dataproc.AcceleratorConfig) interface{} {
 	acceleratorsTypeSet := schema.NewSet(schema.HashResource(acceleratorsSchema()), []interface{}{})
 	for _, accelerator := range accelerators {
@@ -914,13 +1065,16 @@ func flattenGceClusterConfig(d *schema.ResourceData, gcc *dataproc.GceClusterCon
 	return []map[string]interface{}{gceConfig}
 }
 
-func flattenPreemptibleInstanceGroupConfig(d *schema.ResourceData, icg *dataproc.InstanceGroupConfig) []map[string]interface{} {
+func flattenPreemptibleInstanceGroupConfig(d *schema.ResourceData, icg *dataproc.InstanceGroupConfig, raw interface{}) []map[string]interface{} {
 	disk := map[string]interface{}{}
 	data := map[string]interface{}{}
 
 	if icg != nil {
 		data["num_instances"] = icg.NumInstances
 		data["instance_names"] = icg.InstanceNames
+		if r, ok := raw.(map[string]interface{}); ok {
+			data["preemptibility"] = r["preemptibility"]
+		}
 		if icg.DiskConfig != nil {
 			disk["boot_disk_size_gb"] = icg.DiskConfig.BootDiskSizeGb
 			disk["num_local_ssds"] = icg.DiskConfig.NumLocalSsds
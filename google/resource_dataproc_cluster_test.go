@@ -504,6 +504,56 @@ func TestAccDataprocCluster_KMS(t *testing.T) {
 	})
 }
 
+func TestAccDataprocCluster_withAutoscalingPolicy(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(10)
+	var cluster dataproc.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataprocClusterDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocCluster_withAutoscalingPolicy(rnd, "asp1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataprocClusterExists("google_dataproc_cluster.with_autoscaling", &cluster),
+					resource.TestMatchResourceAttr("google_dataproc_cluster.with_autoscaling", "cluster_config.0.autoscaling_config.0.policy_uri", regexp.MustCompile("autoscalingPolicies/dproc-policy-1-")),
+				),
+			},
+			{
+				Config: testAccDataprocCluster_withAutoscalingPolicy(rnd, "asp2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("google_dataproc_cluster.with_autoscaling", "cluster_config.0.autoscaling_config.0.policy_uri", regexp.MustCompile("autoscalingPolicies/dproc-policy-2-")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataprocCluster_withComponentGatewayAndSpotWorkers(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(10)
+	var cluster dataproc.Cluster
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataprocClusterDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocCluster_withComponentGatewayAndSpotWorkers(rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDataprocClusterExists("google_dataproc_cluster.with_gateway", &cluster),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.with_gateway", "cluster_config.0.endpoint_config.0.enable_http_port_access", "true"),
+					resource.TestCheckResourceAttrSet("google_dataproc_cluster.with_gateway", "cluster_config.0.endpoint_config.0.http_ports.%"),
+					resource.TestCheckResourceAttr("google_dataproc_cluster.with_gateway", "cluster_config.0.preemptible_worker_config.0.preemptibility", "SPOT"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDataprocClusterDestroy() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -1203,3 +1253,109 @@ resource "google_dataproc_cluster" "kms" {
 	}
 }`, pid, rnd, kmsKey)
 }
+
+func testAccDataprocCluster_withAutoscalingPolicy(rnd, policy string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_autoscaling_policy" "asp1" {
+	policy_id = "dproc-policy-1-%s"
+	location  = "us-central1"
+
+	worker_config {
+		max_instances = 3
+	}
+
+	basic_algorithm {
+		yarn_config {
+			graceful_decommission_timeout = "30s"
+			scale_up_factor               = 0.5
+			scale_down_factor             = 0.5
+		}
+	}
+}
+
+resource "google_dataproc_autoscaling_policy" "asp2" {
+	policy_id = "dproc-policy-2-%s"
+	location  = "us-central1"
+
+	worker_config {
+		max_instances = 4
+	}
+
+	basic_algorithm {
+		yarn_config {
+			graceful_decommission_timeout = "60s"
+			scale_up_factor               = 1.0
+			scale_down_factor             = 1.0
+		}
+	}
+}
+
+resource "google_dataproc_cluster" "with_autoscaling" {
+	name   = "dproc-cluster-test-%s"
+	region = "us-central1"
+
+	cluster_config {
+		master_config {
+			num_instances = 1
+			machine_type  = "n1-standard-1"
+			disk_config {
+				boot_disk_size_gb = 15
+			}
+		}
+
+		worker_config {
+			num_instances = 2
+			machine_type  = "n1-standard-1"
+			disk_config {
+				boot_disk_size_gb = 15
+			}
+		}
+
+		autoscaling_config {
+			policy_uri = "${google_dataproc_autoscaling_policy.%s.name}"
+		}
+	}
+}`, rnd, rnd, rnd, policy)
+}
+
+func testAccDataprocCluster_withComponentGatewayAndSpotWorkers(rnd string) string {
+	return fmt.Sprintf(`
+resource "google_dataproc_cluster" "with_gateway" {
+	name   = "dproc-cluster-test-%s"
+	region = "us-central1"
+
+	cluster_config {
+		master_config {
+			num_instances = 1
+			machine_type  = "n1-standard-1"
+			disk_config {
+				boot_disk_size_gb = 15
+			}
+		}
+
+		worker_config {
+			num_instances = 2
+			machine_type  = "n1-standard-1"
+			disk_config {
+				boot_disk_size_gb = 15
+			}
+		}
+
+		preemptible_worker_config {
+			num_instances  = 1
+			preemptibility = "SPOT"
+			disk_config {
+				boot_disk_size_gb = 15
+			}
+		}
+
+		software_config {
+			image_version = "2.0-debian10"
+		}
+
+		endpoint_config {
+			enable_http_port_access = true
+		}
+	}
+}`, rnd)
+}
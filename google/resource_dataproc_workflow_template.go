@@ -0,0 +1,4458 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceDataprocWorkflowTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataprocWorkflowTemplateCreate,
+		Read:   resourceDataprocWorkflowTemplateRead,
+		Delete: resourceDataprocWorkflowTemplateDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceDataprocWorkflowTemplateImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"jobs": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"step_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"hadoop_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"archive_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"main_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"main_jar_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"hive_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continue_on_failure": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"queries": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"script_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"labels": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"pig_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continue_on_failure": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"queries": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"script_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"prerequisite_step_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"presto_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_tags": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"continue_on_failure": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"output_format": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"queries": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"pyspark_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"main_python_file_uri": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"archive_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"python_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"scheduling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_failures_per_hour": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"max_failures_total": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spark_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"archive_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"args": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"main_class": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"main_jar_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"spark_sql_job": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"jar_file_uris": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"properties": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"query_file_uri": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query_list": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"queries": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"script_variables": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"placement": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_selector": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_labels": {
+										Type:     schema.TypeMap,
+										Required: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"zone": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"managed_cluster": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cluster_name": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"config": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"autoscaling_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"policy": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+														},
+													},
+												},
+												"encryption_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"gce_pd_kms_key_name": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"endpoint_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"enable_http_port_access": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"http_ports": {
+																Type:     schema.TypeMap,
+																Computed: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"gce_cluster_config": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"internal_ip_only": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																ForceNew: true,
+															},
+															"metadata": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+															"network": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"service_account": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+															"service_account_scopes": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+															"subnetwork": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"tags": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+															"zone": {
+																Type:             schema.TypeString,
+																Computed:         true,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+														},
+													},
+												},
+												"initialization_actions": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"executable_file": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+															"execution_timeout": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+														},
+													},
+												},
+												"master_config": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"accelerators": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"accelerator_count": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"accelerator_type": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ForceNew:         true,
+																			DiffSuppressFunc: compareSelfLinkOrResourceName,
+																		},
+																	},
+																},
+															},
+															"disk_config": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"boot_disk_size_gb": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"boot_disk_type": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"num_local_ssds": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+															"image": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"machine_type": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"min_cpu_platform": {
+																Type:     schema.TypeString,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"num_instances": {
+																Type:     schema.TypeInt,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"instance_names": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+														},
+													},
+												},
+												"secondary_worker_config": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"accelerators": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"accelerator_count": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"accelerator_type": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ForceNew:         true,
+																			DiffSuppressFunc: compareSelfLinkOrResourceName,
+																		},
+																	},
+																},
+															},
+															"disk_config": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"boot_disk_size_gb": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"boot_disk_type": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"num_local_ssds": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+															"image": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"machine_type": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"min_cpu_platform": {
+																Type:     schema.TypeString,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"num_instances": {
+																Type:     schema.TypeInt,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"preemptibility": {
+																Type:         schema.TypeString,
+																Computed:     true,
+																Optional:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.StringInSlice([]string{"PREEMPTIBLE", "NON_PREEMPTIBLE", "SPOT", ""}, false),
+															},
+															"instance_names": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+														},
+													},
+												},
+												"software_config": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"image_version": {
+																Type:     schema.TypeString,
+																Optional: true,
+																ForceNew: true,
+															},
+															"optional_components": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+															"properties": {
+																Type:     schema.TypeMap,
+																Optional: true,
+																ForceNew: true,
+																Elem:     &schema.Schema{Type: schema.TypeString},
+															},
+														},
+													},
+												},
+												"staging_bucket": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"worker_config": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"accelerators": {
+																Type:     schema.TypeList,
+																Optional: true,
+																ForceNew: true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"accelerator_count": {
+																			Type:     schema.TypeInt,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"accelerator_type": {
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			ForceNew:         true,
+																			DiffSuppressFunc: compareSelfLinkOrResourceName,
+																		},
+																	},
+																},
+															},
+															"disk_config": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"boot_disk_size_gb": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"boot_disk_type": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																		"num_local_ssds": {
+																			Type:     schema.TypeInt,
+																			Computed: true,
+																			Optional: true,
+																			ForceNew: true,
+																		},
+																	},
+																},
+															},
+															"image": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"machine_type": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																DiffSuppressFunc: compareSelfLinkOrResourceName,
+															},
+															"min_cpu_platform": {
+																Type:     schema.TypeString,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"num_instances": {
+																Type:     schema.TypeInt,
+																Computed: true,
+																Optional: true,
+																ForceNew: true,
+															},
+															"instance_names": {
+																Type:     schema.TypeList,
+																Computed: true,
+																Elem: &schema.Schema{
+																	Type: schema.TypeString,
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"labels": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"dag_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "global",
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fields": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"validation": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"regex": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"regexes": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"values": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDataprocWorkflowTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandDataprocWorkflowTemplateName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["id"] = nameProp
+	}
+	labelsProp, err := expandDataprocWorkflowTemplateLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	dagTimeoutProp, err := expandDataprocWorkflowTemplateDagTimeout(d.Get("dag_timeout"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("dag_timeout"); !isEmptyValue(reflect.ValueOf(dagTimeoutProp)) && (ok || !reflect.DeepEqual(v, dagTimeoutProp)) {
+		obj["dagTimeout"] = dagTimeoutProp
+	}
+	placementProp, err := expandDataprocWorkflowTemplatePlacement(d.Get("placement"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("placement"); !isEmptyValue(reflect.ValueOf(placementProp)) && (ok || !reflect.DeepEqual(v, placementProp)) {
+		obj["placement"] = placementProp
+	}
+	jobsProp, err := expandDataprocWorkflowTemplateJobs(d.Get("jobs"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("jobs"); !isEmptyValue(reflect.ValueOf(jobsProp)) && (ok || !reflect.DeepEqual(v, jobsProp)) {
+		obj["jobs"] = jobsProp
+	}
+	parametersProp, err := expandDataprocWorkflowTemplateParameters(d.Get("parameters"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("parameters"); !isEmptyValue(reflect.ValueOf(parametersProp)) && (ok || !reflect.DeepEqual(v, parametersProp)) {
+		obj["parameters"] = parametersProp
+	}
+
+	url, err := replaceVars(d, config, "https://dataproc.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workflowTemplates")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new WorkflowTemplate: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating WorkflowTemplate: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workflowTemplates/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating WorkflowTemplate %q: %#v", d.Id(), res)
+
+	return resourceDataprocWorkflowTemplateRead(d, meta)
+}
+
+func resourceDataprocWorkflowTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://dataproc.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workflowTemplates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DataprocWorkflowTemplate %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+
+	if err := d.Set("name", flattenDataprocWorkflowTemplateName(res["id"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("labels", flattenDataprocWorkflowTemplateLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("dag_timeout", flattenDataprocWorkflowTemplateDagTimeout(res["dagTimeout"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("placement", flattenDataprocWorkflowTemplatePlacement(res["placement"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("jobs", flattenDataprocWorkflowTemplateJobs(res["jobs"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("parameters", flattenDataprocWorkflowTemplateParameters(res["parameters"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("version", flattenDataprocWorkflowTemplateVersion(res["version"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("create_time", flattenDataprocWorkflowTemplateCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+	if err := d.Set("update_time", flattenDataprocWorkflowTemplateUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkflowTemplate: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDataprocWorkflowTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://dataproc.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workflowTemplates/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting WorkflowTemplate %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "WorkflowTemplate")
+	}
+
+	log.Printf("[DEBUG] Finished deleting WorkflowTemplate %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceDataprocWorkflowTemplateImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workflowTemplates/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)", "(?P<location>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workflowTemplates/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenDataprocWorkflowTemplateName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateDagTimeout(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacement(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["managed_cluster"] =
+		flattenDataprocWorkflowTemplatePlacementManagedCluster(original["managedCluster"], d)
+	transformed["cluster_selector"] =
+		flattenDataprocWorkflowTemplatePlacementClusterSelector(original["clusterSelector"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedCluster(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["cluster_name"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterClusterName(original["clusterName"], d)
+	transformed["labels"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterLabels(original["labels"], d)
+	transformed["config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfig(original["config"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterClusterName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["staging_bucket"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigStagingBucket(original["configBucket"], d)
+	transformed["gce_cluster_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfig(original["gceClusterConfig"], d)
+	transformed["master_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfig(original["masterConfig"], d)
+	transformed["worker_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfig(original["workerConfig"], d)
+	transformed["secondary_worker_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfig(original["secondaryWorkerConfig"], d)
+	transformed["software_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfig(original["softwareConfig"], d)
+	transformed["initialization_actions"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActions(original["initializationActions"], d)
+	transformed["autoscaling_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfig(original["autoscalingConfig"], d)
+	transformed["endpoint_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfig(original["endpointConfig"], d)
+	transformed["encryption_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfig(original["encryptionConfig"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigStagingBucket(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["zone"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigZone(original["zoneUri"], d)
+	transformed["network"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigNetwork(original["networkUri"], d)
+	transformed["subnetwork"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigSubnetwork(original["subnetworkUri"], d)
+	transformed["internal_ip_only"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigInternalIpOnly(original["internalIpOnly"], d)
+	transformed["service_account"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccount(original["serviceAccount"], d)
+	transformed["service_account_scopes"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccountScopes(original["serviceAccountScopes"], d)
+	transformed["tags"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigTags(original["tags"], d)
+	transformed["metadata"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigMetadata(original["metadata"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigZone(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigSubnetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigInternalIpOnly(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccountScopes(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigTags(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigMetadata(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["num_instances"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigNumInstances(original["numInstances"], d)
+	transformed["machine_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMachineType(original["machineTypeUri"], d)
+	transformed["image"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigImage(original["imageUri"], d)
+	transformed["min_cpu_platform"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMinCpuPlatform(original["minCpuPlatform"], d)
+	transformed["disk_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfig(original["diskConfig"], d)
+	transformed["accelerators"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAccelerators(original["accelerators"], d)
+	transformed["instance_names"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigInstanceNames(original["instanceNames"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigNumInstances(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMachineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigImage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMinCpuPlatform(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["boot_disk_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskType(original["bootDiskType"], d)
+	transformed["boot_disk_size_gb"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskSizeGb(original["bootDiskSizeGb"], d)
+	transformed["num_local_ssds"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigNumLocalSsds(original["numLocalSsds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigNumLocalSsds(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAccelerators(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"accelerator_type":  flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorType(original["acceleratorTypeUri"], d),
+			"accelerator_count": flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorCount(original["acceleratorCount"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigInstanceNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["num_instances"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigNumInstances(original["numInstances"], d)
+	transformed["machine_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMachineType(original["machineTypeUri"], d)
+	transformed["image"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigImage(original["imageUri"], d)
+	transformed["min_cpu_platform"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMinCpuPlatform(original["minCpuPlatform"], d)
+	transformed["disk_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfig(original["diskConfig"], d)
+	transformed["accelerators"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAccelerators(original["accelerators"], d)
+	transformed["instance_names"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigInstanceNames(original["instanceNames"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigNumInstances(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMachineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigImage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMinCpuPlatform(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["boot_disk_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskType(original["bootDiskType"], d)
+	transformed["boot_disk_size_gb"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskSizeGb(original["bootDiskSizeGb"], d)
+	transformed["num_local_ssds"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigNumLocalSsds(original["numLocalSsds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigNumLocalSsds(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAccelerators(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"accelerator_type":  flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorType(original["acceleratorTypeUri"], d),
+			"accelerator_count": flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorCount(original["acceleratorCount"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigInstanceNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["num_instances"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigNumInstances(original["numInstances"], d)
+	transformed["machine_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMachineType(original["machineTypeUri"], d)
+	transformed["image"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigImage(original["imageUri"], d)
+	transformed["min_cpu_platform"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMinCpuPlatform(original["minCpuPlatform"], d)
+	transformed["disk_config"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfig(original["diskConfig"], d)
+	transformed["accelerators"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAccelerators(original["accelerators"], d)
+	transformed["instance_names"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigInstanceNames(original["instanceNames"], d)
+	transformed["preemptibility"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigPreemptibility(original["preemptibility"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigNumInstances(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMachineType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigImage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMinCpuPlatform(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["boot_disk_type"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskType(original["bootDiskType"], d)
+	transformed["boot_disk_size_gb"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskSizeGb(original["bootDiskSizeGb"], d)
+	transformed["num_local_ssds"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigNumLocalSsds(original["numLocalSsds"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigNumLocalSsds(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAccelerators(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"accelerator_type":  flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorType(original["acceleratorTypeUri"], d),
+			"accelerator_count": flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorCount(original["acceleratorCount"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigInstanceNames(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigPreemptibility(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["image_version"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigImageVersion(original["imageVersion"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigProperties(original["properties"], d)
+	transformed["optional_components"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigOptionalComponents(original["optionalComponents"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigImageVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigOptionalComponents(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActions(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"executable_file":   flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutableFile(original["executableFile"], d),
+			"execution_timeout": flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutionTimeout(original["executionTimeout"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutableFile(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutionTimeout(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["policy"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfigPolicy(original["policyUri"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfigPolicy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enable_http_port_access"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigEnableHttpPortAccess(original["enableHttpPortAccess"], d)
+	transformed["http_ports"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigHttpPorts(original["httpPorts"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigEnableHttpPortAccess(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigHttpPorts(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["gce_pd_kms_key_name"] =
+		flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfigGcePdKmsKeyName(original["gcePdKmsKeyName"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfigGcePdKmsKeyName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementClusterSelector(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["zone"] =
+		flattenDataprocWorkflowTemplatePlacementClusterSelectorZone(original["zone"], d)
+	transformed["cluster_labels"] =
+		flattenDataprocWorkflowTemplatePlacementClusterSelectorClusterLabels(original["clusterLabels"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplatePlacementClusterSelectorZone(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplatePlacementClusterSelectorClusterLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobs(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"step_id":               flattenDataprocWorkflowTemplateJobsStepId(original["stepId"], d),
+			"prerequisite_step_ids": flattenDataprocWorkflowTemplateJobsPrerequisiteStepIds(original["prerequisiteStepIds"], d),
+			"labels":                flattenDataprocWorkflowTemplateJobsLabels(original["labels"], d),
+			"hadoop_job":            flattenDataprocWorkflowTemplateJobsHadoopJob(original["hadoopJob"], d),
+			"spark_job":             flattenDataprocWorkflowTemplateJobsSparkJob(original["sparkJob"], d),
+			"pyspark_job":           flattenDataprocWorkflowTemplateJobsPysparkJob(original["pysparkJob"], d),
+			"spark_sql_job":         flattenDataprocWorkflowTemplateJobsSparkSqlJob(original["sparkSqlJob"], d),
+			"hive_job":              flattenDataprocWorkflowTemplateJobsHiveJob(original["hiveJob"], d),
+			"pig_job":               flattenDataprocWorkflowTemplateJobsPigJob(original["pigJob"], d),
+			"presto_job":            flattenDataprocWorkflowTemplateJobsPrestoJob(original["prestoJob"], d),
+			"scheduling":            flattenDataprocWorkflowTemplateJobsScheduling(original["scheduling"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplateJobsStepId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrerequisiteStepIds(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["main_jar_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobMainJarFileUri(original["mainJarFileUri"], d)
+	transformed["main_class"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobMainClass(original["mainClass"], d)
+	transformed["args"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobArgs(original["args"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobJarFileUris(original["jarFileUris"], d)
+	transformed["file_uris"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobFileUris(original["fileUris"], d)
+	transformed["archive_uris"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobArchiveUris(original["archiveUris"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsHadoopJobProperties(original["properties"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobMainJarFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobMainClass(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobArgs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobArchiveUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHadoopJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["main_jar_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobMainJarFileUri(original["mainJarFileUri"], d)
+	transformed["main_class"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobMainClass(original["mainClass"], d)
+	transformed["args"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobArgs(original["args"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobJarFileUris(original["jarFileUris"], d)
+	transformed["file_uris"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobFileUris(original["fileUris"], d)
+	transformed["archive_uris"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobArchiveUris(original["archiveUris"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsSparkJobProperties(original["properties"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobMainJarFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobMainClass(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobArgs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobArchiveUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["main_python_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobMainPythonFileUri(original["mainPythonFileUri"], d)
+	transformed["python_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobPythonFileUris(original["pythonFileUris"], d)
+	transformed["args"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobArgs(original["args"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobJarFileUris(original["jarFileUris"], d)
+	transformed["file_uris"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobFileUris(original["fileUris"], d)
+	transformed["archive_uris"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobArchiveUris(original["archiveUris"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsPysparkJobProperties(original["properties"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobMainPythonFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobPythonFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobArgs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobArchiveUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPysparkJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["query_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryFileUri(original["queryFileUri"], d)
+	transformed["query_list"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryList(original["queryList"], d)
+	transformed["script_variables"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobScriptVariables(original["scriptVariables"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobProperties(original["properties"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobJarFileUris(original["jarFileUris"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryList(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["queries"] =
+		flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryListQueries(original["queries"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobQueryListQueries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobScriptVariables(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSparkSqlJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["query_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobQueryFileUri(original["queryFileUri"], d)
+	transformed["query_list"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobQueryList(original["queryList"], d)
+	transformed["script_variables"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobScriptVariables(original["scriptVariables"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobProperties(original["properties"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobJarFileUris(original["jarFileUris"], d)
+	transformed["continue_on_failure"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobContinueOnFailure(original["continueOnFailure"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobQueryFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobQueryList(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["queries"] =
+		flattenDataprocWorkflowTemplateJobsHiveJobQueryListQueries(original["queries"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobQueryListQueries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobScriptVariables(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsHiveJobContinueOnFailure(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["query_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsPigJobQueryFileUri(original["queryFileUri"], d)
+	transformed["query_list"] =
+		flattenDataprocWorkflowTemplateJobsPigJobQueryList(original["queryList"], d)
+	transformed["script_variables"] =
+		flattenDataprocWorkflowTemplateJobsPigJobScriptVariables(original["scriptVariables"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsPigJobProperties(original["properties"], d)
+	transformed["jar_file_uris"] =
+		flattenDataprocWorkflowTemplateJobsPigJobJarFileUris(original["jarFileUris"], d)
+	transformed["continue_on_failure"] =
+		flattenDataprocWorkflowTemplateJobsPigJobContinueOnFailure(original["continueOnFailure"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobQueryFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobQueryList(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["queries"] =
+		flattenDataprocWorkflowTemplateJobsPigJobQueryListQueries(original["queries"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobQueryListQueries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobScriptVariables(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobJarFileUris(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPigJobContinueOnFailure(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJob(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["query_file_uri"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobQueryFileUri(original["queryFileUri"], d)
+	transformed["query_list"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobQueryList(original["queryList"], d)
+	transformed["continue_on_failure"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobContinueOnFailure(original["continueOnFailure"], d)
+	transformed["output_format"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobOutputFormat(original["outputFormat"], d)
+	transformed["client_tags"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobClientTags(original["clientTags"], d)
+	transformed["properties"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobProperties(original["properties"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobQueryFileUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobQueryList(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["queries"] =
+		flattenDataprocWorkflowTemplateJobsPrestoJobQueryListQueries(original["queries"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobQueryListQueries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobContinueOnFailure(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobOutputFormat(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobClientTags(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsPrestoJobProperties(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsScheduling(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["max_failures_per_hour"] =
+		flattenDataprocWorkflowTemplateJobsSchedulingMaxFailuresPerHour(original["maxFailuresPerHour"], d)
+	transformed["max_failures_total"] =
+		flattenDataprocWorkflowTemplateJobsSchedulingMaxFailuresTotal(original["maxFailuresTotal"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateJobsSchedulingMaxFailuresPerHour(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplateJobsSchedulingMaxFailuresTotal(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplateParameters(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name":        flattenDataprocWorkflowTemplateParametersName(original["name"], d),
+			"fields":      flattenDataprocWorkflowTemplateParametersFields(original["fields"], d),
+			"description": flattenDataprocWorkflowTemplateParametersDescription(original["description"], d),
+			"validation":  flattenDataprocWorkflowTemplateParametersValidation(original["validation"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenDataprocWorkflowTemplateParametersName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateParametersFields(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateParametersDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateParametersValidation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["regex"] =
+		flattenDataprocWorkflowTemplateParametersValidationRegex(original["regex"], d)
+	transformed["values"] =
+		flattenDataprocWorkflowTemplateParametersValidationValues(original["values"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateParametersValidationRegex(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["regexes"] =
+		flattenDataprocWorkflowTemplateParametersValidationRegexRegexes(original["regexes"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateParametersValidationRegexRegexes(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateParametersValidationValues(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["values"] =
+		flattenDataprocWorkflowTemplateParametersValidationValuesValues(original["values"], d)
+	return []interface{}{transformed}
+}
+
+func flattenDataprocWorkflowTemplateParametersValidationValuesValues(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateVersion(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenDataprocWorkflowTemplateCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenDataprocWorkflowTemplateUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandDataprocWorkflowTemplateName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateDagTimeout(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacement(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedManagedCluster, err := expandDataprocWorkflowTemplatePlacementManagedCluster(original["managed_cluster"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedManagedCluster); val.IsValid() && !isEmptyValue(val) {
+		transformed["managedCluster"] = transformedManagedCluster
+	}
+
+	transformedClusterSelector, err := expandDataprocWorkflowTemplatePlacementClusterSelector(original["cluster_selector"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterSelector); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterSelector"] = transformedClusterSelector
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedCluster(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedClusterName, err := expandDataprocWorkflowTemplatePlacementManagedClusterClusterName(original["cluster_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterName); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterName"] = transformedClusterName
+	}
+
+	transformedLabels, err := expandDataprocWorkflowTemplatePlacementManagedClusterLabels(original["labels"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLabels); val.IsValid() && !isEmptyValue(val) {
+		transformed["labels"] = transformedLabels
+	}
+
+	transformedConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfig(original["config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["config"] = transformedConfig
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterClusterName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedStagingBucket, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigStagingBucket(original["staging_bucket"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStagingBucket); val.IsValid() && !isEmptyValue(val) {
+		transformed["configBucket"] = transformedStagingBucket
+	}
+
+	transformedGceClusterConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfig(original["gce_cluster_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGceClusterConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["gceClusterConfig"] = transformedGceClusterConfig
+	}
+
+	transformedMasterConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfig(original["master_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMasterConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["masterConfig"] = transformedMasterConfig
+	}
+
+	transformedWorkerConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfig(original["worker_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWorkerConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["workerConfig"] = transformedWorkerConfig
+	}
+
+	transformedSecondaryWorkerConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfig(original["secondary_worker_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSecondaryWorkerConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["secondaryWorkerConfig"] = transformedSecondaryWorkerConfig
+	}
+
+	transformedSoftwareConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfig(original["software_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSoftwareConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["softwareConfig"] = transformedSoftwareConfig
+	}
+
+	transformedInitializationActions, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActions(original["initialization_actions"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInitializationActions); val.IsValid() && !isEmptyValue(val) {
+		transformed["initializationActions"] = transformedInitializationActions
+	}
+
+	transformedAutoscalingConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfig(original["autoscaling_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAutoscalingConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["autoscalingConfig"] = transformedAutoscalingConfig
+	}
+
+	transformedEndpointConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfig(original["endpoint_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEndpointConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["endpointConfig"] = transformedEndpointConfig
+	}
+
+	transformedEncryptionConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfig(original["encryption_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEncryptionConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["encryptionConfig"] = transformedEncryptionConfig
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigStagingBucket(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedZone, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigZone(original["zone"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedZone); val.IsValid() && !isEmptyValue(val) {
+		transformed["zoneUri"] = transformedZone
+	}
+
+	transformedNetwork, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigNetwork(original["network"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNetwork); val.IsValid() && !isEmptyValue(val) {
+		transformed["networkUri"] = transformedNetwork
+	}
+
+	transformedSubnetwork, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigSubnetwork(original["subnetwork"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSubnetwork); val.IsValid() && !isEmptyValue(val) {
+		transformed["subnetworkUri"] = transformedSubnetwork
+	}
+
+	transformedInternalIpOnly, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigInternalIpOnly(original["internal_ip_only"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedInternalIpOnly); val.IsValid() && !isEmptyValue(val) {
+		transformed["internalIpOnly"] = transformedInternalIpOnly
+	}
+
+	transformedServiceAccount, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccount(original["service_account"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedServiceAccount); val.IsValid() && !isEmptyValue(val) {
+		transformed["serviceAccount"] = transformedServiceAccount
+	}
+
+	transformedServiceAccountScopes, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccountScopes(original["service_account_scopes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedServiceAccountScopes); val.IsValid() && !isEmptyValue(val) {
+		transformed["serviceAccountScopes"] = transformedServiceAccountScopes
+	}
+
+	transformedTags, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigTags(original["tags"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTags); val.IsValid() && !isEmptyValue(val) {
+		transformed["tags"] = transformedTags
+	}
+
+	transformedMetadata, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigMetadata(original["metadata"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetadata); val.IsValid() && !isEmptyValue(val) {
+		transformed["metadata"] = transformedMetadata
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigSubnetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigInternalIpOnly(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigServiceAccountScopes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigTags(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigGceClusterConfigMetadata(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNumInstances, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigNumInstances(original["num_instances"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumInstances); val.IsValid() && !isEmptyValue(val) {
+		transformed["numInstances"] = transformedNumInstances
+	}
+
+	transformedMachineType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !isEmptyValue(val) {
+		transformed["machineTypeUri"] = transformedMachineType
+	}
+
+	transformedImage, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigImage(original["image"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedImage); val.IsValid() && !isEmptyValue(val) {
+		transformed["imageUri"] = transformedImage
+	}
+
+	transformedMinCpuPlatform, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMinCpuPlatform(original["min_cpu_platform"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinCpuPlatform); val.IsValid() && !isEmptyValue(val) {
+		transformed["minCpuPlatform"] = transformedMinCpuPlatform
+	}
+
+	transformedDiskConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfig(original["disk_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiskConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["diskConfig"] = transformedDiskConfig
+	}
+
+	transformedAccelerators, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAccelerators(original["accelerators"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAccelerators); val.IsValid() && !isEmptyValue(val) {
+		transformed["accelerators"] = transformedAccelerators
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigNumInstances(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMachineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigImage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigMinCpuPlatform(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBootDiskType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskType(original["boot_disk_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskType); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskType"] = transformedBootDiskType
+	}
+
+	transformedBootDiskSizeGb, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskSizeGb(original["boot_disk_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskSizeGb"] = transformedBootDiskSizeGb
+	}
+
+	transformedNumLocalSsds, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigNumLocalSsds(original["num_local_ssds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumLocalSsds); val.IsValid() && !isEmptyValue(val) {
+		transformed["numLocalSsds"] = transformedNumLocalSsds
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigBootDiskSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigDiskConfigNumLocalSsds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAccelerators(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAcceleratorType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorTypeUri"] = transformedAcceleratorType
+		}
+
+		transformedAcceleratorCount, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorCount(original["accelerator_count"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorCount); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorCount"] = transformedAcceleratorCount
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigMasterConfigAcceleratorsAcceleratorCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNumInstances, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigNumInstances(original["num_instances"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumInstances); val.IsValid() && !isEmptyValue(val) {
+		transformed["numInstances"] = transformedNumInstances
+	}
+
+	transformedMachineType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !isEmptyValue(val) {
+		transformed["machineTypeUri"] = transformedMachineType
+	}
+
+	transformedImage, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigImage(original["image"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedImage); val.IsValid() && !isEmptyValue(val) {
+		transformed["imageUri"] = transformedImage
+	}
+
+	transformedMinCpuPlatform, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMinCpuPlatform(original["min_cpu_platform"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinCpuPlatform); val.IsValid() && !isEmptyValue(val) {
+		transformed["minCpuPlatform"] = transformedMinCpuPlatform
+	}
+
+	transformedDiskConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfig(original["disk_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiskConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["diskConfig"] = transformedDiskConfig
+	}
+
+	transformedAccelerators, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAccelerators(original["accelerators"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAccelerators); val.IsValid() && !isEmptyValue(val) {
+		transformed["accelerators"] = transformedAccelerators
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigNumInstances(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMachineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigImage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigMinCpuPlatform(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBootDiskType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskType(original["boot_disk_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskType); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskType"] = transformedBootDiskType
+	}
+
+	transformedBootDiskSizeGb, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskSizeGb(original["boot_disk_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskSizeGb"] = transformedBootDiskSizeGb
+	}
+
+	transformedNumLocalSsds, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigNumLocalSsds(original["num_local_ssds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumLocalSsds); val.IsValid() && !isEmptyValue(val) {
+		transformed["numLocalSsds"] = transformedNumLocalSsds
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigBootDiskSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigDiskConfigNumLocalSsds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAccelerators(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAcceleratorType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorTypeUri"] = transformedAcceleratorType
+		}
+
+		transformedAcceleratorCount, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorCount(original["accelerator_count"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorCount); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorCount"] = transformedAcceleratorCount
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigWorkerConfigAcceleratorsAcceleratorCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNumInstances, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigNumInstances(original["num_instances"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumInstances); val.IsValid() && !isEmptyValue(val) {
+		transformed["numInstances"] = transformedNumInstances
+	}
+
+	transformedMachineType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMachineType(original["machine_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMachineType); val.IsValid() && !isEmptyValue(val) {
+		transformed["machineTypeUri"] = transformedMachineType
+	}
+
+	transformedImage, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigImage(original["image"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedImage); val.IsValid() && !isEmptyValue(val) {
+		transformed["imageUri"] = transformedImage
+	}
+
+	transformedMinCpuPlatform, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMinCpuPlatform(original["min_cpu_platform"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMinCpuPlatform); val.IsValid() && !isEmptyValue(val) {
+		transformed["minCpuPlatform"] = transformedMinCpuPlatform
+	}
+
+	transformedDiskConfig, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfig(original["disk_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiskConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["diskConfig"] = transformedDiskConfig
+	}
+
+	transformedAccelerators, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAccelerators(original["accelerators"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAccelerators); val.IsValid() && !isEmptyValue(val) {
+		transformed["accelerators"] = transformedAccelerators
+	}
+
+	transformedPreemptibility, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigPreemptibility(original["preemptibility"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPreemptibility); val.IsValid() && !isEmptyValue(val) {
+		transformed["preemptibility"] = transformedPreemptibility
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigNumInstances(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMachineType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigImage(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigMinCpuPlatform(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedBootDiskType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskType(original["boot_disk_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskType); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskType"] = transformedBootDiskType
+	}
+
+	transformedBootDiskSizeGb, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskSizeGb(original["boot_disk_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBootDiskSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["bootDiskSizeGb"] = transformedBootDiskSizeGb
+	}
+
+	transformedNumLocalSsds, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigNumLocalSsds(original["num_local_ssds"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNumLocalSsds); val.IsValid() && !isEmptyValue(val) {
+		transformed["numLocalSsds"] = transformedNumLocalSsds
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigBootDiskSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigDiskConfigNumLocalSsds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAccelerators(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedAcceleratorType, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorType(original["accelerator_type"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorType); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorTypeUri"] = transformedAcceleratorType
+		}
+
+		transformedAcceleratorCount, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorCount(original["accelerator_count"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedAcceleratorCount); val.IsValid() && !isEmptyValue(val) {
+			transformed["acceleratorCount"] = transformedAcceleratorCount
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigAcceleratorsAcceleratorCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSecondaryWorkerConfigPreemptibility(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedImageVersion, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigImageVersion(original["image_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedImageVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["imageVersion"] = transformedImageVersion
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	transformedOptionalComponents, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigOptionalComponents(original["optional_components"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedOptionalComponents); val.IsValid() && !isEmptyValue(val) {
+		transformed["optionalComponents"] = transformedOptionalComponents
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigImageVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigSoftwareConfigOptionalComponents(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedExecutableFile, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutableFile(original["executable_file"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedExecutableFile); val.IsValid() && !isEmptyValue(val) {
+			transformed["executableFile"] = transformedExecutableFile
+		}
+
+		transformedExecutionTimeout, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutionTimeout(original["execution_timeout"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedExecutionTimeout); val.IsValid() && !isEmptyValue(val) {
+			transformed["executionTimeout"] = transformedExecutionTimeout
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutableFile(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigInitializationActionsExecutionTimeout(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedPolicy, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfigPolicy(original["policy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPolicy); val.IsValid() && !isEmptyValue(val) {
+		transformed["policyUri"] = transformedPolicy
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigAutoscalingConfigPolicy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnableHttpPortAccess, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigEnableHttpPortAccess(original["enable_http_port_access"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnableHttpPortAccess); val.IsValid() && !isEmptyValue(val) {
+		transformed["enableHttpPortAccess"] = transformedEnableHttpPortAccess
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigEndpointConfigEnableHttpPortAccess(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedGcePdKmsKeyName, err := expandDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfigGcePdKmsKeyName(original["gce_pd_kms_key_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGcePdKmsKeyName); val.IsValid() && !isEmptyValue(val) {
+		transformed["gcePdKmsKeyName"] = transformedGcePdKmsKeyName
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementManagedClusterConfigEncryptionConfigGcePdKmsKeyName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementClusterSelector(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedZone, err := expandDataprocWorkflowTemplatePlacementClusterSelectorZone(original["zone"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedZone); val.IsValid() && !isEmptyValue(val) {
+		transformed["zone"] = transformedZone
+	}
+
+	transformedClusterLabels, err := expandDataprocWorkflowTemplatePlacementClusterSelectorClusterLabels(original["cluster_labels"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterLabels); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterLabels"] = transformedClusterLabels
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementClusterSelectorZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplatePlacementClusterSelectorClusterLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedStepId, err := expandDataprocWorkflowTemplateJobsStepId(original["step_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedStepId); val.IsValid() && !isEmptyValue(val) {
+			transformed["stepId"] = transformedStepId
+		}
+
+		transformedPrerequisiteStepIds, err := expandDataprocWorkflowTemplateJobsPrerequisiteStepIds(original["prerequisite_step_ids"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPrerequisiteStepIds); val.IsValid() && !isEmptyValue(val) {
+			transformed["prerequisiteStepIds"] = transformedPrerequisiteStepIds
+		}
+
+		transformedLabels, err := expandDataprocWorkflowTemplateJobsLabels(original["labels"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedLabels); val.IsValid() && !isEmptyValue(val) {
+			transformed["labels"] = transformedLabels
+		}
+
+		transformedHadoopJob, err := expandDataprocWorkflowTemplateJobsHadoopJob(original["hadoop_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHadoopJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["hadoopJob"] = transformedHadoopJob
+		}
+
+		transformedSparkJob, err := expandDataprocWorkflowTemplateJobsSparkJob(original["spark_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSparkJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["sparkJob"] = transformedSparkJob
+		}
+
+		transformedPysparkJob, err := expandDataprocWorkflowTemplateJobsPysparkJob(original["pyspark_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPysparkJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["pysparkJob"] = transformedPysparkJob
+		}
+
+		transformedSparkSqlJob, err := expandDataprocWorkflowTemplateJobsSparkSqlJob(original["spark_sql_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedSparkSqlJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["sparkSqlJob"] = transformedSparkSqlJob
+		}
+
+		transformedHiveJob, err := expandDataprocWorkflowTemplateJobsHiveJob(original["hive_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedHiveJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["hiveJob"] = transformedHiveJob
+		}
+
+		transformedPigJob, err := expandDataprocWorkflowTemplateJobsPigJob(original["pig_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPigJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["pigJob"] = transformedPigJob
+		}
+
+		transformedPrestoJob, err := expandDataprocWorkflowTemplateJobsPrestoJob(original["presto_job"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedPrestoJob); val.IsValid() && !isEmptyValue(val) {
+			transformed["prestoJob"] = transformedPrestoJob
+		}
+
+		transformedScheduling, err := expandDataprocWorkflowTemplateJobsScheduling(original["scheduling"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedScheduling); val.IsValid() && !isEmptyValue(val) {
+			transformed["scheduling"] = transformedScheduling
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplateJobsStepId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrerequisiteStepIds(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMainJarFileUri, err := expandDataprocWorkflowTemplateJobsHadoopJobMainJarFileUri(original["main_jar_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMainJarFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["mainJarFileUri"] = transformedMainJarFileUri
+	}
+
+	transformedMainClass, err := expandDataprocWorkflowTemplateJobsHadoopJobMainClass(original["main_class"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMainClass); val.IsValid() && !isEmptyValue(val) {
+		transformed["mainClass"] = transformedMainClass
+	}
+
+	transformedArgs, err := expandDataprocWorkflowTemplateJobsHadoopJobArgs(original["args"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArgs); val.IsValid() && !isEmptyValue(val) {
+		transformed["args"] = transformedArgs
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsHadoopJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	transformedFileUris, err := expandDataprocWorkflowTemplateJobsHadoopJobFileUris(original["file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["fileUris"] = transformedFileUris
+	}
+
+	transformedArchiveUris, err := expandDataprocWorkflowTemplateJobsHadoopJobArchiveUris(original["archive_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArchiveUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["archiveUris"] = transformedArchiveUris
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsHadoopJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobMainJarFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobMainClass(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobArgs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobArchiveUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHadoopJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMainJarFileUri, err := expandDataprocWorkflowTemplateJobsSparkJobMainJarFileUri(original["main_jar_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMainJarFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["mainJarFileUri"] = transformedMainJarFileUri
+	}
+
+	transformedMainClass, err := expandDataprocWorkflowTemplateJobsSparkJobMainClass(original["main_class"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMainClass); val.IsValid() && !isEmptyValue(val) {
+		transformed["mainClass"] = transformedMainClass
+	}
+
+	transformedArgs, err := expandDataprocWorkflowTemplateJobsSparkJobArgs(original["args"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArgs); val.IsValid() && !isEmptyValue(val) {
+		transformed["args"] = transformedArgs
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsSparkJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	transformedFileUris, err := expandDataprocWorkflowTemplateJobsSparkJobFileUris(original["file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["fileUris"] = transformedFileUris
+	}
+
+	transformedArchiveUris, err := expandDataprocWorkflowTemplateJobsSparkJobArchiveUris(original["archive_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArchiveUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["archiveUris"] = transformedArchiveUris
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsSparkJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobMainJarFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobMainClass(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobArgs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobArchiveUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMainPythonFileUri, err := expandDataprocWorkflowTemplateJobsPysparkJobMainPythonFileUri(original["main_python_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMainPythonFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["mainPythonFileUri"] = transformedMainPythonFileUri
+	}
+
+	transformedPythonFileUris, err := expandDataprocWorkflowTemplateJobsPysparkJobPythonFileUris(original["python_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPythonFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["pythonFileUris"] = transformedPythonFileUris
+	}
+
+	transformedArgs, err := expandDataprocWorkflowTemplateJobsPysparkJobArgs(original["args"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArgs); val.IsValid() && !isEmptyValue(val) {
+		transformed["args"] = transformedArgs
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsPysparkJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	transformedFileUris, err := expandDataprocWorkflowTemplateJobsPysparkJobFileUris(original["file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["fileUris"] = transformedFileUris
+	}
+
+	transformedArchiveUris, err := expandDataprocWorkflowTemplateJobsPysparkJobArchiveUris(original["archive_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedArchiveUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["archiveUris"] = transformedArchiveUris
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsPysparkJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobMainPythonFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobPythonFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobArgs(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobArchiveUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPysparkJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueryFileUri, err := expandDataprocWorkflowTemplateJobsSparkSqlJobQueryFileUri(original["query_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryFileUri"] = transformedQueryFileUri
+	}
+
+	transformedQueryList, err := expandDataprocWorkflowTemplateJobsSparkSqlJobQueryList(original["query_list"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryList); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryList"] = transformedQueryList
+	}
+
+	transformedScriptVariables, err := expandDataprocWorkflowTemplateJobsSparkSqlJobScriptVariables(original["script_variables"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedScriptVariables); val.IsValid() && !isEmptyValue(val) {
+		transformed["scriptVariables"] = transformedScriptVariables
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsSparkSqlJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsSparkSqlJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobQueryFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobQueryList(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueries, err := expandDataprocWorkflowTemplateJobsSparkSqlJobQueryListQueries(original["queries"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueries); val.IsValid() && !isEmptyValue(val) {
+		transformed["queries"] = transformedQueries
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobQueryListQueries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobScriptVariables(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSparkSqlJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueryFileUri, err := expandDataprocWorkflowTemplateJobsHiveJobQueryFileUri(original["query_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryFileUri"] = transformedQueryFileUri
+	}
+
+	transformedQueryList, err := expandDataprocWorkflowTemplateJobsHiveJobQueryList(original["query_list"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryList); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryList"] = transformedQueryList
+	}
+
+	transformedScriptVariables, err := expandDataprocWorkflowTemplateJobsHiveJobScriptVariables(original["script_variables"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedScriptVariables); val.IsValid() && !isEmptyValue(val) {
+		transformed["scriptVariables"] = transformedScriptVariables
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsHiveJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsHiveJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	transformedContinueOnFailure, err := expandDataprocWorkflowTemplateJobsHiveJobContinueOnFailure(original["continue_on_failure"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContinueOnFailure); val.IsValid() && !isEmptyValue(val) {
+		transformed["continueOnFailure"] = transformedContinueOnFailure
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobQueryFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobQueryList(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueries, err := expandDataprocWorkflowTemplateJobsHiveJobQueryListQueries(original["queries"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueries); val.IsValid() && !isEmptyValue(val) {
+		transformed["queries"] = transformedQueries
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobQueryListQueries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobScriptVariables(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsHiveJobContinueOnFailure(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueryFileUri, err := expandDataprocWorkflowTemplateJobsPigJobQueryFileUri(original["query_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryFileUri"] = transformedQueryFileUri
+	}
+
+	transformedQueryList, err := expandDataprocWorkflowTemplateJobsPigJobQueryList(original["query_list"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryList); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryList"] = transformedQueryList
+	}
+
+	transformedScriptVariables, err := expandDataprocWorkflowTemplateJobsPigJobScriptVariables(original["script_variables"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedScriptVariables); val.IsValid() && !isEmptyValue(val) {
+		transformed["scriptVariables"] = transformedScriptVariables
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsPigJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	transformedJarFileUris, err := expandDataprocWorkflowTemplateJobsPigJobJarFileUris(original["jar_file_uris"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedJarFileUris); val.IsValid() && !isEmptyValue(val) {
+		transformed["jarFileUris"] = transformedJarFileUris
+	}
+
+	transformedContinueOnFailure, err := expandDataprocWorkflowTemplateJobsPigJobContinueOnFailure(original["continue_on_failure"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContinueOnFailure); val.IsValid() && !isEmptyValue(val) {
+		transformed["continueOnFailure"] = transformedContinueOnFailure
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobQueryFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobQueryList(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueries, err := expandDataprocWorkflowTemplateJobsPigJobQueryListQueries(original["queries"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueries); val.IsValid() && !isEmptyValue(val) {
+		transformed["queries"] = transformedQueries
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobQueryListQueries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobScriptVariables(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobJarFileUris(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPigJobContinueOnFailure(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJob(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueryFileUri, err := expandDataprocWorkflowTemplateJobsPrestoJobQueryFileUri(original["query_file_uri"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryFileUri); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryFileUri"] = transformedQueryFileUri
+	}
+
+	transformedQueryList, err := expandDataprocWorkflowTemplateJobsPrestoJobQueryList(original["query_list"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueryList); val.IsValid() && !isEmptyValue(val) {
+		transformed["queryList"] = transformedQueryList
+	}
+
+	transformedContinueOnFailure, err := expandDataprocWorkflowTemplateJobsPrestoJobContinueOnFailure(original["continue_on_failure"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContinueOnFailure); val.IsValid() && !isEmptyValue(val) {
+		transformed["continueOnFailure"] = transformedContinueOnFailure
+	}
+
+	transformedOutputFormat, err := expandDataprocWorkflowTemplateJobsPrestoJobOutputFormat(original["output_format"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedOutputFormat); val.IsValid() && !isEmptyValue(val) {
+		transformed["outputFormat"] = transformedOutputFormat
+	}
+
+	transformedClientTags, err := expandDataprocWorkflowTemplateJobsPrestoJobClientTags(original["client_tags"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClientTags); val.IsValid() && !isEmptyValue(val) {
+		transformed["clientTags"] = transformedClientTags
+	}
+
+	transformedProperties, err := expandDataprocWorkflowTemplateJobsPrestoJobProperties(original["properties"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProperties); val.IsValid() && !isEmptyValue(val) {
+		transformed["properties"] = transformedProperties
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobQueryFileUri(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobQueryList(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedQueries, err := expandDataprocWorkflowTemplateJobsPrestoJobQueryListQueries(original["queries"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedQueries); val.IsValid() && !isEmptyValue(val) {
+		transformed["queries"] = transformedQueries
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobQueryListQueries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobContinueOnFailure(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobOutputFormat(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobClientTags(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsPrestoJobProperties(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandDataprocWorkflowTemplateJobsScheduling(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMaxFailuresPerHour, err := expandDataprocWorkflowTemplateJobsSchedulingMaxFailuresPerHour(original["max_failures_per_hour"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaxFailuresPerHour); val.IsValid() && !isEmptyValue(val) {
+		transformed["maxFailuresPerHour"] = transformedMaxFailuresPerHour
+	}
+
+	transformedMaxFailuresTotal, err := expandDataprocWorkflowTemplateJobsSchedulingMaxFailuresTotal(original["max_failures_total"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaxFailuresTotal); val.IsValid() && !isEmptyValue(val) {
+		transformed["maxFailuresTotal"] = transformedMaxFailuresTotal
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSchedulingMaxFailuresPerHour(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateJobsSchedulingMaxFailuresTotal(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateParameters(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandDataprocWorkflowTemplateParametersName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		transformedFields, err := expandDataprocWorkflowTemplateParametersFields(original["fields"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedFields); val.IsValid() && !isEmptyValue(val) {
+			transformed["fields"] = transformedFields
+		}
+
+		transformedDescription, err := expandDataprocWorkflowTemplateParametersDescription(original["description"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedDescription); val.IsValid() && !isEmptyValue(val) {
+			transformed["description"] = transformedDescription
+		}
+
+		transformedValidation, err := expandDataprocWorkflowTemplateParametersValidation(original["validation"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedValidation); val.IsValid() && !isEmptyValue(val) {
+			transformed["validation"] = transformedValidation
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandDataprocWorkflowTemplateParametersName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateParametersFields(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateParametersDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateParametersValidation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRegex, err := expandDataprocWorkflowTemplateParametersValidationRegex(original["regex"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRegex); val.IsValid() && !isEmptyValue(val) {
+		transformed["regex"] = transformedRegex
+	}
+
+	transformedValues, err := expandDataprocWorkflowTemplateParametersValidationValues(original["values"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedValues); val.IsValid() && !isEmptyValue(val) {
+		transformed["values"] = transformedValues
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateParametersValidationRegex(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRegexes, err := expandDataprocWorkflowTemplateParametersValidationRegexRegexes(original["regexes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRegexes); val.IsValid() && !isEmptyValue(val) {
+		transformed["regexes"] = transformedRegexes
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateParametersValidationRegexRegexes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandDataprocWorkflowTemplateParametersValidationValues(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedValues, err := expandDataprocWorkflowTemplateParametersValidationValuesValues(original["values"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedValues); val.IsValid() && !isEmptyValue(val) {
+		transformed["values"] = transformedValues
+	}
+
+	return transformed, nil
+}
+
+func expandDataprocWorkflowTemplateParametersValidationValuesValues(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,128 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDataprocWorkflowTemplate_dataprocWorkflowTemplateBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDataprocWorkflowTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataprocWorkflowTemplate_dataprocWorkflowTemplateBasicExample(context),
+			},
+			{
+				ResourceName:      "google_dataproc_workflow_template.template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDataprocWorkflowTemplate_dataprocWorkflowTemplateBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_dataproc_workflow_template" "template" {
+  name     = "tf-test-template-%{random_suffix}"
+  location = "us-central1"
+
+  placement {
+    managed_cluster {
+      cluster_name = "my-cluster"
+
+      config {
+        gce_cluster_config {
+          zone = "us-central1-a"
+          tags = ["foo", "bar"]
+        }
+
+        master_config {
+          num_instances = 1
+          machine_type  = "n1-standard-1"
+
+          disk_config {
+            boot_disk_type    = "pd-ssd"
+            boot_disk_size_gb = 15
+          }
+        }
+
+        worker_config {
+          num_instances = 2
+          machine_type  = "n1-standard-2"
+
+          disk_config {
+            boot_disk_size_gb = 15
+          }
+        }
+
+        secondary_worker_config {
+          num_instances = 2
+        }
+
+        software_config {
+          image_version = "1.3.7-deb9"
+        }
+      }
+    }
+  }
+
+  jobs {
+    step_id = "someJob"
+
+    spark_job {
+      main_class = "SomeClass"
+    }
+  }
+
+  jobs {
+    step_id               = "otherJob"
+    prerequisite_step_ids = ["someJob"]
+
+    hive_job {
+      query_list {
+        queries = ["SHOW DATABASES"]
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckDataprocWorkflowTemplateDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_dataproc_workflow_template" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://dataproc.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workflowTemplates/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("DataprocWorkflowTemplate still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDatastoreIndex_datastoreIndexExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDatastoreIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatastoreIndex_datastoreIndexExample(context),
+			},
+			{
+				ResourceName:      "google_datastore_index.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDatastoreIndex_datastoreIndexExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_datastore_index" "default" {
+  kind = "foo"
+  properties {
+    name      = "property_a%{random_suffix}"
+    direction = "ASCENDING"
+  }
+  properties {
+    name      = "property_b%{random_suffix}"
+    direction = "ASCENDING"
+  }
+}
+`, context)
+}
+
+func testAccCheckDatastoreIndexDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_datastore_index" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://datastore.googleapis.com/v1/projects/{{project}}/indexes/{{index_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("DatastoreIndex still exists at %s", url)
+		}
+	}
+
+	return nil
+}
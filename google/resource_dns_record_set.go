@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/dns/v1"
 	"net"
 )
@@ -21,6 +22,8 @@ func resourceDnsRecordSet() *schema.Resource {
 			State: resourceDnsRecordSetImportState,
 		},
 
+		CustomizeDiff: resourceDnsRecordSetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"managed_zone": {
 				Type:     schema.TypeString,
@@ -35,8 +38,9 @@ func resourceDnsRecordSet() *schema.Resource {
 			},
 
 			"rrdatas": {
-				Type:     schema.TypeList,
-				Required: true,
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"routing_policy"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -53,7 +57,7 @@ func resourceDnsRecordSet() *schema.Resource {
 
 			"ttl": {
 				Type:     schema.TypeInt,
-				Required: true,
+				Optional: true,
 			},
 
 			"type": {
@@ -61,6 +65,76 @@ func resourceDnsRecordSet() *schema.Resource {
 				Required: true,
 			},
 
+			"routing_policy": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rrdatas"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"wrr": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeFloat,
+										Required: true,
+									},
+									"rrdatas": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"health_checked_targets": dnsHealthCheckedTargetsSchema(),
+								},
+							},
+						},
+						"geo": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     dnsGeoPolicyItemSchema(),
+						},
+						"enable_geo_fencing": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"primary_backup": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"primary": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"internal_load_balancers": dnsInternalLoadBalancersSchema(),
+											},
+										},
+									},
+									"backup_geo": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     dnsGeoPolicyItemSchema(),
+									},
+									"enable_geo_fencing_for_backups": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"trickle_ratio": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,6 +145,92 @@ func resourceDnsRecordSet() *schema.Resource {
 	}
 }
 
+func dnsGeoPolicyItemSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rrdatas": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"health_checked_targets": dnsHealthCheckedTargetsSchema(),
+		},
+	}
+}
+
+func dnsHealthCheckedTargetsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"internal_load_balancers": dnsInternalLoadBalancersSchema(),
+			},
+		},
+	}
+}
+
+func dnsInternalLoadBalancersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"load_balancer_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"regionalL4ilb", "regionalL7ilb", "globalL7ilb"}, false),
+				},
+				"ip_address": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"port": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"ip_protocol": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+				},
+				"network_url": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: compareSelfLinkOrResourceName,
+				},
+				"project": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"region": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// A record set needs its data from either rrdatas or routing_policy. Values
+// that aren't known yet are let through and checked by the API instead.
+func resourceDnsRecordSetCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("rrdatas") || !diff.NewValueKnown("routing_policy") {
+		return nil
+	}
+	_, hasRrdatas := diff.GetOk("rrdatas")
+	_, hasRoutingPolicy := diff.GetOk("routing_policy")
+	if !hasRrdatas && !hasRoutingPolicy {
+		return fmt.Errorf("one of rrdatas or routing_policy must be set")
+	}
+	return nil
+}
+
 func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -94,6 +254,7 @@ func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error
 			},
 		},
 	}
+	routingPolicy := expandDnsRecordSetRoutingPolicy(d.Get("routing_policy").([]interface{}))
 
 	// we need to replace NS record sets in the same call. That means
 	// we need to list all the current NS record sets attached to the
@@ -123,7 +284,7 @@ func resourceDnsRecordSetCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] DNS Record create request: %#v", chg)
-	chg, err = config.clientDns.Changes.Create(project, zone, chg).Do()
+	chg, err = createDnsChange(config, project, zone, chg, nil, routingPolicy)
 	if err != nil {
 		return fmt.Errorf("Error creating DNS RecordSet: %s", err)
 	}
@@ -158,24 +319,33 @@ func resourceDnsRecordSetRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	dnsType := d.Get("type").(string)
 
-	resp, err := config.clientDns.ResourceRecordSets.List(
-		project, zone).Name(name).Type(dnsType).Do()
+	// The vendored DNS client predates routing policies, so record sets are read
+	// through the JSON API to see them.
+	url := fmt.Sprintf("https://www.googleapis.com/dns/v1/projects/%s/managedZones/%s/rrsets?name=%s&type=%s", project, zone, name, dnsType)
+	resp, err := sendRequest(config, "GET", url, nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("DNS Record Set %q", d.Get("name").(string)))
 	}
-	if len(resp.Rrsets) == 0 {
+	rrsets, _ := resp["rrsets"].([]interface{})
+	if len(rrsets) == 0 {
 		// The resource doesn't exist anymore
 		d.SetId("")
 		return nil
 	}
 
-	if len(resp.Rrsets) > 1 {
-		return fmt.Errorf("Only expected 1 record set, got %d", len(resp.Rrsets))
+	if len(rrsets) > 1 {
+		return fmt.Errorf("Only expected 1 record set, got %d", len(rrsets))
 	}
 
-	d.Set("type", resp.Rrsets[0].Type)
-	d.Set("ttl", resp.Rrsets[0].Ttl)
-	d.Set("rrdatas", resp.Rrsets[0].Rrdatas)
+	rrset := rrsets[0].(map[string]interface{})
+	d.Set("type", rrset["type"])
+	if ttl, ok := rrset["ttl"].(float64); ok {
+		d.Set("ttl", int(ttl))
+	}
+	d.Set("rrdatas", rrset["rrdatas"])
+	if err := d.Set("routing_policy", flattenDnsRecordSetRoutingPolicy(rrset["routingPolicy"])); err != nil {
+		return fmt.Errorf("Error reading DNS Record Set routing_policy: %s", err)
+	}
 	d.Set("project", project)
 
 	return nil
@@ -223,8 +393,10 @@ func resourceDnsRecordSetDelete(d *schema.ResourceData, meta interface{}) error
 		},
 	}
 
+	routingPolicy := expandDnsRecordSetRoutingPolicy(d.Get("routing_policy").([]interface{}))
+
 	log.Printf("[DEBUG] DNS Record delete request: %#v", chg)
-	chg, err = config.clientDns.Changes.Create(project, zone, chg).Do()
+	chg, err = createDnsChange(config, project, zone, chg, routingPolicy, nil)
 	if err != nil {
 		return fmt.Errorf("Error deleting DNS RecordSet: %s", err)
 	}
@@ -285,8 +457,12 @@ func resourceDnsRecordSetUpdate(d *schema.ResourceData, meta interface{}) error
 		oldRR, _ := d.GetChange(rrKey)
 		chg.Deletions[0].Rrdatas[i] = oldRR.(string)
 	}
+	oldRoutingPolicy, newRoutingPolicy := d.GetChange("routing_policy")
+
 	log.Printf("[DEBUG] DNS Record change request: %#v old: %#v new: %#v", chg, chg.Deletions[0], chg.Additions[0])
-	chg, err = config.clientDns.Changes.Create(project, zone, chg).Do()
+	chg, err = createDnsChange(config, project, zone, chg,
+		expandDnsRecordSetRoutingPolicy(oldRoutingPolicy.([]interface{})),
+		expandDnsRecordSetRoutingPolicy(newRoutingPolicy.([]interface{})))
 	if err != nil {
 		return fmt.Errorf("Error changing DNS RecordSet: %s", err)
 	}
@@ -317,6 +493,228 @@ func resourceDnsRecordSetImportState(d *schema.ResourceData, _ interface{}) ([]*
 	return []*schema.ResourceData{d}, nil
 }
 
+// createDnsChange submits chg through the JSON API, attaching the given routing
+// policies to its first deletion and addition, which the vendored client can't
+// represent.
+func createDnsChange(config *Config, project, zone string, chg *dns.Change, deletionPolicy, additionPolicy map[string]interface{}) (*dns.Change, error) {
+	obj, err := ConvertToMap(chg)
+	if err != nil {
+		return nil, err
+	}
+	if deletionPolicy != nil {
+		setDnsChangeRoutingPolicy(obj, "deletions", deletionPolicy)
+	}
+	if additionPolicy != nil {
+		setDnsChangeRoutingPolicy(obj, "additions", additionPolicy)
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/dns/v1/projects/%s/managedZones/%s/changes", project, zone)
+	res, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &dns.Change{}
+	if err := Convert(res, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func setDnsChangeRoutingPolicy(chg map[string]interface{}, field string, routingPolicy map[string]interface{}) {
+	rrsets, ok := chg[field].([]interface{})
+	if !ok || len(rrsets) == 0 {
+		return
+	}
+	rrset := rrsets[0].(map[string]interface{})
+	rrset["routingPolicy"] = routingPolicy
+	// A record set with a routing policy keeps its data inside the policy.
+	delete(rrset, "rrdatas")
+}
+
+func expandDnsRecordSetRoutingPolicy(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	data := configured[0].(map[string]interface{})
+	policy := make(map[string]interface{})
+
+	if wrr := data["wrr"].([]interface{}); len(wrr) > 0 {
+		items := make([]interface{}, 0, len(wrr))
+		for _, raw := range wrr {
+			item := raw.(map[string]interface{})
+			items = append(items, map[string]interface{}{
+				"weight":               item["weight"],
+				"rrdatas":              item["rrdatas"],
+				"healthCheckedTargets": expandDnsHealthCheckedTargets(item["health_checked_targets"].([]interface{})),
+			})
+		}
+		policy["wrr"] = map[string]interface{}{
+			"items": items,
+		}
+	}
+
+	if geo := data["geo"].([]interface{}); len(geo) > 0 {
+		policy["geo"] = map[string]interface{}{
+			"items":         expandDnsGeoPolicyItems(geo),
+			"enableFencing": data["enable_geo_fencing"],
+		}
+	}
+
+	if pb := data["primary_backup"].([]interface{}); len(pb) > 0 {
+		primaryBackup := pb[0].(map[string]interface{})
+		primary := primaryBackup["primary"].([]interface{})[0].(map[string]interface{})
+		policy["primaryBackup"] = map[string]interface{}{
+			"primaryTargets": map[string]interface{}{
+				"internalLoadBalancers": expandDnsInternalLoadBalancers(primary["internal_load_balancers"].([]interface{})),
+			},
+			"backupGeoTargets": map[string]interface{}{
+				"items":         expandDnsGeoPolicyItems(primaryBackup["backup_geo"].([]interface{})),
+				"enableFencing": primaryBackup["enable_geo_fencing_for_backups"],
+			},
+			"trickleTraffic": primaryBackup["trickle_ratio"],
+		}
+	}
+
+	return policy
+}
+
+func expandDnsGeoPolicyItems(configured []interface{}) []interface{} {
+	items := make([]interface{}, 0, len(configured))
+	for _, raw := range configured {
+		item := raw.(map[string]interface{})
+		items = append(items, map[string]interface{}{
+			"location":             item["location"],
+			"rrdatas":              item["rrdatas"],
+			"healthCheckedTargets": expandDnsHealthCheckedTargets(item["health_checked_targets"].([]interface{})),
+		})
+	}
+	return items
+}
+
+func expandDnsHealthCheckedTargets(configured []interface{}) interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	data := configured[0].(map[string]interface{})
+	return map[string]interface{}{
+		"internalLoadBalancers": expandDnsInternalLoadBalancers(data["internal_load_balancers"].([]interface{})),
+	}
+}
+
+func expandDnsInternalLoadBalancers(configured []interface{}) []interface{} {
+	lbs := make([]interface{}, 0, len(configured))
+	for _, raw := range configured {
+		lb := raw.(map[string]interface{})
+		transformed := map[string]interface{}{
+			"loadBalancerType": lb["load_balancer_type"],
+			"ipAddress":        lb["ip_address"],
+			"port":             lb["port"],
+			"ipProtocol":       lb["ip_protocol"],
+			"networkUrl":       lb["network_url"],
+			"project":          lb["project"],
+		}
+		// Only regional load balancers have a region. Sending an empty one would
+		// not match the record set the API returns when it's deleted later.
+		if region, ok := lb["region"].(string); ok && region != "" {
+			transformed["region"] = region
+		}
+		lbs = append(lbs, transformed)
+	}
+	return lbs
+}
+
+func flattenDnsRecordSetRoutingPolicy(v interface{}) []interface{} {
+	policy, ok := v.(map[string]interface{})
+	if !ok || len(policy) == 0 {
+		return nil
+	}
+	data := map[string]interface{}{}
+
+	if wrr, ok := policy["wrr"].(map[string]interface{}); ok {
+		items, _ := wrr["items"].([]interface{})
+		flattened := make([]interface{}, 0, len(items))
+		for _, raw := range items {
+			item := raw.(map[string]interface{})
+			flattened = append(flattened, map[string]interface{}{
+				"weight":                 item["weight"],
+				"rrdatas":                item["rrdatas"],
+				"health_checked_targets": flattenDnsHealthCheckedTargets(item["healthCheckedTargets"]),
+			})
+		}
+		data["wrr"] = flattened
+	}
+
+	if geo, ok := policy["geo"].(map[string]interface{}); ok {
+		data["geo"] = flattenDnsGeoPolicyItems(geo["items"])
+		data["enable_geo_fencing"] = geo["enableFencing"]
+	}
+
+	if pb, ok := policy["primaryBackup"].(map[string]interface{}); ok {
+		primaryBackup := map[string]interface{}{
+			"trickle_ratio": pb["trickleTraffic"],
+		}
+		if primary, ok := pb["primaryTargets"].(map[string]interface{}); ok {
+			primaryBackup["primary"] = []interface{}{
+				map[string]interface{}{
+					"internal_load_balancers": flattenDnsInternalLoadBalancers(primary["internalLoadBalancers"]),
+				},
+			}
+		}
+		if backup, ok := pb["backupGeoTargets"].(map[string]interface{}); ok {
+			primaryBackup["backup_geo"] = flattenDnsGeoPolicyItems(backup["items"])
+			primaryBackup["enable_geo_fencing_for_backups"] = backup["enableFencing"]
+		}
+		data["primary_backup"] = []interface{}{primaryBackup}
+	}
+
+	return []interface{}{data}
+}
+
+func flattenDnsGeoPolicyItems(v interface{}) []interface{} {
+	items, _ := v.([]interface{})
+	flattened := make([]interface{}, 0, len(items))
+	for _, raw := range items {
+		item := raw.(map[string]interface{})
+		flattened = append(flattened, map[string]interface{}{
+			"location":               item["location"],
+			"rrdatas":                item["rrdatas"],
+			"health_checked_targets": flattenDnsHealthCheckedTargets(item["healthCheckedTargets"]),
+		})
+	}
+	return flattened
+}
+
+func flattenDnsHealthCheckedTargets(v interface{}) []interface{} {
+	targets, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"internal_load_balancers": flattenDnsInternalLoadBalancers(targets["internalLoadBalancers"]),
+		},
+	}
+}
+
+func flattenDnsInternalLoadBalancers(v interface{}) []interface{} {
+	lbs, _ := v.([]interface{})
+	flattened := make([]interface{}, 0, len(lbs))
+	for _, raw := range lbs {
+		lb := raw.(map[string]interface{})
+		flattened = append(flattened, map[string]interface{}{
+			"load_balancer_type": lb["loadBalancerType"],
+			"ip_address":         lb["ipAddress"],
+			"port":               lb["port"],
+			"ip_protocol":        lb["ipProtocol"],
+			"network_url":        lb["networkUrl"],
+			"project":            lb["project"],
+			"region":             lb["region"],
+		})
+	}
+	return flattened
+}
+
 func rrdata(
 	d *schema.ResourceData,
 ) []string {
@@ -34,6 +34,36 @@ func TestIpv6AddressDiffSuppress(t *testing.T) {
 	}
 }
 
+func TestExpandDnsInternalLoadBalancersOmitsEmptyRegion(t *testing.T) {
+	lbs := expandDnsInternalLoadBalancers([]interface{}{
+		map[string]interface{}{
+			"load_balancer_type": "globalL7ilb",
+			"ip_address":         "10.0.0.1",
+			"port":               "80",
+			"ip_protocol":        "tcp",
+			"network_url":        "https://www.googleapis.com/compute/v1/projects/p/global/networks/n",
+			"project":            "p",
+			"region":             "",
+		},
+		map[string]interface{}{
+			"load_balancer_type": "regionalL4ilb",
+			"ip_address":         "10.0.0.2",
+			"port":               "80",
+			"ip_protocol":        "tcp",
+			"network_url":        "https://www.googleapis.com/compute/v1/projects/p/global/networks/n",
+			"project":            "p",
+			"region":             "us-central1",
+		},
+	})
+
+	if _, ok := lbs[0].(map[string]interface{})["region"]; ok {
+		t.Errorf("expected no region for a global load balancer, got %v", lbs[0])
+	}
+	if region := lbs[1].(map[string]interface{})["region"]; region != "us-central1" {
+		t.Errorf("expected region us-central1, got %v", region)
+	}
+}
+
 func TestAccDnsRecordSet_basic(t *testing.T) {
 	t.Parallel()
 
@@ -207,6 +237,60 @@ func TestAccDnsRecordSet_uppercaseMX(t *testing.T) {
 	})
 }
 
+func TestAccDnsRecordSet_routingPolicy(t *testing.T) {
+	t.Parallel()
+
+	zoneName := fmt.Sprintf("dnszone-test-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsRecordSet_routingPolicyWrr(zoneName, 300),
+			},
+			{
+				ResourceName:      "google_dns_record_set.foobar",
+				ImportStateId:     fmt.Sprintf("%s/test-record.%s.hashicorptest.com./A", zoneName, zoneName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDnsRecordSet_routingPolicyGeo(zoneName, 300),
+			},
+			{
+				ResourceName:      "google_dns_record_set.foobar",
+				ImportStateId:     fmt.Sprintf("%s/test-record.%s.hashicorptest.com./A", zoneName, zoneName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDnsRecordSet_routingPolicyPrimaryBackup(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	zoneName := fmt.Sprintf("dnszone-test-%s", suffix)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsRecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDnsRecordSet_routingPolicyPrimaryBackup(zoneName, suffix, 300),
+			},
+			{
+				ResourceName:      "google_dns_record_set.foobar",
+				ImportStateId:     fmt.Sprintf("%s/test-record.%s.hashicorptest.com./A", zoneName, zoneName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDnsRecordSetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -364,3 +448,143 @@ func testAccDnsRecordSet_uppercaseMX(name string, ttl int) string {
 	}
 	`, name, name, name, ttl)
 }
+
+func testAccDnsRecordSet_routingPolicyWrr(zoneName string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "google_dns_managed_zone" "parent-zone" {
+		name = "%s"
+		dns_name = "%s.hashicorptest.com."
+		description = "Test Description"
+	}
+	resource "google_dns_record_set" "foobar" {
+		managed_zone = "${google_dns_managed_zone.parent-zone.name}"
+		name = "test-record.%s.hashicorptest.com."
+		type = "A"
+		ttl = %d
+
+		routing_policy {
+			wrr {
+				weight = 0
+				rrdatas = ["1.2.3.4", "4.3.2.1"]
+			}
+
+			wrr {
+				weight = 0
+				rrdatas = ["2.3.4.5", "5.4.3.2"]
+			}
+		}
+	}
+	`, zoneName, zoneName, zoneName, ttl)
+}
+
+func testAccDnsRecordSet_routingPolicyGeo(zoneName string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "google_dns_managed_zone" "parent-zone" {
+		name = "%s"
+		dns_name = "%s.hashicorptest.com."
+		description = "Test Description"
+	}
+	resource "google_dns_record_set" "foobar" {
+		managed_zone = "${google_dns_managed_zone.parent-zone.name}"
+		name = "test-record.%s.hashicorptest.com."
+		type = "A"
+		ttl = %d
+
+		routing_policy {
+			enable_geo_fencing = true
+
+			geo {
+				location = "us-east4"
+				rrdatas = ["1.2.3.4", "4.3.2.1"]
+			}
+
+			geo {
+				location = "asia-east1"
+				rrdatas = ["2.3.4.5", "5.4.3.2"]
+			}
+		}
+	}
+	`, zoneName, zoneName, zoneName, ttl)
+}
+
+func testAccDnsRecordSet_routingPolicyPrimaryBackup(zoneName, suffix string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "google_compute_network" "default" {
+		name = "tf-test-network-%s"
+		auto_create_subnetworks = true
+	}
+
+	resource "google_compute_health_check" "default" {
+		name = "tf-test-hc-%s"
+		check_interval_sec = 1
+		timeout_sec = 1
+
+		tcp_health_check {
+			port = "80"
+		}
+	}
+
+	resource "google_compute_region_backend_service" "default" {
+		name = "tf-test-bs-%s"
+		region = "us-central1"
+		health_checks = ["${google_compute_health_check.default.self_link}"]
+	}
+
+	resource "google_compute_forwarding_rule" "default" {
+		name = "tf-test-fr-%s"
+		region = "us-central1"
+		load_balancing_scheme = "INTERNAL"
+		backend_service = "${google_compute_region_backend_service.default.self_link}"
+		all_ports = true
+		network = "${google_compute_network.default.name}"
+	}
+
+	resource "google_dns_managed_zone" "parent-zone" {
+		name = "%s"
+		dns_name = "%s.hashicorptest.com."
+		description = "Test Description"
+		visibility = "private"
+
+		private_visibility_config {
+			networks {
+				network_url = "${google_compute_network.default.self_link}"
+			}
+		}
+	}
+
+	resource "google_dns_record_set" "foobar" {
+		managed_zone = "${google_dns_managed_zone.parent-zone.name}"
+		name = "test-record.%s.hashicorptest.com."
+		type = "A"
+		ttl = %d
+
+		routing_policy {
+			primary_backup {
+				trickle_ratio = 0.1
+
+				primary {
+					internal_load_balancers {
+						load_balancer_type = "regionalL4ilb"
+						ip_address = "${google_compute_forwarding_rule.default.ip_address}"
+						port = "80"
+						ip_protocol = "tcp"
+						network_url = "${google_compute_network.default.self_link}"
+						project = "${google_compute_forwarding_rule.default.project}"
+						region = "${google_compute_forwarding_rule.default.region}"
+					}
+				}
+
+				backup_geo {
+					location = "asia-east1"
+					rrdatas = ["1.2.3.4"]
+				}
+
+				backup_geo {
+					location = "us-west1"
+					rrdatas = ["2.3.4.5"]
+				}
+			}
+		}
+	}
+	`, suffix, suffix, suffix, suffix, zoneName, zoneName, zoneName, ttl)
+}
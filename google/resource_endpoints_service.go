@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/servicemanagement/v1"
@@ -16,6 +17,10 @@ func resourceEndpointsService() *schema.Resource {
 		Delete: resourceEndpointsServiceDelete,
 		Update: resourceEndpointsServiceUpdate,
 
+		Importer: &schema.ResourceImporter{
+			State: resourceEndpointsServiceImport,
+		},
+
 		// Migrates protoc_output -> protoc_output_base64.
 		SchemaVersion: 1,
 		MigrateState:  migrateEndpointsService,
@@ -269,7 +274,7 @@ func resourceEndpointsServiceRead(d *schema.ResourceData, meta interface{}) erro
 	servicesService := servicemanagement.NewServicesService(config.clientServiceMan)
 	service, err := servicesService.GetConfig(d.Get("service_name").(string)).Do()
 	if err != nil {
-		return err
+		return handleNotFoundError(err, d, fmt.Sprintf("Endpoints Service %q", d.Get("service_name").(string)))
 	}
 	d.Set("config_id", service.Id)
 	d.Set("dns_address", service.Name)
@@ -279,6 +284,23 @@ func resourceEndpointsServiceRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func resourceEndpointsServiceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	servicesService := servicemanagement.NewServicesService(config.clientServiceMan)
+	service, err := servicesService.Get(d.Id()).Do()
+	if err != nil {
+		return nil, fmt.Errorf("Error reading Endpoints Service %q: %s", d.Id(), err)
+	}
+
+	// The config source files can't be read back from the API, so only the
+	// service's identity is imported.
+	d.Set("service_name", service.ServiceName)
+	d.Set("project", service.ProducerProjectId)
+	d.SetId(service.ServiceName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenServiceManagementAPIs(apis []*servicemanagement.Api) []map[string]interface{} {
 	flattened := make([]map[string]interface{}, len(apis))
 	for i, a := range apis {
@@ -24,6 +24,12 @@ func TestAccEndpointsService_basic(t *testing.T) {
 				Config: testAccEndpointsService_basic(random_name),
 				Check:  testAccCheckEndpointExistsByName(random_name),
 			},
+			{
+				ResourceName:            "google_endpoints_service.endpoints_service",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"openapi_config", "grpc_config", "protoc_output_base64"},
+			},
 		},
 	})
 }
@@ -40,6 +46,12 @@ func TestAccEndpointsService_grpc(t *testing.T) {
 				Config: testAccEndpointsService_grpc(random_name),
 				Check:  testAccCheckEndpointExistsByName(random_name),
 			},
+			{
+				ResourceName:            "google_endpoints_service.endpoints_service",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"openapi_config", "grpc_config", "protoc_output_base64"},
+			},
 		},
 	})
 }
@@ -0,0 +1,257 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceEssentialContactsContact() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEssentialContactsContactCreate,
+		Read:   resourceEssentialContactsContactRead,
+		Update: resourceEssentialContactsContactUpdate,
+		Delete: resourceEssentialContactsContactDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceEssentialContactsContactImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"language_tag": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"notification_category_subscriptions": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^(projects|folders|organizations)/[^/]+$`),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceEssentialContactsContactCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	emailProp, err := expandEssentialContactsContactEmail(d.Get("email"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("email"); !isEmptyValue(reflect.ValueOf(emailProp)) && (ok || !reflect.DeepEqual(v, emailProp)) {
+		obj["email"] = emailProp
+	}
+	notificationCategorySubscriptionsProp, err := expandEssentialContactsContactNotificationCategorySubscriptions(d.Get("notification_category_subscriptions"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("notification_category_subscriptions"); !isEmptyValue(reflect.ValueOf(notificationCategorySubscriptionsProp)) && (ok || !reflect.DeepEqual(v, notificationCategorySubscriptionsProp)) {
+		obj["notificationCategorySubscriptions"] = notificationCategorySubscriptionsProp
+	}
+	languageTagProp, err := expandEssentialContactsContactLanguageTag(d.Get("language_tag"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("language_tag"); !isEmptyValue(reflect.ValueOf(languageTagProp)) && (ok || !reflect.DeepEqual(v, languageTagProp)) {
+		obj["languageTag"] = languageTagProp
+	}
+
+	url, err := replaceVars(d, config, "https://essentialcontacts.googleapis.com/v1/{{parent}}/contacts")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Contact: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Contact: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Contact %q: %#v", d.Id(), res)
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.Set("name", name.(string))
+	d.SetId(name.(string))
+
+	return resourceEssentialContactsContactRead(d, meta)
+}
+
+func resourceEssentialContactsContactRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://essentialcontacts.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("EssentialContactsContact %q", d.Id()))
+	}
+
+	if err := d.Set("name", flattenEssentialContactsContactName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Contact: %s", err)
+	}
+	if err := d.Set("email", flattenEssentialContactsContactEmail(res["email"], d)); err != nil {
+		return fmt.Errorf("Error reading Contact: %s", err)
+	}
+	if err := d.Set("notification_category_subscriptions", flattenEssentialContactsContactNotificationCategorySubscriptions(res["notificationCategorySubscriptions"], d)); err != nil {
+		return fmt.Errorf("Error reading Contact: %s", err)
+	}
+	if err := d.Set("language_tag", flattenEssentialContactsContactLanguageTag(res["languageTag"], d)); err != nil {
+		return fmt.Errorf("Error reading Contact: %s", err)
+	}
+
+	return nil
+}
+
+func resourceEssentialContactsContactUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	notificationCategorySubscriptionsProp, err := expandEssentialContactsContactNotificationCategorySubscriptions(d.Get("notification_category_subscriptions"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("notification_category_subscriptions"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, notificationCategorySubscriptionsProp)) {
+		obj["notificationCategorySubscriptions"] = notificationCategorySubscriptionsProp
+	}
+	languageTagProp, err := expandEssentialContactsContactLanguageTag(d.Get("language_tag"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("language_tag"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, languageTagProp)) {
+		obj["languageTag"] = languageTagProp
+	}
+
+	url, err := replaceVars(d, config, "https://essentialcontacts.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Contact %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("notification_category_subscriptions") {
+		updateMask = append(updateMask, "notificationCategorySubscriptions")
+	}
+
+	if d.HasChange("language_tag") {
+		updateMask = append(updateMask, "languageTag")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Contact %q: %s", d.Id(), err)
+	}
+
+	return resourceEssentialContactsContactRead(d, meta)
+}
+
+func resourceEssentialContactsContactDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://essentialcontacts.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Contact %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Contact")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Contact %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceEssentialContactsContactImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// The parent isn't returned by the API, but it's part of the name.
+	if parts := strings.Split(d.Id(), "/contacts/"); len(parts) == 2 {
+		d.Set("parent", parts[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenEssentialContactsContactName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenEssentialContactsContactEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenEssentialContactsContactNotificationCategorySubscriptions(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenEssentialContactsContactLanguageTag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandEssentialContactsContactEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandEssentialContactsContactNotificationCategorySubscriptions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandEssentialContactsContactLanguageTag(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,72 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccEssentialContactsContact_essentialContactExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEssentialContactsContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEssentialContactsContact_essentialContactExample(context),
+			},
+			{
+				ResourceName:      "google_essential_contacts_contact.contact",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEssentialContactsContact_essentialContactExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_essential_contacts_contact" "contact" {
+  parent                              = "projects/%{project}"
+  email                               = "foo@bar.com"
+  language_tag                        = "en-GB"
+  notification_category_subscriptions = ["ALL"]
+}
+`, context)
+}
+
+func testAccCheckEssentialContactsContactDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_essential_contacts_contact" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://essentialcontacts.googleapis.com/v1/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("EssentialContactsContact still exists at %s", url)
+		}
+	}
+
+	return nil
+}
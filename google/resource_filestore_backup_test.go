@@ -0,0 +1,93 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFilestoreBackup_filestoreBackupBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFilestoreBackupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilestoreBackup_filestoreBackupBasicExample(context),
+			},
+			{
+				ResourceName:      "google_filestore_backup.backup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilestoreBackup_filestoreBackupBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_filestore_instance" "instance" {
+  name = "tf-test-instance-%{random_suffix}"
+  zone = "us-central1-b"
+  tier = "BASIC_HDD"
+
+  file_shares {
+    capacity_gb = 1024
+    name        = "share1"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+
+resource "google_filestore_backup" "backup" {
+  name              = "tf-test-fs-bkup%{random_suffix}"
+  location          = "us-central1"
+  description       = "This is a filestore backup for the test instance"
+  source_instance   = "${google_filestore_instance.instance.id}"
+  source_file_share = "share1"
+
+  labels = {
+    "files":"label1",
+    "other-label": "label2"
+  }
+}
+`, context)
+}
+
+func testAccCheckFilestoreBackupDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_filestore_backup" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://file.googleapis.com/v1/projects/{{project}}/locations/{{location}}/backups/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FilestoreBackup still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -1,3 +1,230 @@
 package google
 
-// Magic Modules doesn't let us remove files - blank out beta-only common-compile files for now.
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFilestoreInstance_filestoreInstanceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFilestoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilestoreInstance_filestoreInstanceBasicExample(context),
+			},
+			{
+				ResourceName:      "google_filestore_instance.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilestoreInstance_filestoreInstanceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_filestore_instance" "instance" {
+  name = "tf-test-instance-%{random_suffix}"
+  zone = "us-central1-b"
+  tier = "BASIC_HDD"
+
+  file_shares {
+    capacity_gb = 1024
+    name        = "share1"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+`, context)
+}
+
+func testAccCheckFilestoreInstanceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_filestore_instance" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://file.googleapis.com/v1/projects/{{project}}/locations/{{zone}}/instances/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FilestoreInstance still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func TestAccFilestoreInstance_update(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFilestoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilestoreInstance_update(name),
+			},
+			{
+				ResourceName:      "google_filestore_instance.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFilestoreInstance_update2(name),
+			},
+			{
+				ResourceName:      "google_filestore_instance.instance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccFilestoreInstance_restore(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFilestoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilestoreInstance_restore(suffix),
+			},
+			{
+				ResourceName:      "google_filestore_instance.restored",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilestoreInstance_update(name string) string {
+	return fmt.Sprintf(`
+resource "google_filestore_instance" "instance" {
+  name        = "%s"
+  zone        = "us-central1-b"
+  tier        = "BASIC_HDD"
+  description = "An instance created during testing."
+
+  file_shares {
+    capacity_gb = 1024
+    name        = "share"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+
+  labels = {
+    baz = "qux"
+  }
+}
+`, name)
+}
+
+func testAccFilestoreInstance_update2(name string) string {
+	return fmt.Sprintf(`
+resource "google_filestore_instance" "instance" {
+  name        = "%s"
+  zone        = "us-central1-b"
+  tier        = "BASIC_HDD"
+  description = "A modified instance created during testing."
+
+  file_shares {
+    capacity_gb = 2048
+    name        = "share"
+
+    nfs_export_options {
+      ip_ranges   = ["10.0.0.0/24"]
+      access_mode = "READ_WRITE"
+      squash_mode = "ROOT_SQUASH"
+      anon_uid    = 123
+      anon_gid    = 456
+    }
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+`, name)
+}
+
+func testAccFilestoreInstance_restore(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_filestore_instance" "source" {
+  name = "tf-test-source-%s"
+  zone = "us-central1-b"
+  tier = "BASIC_HDD"
+
+  file_shares {
+    capacity_gb = 1024
+    name        = "share1"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+
+resource "google_filestore_backup" "backup" {
+  name              = "tf-test-backup-%s"
+  location          = "us-central1"
+  source_instance   = "${google_filestore_instance.source.id}"
+  source_file_share = "share1"
+}
+
+resource "google_filestore_instance" "restored" {
+  name = "tf-test-restored-%s"
+  zone = "us-central1-b"
+  tier = "BASIC_HDD"
+
+  file_shares {
+    capacity_gb   = 1024
+    name          = "share1"
+    source_backup = "${google_filestore_backup.backup.id}"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+`, suffix, suffix, suffix)
+}
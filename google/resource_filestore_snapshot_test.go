@@ -0,0 +1,90 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFilestoreSnapshot_filestoreSnapshotBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFilestoreSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilestoreSnapshot_filestoreSnapshotBasicExample(context),
+			},
+			{
+				ResourceName:      "google_filestore_snapshot.snapshot",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilestoreSnapshot_filestoreSnapshotBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_filestore_instance" "instance" {
+  name = "tf-test-instance-%{random_suffix}"
+  zone = "us-central1-b"
+  tier = "HIGH_SCALE_SSD"
+
+  file_shares {
+    capacity_gb = 10240
+    name        = "share1"
+  }
+
+  networks {
+    network = "default"
+    modes   = ["MODE_IPV4"]
+  }
+}
+
+resource "google_filestore_snapshot" "snapshot" {
+  name     = "tf-test-snapshot-%{random_suffix}"
+  instance = "${google_filestore_instance.instance.name}"
+  location = "us-central1-b"
+
+  labels = {
+    my_label = "value"
+  }
+}
+`, context)
+}
+
+func testAccCheckFilestoreSnapshotDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_filestore_snapshot" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://file.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances/{{instance}}/snapshots/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FilestoreSnapshot still exists at %s", url)
+		}
+	}
+
+	return nil
+}
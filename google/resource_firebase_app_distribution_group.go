@@ -0,0 +1,254 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFirebaseAppDistributionGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirebaseAppDistributionGroupCreate,
+		Read:   resourceFirebaseAppDistributionGroupRead,
+		Update: resourceFirebaseAppDistributionGroupUpdate,
+		Delete: resourceFirebaseAppDistributionGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirebaseAppDistributionGroupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z][a-z0-9-]{3,62}$`),
+			},
+			"invite_link_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tester_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirebaseAppDistributionGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandFirebaseAppDistributionGroupDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups?groupId={{group_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Group: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Group: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/groups/{{group_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Group %q: %#v", d.Id(), res)
+
+	return resourceFirebaseAppDistributionGroupRead(d, meta)
+}
+
+func resourceFirebaseAppDistributionGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups/{{group_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirebaseAppDistributionGroup %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenFirebaseAppDistributionGroupDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+	if err := d.Set("name", flattenFirebaseAppDistributionGroupName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+	if err := d.Set("tester_count", flattenFirebaseAppDistributionGroupTesterCount(res["testerCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+	if err := d.Set("release_count", flattenFirebaseAppDistributionGroupReleaseCount(res["releaseCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+	if err := d.Set("invite_link_count", flattenFirebaseAppDistributionGroupInviteLinkCount(res["inviteLinkCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Group: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirebaseAppDistributionGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandFirebaseAppDistributionGroupDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups/{{group_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Group %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Group %q: %s", d.Id(), err)
+	}
+
+	return resourceFirebaseAppDistributionGroupRead(d, meta)
+}
+
+func resourceFirebaseAppDistributionGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups/{{group_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Group %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Group")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Group %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirebaseAppDistributionGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/groups/(?P<group_id>[^/]+)", "(?P<project>[^/]+)/(?P<group_id>[^/]+)", "(?P<group_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/groups/{{group_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirebaseAppDistributionGroupDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaseAppDistributionGroupName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirebaseAppDistributionGroupTesterCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenFirebaseAppDistributionGroupReleaseCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenFirebaseAppDistributionGroupInviteLinkCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func expandFirebaseAppDistributionGroupDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
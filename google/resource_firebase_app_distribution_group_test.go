@@ -0,0 +1,69 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirebaseAppDistributionGroup_firebaseAppDistributionGroupBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirebaseAppDistributionGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirebaseAppDistributionGroup_firebaseAppDistributionGroupBasicExample(context),
+			},
+			{
+				ResourceName:      "google_firebase_app_distribution_group.qa",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFirebaseAppDistributionGroup_firebaseAppDistributionGroupBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firebase_app_distribution_group" "qa" {
+  group_id     = "tf-test-qa%{random_suffix}"
+  display_name = "QA testers"
+}
+`, context)
+}
+
+func testAccCheckFirebaseAppDistributionGroupDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firebase_app_distribution_group" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups/{{group_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirebaseAppDistributionGroup still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFirebaseAppDistributionTester() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirebaseAppDistributionTesterCreate,
+		Read:   resourceFirebaseAppDistributionTesterRead,
+		Update: resourceFirebaseAppDistributionTesterUpdate,
+		Delete: resourceFirebaseAppDistributionTesterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirebaseAppDistributionTesterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirebaseAppDistributionTesterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/testers:batchAdd")
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"emails": []string{d.Get("email").(string)},
+	}
+
+	log.Printf("[DEBUG] Creating new Tester: %#v", obj)
+	if _, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error creating Tester: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/testers/{{email}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	for _, group := range convertStringSet(d.Get("groups").(*schema.Set)) {
+		if err := updateFirebaseAppDistributionTesterGroup(d, config, group, "batchJoin", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating Tester %q", d.Id())
+
+	return resourceFirebaseAppDistributionTesterRead(d, meta)
+}
+
+func resourceFirebaseAppDistributionTesterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	tester, err := findFirebaseAppDistributionTester(config, project, d.Get("email").(string))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirebaseAppDistributionTester %q", d.Id()))
+	}
+	if tester == nil {
+		log.Printf("[WARN] Removing Tester %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Tester: %s", err)
+	}
+	if err := d.Set("name", tester["name"]); err != nil {
+		return fmt.Errorf("Error reading Tester: %s", err)
+	}
+	if err := d.Set("display_name", tester["displayName"]); err != nil {
+		return fmt.Errorf("Error reading Tester: %s", err)
+	}
+
+	// Groups are returned as full resource names, but configured by alias.
+	groups := make([]string, 0)
+	if l, ok := tester["groups"].([]interface{}); ok {
+		for _, g := range l {
+			groups = append(groups, GetResourceNameFromSelfLink(g.(string)))
+		}
+	}
+	if err := d.Set("groups", groups); err != nil {
+		return fmt.Errorf("Error reading Tester: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirebaseAppDistributionTesterUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("groups") {
+		o, n := d.GetChange("groups")
+		oldGroups, newGroups := o.(*schema.Set), n.(*schema.Set)
+
+		for _, group := range convertStringSet(newGroups.Difference(oldGroups)) {
+			if err := updateFirebaseAppDistributionTesterGroup(d, config, group, "batchJoin", d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+		for _, group := range convertStringSet(oldGroups.Difference(newGroups)) {
+			if err := updateFirebaseAppDistributionTesterGroup(d, config, group, "batchLeave", d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceFirebaseAppDistributionTesterRead(d, meta)
+}
+
+func resourceFirebaseAppDistributionTesterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/testers:batchRemove")
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"emails": []string{d.Get("email").(string)},
+	}
+
+	log.Printf("[DEBUG] Deleting Tester %q", d.Id())
+	if _, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return handleNotFoundError(err, d, "Tester")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Tester %q", d.Id())
+	return nil
+}
+
+func resourceFirebaseAppDistributionTesterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/testers/(?P<email>[^/]+)",
+		"(?P<project>[^/]+)/(?P<email>[^/]+)",
+		"(?P<email>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/testers/{{email}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findFirebaseAppDistributionTester returns the tester with the given email,
+// or nil if the project has no such tester. The API has no method to get a
+// single tester, so the project's testers are listed instead.
+func findFirebaseAppDistributionTester(config *Config, project, email string) (map[string]interface{}, error) {
+	url := fmt.Sprintf("https://firebaseappdistribution.googleapis.com/v1/projects/%s/testers", project)
+
+	suffix := "/testers/" + strings.ToLower(email)
+	pageToken := ""
+	for {
+		pageUrl, err := addQueryParams(url, map[string]string{"pageToken": pageToken})
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := sendRequest(config, "GET", pageUrl, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		if testers, ok := res["testers"].([]interface{}); ok {
+			for _, raw := range testers {
+				tester := raw.(map[string]interface{})
+				if name, ok := tester["name"].(string); ok && strings.HasSuffix(strings.ToLower(name), suffix) {
+					return tester, nil
+				}
+			}
+		}
+
+		next, ok := res["nextPageToken"].(string)
+		if !ok || next == "" {
+			return nil, nil
+		}
+		pageToken = next
+	}
+}
+
+// updateFirebaseAppDistributionTesterGroup adds the tester to, or removes it
+// from, the given group with the group's batchJoin or batchLeave method.
+func updateFirebaseAppDistributionTesterGroup(d *schema.ResourceData, config *Config, group, action string, timeout time.Duration) error {
+	url, err := replaceVars(d, config, "https://firebaseappdistribution.googleapis.com/v1/projects/{{project}}/groups/")
+	if err != nil {
+		return err
+	}
+	url = fmt.Sprintf("%s%s:%s", url, group, action)
+
+	obj := map[string]interface{}{
+		"emails": []string{d.Get("email").(string)},
+	}
+
+	log.Printf("[DEBUG] Calling %s on group %q for Tester %q", action, group, d.Id())
+	if _, err := sendRequestWithTimeout(config, "POST", url, obj, timeout); err != nil {
+		return fmt.Errorf("Error calling %s on group %q for Tester %q: %s", action, group, d.Id(), err)
+	}
+	return nil
+}
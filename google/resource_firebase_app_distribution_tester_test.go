@@ -0,0 +1,81 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirebaseAppDistributionTester_groups(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirebaseAppDistributionTesterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirebaseAppDistributionTester_groups(suffix, "qa"),
+				Check:  resource.TestCheckResourceAttr("google_firebase_app_distribution_tester.tester", "groups.#", "1"),
+			},
+			{
+				ResourceName:      "google_firebase_app_distribution_tester.tester",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccFirebaseAppDistributionTester_groups(suffix, "beta"),
+				Check:  resource.TestCheckResourceAttr("google_firebase_app_distribution_tester.tester", "groups.#", "1"),
+			},
+			{
+				ResourceName:      "google_firebase_app_distribution_tester.tester",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckFirebaseAppDistributionTesterDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firebase_app_distribution_tester" {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		tester, err := findFirebaseAppDistributionTester(config, rs.Primary.Attributes["project"], rs.Primary.Attributes["email"])
+		if err != nil {
+			return err
+		}
+		if tester != nil {
+			return fmt.Errorf("Tester %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccFirebaseAppDistributionTester_groups(suffix, group string) string {
+	return fmt.Sprintf(`
+resource "google_firebase_app_distribution_group" "qa" {
+  group_id     = "tf-test-qa%s"
+  display_name = "QA testers"
+}
+
+resource "google_firebase_app_distribution_group" "beta" {
+  group_id     = "tf-test-beta%s"
+  display_name = "Beta testers"
+}
+
+resource "google_firebase_app_distribution_tester" "tester" {
+  email  = "tf-test-%s@example.com"
+  groups = ["${google_firebase_app_distribution_group.%s.group_id}"]
+}
+`, suffix, suffix, suffix, group)
+}
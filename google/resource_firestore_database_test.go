@@ -0,0 +1,160 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirestoreDatabase_firestoreDatabaseBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreDatabase_firestoreDatabaseBasicExample(context),
+			},
+			{
+				ResourceName:            "google_firestore_database.database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
+			},
+		},
+	})
+}
+
+func testAccFirestoreDatabase_firestoreDatabaseBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_database" "database" {
+  name        = "tf-test-database-%{random_suffix}"
+  location_id = "nam5"
+  type        = "FIRESTORE_NATIVE"
+
+  deletion_policy = "DELETE"
+}
+`, context)
+}
+
+func TestAccFirestoreDatabase_firestoreDatabaseDatastoreModeExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreDatabase_firestoreDatabaseDatastoreModeExample(context),
+			},
+			{
+				ResourceName:            "google_firestore_database.datastore_mode_database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
+			},
+		},
+	})
+}
+
+func testAccFirestoreDatabase_firestoreDatabaseDatastoreModeExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_database" "datastore_mode_database" {
+  name        = "tf-test-database-%{random_suffix}"
+  location_id = "nam5"
+  type        = "DATASTORE_MODE"
+
+  concurrency_mode                  = "OPTIMISTIC"
+  point_in_time_recovery_enablement = "POINT_IN_TIME_RECOVERY_ENABLED"
+
+  deletion_policy = "DELETE"
+}
+`, context)
+}
+
+func testAccCheckFirestoreDatabaseDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firestore_database" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://firestore.googleapis.com/v1/projects/{{project}}/databases/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirestoreDatabase still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func TestAccFirestoreDatabase_update(t *testing.T) {
+	t.Parallel()
+
+	name := fmt.Sprintf("tf-test-database-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreDatabase_update(name, "POINT_IN_TIME_RECOVERY_DISABLED", "OPTIMISTIC"),
+			},
+			{
+				ResourceName:            "google_firestore_database.database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
+			},
+			{
+				Config: testAccFirestoreDatabase_update(name, "POINT_IN_TIME_RECOVERY_ENABLED", "PESSIMISTIC"),
+			},
+			{
+				ResourceName:            "google_firestore_database.database",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_policy"},
+			},
+		},
+	})
+}
+
+func testAccFirestoreDatabase_update(name, pitr, concurrencyMode string) string {
+	return fmt.Sprintf(`
+resource "google_firestore_database" "database" {
+  name        = "%s"
+  location_id = "nam5"
+  type        = "FIRESTORE_NATIVE"
+
+  point_in_time_recovery_enablement = "%s"
+  concurrency_mode                  = "%s"
+
+  deletion_policy = "DELETE"
+}
+`, name, pitr, concurrencyMode)
+}
@@ -0,0 +1,389 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceFirestoreIndex() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFirestoreIndexCreate,
+		Read:   resourceFirestoreIndexRead,
+		Delete: resourceFirestoreIndexDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceFirestoreIndexImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(600 * time.Second),
+			Delete: schema.DefaultTimeout(600 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"collection": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fields": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"array_config": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CONTAINS", ""}, false),
+						},
+						"field_path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"order": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ASCENDING", "DESCENDING", ""}, false),
+						},
+						"vector_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"dimension": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										ForceNew: true,
+									},
+									"flat": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "(default)",
+			},
+			"query_scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"COLLECTION", "COLLECTION_GROUP", ""}, false),
+				Default:      "COLLECTION",
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFirestoreIndexCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	queryScopeProp, err := expandFirestoreIndexQueryScope(d.Get("query_scope"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("query_scope"); !isEmptyValue(reflect.ValueOf(queryScopeProp)) && (ok || !reflect.DeepEqual(v, queryScopeProp)) {
+		obj["queryScope"] = queryScopeProp
+	}
+	fieldsProp, err := expandFirestoreIndexFields(d.Get("fields"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fields"); !isEmptyValue(reflect.ValueOf(fieldsProp)) && (ok || !reflect.DeepEqual(v, fieldsProp)) {
+		obj["fields"] = fieldsProp
+	}
+
+	url, err := replaceVars(d, config, "https://firestore.googleapis.com/v1/projects/{{project}}/databases/{{database}}/collectionGroups/{{collection}}/indexes")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Index: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Index: %s", err)
+	}
+
+	// The index name is server generated and only available in the
+	// operation metadata.
+	metadata, ok := res["metadata"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	name, ok := metadata["index"].(string)
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	log.Printf("[DEBUG] Setting Index name, id to %s", name)
+	d.Set("name", name)
+	d.SetId(name)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := firestoreOperationWaitTime(
+		config, res, project, "Creating Index",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Index: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Index %q: %#v", d.Id(), res)
+
+	return resourceFirestoreIndexRead(d, meta)
+}
+
+func resourceFirestoreIndexRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firestore.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("FirestoreIndex %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Index: %s", err)
+	}
+
+	if err := d.Set("name", flattenFirestoreIndexName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Index: %s", err)
+	}
+	if err := d.Set("query_scope", flattenFirestoreIndexQueryScope(res["queryScope"], d)); err != nil {
+		return fmt.Errorf("Error reading Index: %s", err)
+	}
+	if err := d.Set("fields", flattenFirestoreIndexFields(res["fields"], d)); err != nil {
+		return fmt.Errorf("Error reading Index: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFirestoreIndexDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://firestore.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Index %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Index")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = firestoreOperationWaitTime(
+		config, res, project, "Deleting Index",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Index %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceFirestoreIndexImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenFirestoreIndexName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreIndexQueryScope(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreIndexFields(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	configured := d.Get("fields").([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for i, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		// The API appends an implicit __name__ field to most indexes. Only keep
+		// it when it was part of the configuration.
+		if i == len(l)-1 && i > 0 && original["fieldPath"] == "__name__" && len(configured) < len(l) {
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"field_path":    flattenFirestoreIndexFieldsFieldPath(original["fieldPath"], d),
+			"order":         flattenFirestoreIndexFieldsOrder(original["order"], d),
+			"array_config":  flattenFirestoreIndexFieldsArrayConfig(original["arrayConfig"], d),
+			"vector_config": flattenFirestoreIndexFieldsVectorConfig(original["vectorConfig"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenFirestoreIndexFieldsFieldPath(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreIndexFieldsOrder(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreIndexFieldsArrayConfig(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenFirestoreIndexFieldsVectorConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["dimension"] =
+		flattenFirestoreIndexFieldsVectorConfigDimension(original["dimension"], d)
+	transformed["flat"] =
+		flattenFirestoreIndexFieldsVectorConfigFlat(original["flat"], d)
+	return []interface{}{transformed}
+}
+
+func flattenFirestoreIndexFieldsVectorConfigDimension(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenFirestoreIndexFieldsVectorConfigFlat(v interface{}, d *schema.ResourceData) interface{} {
+	// flat has no options; an empty object means the flat index is enabled.
+	if v == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{}}
+}
+
+func expandFirestoreIndexQueryScope(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandFirestoreIndexFields(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		if val, ok := original["field_path"]; ok && val != "" {
+			transformed["fieldPath"] = val
+		}
+		if val, ok := original["order"]; ok && val != "" {
+			transformed["order"] = val
+		}
+		if val, ok := original["array_config"]; ok && val != "" {
+			transformed["arrayConfig"] = val
+		}
+
+		vectorConfig, err := expandFirestoreIndexFieldsVectorConfig(original["vector_config"], d, config)
+		if err != nil {
+			return nil, err
+		} else if vectorConfig != nil {
+			transformed["vectorConfig"] = vectorConfig
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandFirestoreIndexFieldsVectorConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	original := l[0].(map[string]interface{})
+	transformed := map[string]interface{}{
+		"dimension": original["dimension"],
+	}
+
+	// flat has no options, so an empty block is sent as an empty object.
+	if flat, ok := original["flat"].([]interface{}); ok && len(flat) > 0 {
+		transformed["flat"] = map[string]interface{}{}
+	}
+	return transformed, nil
+}
@@ -0,0 +1,130 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFirestoreIndex_firestoreIndexBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreIndex_firestoreIndexBasicExample(context),
+			},
+			{
+				ResourceName:            "google_firestore_index.my-index",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"database", "collection"},
+			},
+		},
+	})
+}
+
+func testAccFirestoreIndex_firestoreIndexBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_index" "my-index" {
+  collection = "chatrooms%{random_suffix}"
+
+  fields {
+    field_path = "name"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "description"
+    order      = "DESCENDING"
+  }
+}
+`, context)
+}
+
+func TestAccFirestoreIndex_firestoreIndexVectorExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFirestoreIndexDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFirestoreIndex_firestoreIndexVectorExample(context),
+			},
+			{
+				ResourceName:            "google_firestore_index.my-index",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"database", "collection"},
+			},
+		},
+	})
+}
+
+func testAccFirestoreIndex_firestoreIndexVectorExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_firestore_index" "my-index" {
+  collection = "atestcollection%{random_suffix}"
+
+  fields {
+    field_path = "field_name"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "__name__"
+    order      = "ASCENDING"
+  }
+
+  fields {
+    field_path = "description"
+    vector_config {
+      dimension = 128
+      flat {}
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckFirestoreIndexDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_firestore_index" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://firestore.googleapis.com/v1/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("FirestoreIndex still exists at %s", url)
+		}
+	}
+
+	return nil
+}
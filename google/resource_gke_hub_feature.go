@@ -0,0 +1,383 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGKEHubFeature() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubFeatureCreate,
+		Read:   resourceGKEHubFeatureRead,
+		Update: resourceGKEHubFeatureUpdate,
+		Delete: resourceGKEHubFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubFeatureImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"fleet_default_member_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"configmanagement": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     gkeHubConfigmanagementSchema(),
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"has_resources": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGKEHubFeatureCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandGKEHubFeatureLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	fleetDefaultMemberConfigProp, err := expandGKEHubFeatureFleetDefaultMemberConfig(d.Get("fleet_default_member_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fleet_default_member_config"); !isEmptyValue(reflect.ValueOf(fleetDefaultMemberConfigProp)) && (ok || !reflect.DeepEqual(v, fleetDefaultMemberConfigProp)) {
+		obj["fleetDefaultMemberConfig"] = fleetDefaultMemberConfigProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features?featureId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Feature: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Feature: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := gkeHubOperationWaitTime(
+		config, res, project, "Creating Feature",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Feature: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Feature %q: %#v", d.Id(), res)
+
+	return resourceGKEHubFeatureRead(d, meta)
+}
+
+func resourceGKEHubFeatureRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubFeature %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+
+	if err := d.Set("labels", flattenGKEHubFeatureLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+	if err := d.Set("fleet_default_member_config", flattenGKEHubFeatureFleetDefaultMemberConfig(res["fleetDefaultMemberConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+	if err := d.Set("resource_state", flattenGKEHubFeatureResourceState(res["resourceState"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+	if err := d.Set("create_time", flattenGKEHubFeatureCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+	if err := d.Set("update_time", flattenGKEHubFeatureUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+	if err := d.Set("delete_time", flattenGKEHubFeatureDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Feature: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubFeatureUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandGKEHubFeatureLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	fleetDefaultMemberConfigProp, err := expandGKEHubFeatureFleetDefaultMemberConfig(d.Get("fleet_default_member_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("fleet_default_member_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, fleetDefaultMemberConfigProp)) {
+		obj["fleetDefaultMemberConfig"] = fleetDefaultMemberConfigProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Feature %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("fleet_default_member_config") {
+		updateMask = append(updateMask, "fleetDefaultMemberConfig")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Feature %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Updating Feature",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGKEHubFeatureRead(d, meta)
+}
+
+func resourceGKEHubFeatureDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Feature %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Feature")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Deleting Feature",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Feature %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceGKEHubFeatureImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/features/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<name>[^/]+)", "(?P<location>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenGKEHubFeatureLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubFeatureFleetDefaultMemberConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["configmanagement"] =
+		flattenGKEHubFeatureMembershipConfigmanagement(original["configmanagement"])
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubFeatureResourceState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["state"] =
+		flattenGKEHubFeatureResourceStateState(original["state"], d)
+	transformed["has_resources"] =
+		flattenGKEHubFeatureResourceStateHasResources(original["hasResources"], d)
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubFeatureResourceStateState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubFeatureResourceStateHasResources(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubFeatureCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubFeatureUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubFeatureDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandGKEHubFeatureLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandGKEHubFeatureFleetDefaultMemberConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0].(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	if cm := expandGKEHubFeatureMembershipConfigmanagement(raw["configmanagement"]); cm != nil {
+		transformed["configmanagement"] = cm
+	}
+
+	return transformed, nil
+}
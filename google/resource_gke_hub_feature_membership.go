@@ -0,0 +1,424 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceGKEHubFeatureMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubFeatureMembershipCreate,
+		Read:   resourceGKEHubFeatureMembershipRead,
+		Update: resourceGKEHubFeatureMembershipUpdate,
+		Delete: resourceGKEHubFeatureMembershipDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubFeatureMembershipImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"feature": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"membership": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"configmanagement": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     gkeHubConfigmanagementSchema(),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// gkeHubConfigmanagementSchema is the Config Management spec of a member
+// cluster. It's shared by feature memberships and the fleet default member
+// config of the feature.
+func gkeHubConfigmanagementSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"config_sync": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"hierarchy", "unstructured", ""}, false),
+						},
+						"prevent_drift": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"git": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"sync_repo": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sync_branch": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"policy_dir": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sync_wait_secs": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"sync_rev": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"secret_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"none", "ssh", "cookiefile", "token", "gcenode", "gcpserviceaccount", ""}, false),
+									},
+									"https_proxy": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"gcp_service_account_email": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"policy_controller": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"template_library_installed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"audit_interval_seconds": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"exemptable_namespaces": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"referential_rules_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"log_denies_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceGKEHubFeatureMembershipCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{feature}}/membershipId/")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id + GetResourceNameFromSelfLink(d.Get("membership").(string)))
+
+	log.Printf("[DEBUG] Creating FeatureMembership %q", d.Id())
+	spec := map[string]interface{}{
+		"configmanagement": expandGKEHubFeatureMembershipConfigmanagement(d.Get("configmanagement")),
+	}
+	if err := patchGKEHubFeatureMembershipSpec(d, config, spec, "Creating FeatureMembership", d.Timeout(schema.TimeoutCreate)); err != nil {
+		if !isOperationCancelledError(err) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished creating FeatureMembership %q", d.Id())
+
+	return resourceGKEHubFeatureMembershipRead(d, meta)
+}
+
+func resourceGKEHubFeatureMembershipRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{feature}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubFeatureMembership %q", d.Id()))
+	}
+
+	spec := findGKEHubFeatureMembershipSpec(res, GetResourceNameFromSelfLink(d.Get("membership").(string)))
+	if spec == nil {
+		log.Printf("[WARN] Removing FeatureMembership %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading FeatureMembership: %s", err)
+	}
+	if err := d.Set("configmanagement", flattenGKEHubFeatureMembershipConfigmanagement(spec["configmanagement"])); err != nil {
+		return fmt.Errorf("Error reading FeatureMembership: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubFeatureMembershipUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("configmanagement") {
+		log.Printf("[DEBUG] Updating FeatureMembership %q", d.Id())
+		spec := map[string]interface{}{
+			"configmanagement": expandGKEHubFeatureMembershipConfigmanagement(d.Get("configmanagement")),
+		}
+		if err := patchGKEHubFeatureMembershipSpec(d, config, spec, "Updating FeatureMembership", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGKEHubFeatureMembershipRead(d, meta)
+}
+
+func resourceGKEHubFeatureMembershipDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	// An empty spec removes the membership's configuration from the feature.
+	log.Printf("[DEBUG] Deleting FeatureMembership %q", d.Id())
+	if err := patchGKEHubFeatureMembershipSpec(d, config, map[string]interface{}{}, "Deleting FeatureMembership", d.Timeout(schema.TimeoutDelete)); err != nil {
+		return handleNotFoundError(err, d, "FeatureMembership")
+	}
+
+	log.Printf("[DEBUG] Finished deleting FeatureMembership %q", d.Id())
+	return nil
+}
+
+func resourceGKEHubFeatureMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/features/(?P<feature>[^/]+)/membershipId/(?P<membership>[^/]+)",
+		"(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)",
+		"(?P<location>[^/]+)/(?P<feature>[^/]+)/(?P<membership>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{feature}}/membershipId/{{membership}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// patchGKEHubFeatureMembershipSpec sets the membership's entry in the
+// feature's membershipSpecs. Entries for other memberships are left alone,
+// but every membership of a feature is written through the same feature, so
+// writes are serialized per feature.
+func patchGKEHubFeatureMembershipSpec(d *schema.ResourceData, config *Config, spec map[string]interface{}, activity string, timeout time.Duration) error {
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	feature, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/features/{{feature}}")
+	if err != nil {
+		return err
+	}
+	mutexKV.Lock(feature)
+	defer mutexKV.Unlock(feature)
+
+	key := fmt.Sprintf("projects/%s/locations/global/memberships/%s", project, GetResourceNameFromSelfLink(d.Get("membership").(string)))
+	obj := map[string]interface{}{
+		"membershipSpecs": map[string]interface{}{
+			key: spec,
+		},
+	}
+
+	url := fmt.Sprintf("https://gkehub.googleapis.com/v1/%s?updateMask=membershipSpecs", feature)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, timeout)
+	if err != nil {
+		return fmt.Errorf("Error updating FeatureMembership %q: %s", d.Id(), err)
+	}
+
+	return gkeHubOperationWaitTime(config, res, project, activity, int(timeout.Minutes()))
+}
+
+// findGKEHubFeatureMembershipSpec returns the feature's spec for the given
+// membership. The API keys specs by project number rather than id, so only
+// the membership id is compared.
+func findGKEHubFeatureMembershipSpec(feature map[string]interface{}, membership string) map[string]interface{} {
+	specs, ok := feature["membershipSpecs"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for k, v := range specs {
+		if !strings.HasSuffix(k, "/memberships/"+membership) {
+			continue
+		}
+		spec, ok := v.(map[string]interface{})
+		if !ok || len(spec) == 0 {
+			return nil
+		}
+		return spec
+	}
+	return nil
+}
+
+func expandGKEHubFeatureMembershipConfigmanagement(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	transformed := map[string]interface{}{}
+	if v, ok := raw["version"]; ok && v != "" {
+		transformed["version"] = v
+	}
+	if l, ok := raw["config_sync"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		cs := l[0].(map[string]interface{})
+		configSync := map[string]interface{}{
+			"sourceFormat": cs["source_format"],
+			"preventDrift": cs["prevent_drift"],
+		}
+		if l, ok := cs["git"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+			git := l[0].(map[string]interface{})
+			configSync["git"] = map[string]interface{}{
+				"syncRepo":               git["sync_repo"],
+				"syncBranch":             git["sync_branch"],
+				"policyDir":              git["policy_dir"],
+				"syncWaitSecs":           git["sync_wait_secs"],
+				"syncRev":                git["sync_rev"],
+				"secretType":             git["secret_type"],
+				"httpsProxy":             git["https_proxy"],
+				"gcpServiceAccountEmail": git["gcp_service_account_email"],
+			}
+		}
+		transformed["configSync"] = configSync
+	}
+	if l, ok := raw["policy_controller"].([]interface{}); ok && len(l) > 0 && l[0] != nil {
+		pc := l[0].(map[string]interface{})
+		transformed["policyController"] = map[string]interface{}{
+			"enabled":                  pc["enabled"],
+			"templateLibraryInstalled": pc["template_library_installed"],
+			"auditIntervalSeconds":     pc["audit_interval_seconds"],
+			"exemptableNamespaces":     pc["exemptable_namespaces"],
+			"referentialRulesEnabled":  pc["referential_rules_enabled"],
+			"logDeniesEnabled":         pc["log_denies_enabled"],
+		}
+	}
+	return transformed
+}
+
+func flattenGKEHubFeatureMembershipConfigmanagement(v interface{}) []interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok || len(original) == 0 {
+		return nil
+	}
+
+	transformed := map[string]interface{}{
+		"version": original["version"],
+	}
+	if cs, ok := original["configSync"].(map[string]interface{}); ok {
+		configSync := map[string]interface{}{
+			"source_format": cs["sourceFormat"],
+			"prevent_drift": cs["preventDrift"],
+		}
+		if git, ok := cs["git"].(map[string]interface{}); ok {
+			configSync["git"] = []interface{}{
+				map[string]interface{}{
+					"sync_repo":                 git["syncRepo"],
+					"sync_branch":               git["syncBranch"],
+					"policy_dir":                git["policyDir"],
+					"sync_wait_secs":            git["syncWaitSecs"],
+					"sync_rev":                  git["syncRev"],
+					"secret_type":               git["secretType"],
+					"https_proxy":               git["httpsProxy"],
+					"gcp_service_account_email": git["gcpServiceAccountEmail"],
+				},
+			}
+		}
+		transformed["config_sync"] = []interface{}{configSync}
+	}
+	if pc, ok := original["policyController"].(map[string]interface{}); ok {
+		transformed["policy_controller"] = []interface{}{
+			map[string]interface{}{
+				"enabled":                    pc["enabled"],
+				"template_library_installed": pc["templateLibraryInstalled"],
+				"audit_interval_seconds":     pc["auditIntervalSeconds"],
+				"exemptable_namespaces":      pc["exemptableNamespaces"],
+				"referential_rules_enabled":  pc["referentialRulesEnabled"],
+				"log_denies_enabled":         pc["logDeniesEnabled"],
+			},
+		}
+	}
+	return []interface{}{transformed}
+}
@@ -0,0 +1,121 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEHubFeatureMembership_configmanagement(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubFeatureMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubFeatureMembership_configmanagement(context, "main"),
+			},
+			{
+				ResourceName:      "google_gke_hub_feature_membership.feature_member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGKEHubFeatureMembership_configmanagement(context, "release"),
+			},
+			{
+				ResourceName:      "google_gke_hub_feature_membership.feature_member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubFeatureMembership_configmanagement(context map[string]interface{}, branch string) string {
+	context["branch"] = branch
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-cluster%{random_suffix}"
+  location           = "us-central1-a"
+  initial_node_count = 1
+}
+
+resource "google_gke_hub_membership" "membership" {
+  membership_id = "tf-test-membership%{random_suffix}"
+  endpoint {
+    gke_cluster {
+      resource_link = "//container.googleapis.com/projects/%{project}/locations/us-central1-a/clusters/${google_container_cluster.primary.name}"
+    }
+  }
+}
+
+resource "google_gke_hub_feature" "feature" {
+  name     = "configmanagement"
+  location = "global"
+}
+
+resource "google_gke_hub_feature_membership" "feature_member" {
+  location   = "global"
+  feature    = "${google_gke_hub_feature.feature.name}"
+  membership = "${google_gke_hub_membership.membership.membership_id}"
+  configmanagement {
+    version = "1.19.0"
+    config_sync {
+      source_format = "unstructured"
+      git {
+        sync_repo   = "https://github.com/GoogleCloudPlatform/anthos-config-management-samples"
+        sync_branch = "%{branch}"
+        policy_dir  = "quickstart/config-sync"
+        secret_type = "none"
+      }
+    }
+    policy_controller {
+      enabled                    = true
+      template_library_installed = true
+      exemptable_namespaces      = ["kube-system"]
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEHubFeatureMembershipDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_feature_membership" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{feature}}")
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			// The feature itself is gone, and its membership specs with it.
+			continue
+		}
+		if findGKEHubFeatureMembershipSpec(res, rs.Primary.Attributes["membership"]) != nil {
+			return fmt.Errorf("GKEHubFeatureMembership still exists at %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
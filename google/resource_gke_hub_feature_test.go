@@ -0,0 +1,72 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEHubFeature_gkehubFeatureMulticlusterServiceDiscoveryExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubFeatureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubFeature_gkehubFeatureMulticlusterServiceDiscoveryExample(context),
+			},
+			{
+				ResourceName:      "google_gke_hub_feature.feature",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubFeature_gkehubFeatureMulticlusterServiceDiscoveryExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_gke_hub_feature" "feature" {
+  name     = "multiclusterservicediscovery"
+  location = "global"
+  labels = {
+    foo = "bar"
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEHubFeatureDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_feature" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/{{location}}/features/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEHubFeature still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEHubMembership_gkehubMembershipBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubMembershipDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubMembership_gkehubMembershipBasicExample(context),
+			},
+			{
+				ResourceName:      "google_gke_hub_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubMembership_gkehubMembershipBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_container_cluster" "primary" {
+  name               = "tf-test-basiccluster%{random_suffix}"
+  location           = "us-central1-a"
+  initial_node_count = 1
+}
+
+resource "google_gke_hub_membership" "membership" {
+  membership_id = "tf-test-basic%{random_suffix}"
+  endpoint {
+    gke_cluster {
+      resource_link = "//container.googleapis.com/projects/%{project}/locations/us-central1-a/clusters/${google_container_cluster.primary.name}"
+    }
+  }
+  labels = {
+    env = "test"
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEHubMembershipDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_membership" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/memberships/{{membership_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEHubMembership still exists at %s", url)
+		}
+	}
+
+	return nil
+}
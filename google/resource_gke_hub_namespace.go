@@ -0,0 +1,393 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGKEHubNamespace() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubNamespaceCreate,
+		Read:   resourceGKEHubNamespaceRead,
+		Update: resourceGKEHubNamespaceUpdate,
+		Delete: resourceGKEHubNamespaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubNamespaceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scope_namespace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"namespace_labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGKEHubNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	scopeProp, err := expandGKEHubNamespaceScope(d.Get("scope"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("scope"); !isEmptyValue(reflect.ValueOf(scopeProp)) && (ok || !reflect.DeepEqual(v, scopeProp)) {
+		obj["scope"] = scopeProp
+	}
+	namespaceLabelsProp, err := expandGKEHubNamespaceNamespaceLabels(d.Get("namespace_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("namespace_labels"); !isEmptyValue(reflect.ValueOf(namespaceLabelsProp)) && (ok || !reflect.DeepEqual(v, namespaceLabelsProp)) {
+		obj["namespaceLabels"] = namespaceLabelsProp
+	}
+	labelsProp, err := expandGKEHubNamespaceLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces?scopeNamespaceId={{scope_namespace_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Namespace: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Namespace: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := gkeHubOperationWaitTime(
+		config, res, project, "Creating Namespace",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Namespace: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Namespace %q: %#v", d.Id(), res)
+
+	return resourceGKEHubNamespaceRead(d, meta)
+}
+
+func resourceGKEHubNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubNamespace %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+
+	if err := d.Set("scope", flattenGKEHubNamespaceScope(res["scope"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("name", flattenGKEHubNamespaceName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("namespace_labels", flattenGKEHubNamespaceNamespaceLabels(res["namespaceLabels"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("labels", flattenGKEHubNamespaceLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("uid", flattenGKEHubNamespaceUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("create_time", flattenGKEHubNamespaceCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("update_time", flattenGKEHubNamespaceUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("delete_time", flattenGKEHubNamespaceDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+	if err := d.Set("state", flattenGKEHubNamespaceState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Namespace: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	namespaceLabelsProp, err := expandGKEHubNamespaceNamespaceLabels(d.Get("namespace_labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("namespace_labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, namespaceLabelsProp)) {
+		obj["namespaceLabels"] = namespaceLabelsProp
+	}
+	labelsProp, err := expandGKEHubNamespaceLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Namespace %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("namespace_labels") {
+		updateMask = append(updateMask, "namespaceLabels")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Namespace %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Updating Namespace",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGKEHubNamespaceRead(d, meta)
+}
+
+func resourceGKEHubNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Namespace %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Namespace")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Deleting Namespace",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Namespace %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceGKEHubNamespaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)/namespaces/(?P<scope_namespace_id>[^/]+)", "(?P<project>[^/]+)/(?P<scope_id>[^/]+)/(?P<scope_namespace_id>[^/]+)", "(?P<scope_id>[^/]+)/(?P<scope_namespace_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenGKEHubNamespaceScope(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceNamespaceLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubNamespaceState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["code"] =
+		flattenGKEHubNamespaceStateCode(original["code"], d)
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubNamespaceStateCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandGKEHubNamespaceScope(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEHubNamespaceNamespaceLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandGKEHubNamespaceLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
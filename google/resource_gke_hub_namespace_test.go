@@ -0,0 +1,77 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEHubNamespace_gkehubNamespaceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubNamespace_gkehubNamespaceBasicExample(context),
+			},
+			{
+				ResourceName:      "google_gke_hub_namespace.namespace",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubNamespace_gkehubNamespaceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_gke_hub_scope" "scope" {
+  scope_id = "tf-test-scope%{random_suffix}"
+}
+
+resource "google_gke_hub_namespace" "namespace" {
+  scope_namespace_id = "tf-test-namespace%{random_suffix}"
+  scope_id           = "${google_gke_hub_scope.scope.scope_id}"
+  scope              = "${google_gke_hub_scope.scope.name}"
+  namespace_labels = {
+    keyb = "valueb"
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEHubNamespaceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_namespace" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/namespaces/{{scope_namespace_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEHubNamespace still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceGKEHubScope() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubScopeCreate,
+		Read:   resourceGKEHubScopeRead,
+		Update: resourceGKEHubScopeUpdate,
+		Delete: resourceGKEHubScopeDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubScopeImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGKEHubScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandGKEHubScopeLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes?scopeId={{scope_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Scope: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Scope: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := gkeHubOperationWaitTime(
+		config, res, project, "Creating Scope",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Scope: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Scope %q: %#v", d.Id(), res)
+
+	return resourceGKEHubScopeRead(d, meta)
+}
+
+func resourceGKEHubScopeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubScope %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+
+	if err := d.Set("name", flattenGKEHubScopeName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("labels", flattenGKEHubScopeLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("uid", flattenGKEHubScopeUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("create_time", flattenGKEHubScopeCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("update_time", flattenGKEHubScopeUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("delete_time", flattenGKEHubScopeDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+	if err := d.Set("state", flattenGKEHubScopeState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Scope: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandGKEHubScopeLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Scope %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Scope %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Updating Scope",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGKEHubScopeRead(d, meta)
+}
+
+func resourceGKEHubScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Scope %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Scope")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Deleting Scope",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Scope %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceGKEHubScopeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)", "(?P<project>[^/]+)/(?P<scope_id>[^/]+)", "(?P<scope_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenGKEHubScopeName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["code"] =
+		flattenGKEHubScopeStateCode(original["code"], d)
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubScopeStateCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandGKEHubScopeLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
@@ -0,0 +1,453 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceGKEHubScopeRBACRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGKEHubScopeRBACRoleBindingCreate,
+		Read:   resourceGKEHubScopeRBACRoleBindingRead,
+		Update: resourceGKEHubScopeRBACRoleBindingUpdate,
+		Delete: resourceGKEHubScopeRBACRoleBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceGKEHubScopeRBACRoleBindingImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"predefined_role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"ADMIN", "EDIT", "VIEW"}, false),
+						},
+					},
+				},
+			},
+			"scope_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scope_rbac_role_binding_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"user"},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"group"},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceGKEHubScopeRBACRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	userProp, err := expandGKEHubScopeRBACRoleBindingUser(d.Get("user"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("user"); !isEmptyValue(reflect.ValueOf(userProp)) && (ok || !reflect.DeepEqual(v, userProp)) {
+		obj["user"] = userProp
+	}
+	groupProp, err := expandGKEHubScopeRBACRoleBindingGroup(d.Get("group"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("group"); !isEmptyValue(reflect.ValueOf(groupProp)) && (ok || !reflect.DeepEqual(v, groupProp)) {
+		obj["group"] = groupProp
+	}
+	roleProp, err := expandGKEHubScopeRBACRoleBindingRole(d.Get("role"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("role"); !isEmptyValue(reflect.ValueOf(roleProp)) && (ok || !reflect.DeepEqual(v, roleProp)) {
+		obj["role"] = roleProp
+	}
+	labelsProp, err := expandGKEHubScopeRBACRoleBindingLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings?rbacrolebindingId={{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ScopeRBACRoleBinding: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ScopeRBACRoleBinding: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := gkeHubOperationWaitTime(
+		config, res, project, "Creating ScopeRBACRoleBinding",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create ScopeRBACRoleBinding: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating ScopeRBACRoleBinding %q: %#v", d.Id(), res)
+
+	return resourceGKEHubScopeRBACRoleBindingRead(d, meta)
+}
+
+func resourceGKEHubScopeRBACRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("GKEHubScopeRBACRoleBinding %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+
+	if err := d.Set("name", flattenGKEHubScopeRBACRoleBindingName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("user", flattenGKEHubScopeRBACRoleBindingUser(res["user"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("group", flattenGKEHubScopeRBACRoleBindingGroup(res["group"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("role", flattenGKEHubScopeRBACRoleBindingRole(res["role"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("labels", flattenGKEHubScopeRBACRoleBindingLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("uid", flattenGKEHubScopeRBACRoleBindingUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("create_time", flattenGKEHubScopeRBACRoleBindingCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("update_time", flattenGKEHubScopeRBACRoleBindingUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("delete_time", flattenGKEHubScopeRBACRoleBindingDeleteTime(res["deleteTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+	if err := d.Set("state", flattenGKEHubScopeRBACRoleBindingState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading ScopeRBACRoleBinding: %s", err)
+	}
+
+	return nil
+}
+
+func resourceGKEHubScopeRBACRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	roleProp, err := expandGKEHubScopeRBACRoleBindingRole(d.Get("role"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("role"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, roleProp)) {
+		obj["role"] = roleProp
+	}
+	labelsProp, err := expandGKEHubScopeRBACRoleBindingLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating ScopeRBACRoleBinding %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("role") {
+		updateMask = append(updateMask, "role")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating ScopeRBACRoleBinding %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Updating ScopeRBACRoleBinding",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceGKEHubScopeRBACRoleBindingRead(d, meta)
+}
+
+func resourceGKEHubScopeRBACRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ScopeRBACRoleBinding %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ScopeRBACRoleBinding")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = gkeHubOperationWaitTime(
+		config, res, project, "Deleting ScopeRBACRoleBinding",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting ScopeRBACRoleBinding %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceGKEHubScopeRBACRoleBindingImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/global/scopes/(?P<scope_id>[^/]+)/rbacrolebindings/(?P<scope_rbac_role_binding_id>[^/]+)", "(?P<project>[^/]+)/(?P<scope_id>[^/]+)/(?P<scope_rbac_role_binding_id>[^/]+)", "(?P<scope_id>[^/]+)/(?P<scope_rbac_role_binding_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenGKEHubScopeRBACRoleBindingName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingUser(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingGroup(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingRole(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["predefined_role"] =
+		flattenGKEHubScopeRBACRoleBindingRolePredefinedRole(original["predefinedRole"], d)
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubScopeRBACRoleBindingRolePredefinedRole(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingDeleteTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenGKEHubScopeRBACRoleBindingState(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["code"] =
+		flattenGKEHubScopeRBACRoleBindingStateCode(original["code"], d)
+	return []interface{}{transformed}
+}
+
+func flattenGKEHubScopeRBACRoleBindingStateCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandGKEHubScopeRBACRoleBindingUser(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEHubScopeRBACRoleBindingGroup(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEHubScopeRBACRoleBindingRole(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedPredefinedRole, err := expandGKEHubScopeRBACRoleBindingRolePredefinedRole(original["predefined_role"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPredefinedRole); val.IsValid() && !isEmptyValue(val) {
+		transformed["predefinedRole"] = transformedPredefinedRole
+	}
+
+	return transformed, nil
+}
+
+func expandGKEHubScopeRBACRoleBindingRolePredefinedRole(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandGKEHubScopeRBACRoleBindingLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
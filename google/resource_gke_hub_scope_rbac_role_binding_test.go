@@ -0,0 +1,77 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccGKEHubScopeRBACRoleBinding_gkehubScopeRbacRoleBindingBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckGKEHubScopeRBACRoleBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGKEHubScopeRBACRoleBinding_gkehubScopeRbacRoleBindingBasicExample(context),
+			},
+			{
+				ResourceName:      "google_gke_hub_scope_rbac_role_binding.binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGKEHubScopeRBACRoleBinding_gkehubScopeRbacRoleBindingBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_gke_hub_scope" "scope" {
+  scope_id = "tf-test-scope%{random_suffix}"
+}
+
+resource "google_gke_hub_scope_rbac_role_binding" "binding" {
+  scope_rbac_role_binding_id = "tf-test-binding%{random_suffix}"
+  scope_id                   = "${google_gke_hub_scope.scope.scope_id}"
+  user                       = "test-email@gmail.com"
+  role {
+    predefined_role = "ADMIN"
+  }
+}
+`, context)
+}
+
+func testAccCheckGKEHubScopeRBACRoleBindingDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_gke_hub_scope_rbac_role_binding" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://gkehub.googleapis.com/v1/projects/{{project}}/locations/global/scopes/{{scope_id}}/rbacrolebindings/{{scope_rbac_role_binding_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("GKEHubScopeRBACRoleBinding still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func folderIamAuditConfigImportStep(resourceName, service string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName: resourceName,
+		ImportStateIdFunc: func(s *terraform.State) (string, error) {
+			rs, ok := s.RootModule().Resources["google_folder.acceptance"]
+			if !ok {
+				return "", fmt.Errorf("Folder not found in state")
+			}
+			return fmt.Sprintf("%s %s", rs.Primary.Attributes["name"], service), nil
+		},
+		ImportState:       true,
+		ImportStateVerify: true,
+	}
+}
+
+// Test that an IAM audit config can be applied to a folder
+func TestAccFolderIamAuditConfig_basic(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	fname := "terraform-" + acctest.RandString(10)
+	service := "cloudkms.googleapis.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Create a new folder
+			{
+				Config: testAccFolderIamBasic(org, fname),
+				Check: resource.ComposeTestCheckFunc(
+					testAccFolderExistingPolicy(org, fname),
+				),
+			},
+			// Apply an IAM audit config
+			{
+				Config: testAccFolderAssociateAuditConfigLogType(org, fname, service, "DATA_READ"),
+			},
+			folderIamAuditConfigImportStep("google_folder_iam_audit_config.acceptance", service),
+			// Change the log type
+			{
+				Config: testAccFolderAssociateAuditConfigLogType(org, fname, service, "DATA_WRITE"),
+			},
+			folderIamAuditConfigImportStep("google_folder_iam_audit_config.acceptance", service),
+			// Remove the audit config
+			{
+				Config: testAccFolderIamBasic(org, fname),
+			},
+		},
+	})
+}
+
+func testAccFolderAssociateAuditConfigLogType(org, fname, service, logType string) string {
+	return fmt.Sprintf(`
+resource "google_folder" "acceptance" {
+  parent       = "organizations/%s"
+  display_name = "%s"
+}
+
+resource "google_folder_iam_audit_config" "acceptance" {
+  folder  = "${google_folder.acceptance.name}"
+  service = "%s"
+  audit_log_config {
+    log_type = "%s"
+    exempted_members = [
+      "user:admin@hashicorptest.com",
+    ]
+  }
+}
+`, org, fname, service, logType)
+}
@@ -68,6 +68,16 @@ func TestAccOrganizationIam(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				// Test Iam Audit Config creation
+				Config: testAccOrganizationIamAuditConfig_basic(org),
+			},
+			{
+				ResourceName:      "google_organization_iam_audit_config.foo",
+				ImportStateId:     fmt.Sprintf("%s cloudkms.googleapis.com", org),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -204,3 +214,18 @@ resource "google_organization_iam_member" "foo" {
 }
 `, account, org)
 }
+
+func testAccOrganizationIamAuditConfig_basic(org string) string {
+	return fmt.Sprintf(`
+resource "google_organization_iam_audit_config" "foo" {
+  org_id  = "%s"
+  service = "cloudkms.googleapis.com"
+  audit_log_config {
+    log_type = "DATA_READ"
+    exempted_members = [
+      "user:admin@hashicorptest.com",
+    ]
+  }
+}
+`, org)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
 	"google.golang.org/api/cloudbilling/v1"
 	"google.golang.org/api/cloudresourcemanager/v1"
@@ -78,6 +79,22 @@ func resourceGoogleProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ancestry": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"billing_account": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -231,10 +248,12 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	waitErr := resourceManagerOperationWaitTime(config, opAsMap, "creating folder", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	waitErr := resourceManagerOperationWaitTime(config, opAsMap, "creating project", int(d.Timeout(schema.TimeoutCreate).Minutes()))
 	if waitErr != nil {
-		// The resource wasn't actually created
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource wasn't actually created
+			d.SetId("")
+		}
 		return waitErr
 	}
 
@@ -251,6 +270,11 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	parent := fmt.Sprintf("//cloudresourcemanager.googleapis.com/projects/%s", d.Get("number").(string))
+	if err := createDefaultResourceTagBindings(config, parent, "global", int(d.Timeout(schema.TimeoutCreate).Minutes())); err != nil {
+		return err
+	}
+
 	// There's no such thing as "don't auto-create network", only "delete the network
 	// post-creation" - but that's what it's called in the UI and let's not confuse
 	// people if we don't have to.  The GCP Console is doing the same thing - creating
@@ -262,7 +286,12 @@ func resourceGoogleProjectCreate(d *schema.ResourceData, meta interface{}) error
 		}
 
 		if err = forceDeleteComputeNetwork(project.ProjectId, "default", config); err != nil {
-			return fmt.Errorf("Error deleting default network in project %s: %s", project.ProjectId, err)
+			// Projects in organizations with the skipDefaultNetworkCreation
+			// constraint never get a default network.
+			if !isGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error deleting default network in project %s: %s", project.ProjectId, err)
+			}
+			log.Printf("[DEBUG] Default network not found for project %q, no need to delete it", project.ProjectId)
 		}
 	}
 	return nil
@@ -305,6 +334,10 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if err := readProjectAncestry(d, config, pid); err != nil {
+		return err
+	}
+
 	// Read the billing account
 	ba, err := config.clientBilling.Projects.GetBillingInfo(prefixedProject(pid)).Do()
 	if err != nil && !isApiNotEnabledError(err) {
@@ -328,6 +361,42 @@ func resourceGoogleProjectRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// readProjectAncestry sets the project's ancestry, ordered from the project
+// itself up to the organization. Callers that can read the project aren't
+// always allowed to read its ancestry, so failing here would break refreshes
+// that used to work. Only a 403 is tolerated, and leaves the ancestry unset.
+func readProjectAncestry(d *schema.ResourceData, config *Config, pid string) error {
+	ancestry, err := config.clientResourceManager.Projects.GetAncestry(pid, &cloudresourcemanager.GetAncestryRequest{}).Do()
+	if err != nil {
+		if !isGoogleApiErrorWithCode(err, 403) {
+			return fmt.Errorf("Error reading ancestry for project %q: %s", pid, err)
+		}
+		log.Printf("[WARN] Not allowed to read ancestry for project %q, leaving it unset: %s", pid, err)
+		if err := d.Set("ancestry", nil); err != nil {
+			return fmt.Errorf("Error setting ancestry: %s", err)
+		}
+		return nil
+	}
+	if err := d.Set("ancestry", flattenProjectAncestry(ancestry.Ancestor)); err != nil {
+		return fmt.Errorf("Error setting ancestry: %s", err)
+	}
+	return nil
+}
+
+func flattenProjectAncestry(ancestors []*cloudresourcemanager.Ancestor) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(ancestors))
+	for _, a := range ancestors {
+		if a.ResourceId == nil {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"type": a.ResourceId.Type,
+			"id":   a.ResourceId.Id,
+		})
+	}
+	return result
+}
+
 func prefixedProject(pid string) string {
 	return "projects/" + pid
 }
@@ -435,13 +504,16 @@ func resourceGoogleProjectUpdate(d *schema.ResourceData, meta interface{}) error
 
 func resourceGoogleProjectDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	pid := d.Id()
 	// Only delete projects if skip_delete isn't set
-	if !d.Get("skip_delete").(bool) {
-		pid := d.Id()
-		_, err := config.clientResourceManager.Projects.Delete(pid).Do()
-		if err != nil {
-			return fmt.Errorf("Error deleting project %q: %s", pid, err)
-		}
+	if d.Get("skip_delete").(bool) {
+		log.Printf("[WARN] skip_delete is set, so project %q will be removed from Terraform state but not deleted", pid)
+		d.SetId("")
+		return nil
+	}
+
+	if _, err := config.clientResourceManager.Projects.Delete(pid).Do(); err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Project %q", pid))
 	}
 	d.SetId("")
 	return nil
@@ -473,7 +545,7 @@ func forceDeleteComputeNetwork(projectId, networkName string, config *Config) er
 			if err != nil {
 				return fmt.Errorf("Error deleting firewall: %s", err)
 			}
-			err = computeSharedOperationWait(config.clientCompute, op, projectId, "Deleting Firewall")
+			err = computeSharedOperationWait(config, op, projectId, "Deleting Firewall")
 			if err != nil {
 				return err
 			}
@@ -521,10 +593,10 @@ func deleteComputeNetwork(project, network string, config *Config) error {
 	op, err := config.clientCompute.Networks.Delete(
 		project, network).Do()
 	if err != nil {
-		return fmt.Errorf("Error deleting network: %s", err)
+		return errwrap.Wrapf("Error deleting network: {{err}}", err)
 	}
 
-	err = computeOperationWaitTime(config.clientCompute, op, project, "Deleting Network", 10)
+	err = computeOperationWaitTime(config, op, project, "Deleting Network", 10)
 	if err != nil {
 		return err
 	}
@@ -186,6 +186,38 @@ func TestAccProjectIamBinding_remove(t *testing.T) {
 	})
 }
 
+// Test that an IAM binding with a condition can be applied to a project
+func TestAccProjectIamBinding_withCondition(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	role := "roles/compute.instanceAdmin"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Create a new project
+			{
+				Config: testAccProject_create(pid, pname, org),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectExistingPolicy(pid),
+				),
+			},
+			// Apply an IAM binding with a condition
+			{
+				Config: testAccProjectAssociateBindingWithCondition(pid, pname, org, role),
+			},
+			{
+				ResourceName:      "google_project_iam_binding.acceptance",
+				ImportStateId:     fmt.Sprintf("%s %s Expires after 2030", pid, role),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccProjectAssociateBindingBasic(pid, name, org, role string) string {
 	return fmt.Sprintf(`
 resource "google_project" "acceptance" {
@@ -255,3 +287,25 @@ resource "google_project_iam_binding" "acceptance" {
 }
 `, pid, name, org, role)
 }
+
+func testAccProjectAssociateBindingWithCondition(pid, name, org, role string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id = "%s"
+  name       = "%s"
+  org_id     = "%s"
+}
+
+resource "google_project_iam_binding" "acceptance" {
+  project = "${google_project.acceptance.project_id}"
+  members = ["user:admin@hashicorptest.com"]
+  role    = "%s"
+
+  condition {
+    title       = "Expires after 2030"
+    description = "Expiring at midnight of 2030-12-31"
+    expression  = "request.time < timestamp(\"2031-01-01T00:00:00Z\")"
+  }
+}
+`, pid, name, org, role)
+}
@@ -130,6 +130,39 @@ func TestAccProjectIamMember_remove(t *testing.T) {
 	})
 }
 
+// Test that an IAM binding with a condition can be applied to a project
+func TestAccProjectIamMember_withCondition(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+	role := "roles/compute.instanceAdmin"
+	member := "user:admin@hashicorptest.com"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			// Create a new project
+			{
+				Config: testAccProject_create(pid, pname, org),
+				Check: resource.ComposeTestCheckFunc(
+					testAccProjectExistingPolicy(pid),
+				),
+			},
+			// Apply an IAM member with a condition
+			{
+				Config: testAccProjectAssociateMemberWithCondition(pid, pname, org, role, member),
+			},
+			{
+				ResourceName:      "google_project_iam_member.acceptance",
+				ImportStateId:     fmt.Sprintf("%s %s %s Expires after 2030", pid, role, member),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccProjectAssociateMemberBasic(pid, name, org, role, member string) string {
 	return fmt.Sprintf(`
 resource "google_project" "acceptance" {
@@ -167,3 +200,25 @@ resource "google_project_iam_member" "multiple" {
 }
 `, pid, name, org, role, member, role2, member2)
 }
+
+func testAccProjectAssociateMemberWithCondition(pid, name, org, role, member string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id = "%s"
+  name       = "%s"
+  org_id     = "%s"
+}
+
+resource "google_project_iam_member" "acceptance" {
+  project = "${google_project.acceptance.project_id}"
+  role    = "%s"
+  member  = "%s"
+
+  condition {
+    title       = "Expires after 2030"
+    description = "Expiring at midnight of 2030-12-31"
+    expression  = "request.time < timestamp(\"2031-01-01T00:00:00Z\")"
+  }
+}
+`, pid, name, org, role, member)
+}
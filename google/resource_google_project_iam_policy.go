@@ -60,10 +60,6 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 	config := meta.(*Config)
 	project := d.Get("project").(string)
 
-	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
 	// Get the policy in the template
 	policy, err := getResourceIamPolicy(d)
 	if err != nil {
@@ -71,8 +67,7 @@ func resourceGoogleProjectIamPolicyCreate(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Setting IAM policy for project %q", project)
-	err = setProjectIamPolicy(policy, config, project)
-	if err != nil {
+	if err := replaceProjectIamPolicy(policy, config, project); err != nil {
 		return err
 	}
 
@@ -89,7 +84,11 @@ func resourceGoogleProjectIamPolicyRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	policyBytes, err := json.Marshal(&cloudresourcemanager.Policy{Bindings: policy.Bindings, AuditConfigs: policy.AuditConfigs})
+	// Members of deleted principals can't be removed through config, so they
+	// are hidden like in the other IAM policy resources.
+	bindings := pruneDeletedIamBindingMembers(policy.Bindings)
+
+	policyBytes, err := json.Marshal(&cloudresourcemanager.Policy{Bindings: canonicalIamBindings(bindings), AuditConfigs: policy.AuditConfigs})
 	if err != nil {
 		return fmt.Errorf("Error marshaling IAM policy: %v", err)
 	}
@@ -104,10 +103,6 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 	config := meta.(*Config)
 	project := d.Get("project").(string)
 
-	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
 	// Get the policy in the template
 	policy, err := getResourceIamPolicy(d)
 	if err != nil {
@@ -115,8 +110,7 @@ func resourceGoogleProjectIamPolicyUpdate(d *schema.ResourceData, meta interface
 	}
 
 	log.Printf("[DEBUG] Updating IAM policy for project %q", project)
-	err = setProjectIamPolicy(policy, config, project)
-	if err != nil {
+	if err := replaceProjectIamPolicy(policy, config, project); err != nil {
 		return fmt.Errorf("Error setting project IAM policy: %v", err)
 	}
 
@@ -128,18 +122,13 @@ func resourceGoogleProjectIamPolicyDelete(d *schema.ResourceData, meta interface
 	config := meta.(*Config)
 	project := d.Get("project").(string)
 
-	mutexKey := getProjectIamPolicyMutexKey(project)
-	mutexKV.Lock(mutexKey)
-	defer mutexKV.Unlock(mutexKey)
-
-	// Get the existing IAM policy from the API so we can repurpose the etag and audit config
-	ep, err := getProjectIamPolicy(project, config)
+	// Only clear the bindings, keeping the etag and audit config of the existing policy
+	updater := &ProjectIamUpdater{resourceId: project, Config: config}
+	err := iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		ep.Bindings = make([]*cloudresourcemanager.Binding, 0)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM policy from project API: %v", err)
-	}
-
-	ep.Bindings = make([]*cloudresourcemanager.Binding, 0)
-	if err = setProjectIamPolicy(ep, config, project); err != nil {
 		return fmt.Errorf("Error applying IAM policy to project: %v", err)
 	}
 
@@ -152,14 +141,38 @@ func resourceGoogleProjectIamPolicyImport(d *schema.ResourceData, meta interface
 	return []*schema.ResourceData{d}, nil
 }
 
-func setProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pid string) error {
-	// Apply the policy
-	pbytes, _ := json.Marshal(policy)
-	log.Printf("[DEBUG] Setting policy %#v for project: %s", string(pbytes), pid)
-	_, err := config.clientResourceManager.Projects.SetIamPolicy(pid,
-		&cloudresourcemanager.SetIamPolicyRequest{Policy: policy, UpdateMask: "bindings,etag,auditConfigs"}).Do()
+// replaceProjectIamPolicy overwrites the bindings and audit configs of the
+// project's policy, as the resource is authoritative. The etag only makes sure
+// the rest of the policy isn't written back stale: after a conflict the newer
+// policy is read and its bindings are replaced too, so concurrent changes to
+// them are still undone.
+func replaceProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pid string) error {
+	updater := &ProjectIamUpdater{resourceId: pid, Config: config}
+	return iamPolicyReadModifyWrite(updater, func(ep *cloudresourcemanager.Policy) error {
+		ep.Bindings = policy.Bindings
+		ep.AuditConfigs = policy.AuditConfigs
+		return nil
+	})
+}
 
+func setProjectIamPolicy(policy *cloudresourcemanager.Policy, config *Config, pid string) error {
+	// Conditional bindings can only be written to a version 3 policy. The
+	// caller's policy is left as it is.
+	versioned := *policy
+	versioned.Version = iamPolicyVersion
+	obj, err := ConvertToMap(&versioned)
 	if err != nil {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"policy":     obj,
+		"updateMask": "bindings,etag,auditConfigs",
+	}
+
+	log.Printf("[DEBUG] Setting policy %#v for project: %s", obj, pid)
+	url := fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v1/projects/%s:setIamPolicy", pid)
+	if _, err := sendRequest(config, "POST", url, body); err != nil {
 		return errwrap.Wrapf(fmt.Sprintf("Error applying IAM policy for project %q. Policy is %#v, error is {{err}}", pid, policy), err)
 	}
 	return nil
@@ -177,12 +190,25 @@ func getResourceIamPolicy(d *schema.ResourceData) (*cloudresourcemanager.Policy,
 }
 
 // Retrieve the existing IAM Policy for a Project
+//
+// The vendored resource manager client can't request a policy version, so the
+// policy is read through the JSON API to include conditional bindings.
 func getProjectIamPolicy(project string, config *Config) (*cloudresourcemanager.Policy, error) {
-	p, err := config.clientResourceManager.Projects.GetIamPolicy(project,
-		&cloudresourcemanager.GetIamPolicyRequest{}).Do()
+	obj := map[string]interface{}{
+		"options": map[string]interface{}{
+			"requestedPolicyVersion": iamPolicyVersion,
+		},
+	}
 
+	url := fmt.Sprintf("https://cloudresourcemanager.googleapis.com/v1/projects/%s:getIamPolicy", project)
+	res, err := sendRequest(config, "POST", url, obj)
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving IAM policy for project %q: %s", project, err)
+		return nil, errwrap.Wrapf(fmt.Sprintf("Error retrieving IAM policy for project %q: {{err}}", project), err)
+	}
+
+	p := &cloudresourcemanager.Policy{}
+	if err := Convert(res, p); err != nil {
+		return nil, err
 	}
 	return p, nil
 }
@@ -272,7 +298,17 @@ func (b sortableBindings) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
 func (b sortableBindings) Less(i, j int) bool {
-	return b[i].Role < b[j].Role
+	if b[i].Role != b[j].Role {
+		return b[i].Role < b[j].Role
+	}
+	ci, cj := conditionKeyFromCondition(b[i].Condition), conditionKeyFromCondition(b[j].Condition)
+	if ci.Title != cj.Title {
+		return ci.Title < cj.Title
+	}
+	if ci.Expression != cj.Expression {
+		return ci.Expression < cj.Expression
+	}
+	return ci.Description < cj.Description
 }
 
 type sortableAuditConfigs []*cloudresourcemanager.AuditConfig
@@ -291,6 +291,44 @@ func TestIamMergeBindings(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: []*cloudresourcemanager.Binding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-1"},
+				},
+				{
+					Role:    "role-1",
+					Members: []string{"member-2"},
+					Condition: &cloudresourcemanager.Expr{
+						Title:      "condition-1",
+						Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")",
+					},
+				},
+				{
+					Role:    "role-1",
+					Members: []string{"member-3"},
+					Condition: &cloudresourcemanager.Expr{
+						Title:      "condition-1",
+						Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")",
+					},
+				},
+			},
+			expect: []cloudresourcemanager.Binding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-1"},
+				},
+				{
+					Role:    "role-1",
+					Members: []string{"member-2", "member-3"},
+					Condition: &cloudresourcemanager.Expr{
+						Title:      "condition-1",
+						Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")",
+					},
+				},
+			},
+		},
 	}
 	for _, test := range table {
 		got := mergeBindings(test.input)
@@ -304,6 +342,130 @@ func TestIamMergeBindings(t *testing.T) {
 	}
 }
 
+func TestNormalizeIamMemberCasing(t *testing.T) {
+	cases := map[string]string{
+		"user:Jane@Example.com":                                          "user:jane@example.com",
+		"User:jane@example.com":                                          "user:jane@example.com",
+		"serviceaccount:SA@project.iam.gserviceaccount.com":              "serviceAccount:sa@project.iam.gserviceaccount.com",
+		"group:Admins@example.com":                                       "group:admins@example.com",
+		"domain:Example.com":                                             "domain:example.com",
+		"deleted:serviceAccount:SA@p.iam.gserviceaccount.com?uid=AbC123": "deleted:serviceAccount:sa@p.iam.gserviceaccount.com?uid=AbC123",
+		"allUsers":                            "allUsers",
+		"projectOwner:My-Project":             "projectOwner:My-Project",
+		"principal://iam.googleapis.com/Pool": "principal://iam.googleapis.com/Pool",
+	}
+	for input, expected := range cases {
+		if got := normalizeIamMemberCasing(input); got != expected {
+			t.Errorf("normalizeIamMemberCasing(%q) = %q, expected %q", input, got, expected)
+		}
+	}
+}
+
+func TestPruneDeletedIamMembers(t *testing.T) {
+	members := []string{
+		"user:jane@example.com",
+		"deleted:serviceAccount:sa@p.iam.gserviceaccount.com?uid=123",
+		"Deleted:user:john@example.com?uid=456",
+		"serviceAccount:sa@p.iam.gserviceaccount.com",
+	}
+	expected := []string{"user:jane@example.com", "serviceAccount:sa@p.iam.gserviceaccount.com"}
+	if got := pruneDeletedIamMembers(members); !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestPruneDeletedIamBindingMembers(t *testing.T) {
+	bindings := []*cloudresourcemanager.Binding{
+		{Role: "role-1", Members: []string{"user:jane@example.com", "deleted:user:john@example.com?uid=123"}},
+		{Role: "role-2", Members: []string{"deleted:user:john@example.com?uid=123"}},
+	}
+	expected := []cloudresourcemanager.Binding{
+		{Role: "role-1", Members: []string{"user:jane@example.com"}},
+	}
+	if got := pruneDeletedIamBindingMembers(bindings); !reflect.DeepEqual(derefBindings(got), expected) {
+		t.Errorf("got %+v, expected %+v", derefBindings(got), expected)
+	}
+}
+
+func TestIamCompareBindingsIgnoresMemberCasingAndOrder(t *testing.T) {
+	a := []*cloudresourcemanager.Binding{
+		{Role: "role-1", Members: []string{"user:Jane@Example.com", "group:admins@example.com"}},
+		{Role: "role-2", Members: []string{"serviceAccount:sa@p.iam.gserviceaccount.com"}},
+	}
+	b := []*cloudresourcemanager.Binding{
+		{Role: "role-2", Members: []string{"serviceAccount:SA@p.iam.gserviceaccount.com"}},
+		{Role: "role-1", Members: []string{"group:admins@example.com", "user:jane@example.com"}},
+	}
+	if !compareBindings(a, b) {
+		t.Errorf("expected bindings to compare equal:\n%+v\n%+v", derefBindings(a), derefBindings(b))
+	}
+
+	b[1].Members = append(b[1].Members, "user:john@example.com")
+	if compareBindings(a, b) {
+		t.Errorf("expected bindings with an added member to differ")
+	}
+}
+
+func TestMarshalIamPolicyIsCanonical(t *testing.T) {
+	policy := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{
+			{Role: "role-2", Members: []string{"user:b@example.com", "user:A@example.com"}},
+			{Role: "role-1", Members: []string{"user:c@example.com"}},
+		},
+	}
+	expected := `{"bindings":[{"members":["user:c@example.com"],"role":"role-1"},{"members":["user:a@example.com","user:b@example.com"],"role":"role-2"}]}`
+	if got := marshalIamPolicy(policy); got != expected {
+		t.Errorf("got %s\nexpected %s", got, expected)
+	}
+}
+
+func TestIamSortBindingsByConditionDescription(t *testing.T) {
+	cond := func(desc string) *cloudresourcemanager.Expr {
+		return &cloudresourcemanager.Expr{
+			Title:       "condition-1",
+			Expression:  "request.time < timestamp(\"2030-01-01T00:00:00Z\")",
+			Description: desc,
+		}
+	}
+	bindings := []*cloudresourcemanager.Binding{
+		{Role: "role-1", Members: []string{"member-2"}, Condition: cond("b")},
+		{Role: "role-1", Members: []string{"member-1"}, Condition: cond("a")},
+	}
+
+	sort.Sort(sortableBindings(bindings))
+	if bindings[0].Condition.Description != "a" || bindings[1].Condition.Description != "b" {
+		t.Errorf("expected bindings ordered by condition description, got %+v", derefBindings(bindings))
+	}
+}
+
+func TestValidateIamPolicyHasNoConditions(t *testing.T) {
+	plain := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{
+			{Role: "role-1", Members: []string{"member-1"}},
+		},
+	}
+	if err := validateIamPolicyHasNoConditions(plain); err != nil {
+		t.Errorf("unexpected error for unconditional policy: %s", err)
+	}
+
+	conditional := &cloudresourcemanager.Policy{
+		Bindings: []*cloudresourcemanager.Binding{
+			{Role: "role-1", Members: []string{"member-1"}},
+			{
+				Role:    "role-2",
+				Members: []string{"member-2"},
+				Condition: &cloudresourcemanager.Expr{
+					Title:      "condition-1",
+					Expression: "request.time < timestamp(\"2030-01-01T00:00:00Z\")",
+				},
+			},
+		},
+	}
+	if err := validateIamPolicyHasNoConditions(conditional); err == nil {
+		t.Errorf("expected an error for conditional policy")
+	}
+}
+
 // Confirm that a project has an IAM policy with at least 1 binding
 func testAccProjectExistingPolicy(pid string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
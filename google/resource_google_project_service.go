@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -20,6 +21,10 @@ func resourceGoogleProjectService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -57,7 +62,7 @@ func resourceGoogleProjectServiceCreate(d *schema.ResourceData, meta interface{}
 
 	srv := d.Get("service").(string)
 
-	if err = enableService(srv, project, config); err != nil {
+	if err = batchRequestEnableService(srv, project, config, d.Timeout(schema.TimeoutCreate)); err != nil {
 		return errwrap.Wrapf("Error enabling service: {{err}}", err)
 	}
 
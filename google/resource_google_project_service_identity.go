@@ -0,0 +1,95 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceProjectServiceIdentity() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectServiceIdentityCreate,
+		Read:   resourceProjectServiceIdentityRead,
+		Delete: resourceProjectServiceIdentityDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"member": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProjectServiceIdentityCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	service := d.Get("service").(string)
+
+	// generateServiceIdentity is only available in v1beta1, which isn't vendored.
+	url := fmt.Sprintf("https://serviceusage.googleapis.com/v1beta1/projects/%s/services/%s:generateServiceIdentity", project, service)
+	res, err := sendRequestWithTimeout(config, "POST", url, nil, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating service identity for %s: %s", service, err)
+	}
+
+	raw, err := serviceUsageOperationWaitTimeWithResponse(config, res, "Creating Service Identity", int(d.Timeout(schema.TimeoutCreate).Minutes()))
+	if err != nil {
+		return err
+	}
+
+	var identity struct {
+		Email string `json:"email"`
+	}
+	if err := json.Unmarshal(raw, &identity); err != nil {
+		return fmt.Errorf("Error reading service identity for %s: %s", service, err)
+	}
+
+	// Some services have a service agent that isn't returned by the API, in
+	// which case there's nothing to record beyond the fact the call succeeded.
+	d.SetId(fmt.Sprintf("projects/%s/services/%s", project, service))
+	d.Set("project", project)
+	d.Set("email", identity.Email)
+	if identity.Email != "" {
+		d.Set("member", "serviceAccount:"+identity.Email)
+	}
+
+	return nil
+}
+
+// Service identities can't be read back or deleted; the resource exists to
+// force their creation, so everything is kept from the create response.
+func resourceProjectServiceIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceProjectServiceIdentityDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,38 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccProjectServiceIdentity_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectServiceIdentity_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_project_service_identity.hc_sa", "email"),
+					resource.TestCheckResourceAttrPair("google_project_service_identity.hc_sa", "email",
+						"data.google_project_service_agent.hc_sa", "email"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectServiceIdentity_basic() string {
+	return `
+resource "google_project_service_identity" "hc_sa" {
+  service = "healthcare.googleapis.com"
+}
+
+data "google_project_service_agent" "hc_sa" {
+  service = "healthcare.googleapis.com"
+}
+`
+}
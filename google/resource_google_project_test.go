@@ -2,6 +2,8 @@ package google
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"reflect"
 	"strconv"
@@ -11,6 +13,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"google.golang.org/api/cloudresourcemanager/v1"
 )
@@ -20,6 +23,72 @@ var (
 	originalPolicy *cloudresourcemanager.Policy
 )
 
+// ancestryRoundTripper answers getAncestry requests with a fixed status code.
+type ancestryRoundTripper struct {
+	code int
+}
+
+func (rt *ancestryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := `{"ancestor": [{"resourceId": {"type": "project", "id": "my-project"}}, {"resourceId": {"type": "organization", "id": "1234"}}]}`
+	if rt.code != http.StatusOK {
+		body = fmt.Sprintf(`{"error": {"code": %d, "message": "error"}}`, rt.code)
+	}
+	return &http.Response{
+		StatusCode: rt.code,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+func TestReadProjectAncestry(t *testing.T) {
+	cases := map[string]struct {
+		Code             int
+		ExpectError      bool
+		ExpectedAncestry int
+	}{
+		"ok": {
+			Code:             http.StatusOK,
+			ExpectedAncestry: 2,
+		},
+		"forbidden is tolerated": {
+			Code: http.StatusForbidden,
+		},
+		"not found": {
+			Code:        http.StatusNotFound,
+			ExpectError: true,
+		},
+		"unauthorized": {
+			Code:        http.StatusUnauthorized,
+			ExpectError: true,
+		},
+		"server error": {
+			Code:        http.StatusInternalServerError,
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		client, err := cloudresourcemanager.New(&http.Client{Transport: &ancestryRoundTripper{code: tc.Code}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		config := &Config{clientResourceManager: client}
+		d := schema.TestResourceDataRaw(t, resourceGoogleProject().Schema, map[string]interface{}{})
+
+		err = readProjectAncestry(d, config, "my-project")
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if got := d.Get("ancestry.#").(int); got != tc.ExpectedAncestry {
+			t.Errorf("%s: expected %d ancestors, got %d", tn, tc.ExpectedAncestry, got)
+		}
+	}
+}
+
 // Test that a Project resource can be created without an organization
 func TestAccProject_createWithoutOrg(t *testing.T) {
 	t.Parallel()
@@ -165,6 +234,7 @@ func TestAccProject_deleteDefaultNetwork(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccProject_deleteDefaultNetwork(pid, pname, org, billingId),
+				Check:  testAccCheckGoogleProjectHasNoDefaultNetwork(pid),
 			},
 		},
 	})
@@ -206,6 +276,22 @@ func testAccCheckGoogleProjectExists(r, pid string) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckGoogleProjectHasNoDefaultNetwork(pid string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+
+		_, err := config.clientCompute.Networks.Get(pid, "default").Do()
+		if err == nil {
+			return fmt.Errorf("Default network still exists in project %q", pid)
+		}
+		if !isGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error checking for the default network in project %q: %s", pid, err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckGoogleProjectHasBillingAccount(r, pid, billingId string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[r]
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"google.golang.org/api/iam/v1"
 )
 
@@ -40,6 +41,16 @@ func resourceGoogleServiceAccount() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"project": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -55,6 +66,10 @@ func resourceGoogleServiceAccount() *schema.Resource {
 	}
 }
 
+// The service account fields that the vendored IAM client doesn't know about,
+// such as description, are read and written through the REST API.
+const iamBasePath = "https://iam.googleapis.com/v1/"
+
 func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	project, err := getProject(d, config)
@@ -62,24 +77,33 @@ func resourceGoogleServiceAccountCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 	aid := d.Get("account_id").(string)
-	displayName := d.Get("display_name").(string)
 
-	sa := &iam.ServiceAccount{
-		DisplayName: displayName,
-	}
-
-	r := &iam.CreateServiceAccountRequest{
-		AccountId:      aid,
-		ServiceAccount: sa,
+	r := map[string]interface{}{
+		"accountId": aid,
+		"serviceAccount": map[string]interface{}{
+			"displayName": d.Get("display_name").(string),
+			"description": d.Get("description").(string),
+		},
 	}
 
-	sa, err = config.clientIAM.Projects.ServiceAccounts.Create("projects/"+project, r).Do()
+	res, err := sendRequest(config, "POST", iamBasePath+"projects/"+project+"/serviceAccounts", r)
 	if err != nil {
 		return fmt.Errorf("Error creating service account: %s", err)
 	}
 
+	sa := &iam.ServiceAccount{}
+	if err := Convert(res, sa); err != nil {
+		return err
+	}
+
 	d.SetId(sa.Name)
 
+	if d.Get("disabled").(bool) {
+		if err := setServiceAccountDisabled(config, d.Id(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceGoogleServiceAccountRead(d, meta)
 }
 
@@ -87,17 +111,24 @@ func resourceGoogleServiceAccountRead(d *schema.ResourceData, meta interface{})
 	config := meta.(*Config)
 
 	// Confirm the service account exists
-	sa, err := config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
+	res, err := sendRequest(config, "GET", iamBasePath+d.Id(), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Service Account %q", d.Id()))
 	}
 
+	sa := &iam.ServiceAccount{}
+	if err := Convert(res, sa); err != nil {
+		return err
+	}
+
 	d.Set("email", sa.Email)
 	d.Set("unique_id", sa.UniqueId)
 	d.Set("project", sa.ProjectId)
 	d.Set("account_id", strings.Split(sa.Email, "@")[0])
 	d.Set("name", sa.Name)
 	d.Set("display_name", sa.DisplayName)
+	d.Set("description", res["description"])
+	d.Set("disabled", res["disabled"] == true)
 	return nil
 }
 
@@ -114,21 +145,52 @@ func resourceGoogleServiceAccountDelete(d *schema.ResourceData, meta interface{}
 
 func resourceGoogleServiceAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
-	if ok := d.HasChange("display_name"); ok {
+
+	updateMask := []string{}
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if len(updateMask) > 0 {
 		sa, err := config.clientIAM.Projects.ServiceAccounts.Get(d.Id()).Do()
 		if err != nil {
 			return fmt.Errorf("Error retrieving service account %q: %s", d.Id(), err)
 		}
-		_, err = config.clientIAM.Projects.ServiceAccounts.Update(d.Id(),
-			&iam.ServiceAccount{
-				DisplayName: d.Get("display_name").(string),
-				Etag:        sa.Etag,
-			}).Do()
-		if err != nil {
+
+		r := map[string]interface{}{
+			"serviceAccount": map[string]interface{}{
+				"displayName": d.Get("display_name").(string),
+				"description": d.Get("description").(string),
+				"etag":        sa.Etag,
+			},
+			"updateMask": strings.Join(updateMask, ","),
+		}
+		if _, err := sendRequest(config, "PATCH", iamBasePath+d.Id(), r); err != nil {
 			return fmt.Errorf("Error updating service account %q: %s", d.Id(), err)
 		}
 	}
 
+	if d.HasChange("disabled") {
+		if err := setServiceAccountDisabled(config, d.Id(), d.Get("disabled").(bool)); err != nil {
+			return err
+		}
+	}
+
+	return resourceGoogleServiceAccountRead(d, meta)
+}
+
+func setServiceAccountDisabled(config *Config, name string, disabled bool) error {
+	action := "enable"
+	if disabled {
+		action = "disable"
+	}
+
+	if _, err := sendRequest(config, "POST", iamBasePath+name+":"+action, map[string]interface{}{}); err != nil {
+		return fmt.Errorf("Error calling %s on service account %q: %s", action, name, err)
+	}
 	return nil
 }
 
@@ -30,6 +30,13 @@ func resourceGoogleServiceAccountKey() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"KEY_ALG_UNSPECIFIED", "KEY_ALG_RSA_1024", "KEY_ALG_RSA_2048"}, false),
 			},
+			// Arbitrary values that force a new key to be created when they
+			// change, so keys can be rotated along with other resources.
+			"keepers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
 			"pgp_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -34,6 +34,62 @@ func TestAccServiceAccountKey_basic(t *testing.T) {
 	})
 }
 
+// Test that changing the keepers of a service account key rotates it
+func TestAccServiceAccountKey_keepers(t *testing.T) {
+	t.Parallel()
+
+	resourceName := "google_service_account_key.acceptance"
+	accountID := "a" + acctest.RandString(10)
+	displayName := "Terraform Test"
+	var keyName string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountKey_keepers(accountID, displayName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "first"),
+					testAccStoreServiceAccountKeyName(resourceName, &keyName),
+				),
+			},
+			{
+				Config: testAccServiceAccountKey_keepers(accountID, displayName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleServiceAccountKeyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "keepers.rotation", "second"),
+					testAccCheckServiceAccountKeyRotated(resourceName, &keyName),
+				),
+			},
+		},
+	})
+}
+
+func testAccStoreServiceAccountKeyName(r string, name *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+		*name = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckServiceAccountKeyRotated(r string, oldName *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[r]
+		if !ok {
+			return fmt.Errorf("Not found: %s", r)
+		}
+		if rs.Primary.ID == *oldName {
+			return fmt.Errorf("Expected service account key %s to be replaced", *oldName)
+		}
+		return nil
+	}
+}
+
 func TestAccServiceAccountKey_fromEmail(t *testing.T) {
 	t.Parallel()
 
@@ -173,3 +229,21 @@ PXFv3m3WfUlnG/om78UjQqyVACRZqqAGmuPq+TSkRUCpt9h+A39LQWkojHqyob3cyLgy6z9Q557O
 9uK3lQozbw2gH9zC0RqnePl+rsWIUU/ga16fH6pWc1uJiEBt8UZGypQ/E56/343epmYAe0a87sHx
 8iDV+dNtDVKfPRENiLOOc19MmS+phmUyrbHqI91c0pmysYcJZCD3a502X1gpjFbPZcRtiTmGnUKd
 OIu60YPNE4+h7u2CfYyFPu3AlUaGNMBlvy6PEpU=`
+
+func testAccServiceAccountKey_keepers(account, name, rotation string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "acceptance" {
+	account_id = "%s"
+	display_name = "%s"
+}
+
+resource "google_service_account_key" "acceptance" {
+	service_account_id = "${google_service_account.acceptance.name}"
+	public_key_type = "TYPE_X509_PEM_FILE"
+
+	keepers = {
+		rotation = "%s"
+	}
+}
+`, account, name, rotation)
+}
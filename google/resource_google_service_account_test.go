@@ -83,6 +83,51 @@ func TestAccServiceAccount_basic(t *testing.T) {
 	})
 }
 
+// Test that a service account's description and disabled state can be updated in place
+func TestAccServiceAccount_descriptionAndDisabled(t *testing.T) {
+	t.Parallel()
+
+	accountId := "a" + acctest.RandString(10)
+	uniqueId := ""
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceAccountDescription(accountId, "Terraform Test", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_service_account.acceptance", "description", "Terraform Test"),
+					resource.TestCheckResourceAttr(
+						"google_service_account.acceptance", "disabled", "false"),
+					testAccStoreServiceAccountUniqueId(&uniqueId),
+				),
+			},
+			{
+				ResourceName:      "google_service_account.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceAccountDescription(accountId, "Terraform Test Update", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_service_account.acceptance", "description", "Terraform Test Update"),
+					resource.TestCheckResourceAttr(
+						"google_service_account.acceptance", "disabled", "true"),
+					resource.TestCheckResourceAttrPtr(
+						"google_service_account.acceptance", "unique_id", &uniqueId),
+				),
+			},
+			{
+				ResourceName:      "google_service_account.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccStoreServiceAccountUniqueId(uniqueId *string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		*uniqueId = s.RootModule().Resources["google_service_account.acceptance"].Primary.Attributes["unique_id"]
@@ -108,3 +153,14 @@ resource "google_service_account" "acceptance" {
 }
 `, project, account, name)
 }
+
+func testAccServiceAccountDescription(account, description string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "acceptance" {
+    account_id = "%v"
+    display_name = "Terraform Test"
+    description = "%v"
+    disabled = %v
+}
+`, account, description, disabled)
+}
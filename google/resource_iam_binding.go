@@ -22,6 +22,7 @@ var iamBindingSchema = map[string]*schema.Schema{
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
 		},
+		Set: iamMemberHash,
 	},
 	"etag": {
 		Type:     schema.TypeString,
@@ -29,25 +30,31 @@ var iamBindingSchema = map[string]*schema.Schema{
 	},
 }
 
-func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+func ResourceIamBinding(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := newIamSettings(options...)
+	s := mergeSchemas(iamBindingSchema, parentSpecificSchema)
+	if settings.EnableConditions {
+		s["condition"] = iamConditionSchema
+	}
+
 	return &schema.Resource{
-		Create: resourceIamBindingCreateUpdate(newUpdaterFunc),
-		Read:   resourceIamBindingRead(newUpdaterFunc),
-		Update: resourceIamBindingCreateUpdate(newUpdaterFunc),
-		Delete: resourceIamBindingDelete(newUpdaterFunc),
-		Schema: mergeSchemas(iamBindingSchema, parentSpecificSchema),
+		Create: resourceIamBindingCreateUpdate(newUpdaterFunc, settings),
+		Read:   resourceIamBindingRead(newUpdaterFunc, settings),
+		Update: resourceIamBindingCreateUpdate(newUpdaterFunc, settings),
+		Delete: resourceIamBindingDelete(newUpdaterFunc, settings),
+		Schema: s,
 	}
 }
 
-func ResourceIamBindingWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) *schema.Resource {
-	r := ResourceIamBinding(parentSpecificSchema, newUpdaterFunc)
+func ResourceIamBindingWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	r := ResourceIamBinding(parentSpecificSchema, newUpdaterFunc, options...)
 	r.Importer = &schema.ResourceImporter{
-		State: iamBindingImport(resourceIdParser),
+		State: iamBindingImport(newUpdaterFunc, resourceIdParser, newIamSettings(options...)),
 	}
 	return r
 }
 
-func resourceIamBindingCreateUpdate(newUpdaterFunc newResourceIamUpdaterFunc) func(*schema.ResourceData, interface{}) error {
+func resourceIamBindingCreateUpdate(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) func(*schema.ResourceData, interface{}) error {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -63,12 +70,12 @@ func resourceIamBindingCreateUpdate(newUpdaterFunc newResourceIamUpdaterFunc) fu
 		if err != nil {
 			return err
 		}
-		d.SetId(updater.GetResourceId() + "/" + p.Role)
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		d.SetId(iamBindingId(updater.GetResourceId(), p))
+		return resourceIamBindingRead(newUpdaterFunc, settings)(d, meta)
 	}
 }
 
-func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
+func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) schema.ReadFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -89,9 +96,10 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v", updater.DescribeResource(), p)
 
+		eCondition := conditionKeyFromCondition(eBinding.Condition)
 		var binding *cloudresourcemanager.Binding
 		for _, b := range p.Bindings {
-			if b.Role != eBinding.Role {
+			if b.Role != eBinding.Role || conditionKeyFromCondition(b.Condition) != eCondition {
 				continue
 			}
 			binding = b
@@ -102,25 +110,34 @@ func resourceIamBindingRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Rea
 			d.SetId("")
 			return nil
 		}
+		members := binding.Members
+		if settings.PruneDeletedMembers {
+			members = pruneDeletedIamMembers(members)
+		}
 		d.Set("etag", p.Etag)
-		d.Set("members", binding.Members)
+		d.Set("members", members)
 		d.Set("role", binding.Role)
+		if !eCondition.Empty() {
+			d.Set("condition", flattenIamCondition(binding.Condition))
+		}
 		return nil
 	}
 }
 
-func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
+func iamBindingImport(newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, settings *IamSettings) schema.StateFunc {
 	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIdParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
 		config := m.(*Config)
 		s := strings.Fields(d.Id())
-		if len(s) != 2 {
+		if len(s) < 2 {
 			d.SetId("")
-			return nil, fmt.Errorf("Wrong number of parts to Binding id %s; expected 'resource_name role'.", s)
+			return nil, fmt.Errorf("Wrong number of parts to Binding id %s; expected 'resource_name role [condition_title]'.", s)
 		}
 		id, role := s[0], s[1]
+		// Condition titles may contain spaces, so everything after the role is the title.
+		conditionTitle := strings.Join(s[2:], " ")
 
 		// Set the ID only to the first part so all IAM types can share the same resourceIdParserFunc.
 		d.SetId(id)
@@ -130,9 +147,21 @@ func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 			return nil, err
 		}
 
+		var condition *cloudresourcemanager.Expr
+		if conditionTitle != "" {
+			if !settings.EnableConditions {
+				return nil, fmt.Errorf("IAM Conditions are not supported for this IAM resource.")
+			}
+			condition, err = findIamConditionByTitle(newUpdaterFunc, d, config, role, conditionTitle)
+			if err != nil {
+				return nil, err
+			}
+			d.Set("condition", flattenIamCondition(condition))
+		}
+
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the resourceIdParserFunc.
-		d.SetId(d.Id() + "/" + role)
+		d.SetId(iamBindingId(d.Id(), &cloudresourcemanager.Binding{Role: role, Condition: condition}))
 		// It is possible to return multiple bindings, since we can learn about all the bindings
 		// for this resource here.  Unfortunately, `terraform import` has some messy behavior here -
 		// there's no way to know at this point which resource is being imported, so it's not possible
@@ -147,7 +176,7 @@ func iamBindingImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 	}
 }
 
-func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.DeleteFunc {
+func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -159,7 +188,7 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
 			toRemove := -1
 			for pos, b := range p.Bindings {
-				if b.Role != binding.Role {
+				if b.Role != binding.Role || conditionKeyFromCondition(b.Condition) != conditionKeyFromCondition(binding.Condition) {
 					continue
 				}
 				toRemove = pos
@@ -181,14 +210,59 @@ func resourceIamBindingDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.D
 			return err
 		}
 
-		return resourceIamBindingRead(newUpdaterFunc)(d, meta)
+		return resourceIamBindingRead(newUpdaterFunc, settings)(d, meta)
 	}
 }
 
 func getResourceIamBinding(d *schema.ResourceData) *cloudresourcemanager.Binding {
 	members := d.Get("members").(*schema.Set).List()
-	return &cloudresourcemanager.Binding{
+	b := &cloudresourcemanager.Binding{
 		Members: convertStringArr(members),
 		Role:    d.Get("role").(string),
 	}
+	if v, ok := d.GetOk("condition"); ok {
+		b.Condition = expandIamCondition(v)
+	}
+	return b
+}
+
+// iamBindingId builds the ID of a binding resource. Bindings with a condition
+// are suffixed with the condition title, since a role may be bound more than
+// once under different conditions.
+func iamBindingId(resourceId string, b *cloudresourcemanager.Binding) string {
+	id := resourceId + "/" + b.Role
+	if k := conditionKeyFromCondition(b.Condition); !k.Empty() {
+		id = id + "/" + k.Title
+	}
+	return id
+}
+
+// findIamConditionByTitle looks up the condition of the binding for role with
+// the given title, so that conditional bindings and members can be imported.
+func findIamConditionByTitle(newUpdaterFunc newResourceIamUpdaterFunc, d *schema.ResourceData, config *Config, role, title string) (*cloudresourcemanager.Expr, error) {
+	updater, err := newUpdaterFunc(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := updater.GetResourceIamPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	var condition *cloudresourcemanager.Expr
+	for _, b := range p.Bindings {
+		if b.Role != role || b.Condition == nil || b.Condition.Title != title {
+			continue
+		}
+		if condition != nil {
+			return nil, fmt.Errorf("Cannot import IAM binding with condition title %q for role %q on %s: the title matches more than one condition.", title, role, updater.DescribeResource())
+		}
+		condition = b.Condition
+	}
+	if condition == nil {
+		return nil, fmt.Errorf("Cannot find a binding for role %q with condition title %q on %s.", role, title, updater.DescribeResource())
+	}
+
+	return condition, nil
 }
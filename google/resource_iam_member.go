@@ -17,9 +17,10 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		ForceNew: true,
 	},
 	"member": {
-		Type:     schema.TypeString,
-		Required: true,
-		ForceNew: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: iamMemberDiffSuppress,
 	},
 	"etag": {
 		Type:     schema.TypeString,
@@ -27,18 +28,20 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 	},
 }
 
-func iamMemberImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
+func iamMemberImport(newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, settings *IamSettings) schema.StateFunc {
 	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 		if resourceIdParser == nil {
 			return nil, errors.New("Import not supported for this IAM resource.")
 		}
 		config := m.(*Config)
 		s := strings.Fields(d.Id())
-		if len(s) != 3 {
+		if len(s) < 3 {
 			d.SetId("")
-			return nil, fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role member'.", s)
+			return nil, fmt.Errorf("Wrong number of parts to Member id %s; expected 'resource_name role member [condition_title]'.", s)
 		}
 		id, role, member := s[0], s[1], s[2]
+		// Condition titles may contain spaces, so everything after the member is the title.
+		conditionTitle := strings.Join(s[3:], " ")
 
 		// Set the ID only to the first part so all IAM types can share the same resourceIdParserFunc.
 		d.SetId(id)
@@ -49,36 +52,66 @@ func iamMemberImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 			return nil, err
 		}
 
+		var condition *cloudresourcemanager.Expr
+		if conditionTitle != "" {
+			if !settings.EnableConditions {
+				return nil, fmt.Errorf("IAM Conditions are not supported for this IAM resource.")
+			}
+			condition, err = findIamConditionByTitle(newUpdaterFunc, d, config, role, conditionTitle)
+			if err != nil {
+				return nil, err
+			}
+			d.Set("condition", flattenIamCondition(condition))
+		}
+
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the resourceIdParserFunc.
-		d.SetId(d.Id() + "/" + role + "/" + member)
+		d.SetId(iamMemberId(d.Id(), &cloudresourcemanager.Binding{Role: role, Members: []string{member}, Condition: condition}))
 		return []*schema.ResourceData{d}, nil
 	}
 }
 
-func ResourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+func ResourceIamMember(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := newIamSettings(options...)
+	s := mergeSchemas(IamMemberBaseSchema, parentSpecificSchema)
+	if settings.EnableConditions {
+		s["condition"] = iamConditionSchema
+	}
+
 	return &schema.Resource{
 		Create: resourceIamMemberCreate(newUpdaterFunc),
 		Read:   resourceIamMemberRead(newUpdaterFunc),
 		Delete: resourceIamMemberDelete(newUpdaterFunc),
 
-		Schema: mergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
+		Schema: s,
 	}
 }
 
-func ResourceIamMemberWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) *schema.Resource {
-	r := ResourceIamMember(parentSpecificSchema, newUpdaterFunc)
+func ResourceIamMemberWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	r := ResourceIamMember(parentSpecificSchema, newUpdaterFunc, options...)
 	r.Importer = &schema.ResourceImporter{
-		State: iamMemberImport(resourceIdParser),
+		State: iamMemberImport(newUpdaterFunc, resourceIdParser, newIamSettings(options...)),
 	}
 	return r
 }
 
 func getResourceIamMember(d *schema.ResourceData) *cloudresourcemanager.Binding {
-	return &cloudresourcemanager.Binding{
+	b := &cloudresourcemanager.Binding{
 		Members: []string{d.Get("member").(string)},
 		Role:    d.Get("role").(string),
 	}
+	if v, ok := d.GetOk("condition"); ok {
+		b.Condition = expandIamCondition(v)
+	}
+	return b
+}
+
+func iamMemberId(resourceId string, b *cloudresourcemanager.Binding) string {
+	id := resourceId + "/" + b.Role + "/" + b.Members[0]
+	if k := conditionKeyFromCondition(b.Condition); !k.Empty() {
+		id = id + "/" + k.Title
+	}
+	return id
 }
 
 func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
@@ -98,7 +131,7 @@ func resourceIamMemberCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 		if err != nil {
 			return err
 		}
-		d.SetId(updater.GetResourceId() + "/" + p.Role + "/" + p.Members[0])
+		d.SetId(iamMemberId(updater.GetResourceId(), p))
 		return resourceIamMemberRead(newUpdaterFunc)(d, meta)
 	}
 }
@@ -123,9 +156,10 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		}
 		log.Printf("[DEBUG]: Retrieved policy for %s: %+v\n", updater.DescribeResource(), p)
 
+		eCondition := conditionKeyFromCondition(eMember.Condition)
 		var binding *cloudresourcemanager.Binding
 		for _, b := range p.Bindings {
-			if b.Role != eMember.Role {
+			if b.Role != eMember.Role || conditionKeyFromCondition(b.Condition) != eCondition {
 				continue
 			}
 			binding = b
@@ -138,7 +172,7 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		}
 		var member string
 		for _, m := range binding.Members {
-			if m == eMember.Members[0] {
+			if normalizeIamMemberCasing(m) == normalizeIamMemberCasing(eMember.Members[0]) {
 				member = m
 			}
 		}
@@ -150,6 +184,9 @@ func resourceIamMemberRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 		d.Set("etag", p.Etag)
 		d.Set("member", member)
 		d.Set("role", binding.Role)
+		if !eCondition.Empty() {
+			d.Set("condition", flattenIamCondition(binding.Condition))
+		}
 		return nil
 	}
 }
@@ -166,7 +203,7 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 		err = iamPolicyReadModifyWrite(updater, func(p *cloudresourcemanager.Policy) error {
 			bindingToRemove := -1
 			for pos, b := range p.Bindings {
-				if b.Role != member.Role {
+				if b.Role != member.Role || conditionKeyFromCondition(b.Condition) != conditionKeyFromCondition(member.Condition) {
 					continue
 				}
 				bindingToRemove = pos
@@ -179,7 +216,7 @@ func resourceIamMemberDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 			binding := p.Bindings[bindingToRemove]
 			memberToRemove := -1
 			for pos, m := range binding.Members {
-				if m != member.Members[0] {
+				if normalizeIamMemberCasing(m) != normalizeIamMemberCasing(member.Members[0]) {
 					continue
 				}
 				memberToRemove = pos
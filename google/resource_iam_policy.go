@@ -37,26 +37,28 @@ func iamPolicyImport(resourceIdParser resourceIdParserFunc) schema.StateFunc {
 	}
 }
 
-func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc) *schema.Resource {
+func ResourceIamPolicy(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := newIamSettings(options...)
+
 	return &schema.Resource{
-		Create: ResourceIamPolicyCreate(newUpdaterFunc),
-		Read:   ResourceIamPolicyRead(newUpdaterFunc),
-		Update: ResourceIamPolicyUpdate(newUpdaterFunc),
+		Create: ResourceIamPolicyCreate(newUpdaterFunc, settings),
+		Read:   ResourceIamPolicyRead(newUpdaterFunc, settings),
+		Update: ResourceIamPolicyUpdate(newUpdaterFunc, settings),
 		Delete: ResourceIamPolicyDelete(newUpdaterFunc),
 
 		Schema: mergeSchemas(IamPolicyBaseSchema, parentSpecificSchema),
 	}
 }
 
-func ResourceIamPolicyWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc) *schema.Resource {
-	r := ResourceIamPolicy(parentSpecificSchema, newUpdaterFunc)
+func ResourceIamPolicyWithImport(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc newResourceIamUpdaterFunc, resourceIdParser resourceIdParserFunc, options ...func(*IamSettings)) *schema.Resource {
+	r := ResourceIamPolicy(parentSpecificSchema, newUpdaterFunc, options...)
 	r.Importer = &schema.ResourceImporter{
 		State: iamPolicyImport(resourceIdParser),
 	}
 	return r
 }
 
-func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.CreateFunc {
+func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) schema.CreateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -64,16 +66,16 @@ func ResourceIamPolicyCreate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Cr
 			return err
 		}
 
-		if err := setIamPolicyData(d, updater); err != nil {
+		if err := setIamPolicyData(d, updater, settings); err != nil {
 			return err
 		}
 
 		d.SetId(updater.GetResourceId())
-		return ResourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return ResourceIamPolicyRead(newUpdaterFunc, settings)(d, meta)
 	}
 }
 
-func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.ReadFunc {
+func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) schema.ReadFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -90,6 +92,10 @@ func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 			return err
 		}
 
+		if settings.PruneDeletedMembers {
+			policy.Bindings = pruneDeletedIamBindingMembers(policy.Bindings)
+		}
+
 		d.Set("etag", policy.Etag)
 		d.Set("policy_data", marshalIamPolicy(policy))
 
@@ -97,7 +103,7 @@ func ResourceIamPolicyRead(newUpdaterFunc newResourceIamUpdaterFunc) schema.Read
 	}
 }
 
-func ResourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.UpdateFunc {
+func ResourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc, settings *IamSettings) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		config := meta.(*Config)
 		updater, err := newUpdaterFunc(d, config)
@@ -106,12 +112,12 @@ func ResourceIamPolicyUpdate(newUpdaterFunc newResourceIamUpdaterFunc) schema.Up
 		}
 
 		if d.HasChange("policy_data") {
-			if err := setIamPolicyData(d, updater); err != nil {
+			if err := setIamPolicyData(d, updater, settings); err != nil {
 				return err
 			}
 		}
 
-		return ResourceIamPolicyRead(newUpdaterFunc)(d, meta)
+		return ResourceIamPolicyRead(newUpdaterFunc, settings)(d, meta)
 	}
 }
 
@@ -133,12 +139,18 @@ func ResourceIamPolicyDelete(newUpdaterFunc newResourceIamUpdaterFunc) schema.De
 	}
 }
 
-func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error {
+func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater, settings *IamSettings) error {
 	policy, err := unmarshalIamPolicy(d.Get("policy_data").(string))
 	if err != nil {
 		return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
 	}
 
+	if !settings.EnableConditions {
+		if err := validateIamPolicyHasNoConditions(policy); err != nil {
+			return fmt.Errorf("'policy_data' is not valid for %s: %s", updater.DescribeResource(), err)
+		}
+	}
+
 	err = updater.SetResourceIamPolicy(policy)
 	if err != nil {
 		return err
@@ -149,7 +161,7 @@ func setIamPolicyData(d *schema.ResourceData, updater ResourceIamUpdater) error
 
 func marshalIamPolicy(policy *cloudresourcemanager.Policy) string {
 	pdBytes, _ := json.Marshal(&cloudresourcemanager.Policy{
-		Bindings: policy.Bindings,
+		Bindings: canonicalIamBindings(policy.Bindings),
 	})
 	return string(pdBytes)
 }
@@ -169,3 +181,14 @@ func validateIamPolicy(i interface{}, k string) (s []string, es []error) {
 	}
 	return
 }
+
+// validateIamPolicyHasNoConditions rejects conditional bindings for resources
+// whose updater only writes version 1 policies, which the API would refuse.
+func validateIamPolicyHasNoConditions(policy *cloudresourcemanager.Policy) error {
+	for _, b := range policy.Bindings {
+		if b.Condition != nil {
+			return fmt.Errorf("binding for role %q has a condition, but IAM Conditions are not supported for this IAM resource", b.Role)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIapBrand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIapBrandCreate,
+		Read:   resourceIapBrandRead,
+		Delete: resourceIapBrandDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceIapBrandImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_title": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"support_email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"org_internal_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIapBrandCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	supportEmailProp, err := expandIapBrandSupportEmail(d.Get("support_email"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("support_email"); !isEmptyValue(reflect.ValueOf(supportEmailProp)) && (ok || !reflect.DeepEqual(v, supportEmailProp)) {
+		obj["supportEmail"] = supportEmailProp
+	}
+	applicationTitleProp, err := expandIapBrandApplicationTitle(d.Get("application_title"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("application_title"); !isEmptyValue(reflect.ValueOf(applicationTitleProp)) && (ok || !reflect.DeepEqual(v, applicationTitleProp)) {
+		obj["applicationTitle"] = applicationTitleProp
+	}
+
+	url, err := replaceVars(d, config, "https://iap.googleapis.com/v1/projects/{{project}}/brands")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Brand: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Brand: %s", err)
+	}
+
+	// The brand id is generated by the server and the returned name uses the
+	// project number, so the name is used as the resource id as-is.
+	name, ok := res["name"].(string)
+	if !ok {
+		return fmt.Errorf("Error creating Brand: response did not include a name")
+	}
+	if err := d.Set("name", name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	d.SetId(name)
+
+	log.Printf("[DEBUG] Finished creating Brand %q: %#v", d.Id(), res)
+
+	return resourceIapBrandRead(d, meta)
+}
+
+func resourceIapBrandRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://iap.googleapis.com/v1/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("IapBrand %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Brand: %s", err)
+	}
+
+	if err := d.Set("support_email", flattenIapBrandSupportEmail(res["supportEmail"], d)); err != nil {
+		return fmt.Errorf("Error reading Brand: %s", err)
+	}
+	if err := d.Set("application_title", flattenIapBrandApplicationTitle(res["applicationTitle"], d)); err != nil {
+		return fmt.Errorf("Error reading Brand: %s", err)
+	}
+	if err := d.Set("org_internal_only", flattenIapBrandOrgInternalOnly(res["orgInternalOnly"], d)); err != nil {
+		return fmt.Errorf("Error reading Brand: %s", err)
+	}
+	if err := d.Set("name", flattenIapBrandName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Brand: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIapBrandDelete(d *schema.ResourceData, meta interface{}) error {
+	// Brands cannot be deleted through the API; they are removed along with
+	// their project.
+	log.Printf("[WARN] IAP Brand %q cannot be deleted, it will only be removed from state", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceIapBrandImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<name>projects/[^/]+/brands/[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenIapBrandSupportEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenIapBrandApplicationTitle(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenIapBrandOrgInternalOnly(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenIapBrandName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandIapBrandSupportEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandIapBrandApplicationTitle(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
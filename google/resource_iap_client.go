@@ -0,0 +1,168 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIapClient() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIapClientCreate,
+		Read:   resourceIapClientRead,
+		Delete: resourceIapClientDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceIapClientImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"brand": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceIapClientCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandIapClientDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+
+	url, err := replaceVars(d, config, "https://iap.googleapis.com/v1/{{brand}}/identityAwareProxyClients")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Client: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Client: %s", err)
+	}
+
+	// The client id is generated by the server and is only part of the name.
+	if err := d.Set("client_id", flattenIapClientClientId(res["name"], d)); err != nil {
+		return fmt.Errorf("Error setting client_id: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{brand}}/identityAwareProxyClients/{{client_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Client %q: %#v", d.Id(), res)
+
+	return resourceIapClientRead(d, meta)
+}
+
+func resourceIapClientRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://iap.googleapis.com/v1/{{brand}}/identityAwareProxyClients/{{client_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("IapClient %q", d.Id()))
+	}
+
+	if err := d.Set("secret", flattenIapClientSecret(res["secret"], d)); err != nil {
+		return fmt.Errorf("Error reading Client: %s", err)
+	}
+	if err := d.Set("display_name", flattenIapClientDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Client: %s", err)
+	}
+	if err := d.Set("client_id", flattenIapClientClientId(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Client: %s", err)
+	}
+
+	return nil
+}
+
+func resourceIapClientDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://iap.googleapis.com/v1/{{brand}}/identityAwareProxyClients/{{client_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Client %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Client")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Client %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceIapClientImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<brand>projects/[^/]+/brands/[^/]+)/identityAwareProxyClients/(?P<client_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{brand}}/identityAwareProxyClients/{{client_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenIapClientSecret(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenIapClientDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenIapClientClientId(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return NameFromSelfLinkStateFunc(v)
+}
+
+func expandIapClientDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
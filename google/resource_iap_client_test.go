@@ -0,0 +1,93 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIapClient_iapClientExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIapClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapClient_iapClientExample(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_iap_client.project_client", "client_id"),
+					resource.TestCheckResourceAttrSet("google_iap_client.project_client", "secret"),
+				),
+			},
+			{
+				ResourceName:      "google_iap_client.project_client",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIapClient_iapClientExample(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_client_openid_userinfo" "me" {}
+
+resource "google_project" "project" {
+  project_id = "tf-test%{random_suffix}"
+  name       = "tf-test%{random_suffix}"
+  org_id     = "%{org_id}"
+}
+
+resource "google_project_service" "project_service" {
+  project = "${google_project.project.project_id}"
+  service = "iap.googleapis.com"
+}
+
+resource "google_iap_brand" "project_brand" {
+  support_email     = "${data.google_client_openid_userinfo.me.email}"
+  application_title = "Cloud IAP protected Application"
+  project           = "${google_project_service.project_service.project}"
+}
+
+resource "google_iap_client" "project_client" {
+  display_name = "Test Client"
+  brand        = "${google_iap_brand.project_brand.name}"
+}
+`, context)
+}
+
+func testAccCheckIapClientDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_iap_client" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://iap.googleapis.com/v1/{{brand}}/identityAwareProxyClients/{{client_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("IapClient still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIapTunnelInstanceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapTunnelInstanceIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_tunnel_instance_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_tunnel/zones/us-central1-a/instances/%s roles/iap.tunnelResourceAccessor", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIapTunnelInstanceIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapTunnelInstanceIamMember_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_tunnel_instance_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_tunnel/zones/us-central1-a/instances/%s roles/iap.tunnelResourceAccessor serviceAccount:%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), name, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIapTunnelInstanceIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapTunnelInstanceIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_tunnel_instance_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_tunnel/zones/us-central1-a/instances/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIapTunnelInstanceIam_base(name, account string) string {
+	return fmt.Sprintf(`
+data "google_compute_image" "my_image" {
+  family  = "debian-9"
+  project = "debian-cloud"
+}
+
+resource "google_compute_instance" "default" {
+  name         = "%s"
+  machine_type = "n1-standard-1"
+  zone         = "us-central1-a"
+
+  boot_disk {
+    initialize_params {
+      image = "${data.google_compute_image.my_image.self_link}"
+    }
+  }
+
+  network_interface {
+    network = "default"
+  }
+}
+
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "IAP IAM Testing Account"
+}
+`, name, account)
+}
+
+func testAccIapTunnelInstanceIamBinding_basic(name, account string) string {
+	return testAccIapTunnelInstanceIam_base(name, account) + `
+resource "google_iap_tunnel_instance_iam_binding" "foo" {
+  zone     = "${google_compute_instance.default.zone}"
+  instance = "${google_compute_instance.default.name}"
+  role     = "roles/iap.tunnelResourceAccessor"
+  members  = ["serviceAccount:${google_service_account.test.email}"]
+}
+`
+}
+
+func testAccIapTunnelInstanceIamMember_basic(name, account string) string {
+	return testAccIapTunnelInstanceIam_base(name, account) + `
+resource "google_iap_tunnel_instance_iam_member" "foo" {
+  zone     = "${google_compute_instance.default.zone}"
+  instance = "${google_compute_instance.default.name}"
+  role     = "roles/iap.tunnelResourceAccessor"
+  member   = "serviceAccount:${google_service_account.test.email}"
+}
+`
+}
+
+func testAccIapTunnelInstanceIamPolicy_basic(name, account string) string {
+	return testAccIapTunnelInstanceIam_base(name, account) + `
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/iap.tunnelResourceAccessor"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_iap_tunnel_instance_iam_policy" "foo" {
+  zone        = "${google_compute_instance.default.zone}"
+  instance    = "${google_compute_instance.default.name}"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`
+}
@@ -0,0 +1,135 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIapWebBackendServiceIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapWebBackendServiceIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_web_backend_service_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_web/compute/services/%s roles/iap.httpsResourceAccessor", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIapWebBackendServiceIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapWebBackendServiceIamMember_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_web_backend_service_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_web/compute/services/%s roles/iap.httpsResourceAccessor serviceAccount:%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), name, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIapWebBackendServiceIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-iap-" + acctest.RandString(10)
+	account := "tf-test-iap-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIapWebBackendServiceIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_iap_web_backend_service_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/iap_web/compute/services/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIapWebBackendServiceIam_base(name, account string) string {
+	return fmt.Sprintf(`
+resource "google_compute_http_health_check" "default" {
+  name               = "%s"
+  request_path       = "/"
+  check_interval_sec = 1
+  timeout_sec        = 1
+}
+
+resource "google_compute_backend_service" "default" {
+  name          = "%s"
+  health_checks = ["${google_compute_http_health_check.default.self_link}"]
+}
+
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "IAP IAM Testing Account"
+}
+`, name, name, account)
+}
+
+func testAccIapWebBackendServiceIamBinding_basic(name, account string) string {
+	return testAccIapWebBackendServiceIam_base(name, account) + `
+resource "google_iap_web_backend_service_iam_binding" "foo" {
+  web_backend_service = "${google_compute_backend_service.default.name}"
+  role                = "roles/iap.httpsResourceAccessor"
+  members             = ["serviceAccount:${google_service_account.test.email}"]
+}
+`
+}
+
+func testAccIapWebBackendServiceIamMember_basic(name, account string) string {
+	return testAccIapWebBackendServiceIam_base(name, account) + `
+resource "google_iap_web_backend_service_iam_member" "foo" {
+  web_backend_service = "${google_compute_backend_service.default.name}"
+  role                = "roles/iap.httpsResourceAccessor"
+  member              = "serviceAccount:${google_service_account.test.email}"
+}
+`
+}
+
+func testAccIapWebBackendServiceIamPolicy_basic(name, account string) string {
+	return testAccIapWebBackendServiceIam_base(name, account) + `
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/iap.httpsResourceAccessor"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_iap_web_backend_service_iam_policy" "foo" {
+  web_backend_service = "${google_compute_backend_service.default.name}"
+  policy_data         = "${data.google_iam_policy.foo.policy_data}"
+}
+`
+}
@@ -0,0 +1,75 @@
+package google
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccIdentityPlatformConfig_blockingFunctionsUpdate(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPlatformConfig_blockingFunctions(context),
+			},
+			{
+				ResourceName:      "google_identity_platform_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIdentityPlatformConfig_blockingFunctionsUpdate(context),
+			},
+			{
+				ResourceName:      "google_identity_platform_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityPlatformConfig_blockingFunctions(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_identity_platform_config" "default" {
+  blocking_functions {
+    triggers {
+      event_type   = "beforeCreate"
+      function_uri = "https://us-east1-%{project}.cloudfunctions.net/tf-test-before-create%{random_suffix}"
+    }
+  }
+}
+`, context)
+}
+
+func testAccIdentityPlatformConfig_blockingFunctionsUpdate(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_identity_platform_config" "default" {
+  blocking_functions {
+    triggers {
+      event_type   = "beforeCreate"
+      function_uri = "https://us-east1-%{project}.cloudfunctions.net/tf-test-before-create%{random_suffix}"
+    }
+    triggers {
+      event_type   = "beforeSignIn"
+      function_uri = "https://us-east1-%{project}.cloudfunctions.net/tf-test-before-sign-in%{random_suffix}"
+    }
+    forward_inbound_credentials {
+      refresh_token = true
+      id_token      = true
+      access_token  = true
+    }
+  }
+}
+`, context)
+}
@@ -0,0 +1,71 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityPlatformDefaultSupportedIdpConfig_identityPlatformDefaultSupportedIdpConfigBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityPlatformDefaultSupportedIdpConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityPlatformDefaultSupportedIdpConfig_identityPlatformDefaultSupportedIdpConfigBasicExample(context),
+			},
+			{
+				ResourceName:      "google_identity_platform_default_supported_idp_config.idp_config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityPlatformDefaultSupportedIdpConfig_identityPlatformDefaultSupportedIdpConfigBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_identity_platform_default_supported_idp_config" "idp_config" {
+  enabled       = true
+  idp_id        = "playgames.google.com"
+  client_id     = "my-client-id"
+  client_secret = "secret"
+}
+`, context)
+}
+
+func testAccCheckIdentityPlatformDefaultSupportedIdpConfigDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_identity_platform_default_supported_idp_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://identitytoolkit.googleapis.com/v2/projects/{{project}}/defaultSupportedIdpConfigs/{{idp_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("IdentityPlatformDefaultSupportedIdpConfig still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,19 @@ func resourceKmsCryptoKey() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: kmsCryptoKeyRingsEquivalent,
 			},
+			"purpose": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "ENCRYPT_DECRYPT",
+				ValidateFunc: validation.StringInSlice([]string{"ENCRYPT_DECRYPT", "ASYMMETRIC_SIGN", "ASYMMETRIC_DECRYPT"}, false),
+			},
+			"destroy_scheduled_duration": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
 			"rotation_period": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -69,6 +82,8 @@ func resourceKmsCryptoKey() *schema.Resource {
 	}
 }
 
+const kmsBasePath = "https://cloudkms.googleapis.com/v1/"
+
 func kmsCryptoKeyRingsEquivalent(k, old, new string, d *schema.ResourceData) bool {
 	keyRingIdWithSpecifiersRegex := regexp.MustCompile("^projects/(" + ProjectRegex + ")/locations/([a-z0-9-])+/keyRings/([a-zA-Z0-9_-]{1,63})$")
 	normalizedKeyRingIdRegex := regexp.MustCompile("^(" + ProjectRegex + ")/([a-z0-9-])+/([a-zA-Z0-9_-]{1,63})$")
@@ -107,7 +122,7 @@ func resourceKmsCryptoKeyCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	key := cloudkms.CryptoKey{
-		Purpose:         "ENCRYPT_DECRYPT",
+		Purpose:         d.Get("purpose").(string),
 		VersionTemplate: expandVersionTemplate(d.Get("version_template").([]interface{})),
 	}
 
@@ -123,12 +138,27 @@ func resourceKmsCryptoKeyCreate(d *schema.ResourceData, meta interface{}) error
 		key.RotationPeriod = rotationPeriod
 	}
 
-	cryptoKey, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Create(cryptoKeyId.KeyRingId.keyRingId(), &key).CryptoKeyId(cryptoKeyId.Name).Do()
+	// The client library predates destroyScheduledDuration, so the key is
+	// created through the REST API to be able to set it.
+	obj, err := ConvertToMap(&key)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("destroy_scheduled_duration"); ok {
+		obj["destroyScheduledDuration"] = v.(string)
+	}
 
+	url := fmt.Sprintf("%s%s/cryptoKeys?cryptoKeyId=%s", kmsBasePath, cryptoKeyId.KeyRingId.keyRingId(), cryptoKeyId.Name)
+	res, err := sendRequest(config, "POST", url, obj)
 	if err != nil {
 		return fmt.Errorf("Error creating CryptoKey: %s", err.Error())
 	}
 
+	cryptoKey := &cloudkms.CryptoKey{}
+	if err := Convert(res, cryptoKey); err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Created CryptoKey %s", cryptoKey.Name)
 
 	d.SetId(cryptoKeyId.cryptoKeyId())
@@ -185,12 +215,19 @@ func resourceKmsCryptoKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Executing read for KMS CryptoKey %s", cryptoKeyId.cryptoKeyId())
 
-	cryptoKey, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Get(cryptoKeyId.cryptoKeyId()).Do()
+	res, err := sendRequest(config, "GET", kmsBasePath+cryptoKeyId.cryptoKeyId(), nil)
 	if err != nil {
 		return fmt.Errorf("Error reading CryptoKey: %s", err)
 	}
+
+	cryptoKey := &cloudkms.CryptoKey{}
+	if err := Convert(res, cryptoKey); err != nil {
+		return err
+	}
 	d.Set("key_ring", cryptoKeyId.KeyRingId.terraformId())
 	d.Set("name", cryptoKeyId.Name)
+	d.Set("purpose", cryptoKey.Purpose)
+	d.Set("destroy_scheduled_duration", res["destroyScheduledDuration"])
 	d.Set("rotation_period", cryptoKey.RotationPeriod)
 	d.Set("self_link", cryptoKey.Name)
 
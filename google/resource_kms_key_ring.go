@@ -28,7 +28,8 @@ func resourceKmsKeyRing() *schema.Resource {
 			},
 			"location": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"project": {
@@ -71,9 +72,14 @@ func resourceKmsKeyRingCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	location, err := getKmsLocation(d, config)
+	if err != nil {
+		return err
+	}
+
 	keyRingId := &kmsKeyRingId{
 		Project:  project,
-		Location: d.Get("location").(string),
+		Location: location,
 		Name:     d.Get("name").(string),
 	}
 
@@ -123,6 +129,7 @@ func resourceKmsKeyRingRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("project", project)
+	d.Set("location", keyRingId.Location)
 	d.Set("self_link", keyRing.Name)
 
 	return nil
@@ -0,0 +1,86 @@
+package google
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/cloudkms/v1"
+)
+
+// resourceKmsSecretCiphertext encrypts a plaintext with a crypto key and
+// keeps the resulting ciphertext in state. The ciphertext is different every
+// time the plaintext is encrypted, so there is nothing to read back from the
+// API and every argument forces a new ciphertext.
+func resourceKmsSecretCiphertext() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKmsSecretCiphertextCreate,
+		Read:   resourceKmsSecretCiphertextRead,
+		Delete: resourceKmsSecretCiphertextDelete,
+
+		Schema: map[string]*schema.Schema{
+			"crypto_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"plaintext": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"additional_authenticated_data": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"ciphertext": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKmsSecretCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	cryptoKeyId, err := parseKmsCryptoKeyId(d.Get("crypto_key").(string), config)
+	if err != nil {
+		return err
+	}
+
+	kmsEncryptRequest := &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string))),
+	}
+
+	if aad, ok := d.GetOk("additional_authenticated_data"); ok {
+		kmsEncryptRequest.AdditionalAuthenticatedData = base64.StdEncoding.EncodeToString([]byte(aad.(string)))
+	}
+
+	encryptResponse, err := config.clientKms.Projects.Locations.KeyRings.CryptoKeys.Encrypt(cryptoKeyId.cryptoKeyId(), kmsEncryptRequest).Do()
+	if err != nil {
+		return fmt.Errorf("Error encrypting plaintext: %s", err)
+	}
+
+	log.Printf("[INFO] Successfully encrypted plaintext with CryptoKey %s", cryptoKeyId.cryptoKeyId())
+
+	d.Set("ciphertext", encryptResponse.Ciphertext)
+	d.SetId(fmt.Sprintf("%s/%s", cryptoKeyId.cryptoKeyId(), encryptResponse.Ciphertext))
+
+	return resourceKmsSecretCiphertextRead(d, meta)
+}
+
+func resourceKmsSecretCiphertextRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceKmsSecretCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing ciphertext %q from state, the ciphertext itself is not stored in KMS", d.Id())
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKmsSecretCiphertext_basic(t *testing.T) {
+	t.Parallel()
+
+	projectOrg := getTestOrgFromEnv(t)
+	projectBillingAccount := getTestBillingAccountFromEnv(t)
+
+	projectId := "terraform-" + acctest.RandString(10)
+	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	cryptoKeyName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	plaintext := fmt.Sprintf("secret-%s", acctest.RandString(10))
+	aad := "plainaad"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleKmsSecretCiphertext(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, plaintext, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_kms_secret_ciphertext.acceptance", "ciphertext"),
+					resource.TestCheckResourceAttr("data.google_kms_secret.acceptance", "plaintext", plaintext),
+				),
+			},
+			{
+				Config: testGoogleKmsSecretCiphertext(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, plaintext, aad),
+				Check:  resource.TestCheckResourceAttr("data.google_kms_secret.acceptance", "plaintext", plaintext),
+			},
+		},
+	})
+}
+
+func testGoogleKmsSecretCiphertext(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName, plaintext, aad string) string {
+	return testGoogleKmsCryptoKey_basic(projectId, projectOrg, projectBillingAccount, keyRingName, cryptoKeyName) + fmt.Sprintf(`
+resource "google_kms_secret_ciphertext" "acceptance" {
+	crypto_key                    = "${google_kms_crypto_key.crypto_key.self_link}"
+	plaintext                     = "%s"
+	additional_authenticated_data = "%s"
+}
+
+data "google_kms_secret" "acceptance" {
+	crypto_key                    = "${google_kms_crypto_key.crypto_key.self_link}"
+	ciphertext                    = "${google_kms_secret_ciphertext.acceptance.ciphertext}"
+	additional_authenticated_data = "%s"
+}
+	`, plaintext, aad, aad)
+}
@@ -31,7 +31,7 @@ func resourceLoggingBillingAccountSinkCreate(d *schema.ResourceData, meta interf
 	id, sink := expandResourceLoggingSink(d, "billingAccounts", d.Get("billing_account").(string))
 
 	// The API will reject any requests that don't explicitly set 'uniqueWriterIdentity' to true.
-	_, err := config.clientLogging.BillingAccounts.Sinks.Create(id.parent(), sink).UniqueWriterIdentity(true).Do()
+	err := createLoggingSink(config, id, sink, true)
 	if err != nil {
 		return err
 	}
@@ -43,12 +43,14 @@ func resourceLoggingBillingAccountSinkCreate(d *schema.ResourceData, meta interf
 func resourceLoggingBillingAccountSinkRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	sink, err := config.clientLogging.BillingAccounts.Sinks.Get(d.Id()).Do()
+	sink, err := readLoggingSink(config, d.Id())
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Billing Logging Sink %s", d.Get("name").(string)))
 	}
 
-	flattenResourceLoggingSink(d, sink)
+	if err := flattenResourceLoggingSink(d, sink); err != nil {
+		return err
+	}
 	return nil
 
 }
@@ -59,8 +61,7 @@ func resourceLoggingBillingAccountSinkUpdate(d *schema.ResourceData, meta interf
 	sink := expandResourceLoggingSinkForUpdate(d)
 
 	// The API will reject any requests that don't explicitly set 'uniqueWriterIdentity' to true.
-	_, err := config.clientLogging.BillingAccounts.Sinks.Patch(d.Id(), sink).
-		UpdateMask(defaultLogSinkUpdateMask).UniqueWriterIdentity(true).Do()
+	err := updateLoggingSink(config, d, sink, true)
 	if err != nil {
 		return err
 	}
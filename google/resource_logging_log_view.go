@@ -0,0 +1,234 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLoggingLogView() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoggingLogViewCreate,
+		Read:   resourceLoggingLogViewRead,
+		Update: resourceLoggingLogViewUpdate,
+		Delete: resourceLoggingLogViewDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceLoggingLogViewImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLoggingLogViewCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandLoggingLogViewDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandLoggingLogViewFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(filterProp)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+
+	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/{{bucket}}/views?viewId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new LogView: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating LogView: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{bucket}}/views/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating LogView %q: %#v", d.Id(), res)
+
+	return resourceLoggingLogViewRead(d, meta)
+}
+
+func resourceLoggingLogViewRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/{{bucket}}/views/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("LoggingLogView %q", d.Id()))
+	}
+
+	if err := d.Set("description", flattenLoggingLogViewDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading LogView: %s", err)
+	}
+	if err := d.Set("filter", flattenLoggingLogViewFilter(res["filter"], d)); err != nil {
+		return fmt.Errorf("Error reading LogView: %s", err)
+	}
+	if err := d.Set("create_time", flattenLoggingLogViewCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading LogView: %s", err)
+	}
+	if err := d.Set("update_time", flattenLoggingLogViewUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading LogView: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLoggingLogViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandLoggingLogViewDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	filterProp, err := expandLoggingLogViewFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+
+	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/{{bucket}}/views/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating LogView %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+
+	if d.HasChange("filter") {
+		updateMask = append(updateMask, "filter")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating LogView %q: %s", d.Id(), err)
+	}
+
+	return resourceLoggingLogViewRead(d, meta)
+}
+
+func resourceLoggingLogViewDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://logging.googleapis.com/v2/{{bucket}}/views/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting LogView %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "LogView")
+	}
+
+	log.Printf("[DEBUG] Finished deleting LogView %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceLoggingLogViewImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<bucket>.+)/views/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{bucket}}/views/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenLoggingLogViewDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenLoggingLogViewFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenLoggingLogViewCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenLoggingLogViewUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandLoggingLogViewDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandLoggingLogViewFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
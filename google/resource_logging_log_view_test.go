@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLoggingLogView_loggingLogViewBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingLogViewDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingLogView_loggingLogViewBasicExample(context),
+			},
+			{
+				ResourceName:      "google_logging_log_view.logging_log_view",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoggingLogView_loggingLogViewBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_logging_project_bucket_config" "logging_log_view" {
+  project        = "%{project}"
+  location       = "global"
+  retention_days = 30
+  bucket_id      = "_Default"
+}
+
+resource "google_logging_log_view" "logging_log_view" {
+  name        = "my-view%{random_suffix}"
+  bucket      = "${google_logging_project_bucket_config.logging_log_view.id}"
+  description = "A logging view configured with Terraform"
+  filter      = "SOURCE(\"projects/%{project}\") AND resource.type = \"gce_instance\""
+}
+`, context)
+}
+
+func testAccCheckLoggingLogViewDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_logging_log_view" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://logging.googleapis.com/v2/{{bucket}}/views/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("LoggingLogView still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLoggingMetric_loggingMetricCounterLabelsExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingMetric_loggingMetricCounterLabelsExample(context),
+			},
+			{
+				ResourceName:      "google_logging_metric.logging_metric",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoggingMetric_loggingMetricCounterLabelsExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_logging_metric" "logging_metric" {
+  name   = "my-(custom)/metric%{random_suffix}"
+  filter = "resource.type=gae_app AND severity>=ERROR"
+  metric_descriptor {
+    metric_kind = "DELTA"
+    value_type  = "INT64"
+    unit        = "1"
+    labels {
+      key         = "mass"
+      value_type  = "STRING"
+      description = "amount of matter"
+    }
+    display_name = "My metric"
+  }
+  label_extractors = {
+    mass = "EXTRACT(jsonPayload.request)"
+  }
+}
+`, context)
+}
+
+func TestAccLoggingMetric_loggingMetricDistributionExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingMetricDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingMetric_loggingMetricDistributionExample(context),
+			},
+			{
+				ResourceName:      "google_logging_metric.logging_metric",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLoggingMetric_loggingMetricDistributionExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_logging_metric" "logging_metric" {
+  name   = "my-(custom)/metric%{random_suffix}"
+  filter = "resource.type=gae_app AND severity>=ERROR"
+  metric_descriptor {
+    metric_kind = "DELTA"
+    value_type  = "DISTRIBUTION"
+    unit        = "1"
+    labels {
+      key         = "mass"
+      value_type  = "STRING"
+      description = "amount of matter"
+    }
+    labels {
+      key         = "sku"
+      value_type  = "INT64"
+      description = "Identifying number for item"
+    }
+    display_name = "My metric"
+  }
+  value_extractor = "EXTRACT(jsonPayload.request)"
+  label_extractors = {
+    mass = "EXTRACT(jsonPayload.request)"
+    sku  = "EXTRACT(jsonPayload.id)"
+  }
+  bucket_options {
+    linear_buckets {
+      num_finite_buckets = 3
+      width              = 1
+      offset             = 1
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckLoggingMetricDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_logging_metric" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://logging.googleapis.com/v2/projects/{{project}}/metrics/{{%name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("LoggingMetric still exists at %s", url)
+		}
+	}
+
+	return nil
+}
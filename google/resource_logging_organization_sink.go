@@ -40,11 +40,11 @@ func resourceLoggingOrganizationSinkCreate(d *schema.ResourceData, meta interfac
 
 	org := d.Get("org_id").(string)
 	id, sink := expandResourceLoggingSink(d, "organizations", org)
-	sink.IncludeChildren = d.Get("include_children").(bool)
+	sink["includeChildren"] = d.Get("include_children").(bool)
 
 	// Must use a unique writer, since all destinations are in projects.
 	// The API will reject any requests that don't explicitly set 'uniqueWriterIdentity' to true.
-	_, err := config.clientLogging.Organizations.Sinks.Create(id.parent(), sink).UniqueWriterIdentity(true).Do()
+	err := createLoggingSink(config, id, sink, true)
 	if err != nil {
 		return err
 	}
@@ -56,13 +56,15 @@ func resourceLoggingOrganizationSinkCreate(d *schema.ResourceData, meta interfac
 func resourceLoggingOrganizationSinkRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	sink, err := config.clientLogging.Organizations.Sinks.Get(d.Id()).Do()
+	sink, err := readLoggingSink(config, d.Id())
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Organization Logging Sink %s", d.Get("name").(string)))
 	}
 
-	flattenResourceLoggingSink(d, sink)
-	d.Set("include_children", sink.IncludeChildren)
+	if err := flattenResourceLoggingSink(d, sink); err != nil {
+		return err
+	}
+	d.Set("include_children", sink["includeChildren"])
 
 	return nil
 }
@@ -73,12 +75,10 @@ func resourceLoggingOrganizationSinkUpdate(d *schema.ResourceData, meta interfac
 	sink := expandResourceLoggingSinkForUpdate(d)
 	// It seems the API might actually accept an update for include_children; this is not in the list of updatable
 	// properties though and might break in the future. Always include the value to prevent it changing.
-	sink.IncludeChildren = d.Get("include_children").(bool)
-	sink.ForceSendFields = append(sink.ForceSendFields, "IncludeChildren")
+	sink["includeChildren"] = d.Get("include_children").(bool)
 
 	// The API will reject any requests that don't explicitly set 'uniqueWriterIdentity' to true.
-	_, err := config.clientLogging.Organizations.Sinks.Patch(d.Id(), sink).
-		UpdateMask(defaultLogSinkUpdateMask).UniqueWriterIdentity(true).Do()
+	err := updateLoggingSink(config, d, sink, true)
 	if err != nil {
 		return err
 	}
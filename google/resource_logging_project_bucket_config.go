@@ -0,0 +1,252 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// Every project has a _Default and a _Required bucket, which can be configured
+// but never created or deleted.
+var loggingBucketConfigBuiltinIds = []string{"_Default", "_Required"}
+
+func resourceLoggingProjectBucketConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLoggingProjectBucketConfigAcquireOrCreate,
+		Read:   resourceLoggingProjectBucketConfigRead,
+		Update: resourceLoggingProjectBucketConfigUpdate,
+		Delete: resourceLoggingProjectBucketConfigDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceLoggingProjectBucketConfigImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"bucket_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"retention_days": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"locked": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"cmek_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"kms_key_version_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"lifecycle_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Builtin buckets already exist and custom ones may have been created outside
+// of Terraform, so existing buckets are updated to match the config instead of
+// failing the create.
+func resourceLoggingProjectBucketConfigAcquireOrCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	id := fmt.Sprintf("projects/%s/locations/%s/buckets/%s", d.Get("project"), d.Get("location"), d.Get("bucket_id"))
+	_, err := sendRequest(config, "GET", loggingBasePath+id, nil)
+	if err == nil {
+		log.Printf("[DEBUG] Logging bucket %q already exists, updating it", id)
+		d.SetId(id)
+		return resourceLoggingProjectBucketConfigUpdate(d, meta)
+	}
+	if !isGoogleApiErrorWithCode(err, 404) {
+		return fmt.Errorf("Error reading logging bucket %q: %s", id, err)
+	}
+
+	url := fmt.Sprintf("%sprojects/%s/locations/%s/buckets?bucketId=%s", loggingBasePath, d.Get("project"), d.Get("location"), d.Get("bucket_id"))
+	_, err = sendRequest(config, "POST", url, expandLoggingBucketConfig(d))
+	if err != nil {
+		return fmt.Errorf("Error creating logging bucket %q: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceLoggingProjectBucketConfigRead(d, meta)
+}
+
+func resourceLoggingProjectBucketConfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := sendRequest(config, "GET", loggingBasePath+d.Id(), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Logging Bucket %q", d.Id()))
+	}
+
+	if res["lifecycleState"] == "DELETE_REQUESTED" {
+		log.Printf("[WARN] Removing logging bucket %q because it's being deleted", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", res["name"])
+	d.Set("description", res["description"])
+	d.Set("locked", res["locked"])
+	d.Set("lifecycle_state", res["lifecycleState"])
+	if v, ok := res["retentionDays"].(float64); ok {
+		d.Set("retention_days", int(v))
+	}
+	if err := d.Set("cmek_settings", flattenLoggingBucketConfigCmekSettings(res["cmekSettings"])); err != nil {
+		return fmt.Errorf("Error reading cmek_settings: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLoggingProjectBucketConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	updateMask := []string{}
+	if d.HasChange("description") {
+		updateMask = append(updateMask, "description")
+	}
+	if d.HasChange("retention_days") {
+		updateMask = append(updateMask, "retentionDays")
+	}
+	if d.HasChange("locked") {
+		updateMask = append(updateMask, "locked")
+	}
+	if d.HasChange("cmek_settings") {
+		updateMask = append(updateMask, "cmekSettings")
+	}
+
+	if len(updateMask) > 0 {
+		url, err := addQueryParams(loggingBasePath+d.Id(), map[string]string{"updateMask": strings.Join(updateMask, ",")})
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "PATCH", url, expandLoggingBucketConfig(d))
+		if err != nil {
+			return fmt.Errorf("Error updating logging bucket %q: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLoggingProjectBucketConfigRead(d, meta)
+}
+
+func resourceLoggingProjectBucketConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	bucketId := d.Get("bucket_id").(string)
+	for _, builtin := range loggingBucketConfigBuiltinIds {
+		if bucketId == builtin {
+			log.Printf("[WARN] Logging bucket %q can't be deleted, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+	}
+
+	_, err := sendRequest(config, "DELETE", loggingBasePath+d.Id(), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Logging Bucket %q", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceLoggingProjectBucketConfigImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/buckets/(?P<bucket_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/locations/%s/buckets/%s", d.Get("project"), d.Get("location"), d.Get("bucket_id")))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandLoggingBucketConfig(d *schema.ResourceData) map[string]interface{} {
+	obj := map[string]interface{}{
+		"description": d.Get("description").(string),
+		"locked":      d.Get("locked").(bool),
+	}
+	if v, ok := d.GetOk("retention_days"); ok {
+		obj["retentionDays"] = v.(int)
+	}
+	if v, ok := d.GetOk("cmek_settings"); ok {
+		cmek := v.([]interface{})[0].(map[string]interface{})
+		obj["cmekSettings"] = map[string]interface{}{
+			"kmsKeyName": cmek["kms_key_name"],
+		}
+	}
+	return obj
+}
+
+func flattenLoggingBucketConfigCmekSettings(v interface{}) []interface{} {
+	cmek, ok := v.(map[string]interface{})
+	if !ok || len(cmek) == 0 {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"kms_key_name":         cmek["kmsKeyName"],
+			"kms_key_version_name": cmek["kmsKeyVersionName"],
+			"name":                 cmek["name"],
+			"service_account_id":   cmek["serviceAccountId"],
+		},
+	}
+}
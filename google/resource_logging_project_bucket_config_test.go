@@ -0,0 +1,107 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLoggingProjectBucketConfig_default(t *testing.T) {
+	t.Parallel()
+
+	project := getTestProjectFromEnv()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectBucketConfig_default(project, 30),
+			},
+			{
+				ResourceName:      "google_logging_project_bucket_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingProjectBucketConfig_default(project, 40),
+				Check:  resource.TestCheckResourceAttr("google_logging_project_bucket_config.default", "retention_days", "40"),
+			},
+		},
+	})
+}
+
+func TestAccLoggingProjectBucketConfig_custom(t *testing.T) {
+	t.Parallel()
+
+	bucketId := "tf-test-bucket-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingProjectBucketConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectBucketConfig_custom(bucketId, "Custom bucket", 30),
+			},
+			{
+				ResourceName:      "google_logging_project_bucket_config.custom",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingProjectBucketConfig_custom(bucketId, "Updated custom bucket", 60),
+			},
+			{
+				ResourceName:      "google_logging_project_bucket_config.custom",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Deleted buckets are kept for 7 days in the DELETE_REQUESTED state before
+// they're removed.
+func testAccCheckLoggingProjectBucketConfigDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_logging_project_bucket_config" {
+			continue
+		}
+
+		res, err := sendRequest(config, "GET", loggingBasePath+rs.Primary.ID, nil)
+		if err == nil && res["lifecycleState"] != "DELETE_REQUESTED" {
+			return fmt.Errorf("Logging bucket %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccLoggingProjectBucketConfig_default(project string, retention int) string {
+	return fmt.Sprintf(`
+resource "google_logging_project_bucket_config" "default" {
+	project        = "%s"
+	location       = "global"
+	bucket_id      = "_Default"
+	retention_days = %d
+}
+`, project, retention)
+}
+
+func testAccLoggingProjectBucketConfig_custom(bucketId, description string, retention int) string {
+	return fmt.Sprintf(`
+resource "google_logging_project_bucket_config" "custom" {
+	project        = "%s"
+	location       = "global"
+	bucket_id      = "%s"
+	description    = "%s"
+	retention_days = %d
+}
+`, getTestProjectFromEnv(), bucketId, description, retention)
+}
@@ -45,7 +45,7 @@ func resourceLoggingProjectSinkCreate(d *schema.ResourceData, meta interface{})
 	id, sink := expandResourceLoggingSink(d, "projects", project)
 	uniqueWriterIdentity := d.Get("unique_writer_identity").(bool)
 
-	_, err = config.clientLogging.Projects.Sinks.Create(id.parent(), sink).UniqueWriterIdentity(uniqueWriterIdentity).Do()
+	err = createLoggingSink(config, id, sink, uniqueWriterIdentity)
 	if err != nil {
 		return err
 	}
@@ -63,14 +63,16 @@ func resourceLoggingProjectSinkRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
-	sink, err := config.clientLogging.Projects.Sinks.Get(d.Id()).Do()
+	sink, err := readLoggingSink(config, d.Id())
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Project Logging Sink %s", d.Get("name").(string)))
 	}
 
 	d.Set("project", project)
-	flattenResourceLoggingSink(d, sink)
-	if sink.WriterIdentity != nonUniqueWriterAccount {
+	if err := flattenResourceLoggingSink(d, sink); err != nil {
+		return err
+	}
+	if sink["writerIdentity"] != nonUniqueWriterAccount {
 		d.Set("unique_writer_identity", true)
 	} else {
 		d.Set("unique_writer_identity", false)
@@ -84,8 +86,7 @@ func resourceLoggingProjectSinkUpdate(d *schema.ResourceData, meta interface{})
 	sink := expandResourceLoggingSinkForUpdate(d)
 	uniqueWriterIdentity := d.Get("unique_writer_identity").(bool)
 
-	_, err := config.clientLogging.Projects.Sinks.Patch(d.Id(), sink).
-		UpdateMask(defaultLogSinkUpdateMask).UniqueWriterIdentity(uniqueWriterIdentity).Do()
+	err := updateLoggingSink(config, d, sink, uniqueWriterIdentity)
 	if err != nil {
 		return err
 	}
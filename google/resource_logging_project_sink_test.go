@@ -87,6 +87,65 @@ func TestAccLoggingProjectSink_heredoc(t *testing.T) {
 	})
 }
 
+func TestAccLoggingProjectSink_updateExclusions(t *testing.T) {
+	t.Parallel()
+
+	sinkName := "tf-test-sink-" + acctest.RandString(10)
+	bucketName := "tf-test-sink-bucket-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingProjectSinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectSink_exclusions(sinkName, bucketName, "INFO", false),
+			},
+			{
+				ResourceName:      "google_logging_project_sink.exclusions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingProjectSink_exclusions(sinkName, bucketName, "WARNING", true),
+			},
+			{
+				ResourceName:      "google_logging_project_sink.exclusions",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLoggingProjectSink_bigqueryOptions(t *testing.T) {
+	t.Parallel()
+
+	sinkName := "tf-test-sink-" + acctest.RandString(10)
+	datasetName := "tf_test_sink_" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLoggingProjectSinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoggingProjectSink_bigqueryOptions(sinkName, datasetName, true),
+				Check:  resource.TestCheckResourceAttr("google_logging_project_sink.bigquery", "bigquery_options.0.use_partitioned_tables", "true"),
+			},
+			{
+				ResourceName:      "google_logging_project_sink.bigquery",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLoggingProjectSink_bigqueryOptions(sinkName, datasetName, false),
+				Check:  resource.TestCheckResourceAttr("google_logging_project_sink.bigquery", "bigquery_options.0.use_partitioned_tables", "false"),
+			},
+		},
+	})
+}
+
 func testAccCheckLoggingProjectSinkDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -177,3 +236,53 @@ resource "google_storage_bucket" "log-bucket" {
 }
 `, name, project, project, bucketName)
 }
+
+func testAccLoggingProjectSink_exclusions(name, bucketName, severity string, disabled bool) string {
+	return fmt.Sprintf(`
+resource "google_logging_project_sink" "exclusions" {
+	name        = "%s"
+	description = "Errors and warnings from %s"
+	destination = "storage.googleapis.com/${google_storage_bucket.log-bucket.name}"
+	filter      = "severity>=%s"
+
+	exclusions {
+		name        = "ignore-gke"
+		description = "Exclude GKE container logs"
+		filter      = "resource.type = k8s_container"
+		disabled    = %t
+	}
+
+	exclusions {
+		name   = "ignore-load-balancers"
+		filter = "resource.type = http_load_balancer"
+	}
+
+	unique_writer_identity = true
+}
+
+resource "google_storage_bucket" "log-bucket" {
+	name = "%s"
+}
+`, name, getTestProjectFromEnv(), severity, disabled, bucketName)
+}
+
+func testAccLoggingProjectSink_bigqueryOptions(name, datasetName string, usePartitionedTables bool) string {
+	return fmt.Sprintf(`
+resource "google_logging_project_sink" "bigquery" {
+	name        = "%s"
+	destination = "bigquery.googleapis.com/projects/%s/datasets/${google_bigquery_dataset.logging_sink.dataset_id}"
+	filter      = "severity>=ERROR"
+
+	bigquery_options {
+		use_partitioned_tables = %t
+	}
+
+	unique_writer_identity = true
+}
+
+resource "google_bigquery_dataset" "logging_sink" {
+	dataset_id  = "%s"
+	description = "Log sink dataset"
+}
+`, name, getTestProjectFromEnv(), usePartitionedTables, datasetName)
+}
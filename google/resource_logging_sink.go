@@ -1,10 +1,14 @@
 package google
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform/helper/schema"
-	"google.golang.org/api/logging/v2"
 )
 
+const loggingBasePath = "https://logging.googleapis.com/v2/"
+
 // Empty update masks will eventually cause updates to fail, currently empty masks default to this string
 const defaultLogSinkUpdateMask = "destination,filter,includeChildren"
 
@@ -27,6 +31,57 @@ func resourceLoggingSinkSchema() map[string]*schema.Schema {
 			DiffSuppressFunc: optionalSurroundingSpacesSuppress,
 		},
 
+		"description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+
+		"disabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+
+		"exclusions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"filter": {
+						Type:             schema.TypeString,
+						Required:         true,
+						DiffSuppressFunc: optionalSurroundingSpacesSuppress,
+					},
+					"disabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+
+		"bigquery_options": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"use_partitioned_tables": {
+						Type:     schema.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"writer_identity": {
 			Type:     schema.TypeString,
 			Computed: true,
@@ -34,43 +89,127 @@ func resourceLoggingSinkSchema() map[string]*schema.Schema {
 	}
 }
 
-func expandResourceLoggingSink(d *schema.ResourceData, resourceType, resourceId string) (LoggingSinkId, *logging.LogSink) {
+// The vendored logging client predates sink exclusions and BigQuery options, so
+// sinks are created, read and updated through the JSON API.
+func expandResourceLoggingSink(d *schema.ResourceData, resourceType, resourceId string) (LoggingSinkId, map[string]interface{}) {
 	id := LoggingSinkId{
 		resourceType: resourceType,
 		resourceId:   resourceId,
 		name:         d.Get("name").(string),
 	}
 
-	sink := logging.LogSink{
-		Name:        d.Get("name").(string),
-		Destination: d.Get("destination").(string),
-		Filter:      d.Get("filter").(string),
+	sink := expandResourceLoggingSinkForUpdate(d)
+	sink["name"] = d.Get("name").(string)
+	return id, sink
+}
+
+func flattenResourceLoggingSink(d *schema.ResourceData, sink map[string]interface{}) error {
+	d.Set("name", sink["name"])
+	d.Set("destination", sink["destination"])
+	d.Set("filter", sink["filter"])
+	d.Set("description", sink["description"])
+	d.Set("disabled", sink["disabled"])
+	d.Set("writer_identity", sink["writerIdentity"])
+	if err := d.Set("exclusions", flattenLoggingSinkExclusions(sink["exclusions"])); err != nil {
+		return fmt.Errorf("Error reading exclusions: %s", err)
+	}
+	if err := d.Set("bigquery_options", flattenLoggingSinkBigqueryOptions(sink["bigqueryOptions"])); err != nil {
+		return fmt.Errorf("Error reading bigquery_options: %s", err)
 	}
-	return id, &sink
+	return nil
 }
 
-func flattenResourceLoggingSink(d *schema.ResourceData, sink *logging.LogSink) {
-	d.Set("name", sink.Name)
-	d.Set("destination", sink.Destination)
-	d.Set("filter", sink.Filter)
-	d.Set("writer_identity", sink.WriterIdentity)
+func expandResourceLoggingSinkForUpdate(d *schema.ResourceData) map[string]interface{} {
+	// The API requires both destination and filter on every update (even if unchanged), the other fields are
+	// sent so the update mask below can clear them.
+	sink := map[string]interface{}{
+		"destination": d.Get("destination").(string),
+		"filter":      d.Get("filter").(string),
+		"description": d.Get("description").(string),
+		"disabled":    d.Get("disabled").(bool),
+		"exclusions":  expandLoggingSinkExclusions(d.Get("exclusions").([]interface{})),
+	}
+	if v, ok := d.GetOk("bigquery_options"); ok {
+		options := v.([]interface{})[0].(map[string]interface{})
+		sink["bigqueryOptions"] = map[string]interface{}{
+			"usePartitionedTables": options["use_partitioned_tables"],
+		}
+	}
+	return sink
+}
+
+// loggingSinkUpdateMask returns the fields updated by a sink sent by
+// expandResourceLoggingSinkForUpdate. BigQuery options are only included when
+// set, as they're rejected for sinks with other destinations.
+func loggingSinkUpdateMask(d *schema.ResourceData) string {
+	fields := []string{defaultLogSinkUpdateMask, "description", "disabled", "exclusions"}
+	if d.HasChange("bigquery_options") {
+		fields = append(fields, "bigqueryOptions")
+	}
+	return strings.Join(fields, ",")
+}
+
+func createLoggingSink(config *Config, id LoggingSinkId, sink map[string]interface{}, uniqueWriterIdentity bool) error {
+	url := fmt.Sprintf("%s%s/sinks?uniqueWriterIdentity=%t", loggingBasePath, id.parent(), uniqueWriterIdentity)
+	_, err := sendRequest(config, "POST", url, sink)
+	return err
+}
+
+func readLoggingSink(config *Config, id string) (map[string]interface{}, error) {
+	return sendRequest(config, "GET", loggingBasePath+id, nil)
 }
 
-func expandResourceLoggingSinkForUpdate(d *schema.ResourceData) *logging.LogSink {
-	// Can only update destination/filter right now. Despite the method below using 'Patch', the API requires both
-	// destination and filter (even if unchanged).
-	sink := logging.LogSink{
-		Destination: d.Get("destination").(string),
-		Filter:      d.Get("filter").(string),
+func updateLoggingSink(config *Config, d *schema.ResourceData, sink map[string]interface{}, uniqueWriterIdentity bool) error {
+	url, err := addQueryParams(loggingBasePath+d.Id(), map[string]string{
+		"updateMask":           loggingSinkUpdateMask(d),
+		"uniqueWriterIdentity": fmt.Sprintf("%t", uniqueWriterIdentity),
+	})
+	if err != nil {
+		return err
 	}
+	_, err = sendRequest(config, "PATCH", url, sink)
+	return err
+}
+
+func expandLoggingSinkExclusions(v []interface{}) []interface{} {
+	exclusions := make([]interface{}, 0, len(v))
+	for _, raw := range v {
+		e := raw.(map[string]interface{})
+		exclusions = append(exclusions, map[string]interface{}{
+			"name":        e["name"],
+			"description": e["description"],
+			"filter":      e["filter"],
+			"disabled":    e["disabled"],
+		})
+	}
+	return exclusions
+}
 
-	if d.HasChange("destination") {
-		sink.ForceSendFields = append(sink.ForceSendFields, "Destination")
+func flattenLoggingSinkExclusions(v interface{}) []interface{} {
+	raw, _ := v.([]interface{})
+	exclusions := make([]interface{}, 0, len(raw))
+	for _, r := range raw {
+		e := r.(map[string]interface{})
+		exclusions = append(exclusions, map[string]interface{}{
+			"name":        e["name"],
+			"description": e["description"],
+			"filter":      e["filter"],
+			"disabled":    e["disabled"],
+		})
 	}
-	if d.HasChange("filter") {
-		sink.ForceSendFields = append(sink.ForceSendFields, "Filter")
+	return exclusions
+}
+
+func flattenLoggingSinkBigqueryOptions(v interface{}) []interface{} {
+	options, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"use_partitioned_tables": options["usePartitionedTables"],
+		},
 	}
-	return &sink
 }
 
 func resourceLoggingSinkImportState(sinkType string) schema.StateFunc {
@@ -42,5 +42,5 @@ func resourceManagerOperationWaitTime(config *Config, op map[string]interface{},
 	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
@@ -0,0 +1,113 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMemcacheInstance_memcacheInstanceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMemcacheInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemcacheInstance_memcacheInstanceBasicExample(context),
+			},
+			{
+				ResourceName:            "google_memcache_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"region"},
+			},
+		},
+	})
+}
+
+func testAccMemcacheInstance_memcacheInstanceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "memcache_network" {
+  name = "tf-test-memcache-network%{random_suffix}"
+}
+
+resource "google_compute_global_address" "service_range" {
+  name          = "tf-test-address%{random_suffix}"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = "${google_compute_network.memcache_network.self_link}"
+}
+
+resource "google_service_networking_connection" "private_service_connection" {
+  network                 = "${google_compute_network.memcache_network.self_link}"
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = ["${google_compute_global_address.service_range.name}"]
+}
+
+resource "google_memcache_instance" "instance" {
+  name               = "tf-test-test-instance%{random_suffix}"
+  region             = "us-central1"
+  authorized_network = "${google_service_networking_connection.private_service_connection.network}"
+
+  labels = {
+    env = "test"
+  }
+
+  node_config {
+    cpu_count      = 1
+    memory_size_mb = 1024
+  }
+  node_count       = 1
+  memcache_version = "MEMCACHE_1_5"
+
+  maintenance_policy {
+    weekly_maintenance_window {
+      day      = "SATURDAY"
+      duration = "14400s"
+      start_time {
+        hours   = 0
+        minutes = 30
+        seconds = 0
+        nanos   = 0
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckMemcacheInstanceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_memcache_instance" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://memcache.googleapis.com/v1/projects/{{project}}/locations/{{region}}/instances/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("MemcacheInstance still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,971 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceMemorystoreInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMemorystoreInstanceCreate,
+		Read:   resourceMemorystoreInstanceRead,
+		Update: resourceMemorystoreInstanceUpdate,
+		Delete: resourceMemorystoreInstanceDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMemorystoreInstanceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3600 * time.Second),
+			Update: schema.DefaultTimeout(7200 * time.Second),
+			Delete: schema.DefaultTimeout(1800 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"desired_psc_auto_connections": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"network": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"shard_count": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"authorization_mode": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AUTHORIZATION_MODE_UNSPECIFIED", "AUTH_DISABLED", "IAM_AUTH", ""}, false),
+			},
+			"deletion_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"engine_configs": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"node_type": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"NODE_TYPE_UNSPECIFIED", "SHARED_CORE_NANO", "HIGHMEM_MEDIUM", "HIGHMEM_XLARGE", "STANDARD_SMALL", ""}, false),
+			},
+			"persistence_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aof_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"append_fsync": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"APPEND_FSYNC_UNSPECIFIED", "NEVER", "EVERY_SEC", "ALWAYS", ""}, false),
+									},
+								},
+							},
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PERSISTENCE_MODE_UNSPECIFIED", "DISABLED", "RDB", "AOF", ""}, false),
+						},
+						"rdb_config": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rdb_snapshot_period": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"SNAPSHOT_PERIOD_UNSPECIFIED", "ONE_HOUR", "SIX_HOURS", "TWELVE_HOURS", "TWENTY_FOUR_HOURS", ""}, false),
+									},
+									"rdb_snapshot_start_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Optional: true,
+			},
+			"transit_encryption_mode": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TRANSIT_ENCRYPTION_MODE_UNSPECIFIED", "TRANSIT_ENCRYPTION_DISABLED", "SERVER_AUTHENTICATION", ""}, false),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"discovery_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"network": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"psc_auto_connections": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"forwarding_rule": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"network": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"project_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"psc_connection_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"service_attachment": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMemorystoreInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandMemorystoreInstanceLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	shardCountProp, err := expandMemorystoreInstanceShardCount(d.Get("shard_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("shard_count"); !isEmptyValue(reflect.ValueOf(shardCountProp)) && (ok || !reflect.DeepEqual(v, shardCountProp)) {
+		obj["shardCount"] = shardCountProp
+	}
+	replicaCountProp, err := expandMemorystoreInstanceReplicaCount(d.Get("replica_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("replica_count"); !isEmptyValue(reflect.ValueOf(replicaCountProp)) && (ok || !reflect.DeepEqual(v, replicaCountProp)) {
+		obj["replicaCount"] = replicaCountProp
+	}
+	authorizationModeProp, err := expandMemorystoreInstanceAuthorizationMode(d.Get("authorization_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("authorization_mode"); !isEmptyValue(reflect.ValueOf(authorizationModeProp)) && (ok || !reflect.DeepEqual(v, authorizationModeProp)) {
+		obj["authorizationMode"] = authorizationModeProp
+	}
+	transitEncryptionModeProp, err := expandMemorystoreInstanceTransitEncryptionMode(d.Get("transit_encryption_mode"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("transit_encryption_mode"); !isEmptyValue(reflect.ValueOf(transitEncryptionModeProp)) && (ok || !reflect.DeepEqual(v, transitEncryptionModeProp)) {
+		obj["transitEncryptionMode"] = transitEncryptionModeProp
+	}
+	nodeTypeProp, err := expandMemorystoreInstanceNodeType(d.Get("node_type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("node_type"); !isEmptyValue(reflect.ValueOf(nodeTypeProp)) && (ok || !reflect.DeepEqual(v, nodeTypeProp)) {
+		obj["nodeType"] = nodeTypeProp
+	}
+	engineVersionProp, err := expandMemorystoreInstanceEngineVersion(d.Get("engine_version"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("engine_version"); !isEmptyValue(reflect.ValueOf(engineVersionProp)) && (ok || !reflect.DeepEqual(v, engineVersionProp)) {
+		obj["engineVersion"] = engineVersionProp
+	}
+	engineConfigsProp, err := expandMemorystoreInstanceEngineConfigs(d.Get("engine_configs"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("engine_configs"); !isEmptyValue(reflect.ValueOf(engineConfigsProp)) && (ok || !reflect.DeepEqual(v, engineConfigsProp)) {
+		obj["engineConfigs"] = engineConfigsProp
+	}
+	deletionProtectionEnabledProp, err := expandMemorystoreInstanceDeletionProtectionEnabled(d.Get("deletion_protection_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("deletion_protection_enabled"); !isEmptyValue(reflect.ValueOf(deletionProtectionEnabledProp)) && (ok || !reflect.DeepEqual(v, deletionProtectionEnabledProp)) {
+		obj["deletionProtectionEnabled"] = deletionProtectionEnabledProp
+	}
+	desiredPscAutoConnectionsProp, err := expandMemorystoreInstanceDesiredPscAutoConnections(d.Get("desired_psc_auto_connections"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("desired_psc_auto_connections"); !isEmptyValue(reflect.ValueOf(desiredPscAutoConnectionsProp)) && (ok || !reflect.DeepEqual(v, desiredPscAutoConnectionsProp)) {
+		obj["desiredPscAutoConnections"] = desiredPscAutoConnectionsProp
+	}
+	persistenceConfigProp, err := expandMemorystoreInstancePersistenceConfig(d.Get("persistence_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("persistence_config"); !isEmptyValue(reflect.ValueOf(persistenceConfigProp)) && (ok || !reflect.DeepEqual(v, persistenceConfigProp)) {
+		obj["persistenceConfig"] = persistenceConfigProp
+	}
+
+	url, err := replaceVars(d, config, "https://memorystore.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances?instanceId={{instance_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Instance: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Instance: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := memorystoreOperationWaitTime(
+		config, res, project, "Creating Instance",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Instance: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Instance %q: %#v", d.Id(), res)
+
+	return resourceMemorystoreInstanceRead(d, meta)
+}
+
+func resourceMemorystoreInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://memorystore.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MemorystoreInstance %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+
+	if err := d.Set("labels", flattenMemorystoreInstanceLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("shard_count", flattenMemorystoreInstanceShardCount(res["shardCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("replica_count", flattenMemorystoreInstanceReplicaCount(res["replicaCount"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("authorization_mode", flattenMemorystoreInstanceAuthorizationMode(res["authorizationMode"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("transit_encryption_mode", flattenMemorystoreInstanceTransitEncryptionMode(res["transitEncryptionMode"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("node_type", flattenMemorystoreInstanceNodeType(res["nodeType"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("engine_version", flattenMemorystoreInstanceEngineVersion(res["engineVersion"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("engine_configs", flattenMemorystoreInstanceEngineConfigs(res["engineConfigs"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("deletion_protection_enabled", flattenMemorystoreInstanceDeletionProtectionEnabled(res["deletionProtectionEnabled"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("persistence_config", flattenMemorystoreInstancePersistenceConfig(res["persistenceConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("create_time", flattenMemorystoreInstanceCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("update_time", flattenMemorystoreInstanceUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("state", flattenMemorystoreInstanceState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("uid", flattenMemorystoreInstanceUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("discovery_endpoints", flattenMemorystoreInstanceDiscoveryEndpoints(res["discoveryEndpoints"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+	if err := d.Set("psc_auto_connections", flattenMemorystoreInstancePscAutoConnections(res["pscAutoConnections"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMemorystoreInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandMemorystoreInstanceLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	shardCountProp, err := expandMemorystoreInstanceShardCount(d.Get("shard_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("shard_count"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, shardCountProp)) {
+		obj["shardCount"] = shardCountProp
+	}
+	replicaCountProp, err := expandMemorystoreInstanceReplicaCount(d.Get("replica_count"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("replica_count"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, replicaCountProp)) {
+		obj["replicaCount"] = replicaCountProp
+	}
+	engineConfigsProp, err := expandMemorystoreInstanceEngineConfigs(d.Get("engine_configs"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("engine_configs"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, engineConfigsProp)) {
+		obj["engineConfigs"] = engineConfigsProp
+	}
+	deletionProtectionEnabledProp, err := expandMemorystoreInstanceDeletionProtectionEnabled(d.Get("deletion_protection_enabled"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("deletion_protection_enabled"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, deletionProtectionEnabledProp)) {
+		obj["deletionProtectionEnabled"] = deletionProtectionEnabledProp
+	}
+	persistenceConfigProp, err := expandMemorystoreInstancePersistenceConfig(d.Get("persistence_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("persistence_config"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, persistenceConfigProp)) {
+		obj["persistenceConfig"] = persistenceConfigProp
+	}
+
+	url, err := replaceVars(d, config, "https://memorystore.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Instance %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("shard_count") {
+		updateMask = append(updateMask, "shardCount")
+	}
+
+	if d.HasChange("replica_count") {
+		updateMask = append(updateMask, "replicaCount")
+	}
+
+	if d.HasChange("engine_configs") {
+		updateMask = append(updateMask, "engineConfigs")
+	}
+
+	if d.HasChange("deletion_protection_enabled") {
+		updateMask = append(updateMask, "deletionProtectionEnabled")
+	}
+
+	if d.HasChange("persistence_config") {
+		updateMask = append(updateMask, "persistenceConfig")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Instance %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = memorystoreOperationWaitTime(
+		config, res, project, "Updating Instance",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceMemorystoreInstanceRead(d, meta)
+}
+
+func resourceMemorystoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://memorystore.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Instance %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Instance")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = memorystoreOperationWaitTime(
+		config, res, project, "Deleting Instance",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Instance %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceMemorystoreInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/instances/(?P<instance_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<instance_id>[^/]+)", "(?P<location>[^/]+)/(?P<instance_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenMemorystoreInstanceLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceShardCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenMemorystoreInstanceReplicaCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenMemorystoreInstanceAuthorizationMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceTransitEncryptionMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceNodeType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceEngineVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceEngineConfigs(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceDeletionProtectionEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePersistenceConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["mode"] =
+		flattenMemorystoreInstancePersistenceConfigMode(original["mode"], d)
+	transformed["rdb_config"] =
+		flattenMemorystoreInstancePersistenceConfigRdbConfig(original["rdbConfig"], d)
+	transformed["aof_config"] =
+		flattenMemorystoreInstancePersistenceConfigAofConfig(original["aofConfig"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMemorystoreInstancePersistenceConfigMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePersistenceConfigRdbConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["rdb_snapshot_period"] =
+		flattenMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotPeriod(original["rdbSnapshotPeriod"], d)
+	transformed["rdb_snapshot_start_time"] =
+		flattenMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotStartTime(original["rdbSnapshotStartTime"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotStartTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePersistenceConfigAofConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["append_fsync"] =
+		flattenMemorystoreInstancePersistenceConfigAofConfigAppendFsync(original["appendFsync"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMemorystoreInstancePersistenceConfigAofConfigAppendFsync(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceDiscoveryEndpoints(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"address": flattenMemorystoreInstanceDiscoveryEndpointsAddress(original["address"], d),
+			"port":    flattenMemorystoreInstanceDiscoveryEndpointsPort(original["port"], d),
+			"network": flattenMemorystoreInstanceDiscoveryEndpointsNetwork(original["network"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenMemorystoreInstanceDiscoveryEndpointsAddress(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstanceDiscoveryEndpointsPort(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenMemorystoreInstanceDiscoveryEndpointsNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnections(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"psc_connection_id":  flattenMemorystoreInstancePscAutoConnectionsPscConnectionId(original["pscConnectionId"], d),
+			"ip_address":         flattenMemorystoreInstancePscAutoConnectionsIpAddress(original["ipAddress"], d),
+			"forwarding_rule":    flattenMemorystoreInstancePscAutoConnectionsForwardingRule(original["forwardingRule"], d),
+			"project_id":         flattenMemorystoreInstancePscAutoConnectionsProjectId(original["projectId"], d),
+			"network":            flattenMemorystoreInstancePscAutoConnectionsNetwork(original["network"], d),
+			"service_attachment": flattenMemorystoreInstancePscAutoConnectionsServiceAttachment(original["serviceAttachment"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsPscConnectionId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsIpAddress(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsForwardingRule(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsProjectId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMemorystoreInstancePscAutoConnectionsServiceAttachment(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandMemorystoreInstanceLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandMemorystoreInstanceShardCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceReplicaCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceAuthorizationMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceTransitEncryptionMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceNodeType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceEngineVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceEngineConfigs(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandMemorystoreInstanceDeletionProtectionEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstanceDesiredPscAutoConnections(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedNetwork, err := expandMemorystoreInstanceDesiredPscAutoConnectionsNetwork(original["network"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedNetwork); val.IsValid() && !isEmptyValue(val) {
+			transformed["network"] = transformedNetwork
+		}
+
+		transformedProjectId, err := expandMemorystoreInstanceDesiredPscAutoConnectionsProjectId(original["project_id"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedProjectId); val.IsValid() && !isEmptyValue(val) {
+			transformed["projectId"] = transformedProjectId
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandMemorystoreInstanceDesiredPscAutoConnectionsNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandMemorystoreInstanceDesiredPscAutoConnectionsProjectId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstancePersistenceConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMode, err := expandMemorystoreInstancePersistenceConfigMode(original["mode"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMode); val.IsValid() && !isEmptyValue(val) {
+		transformed["mode"] = transformedMode
+	}
+
+	transformedRdbConfig, err := expandMemorystoreInstancePersistenceConfigRdbConfig(original["rdb_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRdbConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["rdbConfig"] = transformedRdbConfig
+	}
+
+	transformedAofConfig, err := expandMemorystoreInstancePersistenceConfigAofConfig(original["aof_config"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAofConfig); val.IsValid() && !isEmptyValue(val) {
+		transformed["aofConfig"] = transformedAofConfig
+	}
+
+	return transformed, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigRdbConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRdbSnapshotPeriod, err := expandMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotPeriod(original["rdb_snapshot_period"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRdbSnapshotPeriod); val.IsValid() && !isEmptyValue(val) {
+		transformed["rdbSnapshotPeriod"] = transformedRdbSnapshotPeriod
+	}
+
+	transformedRdbSnapshotStartTime, err := expandMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotStartTime(original["rdb_snapshot_start_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRdbSnapshotStartTime); val.IsValid() && !isEmptyValue(val) {
+		transformed["rdbSnapshotStartTime"] = transformedRdbSnapshotStartTime
+	}
+
+	return transformed, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotPeriod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigRdbConfigRdbSnapshotStartTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigAofConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAppendFsync, err := expandMemorystoreInstancePersistenceConfigAofConfigAppendFsync(original["append_fsync"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAppendFsync); val.IsValid() && !isEmptyValue(val) {
+		transformed["appendFsync"] = transformedAppendFsync
+	}
+
+	return transformed, nil
+}
+
+func expandMemorystoreInstancePersistenceConfigAofConfigAppendFsync(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
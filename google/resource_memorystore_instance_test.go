@@ -0,0 +1,88 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMemorystoreInstance_memorystoreInstanceBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"network_name":  BootstrapSharedServiceConnectionPolicy(t, "gcp-memorystore"),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMemorystoreInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemorystoreInstance_memorystoreInstanceBasicExample(context),
+			},
+			{
+				ResourceName:            "google_memorystore_instance.instance-basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"desired_psc_auto_connections"},
+			},
+		},
+	})
+}
+
+func testAccMemorystoreInstance_memorystoreInstanceBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+data "google_project" "project" {}
+
+resource "google_memorystore_instance" "instance-basic" {
+  instance_id = "tf-test-instance%{random_suffix}"
+  shard_count = 3
+  location    = "us-central1"
+
+  desired_psc_auto_connections {
+    network    = "%{network_name}"
+    project_id = "${data.google_project.project.project_id}"
+  }
+
+  persistence_config {
+    mode = "AOF"
+    aof_config {
+      append_fsync = "EVERY_SEC"
+    }
+  }
+
+  deletion_protection_enabled = false
+}
+`, context)
+}
+
+func testAccCheckMemorystoreInstanceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_memorystore_instance" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://memorystore.googleapis.com/v1/projects/{{project}}/locations/{{location}}/instances/{{instance_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("MemorystoreInstance still exists at %s", url)
+		}
+	}
+
+	return nil
+}
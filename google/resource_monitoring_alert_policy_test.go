@@ -92,6 +92,28 @@ func TestAccMonitoringAlertPolicy_full(t *testing.T) {
 	})
 }
 
+func TestAccMonitoringAlertPolicy_mql(t *testing.T) {
+
+	alertName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	conditionName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAlertPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringAlertPolicy_mql(alertName, conditionName),
+			},
+			{
+				ResourceName:      "google_monitoring_alert_policy.mql",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckAlertPolicyDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -206,3 +228,31 @@ resource "google_monitoring_alert_policy" "full" {
 }
 `, alertName, conditionName1, conditionName2)
 }
+
+func testAccMonitoringAlertPolicy_mql(alertName, conditionName string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_alert_policy" "mql" {
+  display_name = "%s"
+  combiner     = "OR"
+  enabled      = true
+
+  conditions {
+    display_name = "%s"
+
+    condition_monitoring_query_language {
+      query    = "fetch gce_instance | metric 'compute.googleapis.com/instance/cpu/utilization' | group_by 5m, [value_utilization_mean: mean(value.utilization)] | every 5m | condition val() > 0.10 '10^2.%%'"
+      duration = "60s"
+
+      trigger {
+        count = 2
+      }
+    }
+  }
+
+  documentation {
+    content   = "test content"
+    mime_type = "text/markdown"
+  }
+}
+`, alertName, conditionName)
+}
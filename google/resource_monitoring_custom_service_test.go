@@ -0,0 +1,73 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMonitoringService_monitoringServiceCustomExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringService_monitoringServiceCustomExample(context),
+			},
+			{
+				ResourceName:      "google_monitoring_custom_service.custom",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitoringService_monitoringServiceCustomExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_monitoring_custom_service" "custom" {
+  service_id   = "custom-srv%{random_suffix}"
+  display_name = "My Custom Service custom-srv%{random_suffix}"
+
+  telemetry {
+    resource_name = "//product.googleapis.com/foo/foo/services/test"
+  }
+}
+`, context)
+}
+
+func testAccCheckMonitoringServiceDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_monitoring_custom_service" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://monitoring.googleapis.com/v3/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("MonitoringService still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// The API fills in fields that weren't part of the submitted dashboard, such
+// as the etag and name or the defaults of nested widgets. Keys that are only
+// present in the stored dashboard are ignored when comparing it to the config.
+func removeComputedKeys(old, new map[string]interface{}) map[string]interface{} {
+	for k, v := range old {
+		newV, ok := new[k]
+		if !ok {
+			delete(old, k)
+			continue
+		}
+
+		switch oldV := v.(type) {
+		case map[string]interface{}:
+			if newMap, ok := newV.(map[string]interface{}); ok {
+				old[k] = removeComputedKeys(oldV, newMap)
+			}
+		case []interface{}:
+			newList, ok := newV.([]interface{})
+			if !ok {
+				continue
+			}
+			for i, item := range oldV {
+				oldMap, ok := item.(map[string]interface{})
+				if !ok || i >= len(newList) {
+					continue
+				}
+				if newMap, ok := newList[i].(map[string]interface{}); ok {
+					oldV[i] = removeComputedKeys(oldMap, newMap)
+				}
+			}
+		}
+	}
+
+	return old
+}
+
+func monitoringDashboardDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldMap, err := structure.ExpandJsonFromString(old)
+	if err != nil {
+		return false
+	}
+	newMap, err := structure.ExpandJsonFromString(new)
+	if err != nil {
+		return false
+	}
+
+	oldMap = removeComputedKeys(oldMap, newMap)
+	return reflect.DeepEqual(oldMap, newMap)
+}
+
+func resourceMonitoringDashboard() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMonitoringDashboardCreate,
+		Read:   resourceMonitoringDashboardRead,
+		Update: resourceMonitoringDashboardUpdate,
+		Delete: resourceMonitoringDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMonitoringDashboardImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_json": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: monitoringDashboardDiffSuppress,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMonitoringDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj, err := structure.ExpandJsonFromString(d.Get("dashboard_json").(string))
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://monitoring.googleapis.com/v1/projects/%s/dashboards", project)
+
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Dashboard: %s", err)
+	}
+
+	// The returned name uses the project number, keep the configured project
+	// in the id instead.
+	name, ok := res["name"].(string)
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.SetId(fmt.Sprintf("projects/%s/dashboards/%s", project, GetResourceNameFromSelfLink(name)))
+	d.Set("project", project)
+
+	return resourceMonitoringDashboardRead(d, meta)
+}
+
+func resourceMonitoringDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := sendRequest(config, "GET", "https://monitoring.googleapis.com/v1/"+d.Id(), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MonitoringDashboard %q", d.Id()))
+	}
+
+	str, err := structure.FlattenJsonToString(res)
+	if err != nil {
+		return fmt.Errorf("Error reading Dashboard: %s", err)
+	}
+	if err := d.Set("dashboard_json", str); err != nil {
+		return fmt.Errorf("Error reading Dashboard: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMonitoringDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj, err := structure.ExpandJsonFromString(d.Get("dashboard_json").(string))
+	if err != nil {
+		return err
+	}
+
+	_, err = sendRequestWithTimeout(config, "PATCH", "https://monitoring.googleapis.com/v1/"+d.Id(), obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Dashboard %q: %s", d.Id(), err)
+	}
+
+	return resourceMonitoringDashboardRead(d, meta)
+}
+
+func resourceMonitoringDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	_, err := sendRequestWithTimeout(config, "DELETE", "https://monitoring.googleapis.com/v1/"+d.Id(), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MonitoringDashboard %q", d.Id()))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceMonitoringDashboardImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// Dashboards can be imported by their id alone, using the provider project.
+	if !strings.HasPrefix(d.Id(), "projects/") {
+		project, err := getProject(d, config)
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(fmt.Sprintf("projects/%s/dashboards/%s", project, d.Id()))
+	}
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 4 || parts[2] != "dashboards" {
+		return nil, fmt.Errorf("Invalid dashboard specifier. Expecting projects/{project}/dashboards/{dashboard_id} or {dashboard_id}, got %s", d.Id())
+	}
+	d.Set("project", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
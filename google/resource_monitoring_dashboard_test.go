@@ -0,0 +1,195 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestMonitoringDashboardDiffSuppress(t *testing.T) {
+	cases := map[string]struct {
+		Old, New           string
+		ExpectDiffSuppress bool
+	}{
+		"identical": {
+			Old:                `{"displayName": "Dashboard", "gridLayout": {"widgets": [{"title": "Widget"}]}}`,
+			New:                `{"displayName": "Dashboard", "gridLayout": {"widgets": [{"title": "Widget"}]}}`,
+			ExpectDiffSuppress: true,
+		},
+		"server added top level fields": {
+			Old:                `{"displayName": "Dashboard", "etag": "abc", "name": "projects/123/dashboards/456"}`,
+			New:                `{"displayName": "Dashboard"}`,
+			ExpectDiffSuppress: true,
+		},
+		"server added nested defaults": {
+			Old:                `{"displayName": "Dashboard", "gridLayout": {"columns": "2", "widgets": [{"title": "Widget", "text": {"content": "foo", "format": "MARKDOWN"}}]}}`,
+			New:                `{"displayName": "Dashboard", "gridLayout": {"widgets": [{"title": "Widget", "text": {"content": "foo"}}]}}`,
+			ExpectDiffSuppress: true,
+		},
+		"changed field": {
+			Old:                `{"displayName": "Dashboard", "etag": "abc"}`,
+			New:                `{"displayName": "Other Dashboard"}`,
+			ExpectDiffSuppress: false,
+		},
+		"added field": {
+			Old:                `{"displayName": "Dashboard"}`,
+			New:                `{"displayName": "Dashboard", "gridLayout": {"columns": "2"}}`,
+			ExpectDiffSuppress: false,
+		},
+		"added widget": {
+			Old:                `{"displayName": "Dashboard", "gridLayout": {"widgets": [{"title": "Widget"}]}}`,
+			New:                `{"displayName": "Dashboard", "gridLayout": {"widgets": [{"title": "Widget"}, {"title": "Other Widget"}]}}`,
+			ExpectDiffSuppress: false,
+		},
+		"invalid json": {
+			Old:                `{"displayName": "Dashboard"}`,
+			New:                `{"displayName": `,
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if monitoringDashboardDiffSuppress("dashboard_json", tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q => %q expect DiffSuppress to return %t", tn, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
+
+func TestAccMonitoringDashboard_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringDashboard_basic(acctest.RandString(10)),
+			},
+			{
+				ResourceName:      "google_monitoring_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMonitoringDashboard_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringDashboardDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringDashboard_basic(suffix),
+			},
+			{
+				ResourceName:      "google_monitoring_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitoringDashboard_gridLayout(suffix),
+			},
+			{
+				ResourceName:      "google_monitoring_dashboard.dashboard",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMonitoringDashboardDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_monitoring_dashboard" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := "https://monitoring.googleapis.com/v1/" + rs.Primary.ID
+		_, err := sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("MonitoringDashboard still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccMonitoringDashboard_basic(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_dashboard" "dashboard" {
+  dashboard_json = <<EOF
+{
+  "displayName": "Demo Dashboard %s",
+  "gridLayout": {
+    "widgets": [
+      {
+        "blank": {}
+      }
+    ]
+  }
+}
+EOF
+}
+`, suffix)
+}
+
+func testAccMonitoringDashboard_gridLayout(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_dashboard" "dashboard" {
+  dashboard_json = <<EOF
+{
+  "displayName": "Grid Layout Example %s",
+  "gridLayout": {
+    "columns": "2",
+    "widgets": [
+      {
+        "title": "Widget 1",
+        "xyChart": {
+          "dataSets": [{
+            "timeSeriesQuery": {
+              "timeSeriesFilter": {
+                "filter": "metric.type=\"agent.googleapis.com/nginx/connections/accepted_count\"",
+                "aggregation": {
+                  "perSeriesAligner": "ALIGN_RATE"
+                }
+              },
+              "unitOverride": "1"
+            },
+            "plotType": "LINE"
+          }],
+          "timeshiftDuration": "0s",
+          "yAxis": {
+            "label": "y1Axis",
+            "scale": "LINEAR"
+          }
+        }
+      },
+      {
+        "text": {
+          "content": "Widget 2",
+          "format": "MARKDOWN"
+        }
+      }
+    ]
+  }
+}
+EOF
+}
+`, suffix)
+}
@@ -0,0 +1,219 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceMonitoringMonitoredProject() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMonitoringMonitoredProjectCreate,
+		Read:   resourceMonitoringMonitoredProjectRead,
+		Delete: resourceMonitoringMonitoredProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMonitoringMonitoredProjectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metrics_scope": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMonitoringMonitoredProjectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	nameProp, err := expandMonitoringMonitoredProjectName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v1/locations/global/metricsScopes/{{metrics_scope}}/projects")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new MonitoredProject: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating MonitoredProject: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "locations/global/metricsScopes/{{metrics_scope}}/projects/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := monitoringOperationWaitTime(
+		config, res, project, "Creating MonitoredProject",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create MonitoredProject: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating MonitoredProject %q: %#v", d.Id(), res)
+
+	return resourceMonitoringMonitoredProjectRead(d, meta)
+}
+
+func resourceMonitoringMonitoredProjectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v1/locations/global/metricsScopes/{{metrics_scope}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MonitoringMonitoredProject %q", d.Id()))
+	}
+
+	// Monitored projects can't be read on their own, so look for this one in
+	// the list held by its metrics scope.
+	res, err = findMonitoringMonitoredProjectInScope(d, config, res)
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		log.Printf("[WARN] Removing MonitoringMonitoredProject %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("name", flattenMonitoringMonitoredProjectName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading MonitoredProject: %s", err)
+	}
+	if err := d.Set("create_time", flattenMonitoringMonitoredProjectCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading MonitoredProject: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMonitoringMonitoredProjectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v1/locations/global/metricsScopes/{{metrics_scope}}/projects/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting MonitoredProject %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "MonitoredProject")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = monitoringOperationWaitTime(
+		config, res, project, "Deleting MonitoredProject",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting MonitoredProject %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceMonitoringMonitoredProjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"v1/locations/global/metricsScopes/(?P<metrics_scope>[^/]+)/projects/(?P<name>[^/]+)", "locations/global/metricsScopes/(?P<metrics_scope>[^/]+)/projects/(?P<name>[^/]+)", "(?P<metrics_scope>[^/]+)/(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "locations/global/metricsScopes/{{metrics_scope}}/projects/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenMonitoringMonitoredProjectName(v interface{}, d *schema.ResourceData) interface{} {
+	// The API reports the monitored project by number; keep whichever form
+	// was configured.
+	return d.Get("name")
+}
+
+func flattenMonitoringMonitoredProjectCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandMonitoringMonitoredProjectName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return replaceVars(d, config, "locations/global/metricsScopes/{{metrics_scope}}/projects/{{name}}")
+}
+
+func findMonitoringMonitoredProjectInScope(d *schema.ResourceData, config *Config, scope map[string]interface{}) (map[string]interface{}, error) {
+	name := d.Get("name").(string)
+	candidates := []string{name}
+	// Entries are keyed by project number, so look it up when given an ID.
+	if _, err := strconv.ParseInt(name, 10, 64); err != nil {
+		p, err := config.clientResourceManager.Projects.Get(name).Do()
+		if err != nil {
+			return nil, handleNotFoundError(err, d, fmt.Sprintf("Project %q", name))
+		}
+		candidates = append(candidates, strconv.FormatInt(p.ProjectNumber, 10))
+	}
+
+	monitoredProjects, _ := scope["monitoredProjects"].([]interface{})
+	for _, raw := range monitoredProjects {
+		monitoredProject, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		id := GetResourceNameFromSelfLink(monitoredProject["name"].(string))
+		for _, candidate := range candidates {
+			if id == candidate {
+				return monitoredProject, nil
+			}
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,88 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMonitoringMonitoredProject_monitoringMonitoredProjectBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project_id":    getTestProjectFromEnv(),
+		"org_id":        getTestOrgFromEnv(t),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringMonitoredProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringMonitoredProject_monitoringMonitoredProjectBasicExample(context),
+			},
+			{
+				ResourceName:      "google_monitoring_monitored_project.primary",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitoringMonitoredProject_monitoringMonitoredProjectBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_monitoring_monitored_project" "primary" {
+  metrics_scope = "%{project_id}"
+  name          = "${google_project.basic.number}"
+}
+
+resource "google_project" "basic" {
+  project_id = "tf-test-m-id%{random_suffix}"
+  name       = "tf-test-m-id%{random_suffix}-display"
+  org_id     = "%{org_id}"
+}
+`, context)
+}
+
+func testAccCheckMonitoringMonitoredProjectDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_monitoring_monitored_project" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://monitoring.googleapis.com/v1/locations/global/metricsScopes/{{metrics_scope}}")
+		if err != nil {
+			return err
+		}
+
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				continue
+			}
+			return err
+		}
+
+		monitoredProjects, _ := res["monitoredProjects"].([]interface{})
+		for _, raw := range monitoredProjects {
+			monitoredProject := raw.(map[string]interface{})
+			if GetResourceNameFromSelfLink(monitoredProject["name"].(string)) == rs.Primary.Attributes["name"] {
+				return fmt.Errorf("MonitoringMonitoredProject still exists at %s", url)
+			}
+		}
+	}
+
+	return nil
+}
@@ -23,6 +23,21 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
+// sensitiveLabels are the labels that can be set through the sensitive_labels
+// block, so their values aren't shown in plans.
+var sensitiveLabels = []string{"auth_token", "service_key", "password"}
+
+func sensitiveLabelCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	for _, sl := range sensitiveLabels {
+		mapLabel := diff.Get("labels." + sl).(string)
+		authLabel := diff.Get("sensitive_labels.0." + sl).(string)
+		if mapLabel != "" && authLabel != "" {
+			return fmt.Errorf("Sensitive label [%s] cannot be set in both `labels` and the `sensitive_labels` block.", sl)
+		}
+	}
+	return nil
+}
+
 func resourceMonitoringNotificationChannel() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceMonitoringNotificationChannelCreate,
@@ -40,6 +55,8 @@ func resourceMonitoringNotificationChannel() *schema.Resource {
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
 
+		CustomizeDiff: sensitiveLabelCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"display_name": {
 				Type:     schema.TypeString,
@@ -63,6 +80,33 @@ func resourceMonitoringNotificationChannel() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"sensitive_labels": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auth_token": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"sensitive_labels.0.password", "sensitive_labels.0.service_key"},
+						},
+						"password": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"sensitive_labels.0.auth_token", "sensitive_labels.0.service_key"},
+						},
+						"service_key": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							Sensitive:     true,
+							ConflictsWith: []string{"sensitive_labels.0.auth_token", "sensitive_labels.0.password"},
+						},
+					},
+				},
+			},
 			"user_labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -127,6 +171,11 @@ func resourceMonitoringNotificationChannelCreate(d *schema.ResourceData, meta in
 		obj["enabled"] = enabledProp
 	}
 
+	obj, err = resourceMonitoringNotificationChannelEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
 	lockName, err := replaceVars(d, config, "stackdriver/notifications/{{project}}")
 	if err != nil {
 		return err
@@ -201,6 +250,9 @@ func resourceMonitoringNotificationChannelRead(d *schema.ResourceData, meta inte
 	if err := d.Set("user_labels", flattenMonitoringNotificationChannelUserLabels(res["userLabels"], d)); err != nil {
 		return fmt.Errorf("Error reading NotificationChannel: %s", err)
 	}
+	if err := d.Set("sensitive_labels", flattenMonitoringNotificationChannelSensitiveLabels(res["sensitiveLabels"], d)); err != nil {
+		return fmt.Errorf("Error reading NotificationChannel: %s", err)
+	}
 	if err := d.Set("description", flattenMonitoringNotificationChannelDescription(res["description"], d)); err != nil {
 		return fmt.Errorf("Error reading NotificationChannel: %s", err)
 	}
@@ -255,6 +307,11 @@ func resourceMonitoringNotificationChannelUpdate(d *schema.ResourceData, meta in
 		obj["enabled"] = enabledProp
 	}
 
+	obj, err = resourceMonitoringNotificationChannelEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
 	lockName, err := replaceVars(d, config, "stackdriver/notifications/{{project}}")
 	if err != nil {
 		return err
@@ -326,6 +383,13 @@ func flattenMonitoringNotificationChannelLabels(v interface{}, d *schema.Resourc
 	}
 	readLabels := v.(map[string]interface{})
 
+	// Labels set through sensitive_labels are read back into that block instead.
+	for _, sl := range sensitiveLabels {
+		if _, ok := d.GetOk("sensitive_labels.0." + sl); ok {
+			delete(readLabels, sl)
+		}
+	}
+
 	stateLabelsRaw, ok := d.GetOk("labels")
 	if !ok {
 		return v
@@ -380,6 +444,11 @@ func flattenMonitoringNotificationChannelUserLabels(v interface{}, d *schema.Res
 	return v
 }
 
+func flattenMonitoringNotificationChannelSensitiveLabels(v interface{}, d *schema.ResourceData) interface{} {
+	// The API never returns sensitive labels unobfuscated, keep the configured values.
+	return d.Get("sensitive_labels")
+}
+
 func flattenMonitoringNotificationChannelDescription(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -429,3 +498,22 @@ func expandMonitoringNotificationChannelDisplayName(v interface{}, d TerraformRe
 func expandMonitoringNotificationChannelEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
+
+func resourceMonitoringNotificationChannelEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	labels, ok := obj["labels"].(map[string]string)
+	if !ok {
+		labels = make(map[string]string)
+	}
+
+	for _, sl := range sensitiveLabels {
+		if auth, ok := d.GetOk("sensitive_labels.0." + sl); ok {
+			labels[sl] = auth.(string)
+		}
+	}
+
+	if len(labels) > 0 {
+		obj["labels"] = labels
+	}
+
+	return obj, nil
+}
@@ -56,6 +56,45 @@ func TestAccMonitoringNotificationChannel_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitoringNotificationChannel_updateSensitiveLabels(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringNotificationChannel_updateSensitiveLabels("slack", "channel_name", "#foobar", "auth_token", "one"),
+			},
+			{
+				ResourceName:            "google_monitoring_notification_channel.update",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sensitive_labels"},
+			},
+			{
+				Config: testAccMonitoringNotificationChannel_updateSensitiveLabels("slack", "channel_name", "#foobar", "auth_token", "two"),
+			},
+			{
+				ResourceName:            "google_monitoring_notification_channel.update",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sensitive_labels"},
+			},
+			{
+				Config: testAccMonitoringNotificationChannel_updateSensitiveLabels("pagerduty", "channel_name", "#foobar", "service_key", "some_service_key"),
+			},
+			{
+				ResourceName:            "google_monitoring_notification_channel.update",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sensitive_labels"},
+			},
+		},
+	})
+}
+
 func testAccMonitoringNotificationChannel_update(channel, labels string) string {
 	return fmt.Sprintf(`
 resource "google_monitoring_notification_channel" "update" {
@@ -68,3 +107,19 @@ resource "google_monitoring_notification_channel" "update" {
 `, channel, labels,
 	)
 }
+
+func testAccMonitoringNotificationChannel_updateSensitiveLabels(channel, labelKey, labelValue, sensitiveKey, sensitiveValue string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_notification_channel" "update" {
+  display_name = "IntTest Notification Channel"
+  type         = "%s"
+  labels = {
+    %s = "%s"
+  }
+  sensitive_labels {
+    %s = "%s"
+  }
+}
+`, channel, labelKey, labelValue, sensitiveKey, sensitiveValue,
+	)
+}
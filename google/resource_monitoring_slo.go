@@ -0,0 +1,1442 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceMonitoringSlo() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMonitoringSloCreate,
+		Read:   resourceMonitoringSloRead,
+		Update: resourceMonitoringSloUpdate,
+		Delete: resourceMonitoringSloDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceMonitoringSloImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"goal": {
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"calendar_period": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"DAY", "WEEK", "FORTNIGHT", "MONTH", ""}, false),
+				ConflictsWith: []string{"rolling_period_days"},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"request_based_sli": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"distribution_cut": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"distribution_filter": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"range": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"min": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+							ConflictsWith: []string{"request_based_sli.0.good_total_ratio"},
+						},
+						"good_total_ratio": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bad_service_filter": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"good_service_filter": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"total_service_filter": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+							ConflictsWith: []string{"request_based_sli.0.distribution_cut"},
+						},
+					},
+				},
+				ConflictsWith: []string{"windows_based_sli"},
+			},
+			"rolling_period_days": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 30),
+				ConflictsWith: []string{"calendar_period"},
+			},
+			"slo_id": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^[a-z0-9\-]+$`),
+			},
+			"windows_based_sli": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"good_bad_metric_filter": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ConflictsWith: []string{"windows_based_sli.0.good_total_ratio_threshold", "windows_based_sli.0.metric_mean_in_range", "windows_based_sli.0.metric_sum_in_range"},
+						},
+						"good_total_ratio_threshold": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"performance": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"distribution_cut": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"distribution_filter": {
+																Type:     schema.TypeString,
+																Required: true,
+															},
+															"range": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"max": {
+																			Type:     schema.TypeFloat,
+																			Optional: true,
+																		},
+																		"min": {
+																			Type:     schema.TypeFloat,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+													ConflictsWith: []string{"windows_based_sli.0.good_total_ratio_threshold.0.performance.0.good_total_ratio"},
+												},
+												"good_total_ratio": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"bad_service_filter": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"good_service_filter": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+															"total_service_filter": {
+																Type:     schema.TypeString,
+																Optional: true,
+															},
+														},
+													},
+													ConflictsWith: []string{"windows_based_sli.0.good_total_ratio_threshold.0.performance.0.distribution_cut"},
+												},
+											},
+										},
+									},
+									"threshold": {
+										Type:     schema.TypeFloat,
+										Optional: true,
+									},
+								},
+							},
+							ConflictsWith: []string{"windows_based_sli.0.good_bad_metric_filter", "windows_based_sli.0.metric_mean_in_range", "windows_based_sli.0.metric_sum_in_range"},
+						},
+						"metric_mean_in_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"range": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"min": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"time_series": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							ConflictsWith: []string{"windows_based_sli.0.good_bad_metric_filter", "windows_based_sli.0.good_total_ratio_threshold", "windows_based_sli.0.metric_sum_in_range"},
+						},
+						"metric_sum_in_range": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"range": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+												"min": {
+													Type:     schema.TypeFloat,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"time_series": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+							ConflictsWith: []string{"windows_based_sli.0.good_bad_metric_filter", "windows_based_sli.0.good_total_ratio_threshold", "windows_based_sli.0.metric_mean_in_range"},
+						},
+						"window_period": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				ConflictsWith: []string{"request_based_sli"},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMonitoringSloCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandMonitoringSloDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	goalProp, err := expandMonitoringSloGoal(d.Get("goal"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("goal"); !isEmptyValue(reflect.ValueOf(goalProp)) && (ok || !reflect.DeepEqual(v, goalProp)) {
+		obj["goal"] = goalProp
+	}
+	rollingPeriodDaysProp, err := expandMonitoringSloRollingPeriodDays(d.Get("rolling_period_days"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rolling_period_days"); !isEmptyValue(reflect.ValueOf(rollingPeriodDaysProp)) && (ok || !reflect.DeepEqual(v, rollingPeriodDaysProp)) {
+		obj["rollingPeriod"] = rollingPeriodDaysProp
+	}
+	calendarPeriodProp, err := expandMonitoringSloCalendarPeriod(d.Get("calendar_period"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("calendar_period"); !isEmptyValue(reflect.ValueOf(calendarPeriodProp)) && (ok || !reflect.DeepEqual(v, calendarPeriodProp)) {
+		obj["calendarPeriod"] = calendarPeriodProp
+	}
+	requestBasedSliProp, err := expandMonitoringSloRequestBasedSli(d.Get("request_based_sli"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("request_based_sli"); !isEmptyValue(reflect.ValueOf(requestBasedSliProp)) && (ok || !reflect.DeepEqual(v, requestBasedSliProp)) {
+		obj["requestBasedSli"] = requestBasedSliProp
+	}
+	windowsBasedSliProp, err := expandMonitoringSloWindowsBasedSli(d.Get("windows_based_sli"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("windows_based_sli"); !isEmptyValue(reflect.ValueOf(windowsBasedSliProp)) && (ok || !reflect.DeepEqual(v, windowsBasedSliProp)) {
+		obj["windowsBasedSli"] = windowsBasedSliProp
+	}
+	obj, err = resourceMonitoringSloEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/projects/{{project}}/services/{{service}}/serviceLevelObjectives?serviceLevelObjectiveId={{slo_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Slo: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Slo: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Slo %q: %#v", d.Id(), res)
+
+	// `name` is autogenerated from the api so needs to be set post-create
+	name, ok := res["name"]
+	if !ok {
+		return fmt.Errorf("Create response didn't contain critical fields. Create may not have succeeded.")
+	}
+	d.Set("name", name.(string))
+	d.SetId(name.(string))
+
+	return resourceMonitoringSloRead(d, meta)
+}
+
+func resourceMonitoringSloRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("MonitoringSlo %q", d.Id()))
+	}
+
+	res, err = resourceMonitoringSloDecoder(d, meta, res)
+	if err != nil {
+		return err
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+
+	// The service and SLO ids are only part of the name in API responses.
+	nameParts := strings.Split(res["name"].(string), "/")
+	if err := d.Set("service", nameParts[3]); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("slo_id", nameParts[5]); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+
+	if err := d.Set("name", flattenMonitoringSloName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("display_name", flattenMonitoringSloDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("goal", flattenMonitoringSloGoal(res["goal"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("rolling_period_days", flattenMonitoringSloRollingPeriodDays(res["rollingPeriod"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("calendar_period", flattenMonitoringSloCalendarPeriod(res["calendarPeriod"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("request_based_sli", flattenMonitoringSloRequestBasedSli(res["requestBasedSli"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+	if err := d.Set("windows_based_sli", flattenMonitoringSloWindowsBasedSli(res["windowsBasedSli"], d)); err != nil {
+		return fmt.Errorf("Error reading Slo: %s", err)
+	}
+
+	return nil
+}
+
+func resourceMonitoringSloUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandMonitoringSloDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	goalProp, err := expandMonitoringSloGoal(d.Get("goal"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("goal"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, goalProp)) {
+		obj["goal"] = goalProp
+	}
+	rollingPeriodDaysProp, err := expandMonitoringSloRollingPeriodDays(d.Get("rolling_period_days"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rolling_period_days"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, rollingPeriodDaysProp)) {
+		obj["rollingPeriod"] = rollingPeriodDaysProp
+	}
+	calendarPeriodProp, err := expandMonitoringSloCalendarPeriod(d.Get("calendar_period"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("calendar_period"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, calendarPeriodProp)) {
+		obj["calendarPeriod"] = calendarPeriodProp
+	}
+	requestBasedSliProp, err := expandMonitoringSloRequestBasedSli(d.Get("request_based_sli"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("request_based_sli"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, requestBasedSliProp)) {
+		obj["requestBasedSli"] = requestBasedSliProp
+	}
+	windowsBasedSliProp, err := expandMonitoringSloWindowsBasedSli(d.Get("windows_based_sli"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("windows_based_sli"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, windowsBasedSliProp)) {
+		obj["windowsBasedSli"] = windowsBasedSliProp
+	}
+	obj, err = resourceMonitoringSloEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Slo %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("goal") {
+		updateMask = append(updateMask, "goal")
+	}
+
+	if d.HasChange("rolling_period_days") {
+		updateMask = append(updateMask, "rollingPeriod")
+	}
+
+	if d.HasChange("calendar_period") {
+		updateMask = append(updateMask, "calendarPeriod")
+	}
+
+	if d.HasChange("request_based_sli") {
+		updateMask = append(updateMask, "serviceLevelIndicator.requestBased")
+	}
+
+	if d.HasChange("windows_based_sli") {
+		updateMask = append(updateMask, "serviceLevelIndicator.windowsBased")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Slo %q: %s", d.Id(), err)
+	}
+
+	return resourceMonitoringSloRead(d, meta)
+}
+
+func resourceMonitoringSloDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://monitoring.googleapis.com/v3/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Slo %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Slo")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Slo %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceMonitoringSloImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenMonitoringSloName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloGoal(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRollingPeriodDays(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	if v.(string) == "" {
+		return nil
+	}
+	dur, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil
+	}
+	return int(dur / (time.Hour * 24))
+}
+
+func flattenMonitoringSloCalendarPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSli(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["distribution_cut"] =
+		flattenMonitoringSloRequestBasedSliDistributionCut(original["distributionCut"], d)
+	transformed["good_total_ratio"] =
+		flattenMonitoringSloRequestBasedSliGoodTotalRatio(original["goodTotalRatio"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloRequestBasedSliDistributionCut(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["distribution_filter"] =
+		flattenMonitoringSloRequestBasedSliDistributionCutDistributionFilter(original["distributionFilter"], d)
+	transformed["range"] =
+		flattenMonitoringSloRequestBasedSliDistributionCutRange(original["range"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloRequestBasedSliDistributionCutDistributionFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSliDistributionCutRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min"] =
+		flattenMonitoringSloRequestBasedSliDistributionCutRangeMin(original["min"], d)
+	transformed["max"] =
+		flattenMonitoringSloRequestBasedSliDistributionCutRangeMax(original["max"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloRequestBasedSliDistributionCutRangeMin(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSliDistributionCutRangeMax(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSliGoodTotalRatio(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["good_service_filter"] =
+		flattenMonitoringSloRequestBasedSliGoodTotalRatioGoodServiceFilter(original["goodServiceFilter"], d)
+	transformed["bad_service_filter"] =
+		flattenMonitoringSloRequestBasedSliGoodTotalRatioBadServiceFilter(original["badServiceFilter"], d)
+	transformed["total_service_filter"] =
+		flattenMonitoringSloRequestBasedSliGoodTotalRatioTotalServiceFilter(original["totalServiceFilter"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloRequestBasedSliGoodTotalRatioGoodServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSliGoodTotalRatioBadServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloRequestBasedSliGoodTotalRatioTotalServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSli(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["window_period"] =
+		flattenMonitoringSloWindowsBasedSliWindowPeriod(original["windowPeriod"], d)
+	transformed["good_bad_metric_filter"] =
+		flattenMonitoringSloWindowsBasedSliGoodBadMetricFilter(original["goodBadMetricFilter"], d)
+	transformed["good_total_ratio_threshold"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThreshold(original["goodTotalRatioThreshold"], d)
+	transformed["metric_mean_in_range"] =
+		flattenMonitoringSloWindowsBasedSliMetricMeanInRange(original["metricMeanInRange"], d)
+	transformed["metric_sum_in_range"] =
+		flattenMonitoringSloWindowsBasedSliMetricSumInRange(original["metricSumInRange"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliWindowPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodBadMetricFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThreshold(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["threshold"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdThreshold(original["threshold"], d)
+	transformed["performance"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformance(original["performance"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdThreshold(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformance(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["good_total_ratio"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatio(original["goodTotalRatio"], d)
+	transformed["distribution_cut"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCut(original["distributionCut"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatio(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["good_service_filter"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioGoodServiceFilter(original["goodServiceFilter"], d)
+	transformed["bad_service_filter"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioBadServiceFilter(original["badServiceFilter"], d)
+	transformed["total_service_filter"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioTotalServiceFilter(original["totalServiceFilter"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioGoodServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioBadServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioTotalServiceFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCut(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["distribution_filter"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutDistributionFilter(original["distributionFilter"], d)
+	transformed["range"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRange(original["range"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutDistributionFilter(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMin(original["min"], d)
+	transformed["max"] =
+		flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMax(original["max"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMin(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMax(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricMeanInRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["time_series"] =
+		flattenMonitoringSloWindowsBasedSliMetricMeanInRangeTimeSeries(original["timeSeries"], d)
+	transformed["range"] =
+		flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRange(original["range"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricMeanInRangeTimeSeries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min"] =
+		flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMin(original["min"], d)
+	transformed["max"] =
+		flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMax(original["max"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMin(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMax(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricSumInRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["time_series"] =
+		flattenMonitoringSloWindowsBasedSliMetricSumInRangeTimeSeries(original["timeSeries"], d)
+	transformed["range"] =
+		flattenMonitoringSloWindowsBasedSliMetricSumInRangeRange(original["range"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricSumInRangeTimeSeries(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricSumInRangeRange(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["min"] =
+		flattenMonitoringSloWindowsBasedSliMetricSumInRangeRangeMin(original["min"], d)
+	transformed["max"] =
+		flattenMonitoringSloWindowsBasedSliMetricSumInRangeRangeMax(original["max"], d)
+	return []interface{}{transformed}
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricSumInRangeRangeMin(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringSloWindowsBasedSliMetricSumInRangeRangeMax(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandMonitoringSloDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloGoal(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRollingPeriodDays(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	i, ok := v.(int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value is not int: %v", v)
+	}
+	if i == 0 {
+		return "", nil
+	}
+	// Day = 86400s
+	return fmt.Sprintf("%ds", i*86400), nil
+}
+
+func expandMonitoringSloCalendarPeriod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSli(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDistributionCut, err := expandMonitoringSloRequestBasedSliDistributionCut(original["distribution_cut"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDistributionCut); val.IsValid() && !isEmptyValue(val) {
+		transformed["distributionCut"] = transformedDistributionCut
+	}
+
+	transformedGoodTotalRatio, err := expandMonitoringSloRequestBasedSliGoodTotalRatio(original["good_total_ratio"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodTotalRatio); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodTotalRatio"] = transformedGoodTotalRatio
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloRequestBasedSliDistributionCut(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDistributionFilter, err := expandMonitoringSloRequestBasedSliDistributionCutDistributionFilter(original["distribution_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDistributionFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["distributionFilter"] = transformedDistributionFilter
+	}
+
+	transformedRange, err := expandMonitoringSloRequestBasedSliDistributionCutRange(original["range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["range"] = transformedRange
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloRequestBasedSliDistributionCutDistributionFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSliDistributionCutRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMin, err := expandMonitoringSloRequestBasedSliDistributionCutRangeMin(original["min"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMin); val.IsValid() && !isEmptyValue(val) {
+		transformed["min"] = transformedMin
+	}
+
+	transformedMax, err := expandMonitoringSloRequestBasedSliDistributionCutRangeMax(original["max"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMax); val.IsValid() && !isEmptyValue(val) {
+		transformed["max"] = transformedMax
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloRequestBasedSliDistributionCutRangeMin(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSliDistributionCutRangeMax(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSliGoodTotalRatio(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedGoodServiceFilter, err := expandMonitoringSloRequestBasedSliGoodTotalRatioGoodServiceFilter(original["good_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodServiceFilter"] = transformedGoodServiceFilter
+	}
+
+	transformedBadServiceFilter, err := expandMonitoringSloRequestBasedSliGoodTotalRatioBadServiceFilter(original["bad_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBadServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["badServiceFilter"] = transformedBadServiceFilter
+	}
+
+	transformedTotalServiceFilter, err := expandMonitoringSloRequestBasedSliGoodTotalRatioTotalServiceFilter(original["total_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTotalServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["totalServiceFilter"] = transformedTotalServiceFilter
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloRequestBasedSliGoodTotalRatioGoodServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSliGoodTotalRatioBadServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloRequestBasedSliGoodTotalRatioTotalServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSli(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedWindowPeriod, err := expandMonitoringSloWindowsBasedSliWindowPeriod(original["window_period"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWindowPeriod); val.IsValid() && !isEmptyValue(val) {
+		transformed["windowPeriod"] = transformedWindowPeriod
+	}
+
+	transformedGoodBadMetricFilter, err := expandMonitoringSloWindowsBasedSliGoodBadMetricFilter(original["good_bad_metric_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodBadMetricFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodBadMetricFilter"] = transformedGoodBadMetricFilter
+	}
+
+	transformedGoodTotalRatioThreshold, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThreshold(original["good_total_ratio_threshold"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodTotalRatioThreshold); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodTotalRatioThreshold"] = transformedGoodTotalRatioThreshold
+	}
+
+	transformedMetricMeanInRange, err := expandMonitoringSloWindowsBasedSliMetricMeanInRange(original["metric_mean_in_range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetricMeanInRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["metricMeanInRange"] = transformedMetricMeanInRange
+	}
+
+	transformedMetricSumInRange, err := expandMonitoringSloWindowsBasedSliMetricSumInRange(original["metric_sum_in_range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMetricSumInRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["metricSumInRange"] = transformedMetricSumInRange
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliWindowPeriod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodBadMetricFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThreshold(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedThreshold, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdThreshold(original["threshold"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedThreshold); val.IsValid() && !isEmptyValue(val) {
+		transformed["threshold"] = transformedThreshold
+	}
+
+	transformedPerformance, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformance(original["performance"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPerformance); val.IsValid() && !isEmptyValue(val) {
+		transformed["performance"] = transformedPerformance
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdThreshold(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformance(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedGoodTotalRatio, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatio(original["good_total_ratio"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodTotalRatio); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodTotalRatio"] = transformedGoodTotalRatio
+	}
+
+	transformedDistributionCut, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCut(original["distribution_cut"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDistributionCut); val.IsValid() && !isEmptyValue(val) {
+		transformed["distributionCut"] = transformedDistributionCut
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatio(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedGoodServiceFilter, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioGoodServiceFilter(original["good_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGoodServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["goodServiceFilter"] = transformedGoodServiceFilter
+	}
+
+	transformedBadServiceFilter, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioBadServiceFilter(original["bad_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBadServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["badServiceFilter"] = transformedBadServiceFilter
+	}
+
+	transformedTotalServiceFilter, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioTotalServiceFilter(original["total_service_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTotalServiceFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["totalServiceFilter"] = transformedTotalServiceFilter
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioGoodServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioBadServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceGoodTotalRatioTotalServiceFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCut(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDistributionFilter, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutDistributionFilter(original["distribution_filter"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDistributionFilter); val.IsValid() && !isEmptyValue(val) {
+		transformed["distributionFilter"] = transformedDistributionFilter
+	}
+
+	transformedRange, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRange(original["range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["range"] = transformedRange
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutDistributionFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMin, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMin(original["min"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMin); val.IsValid() && !isEmptyValue(val) {
+		transformed["min"] = transformedMin
+	}
+
+	transformedMax, err := expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMax(original["max"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMax); val.IsValid() && !isEmptyValue(val) {
+		transformed["max"] = transformedMax
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMin(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliGoodTotalRatioThresholdPerformanceDistributionCutRangeMax(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricMeanInRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTimeSeries, err := expandMonitoringSloWindowsBasedSliMetricMeanInRangeTimeSeries(original["time_series"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTimeSeries); val.IsValid() && !isEmptyValue(val) {
+		transformed["timeSeries"] = transformedTimeSeries
+	}
+
+	transformedRange, err := expandMonitoringSloWindowsBasedSliMetricMeanInRangeRange(original["range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["range"] = transformedRange
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricMeanInRangeTimeSeries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricMeanInRangeRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMin, err := expandMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMin(original["min"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMin); val.IsValid() && !isEmptyValue(val) {
+		transformed["min"] = transformedMin
+	}
+
+	transformedMax, err := expandMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMax(original["max"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMax); val.IsValid() && !isEmptyValue(val) {
+		transformed["max"] = transformedMax
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMin(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricMeanInRangeRangeMax(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricSumInRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTimeSeries, err := expandMonitoringSloWindowsBasedSliMetricSumInRangeTimeSeries(original["time_series"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTimeSeries); val.IsValid() && !isEmptyValue(val) {
+		transformed["timeSeries"] = transformedTimeSeries
+	}
+
+	transformedRange, err := expandMonitoringSloWindowsBasedSliMetricSumInRangeRange(original["range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["range"] = transformedRange
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricSumInRangeTimeSeries(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricSumInRangeRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedMin, err := expandMonitoringSloWindowsBasedSliMetricSumInRangeRangeMin(original["min"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMin); val.IsValid() && !isEmptyValue(val) {
+		transformed["min"] = transformedMin
+	}
+
+	transformedMax, err := expandMonitoringSloWindowsBasedSliMetricSumInRangeRangeMax(original["max"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMax); val.IsValid() && !isEmptyValue(val) {
+		transformed["max"] = transformedMax
+	}
+
+	return transformed, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricSumInRangeRangeMin(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringSloWindowsBasedSliMetricSumInRangeRangeMax(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func resourceMonitoringSloEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// The API nests the request and windows based SLIs in a single
+	// serviceLevelIndicator field, only one of which can be set.
+	sli := make(map[string]interface{})
+	if v, ok := obj["requestBasedSli"]; ok {
+		sli["requestBased"] = v
+		delete(obj, "requestBasedSli")
+	}
+	if v, ok := obj["windowsBasedSli"]; ok {
+		sli["windowsBased"] = v
+		delete(obj, "windowsBasedSli")
+	}
+	if len(sli) > 0 {
+		obj["serviceLevelIndicator"] = sli
+	}
+	return obj, nil
+}
+
+func resourceMonitoringSloDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
+	if sli, ok := res["serviceLevelIndicator"].(map[string]interface{}); ok {
+		res["requestBasedSli"] = sli["requestBased"]
+		res["windowsBasedSli"] = sli["windowsBased"]
+	}
+	return res, nil
+}
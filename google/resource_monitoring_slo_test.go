@@ -0,0 +1,141 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccMonitoringSlo_monitoringSloRequestBasedExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringSloDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringSlo_monitoringSloRequestBasedExample(context),
+			},
+			{
+				ResourceName:      "google_monitoring_slo.request_based_slo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitoringSlo_monitoringSloRequestBasedExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_monitoring_custom_service" "customsrv" {
+  service_id   = "custom-srv-request-slos%{random_suffix}"
+  display_name = "My Custom Service"
+}
+
+resource "google_monitoring_slo" "request_based_slo" {
+  service      = "${google_monitoring_custom_service.customsrv.service_id}"
+  slo_id       = "consumed-api-slo%{random_suffix}"
+  display_name = "Test SLO with request based SLI (good total ratio)"
+
+  goal                = 0.9
+  rolling_period_days = 30
+
+  request_based_sli {
+    distribution_cut {
+      distribution_filter = "metric.type=\"serviceruntime.googleapis.com/api/request_latencies\" resource.type=\"consumed_api\""
+
+      range {
+        max = 0.5
+      }
+    }
+  }
+}
+`, context)
+}
+
+func TestAccMonitoringSlo_monitoringSloWindowsBasedMetricSumExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringSloDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringSlo_monitoringSloWindowsBasedMetricSumExample(context),
+			},
+			{
+				ResourceName:      "google_monitoring_slo.windows_based",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMonitoringSlo_monitoringSloWindowsBasedMetricSumExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_monitoring_custom_service" "customsrv" {
+  service_id   = "custom-srv-windows-slos%{random_suffix}"
+  display_name = "My Custom Service"
+}
+
+resource "google_monitoring_slo" "windows_based" {
+  service      = "${google_monitoring_custom_service.customsrv.service_id}"
+  display_name = "Test SLO with window based SLI"
+
+  goal            = 0.95
+  calendar_period = "FORTNIGHT"
+
+  windows_based_sli {
+    window_period = "400s"
+
+    metric_sum_in_range {
+      time_series = "metric.type=\"monitoring.googleapis.com/uptime_check/request_latency\" resource.type=\"uptime_url\""
+
+      range {
+        max = 5000
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckMonitoringSloDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_monitoring_slo" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://monitoring.googleapis.com/v3/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("MonitoringSlo still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -61,6 +61,12 @@ func resourceMonitoringUptimeCheckConfig() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"matcher": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"CONTENT_MATCHER_OPTION_UNSPECIFIED", "CONTAINS_STRING", "NOT_CONTAINS_STRING", "MATCHES_REGEX", "NOT_MATCHES_REGEX", ""}, false),
+							Default:      "CONTAINS_STRING",
+						},
 					},
 				},
 			},
@@ -70,6 +76,23 @@ func resourceMonitoringUptimeCheckConfig() *schema.Resource {
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"accepted_response_status_codes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status_class": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"STATUS_CLASS_1XX", "STATUS_CLASS_2XX", "STATUS_CLASS_3XX", "STATUS_CLASS_4XX", "STATUS_CLASS_5XX", "STATUS_CLASS_ANY", ""}, false),
+									},
+									"status_value": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
 						"auth_info": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -88,6 +111,15 @@ func resourceMonitoringUptimeCheckConfig() *schema.Resource {
 								},
 							},
 						},
+						"body": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"TYPE_UNSPECIFIED", "URL_ENCODED", ""}, false),
+						},
 						"headers": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -107,10 +139,21 @@ func resourceMonitoringUptimeCheckConfig() *schema.Resource {
 							Computed: true,
 							Optional: true,
 						},
+						"request_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"METHOD_UNSPECIFIED", "GET", "POST", ""}, false),
+							Default:      "GET",
+						},
 						"use_ssl": {
 							Type:     schema.TypeBool,
 							Optional: true,
 						},
+						"validate_ssl": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 				ConflictsWith: []string{"tcp_check"},
@@ -576,6 +619,7 @@ func flattenMonitoringUptimeCheckConfigContentMatchers(v interface{}, d *schema.
 		}
 		transformed = append(transformed, map[string]interface{}{
 			"content": flattenMonitoringUptimeCheckConfigContentMatchersContent(original["content"], d),
+			"matcher": flattenMonitoringUptimeCheckConfigContentMatchersMatcher(original["matcher"], d),
 		})
 	}
 	return transformed
@@ -584,6 +628,10 @@ func flattenMonitoringUptimeCheckConfigContentMatchersContent(v interface{}, d *
 	return v
 }
 
+func flattenMonitoringUptimeCheckConfigContentMatchersMatcher(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenMonitoringUptimeCheckConfigSelectedRegions(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -655,6 +703,16 @@ func flattenMonitoringUptimeCheckConfigHttpCheck(v interface{}, d *schema.Resour
 		flattenMonitoringUptimeCheckConfigHttpCheckUseSsl(original["useSsl"], d)
 	transformed["mask_headers"] =
 		flattenMonitoringUptimeCheckConfigHttpCheckMaskHeaders(original["maskHeaders"], d)
+	transformed["request_method"] =
+		flattenMonitoringUptimeCheckConfigHttpCheckRequestMethod(original["requestMethod"], d)
+	transformed["content_type"] =
+		flattenMonitoringUptimeCheckConfigHttpCheckContentType(original["contentType"], d)
+	transformed["body"] =
+		flattenMonitoringUptimeCheckConfigHttpCheckBody(original["body"], d)
+	transformed["validate_ssl"] =
+		flattenMonitoringUptimeCheckConfigHttpCheckValidateSsl(original["validateSsl"], d)
+	transformed["accepted_response_status_codes"] =
+		flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodes(original["acceptedResponseStatusCodes"], d)
 	return []interface{}{transformed}
 }
 func flattenMonitoringUptimeCheckConfigHttpCheckAuthInfo(v interface{}, d *schema.ResourceData) interface{} {
@@ -706,6 +764,55 @@ func flattenMonitoringUptimeCheckConfigHttpCheckMaskHeaders(v interface{}, d *sc
 	return v
 }
 
+func flattenMonitoringUptimeCheckConfigHttpCheckRequestMethod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringUptimeCheckConfigHttpCheckContentType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringUptimeCheckConfigHttpCheckBody(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringUptimeCheckConfigHttpCheckValidateSsl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodes(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"status_value": flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusValue(original["statusValue"], d),
+			"status_class": flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusClass(original["statusClass"], d),
+		})
+	}
+	return transformed
+}
+func flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusValue(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusClass(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenMonitoringUptimeCheckConfigTcpCheck(v interface{}, d *schema.ResourceData) interface{} {
 	if v == nil {
 		return nil
@@ -805,6 +912,13 @@ func expandMonitoringUptimeCheckConfigContentMatchers(v interface{}, d Terraform
 			transformed["content"] = transformedContent
 		}
 
+		transformedMatcher, err := expandMonitoringUptimeCheckConfigContentMatchersMatcher(original["matcher"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMatcher); val.IsValid() && !isEmptyValue(val) {
+			transformed["matcher"] = transformedMatcher
+		}
+
 		req = append(req, transformed)
 	}
 	return req, nil
@@ -814,6 +928,10 @@ func expandMonitoringUptimeCheckConfigContentMatchersContent(v interface{}, d Te
 	return v, nil
 }
 
+func expandMonitoringUptimeCheckConfigContentMatchersMatcher(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandMonitoringUptimeCheckConfigSelectedRegions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return v, nil
 }
@@ -943,6 +1061,41 @@ func expandMonitoringUptimeCheckConfigHttpCheck(v interface{}, d TerraformResour
 		transformed["maskHeaders"] = transformedMaskHeaders
 	}
 
+	transformedRequestMethod, err := expandMonitoringUptimeCheckConfigHttpCheckRequestMethod(original["request_method"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRequestMethod); val.IsValid() && !isEmptyValue(val) {
+		transformed["requestMethod"] = transformedRequestMethod
+	}
+
+	transformedContentType, err := expandMonitoringUptimeCheckConfigHttpCheckContentType(original["content_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedContentType); val.IsValid() && !isEmptyValue(val) {
+		transformed["contentType"] = transformedContentType
+	}
+
+	transformedBody, err := expandMonitoringUptimeCheckConfigHttpCheckBody(original["body"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBody); val.IsValid() && !isEmptyValue(val) {
+		transformed["body"] = transformedBody
+	}
+
+	transformedValidateSsl, err := expandMonitoringUptimeCheckConfigHttpCheckValidateSsl(original["validate_ssl"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedValidateSsl); val.IsValid() && !isEmptyValue(val) {
+		transformed["validateSsl"] = transformedValidateSsl
+	}
+
+	transformedAcceptedResponseStatusCodes, err := expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodes(original["accepted_response_status_codes"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAcceptedResponseStatusCodes); val.IsValid() && !isEmptyValue(val) {
+		transformed["acceptedResponseStatusCodes"] = transformedAcceptedResponseStatusCodes
+	}
+
 	return transformed, nil
 }
 
@@ -1007,6 +1160,59 @@ func expandMonitoringUptimeCheckConfigHttpCheckMaskHeaders(v interface{}, d Terr
 	return v, nil
 }
 
+func expandMonitoringUptimeCheckConfigHttpCheckRequestMethod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckContentType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckBody(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckValidateSsl(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedStatusValue, err := expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusValue(original["status_value"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedStatusValue); val.IsValid() && !isEmptyValue(val) {
+			transformed["statusValue"] = transformedStatusValue
+		}
+
+		transformedStatusClass, err := expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusClass(original["status_class"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedStatusClass); val.IsValid() && !isEmptyValue(val) {
+			transformed["statusClass"] = transformedStatusClass
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusValue(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandMonitoringUptimeCheckConfigHttpCheckAcceptedResponseStatusCodesStatusClass(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandMonitoringUptimeCheckConfigTcpCheck(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
@@ -40,6 +40,36 @@ func TestAccMonitoringUptimeCheckConfig_update(t *testing.T) {
 	})
 }
 
+func TestAccMonitoringUptimeCheckConfig_post(t *testing.T) {
+	t.Parallel()
+	project := getTestProjectFromEnv()
+	host := "192.168.1.1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckMonitoringUptimeCheckConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMonitoringUptimeCheckConfig_post(project, host, "STATUS_CLASS_2XX"),
+			},
+			{
+				ResourceName:      "google_monitoring_uptime_check_config.http",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMonitoringUptimeCheckConfig_post(project, host, "STATUS_CLASS_ANY"),
+			},
+			{
+				ResourceName:      "google_monitoring_uptime_check_config.http",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // The second update should force a recreation of the uptime check because 'monitored_resource' isn't
 // updatable in place
 func TestAccMonitoringUptimeCheckConfig_changeNonUpdatableFields(t *testing.T) {
@@ -112,3 +142,42 @@ resource "google_monitoring_uptime_check_config" "http" {
 `, acctest.RandString(4), path, project, pwd, host,
 	)
 }
+
+func testAccMonitoringUptimeCheckConfig_post(project, host, statusClass string) string {
+	return fmt.Sprintf(`
+resource "google_monitoring_uptime_check_config" "http" {
+  display_name = "http-uptime-check-%s"
+  timeout      = "60s"
+
+  http_check {
+    path           = "/some-path"
+    port           = "8010"
+    request_method = "POST"
+    content_type   = "URL_ENCODED"
+    body           = "Zm9vJTI1M0RiYXI="
+    validate_ssl   = false
+
+    accepted_response_status_codes {
+      status_value = 302
+    }
+    accepted_response_status_codes {
+      status_class = "%s"
+    }
+  }
+
+  monitored_resource {
+    type = "uptime_url"
+    labels = {
+      project_id = "%s"
+      host       = "%s"
+    }
+  }
+
+  content_matchers {
+    content = "example"
+    matcher = "MATCHES_REGEX"
+  }
+}
+`, acctest.RandString(4), statusClass, project, host,
+	)
+}
@@ -0,0 +1,676 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceNetworkConnectivityPolicyBasedRoute() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkConnectivityPolicyBasedRouteCreate,
+		Read:   resourceNetworkConnectivityPolicyBasedRouteRead,
+		Delete: resourceNetworkConnectivityPolicyBasedRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceNetworkConnectivityPolicyBasedRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"protocol_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"IPV4"}, false),
+						},
+						"dest_range": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "0.0.0.0/0",
+						},
+						"ip_protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "ALL",
+						},
+						"src_range": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "0.0.0.0/0",
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"interconnect_attachment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"region": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"next_hop_ilb_ip": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"next_hop_other_routes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"DEFAULT_ROUTING", ""}, false),
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1000,
+			},
+			"virtual_machine": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kind": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"data": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"warning_message": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkConnectivityPolicyBasedRouteCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	descriptionProp, err := expandNetworkConnectivityPolicyBasedRouteDescription(d.Get("description"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("description"); !isEmptyValue(reflect.ValueOf(descriptionProp)) && (ok || !reflect.DeepEqual(v, descriptionProp)) {
+		obj["description"] = descriptionProp
+	}
+	labelsProp, err := expandNetworkConnectivityPolicyBasedRouteLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	networkProp, err := expandNetworkConnectivityPolicyBasedRouteNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	filterProp, err := expandNetworkConnectivityPolicyBasedRouteFilter(d.Get("filter"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("filter"); !isEmptyValue(reflect.ValueOf(filterProp)) && (ok || !reflect.DeepEqual(v, filterProp)) {
+		obj["filter"] = filterProp
+	}
+	nextHopIlbIpProp, err := expandNetworkConnectivityPolicyBasedRouteNextHopIlbIp(d.Get("next_hop_ilb_ip"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("next_hop_ilb_ip"); !isEmptyValue(reflect.ValueOf(nextHopIlbIpProp)) && (ok || !reflect.DeepEqual(v, nextHopIlbIpProp)) {
+		obj["nextHopIlbIp"] = nextHopIlbIpProp
+	}
+	nextHopOtherRoutesProp, err := expandNetworkConnectivityPolicyBasedRouteNextHopOtherRoutes(d.Get("next_hop_other_routes"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("next_hop_other_routes"); !isEmptyValue(reflect.ValueOf(nextHopOtherRoutesProp)) && (ok || !reflect.DeepEqual(v, nextHopOtherRoutesProp)) {
+		obj["nextHopOtherRoutes"] = nextHopOtherRoutesProp
+	}
+	priorityProp, err := expandNetworkConnectivityPolicyBasedRoutePriority(d.Get("priority"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("priority"); !isEmptyValue(reflect.ValueOf(priorityProp)) && (ok || !reflect.DeepEqual(v, priorityProp)) {
+		obj["priority"] = priorityProp
+	}
+	virtualMachineProp, err := expandNetworkConnectivityPolicyBasedRouteVirtualMachine(d.Get("virtual_machine"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("virtual_machine"); !isEmptyValue(reflect.ValueOf(virtualMachineProp)) && (ok || !reflect.DeepEqual(v, virtualMachineProp)) {
+		obj["virtualMachine"] = virtualMachineProp
+	}
+	interconnectAttachmentProp, err := expandNetworkConnectivityPolicyBasedRouteInterconnectAttachment(d.Get("interconnect_attachment"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("interconnect_attachment"); !isEmptyValue(reflect.ValueOf(interconnectAttachmentProp)) && (ok || !reflect.DeepEqual(v, interconnectAttachmentProp)) {
+		obj["interconnectAttachment"] = interconnectAttachmentProp
+	}
+
+	url, err := replaceVars(d, config, "https://networkconnectivity.googleapis.com/v1/projects/{{project}}/locations/global/policyBasedRoutes?policyBasedRouteId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new PolicyBasedRoute: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating PolicyBasedRoute: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/policyBasedRoutes/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := networkConnectivityOperationWaitTime(
+		config, res, project, "Creating PolicyBasedRoute",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create PolicyBasedRoute: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating PolicyBasedRoute %q: %#v", d.Id(), res)
+
+	return resourceNetworkConnectivityPolicyBasedRouteRead(d, meta)
+}
+
+func resourceNetworkConnectivityPolicyBasedRouteRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://networkconnectivity.googleapis.com/v1/projects/{{project}}/locations/global/policyBasedRoutes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("NetworkConnectivityPolicyBasedRoute %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+
+	if err := d.Set("description", flattenNetworkConnectivityPolicyBasedRouteDescription(res["description"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("labels", flattenNetworkConnectivityPolicyBasedRouteLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("network", flattenNetworkConnectivityPolicyBasedRouteNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("filter", flattenNetworkConnectivityPolicyBasedRouteFilter(res["filter"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("next_hop_ilb_ip", flattenNetworkConnectivityPolicyBasedRouteNextHopIlbIp(res["nextHopIlbIp"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("next_hop_other_routes", flattenNetworkConnectivityPolicyBasedRouteNextHopOtherRoutes(res["nextHopOtherRoutes"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("priority", flattenNetworkConnectivityPolicyBasedRoutePriority(res["priority"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("virtual_machine", flattenNetworkConnectivityPolicyBasedRouteVirtualMachine(res["virtualMachine"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("interconnect_attachment", flattenNetworkConnectivityPolicyBasedRouteInterconnectAttachment(res["interconnectAttachment"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("create_time", flattenNetworkConnectivityPolicyBasedRouteCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("update_time", flattenNetworkConnectivityPolicyBasedRouteUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("kind", flattenNetworkConnectivityPolicyBasedRouteKind(res["kind"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+	if err := d.Set("warnings", flattenNetworkConnectivityPolicyBasedRouteWarnings(res["warnings"], d)); err != nil {
+		return fmt.Errorf("Error reading PolicyBasedRoute: %s", err)
+	}
+
+	return nil
+}
+
+func resourceNetworkConnectivityPolicyBasedRouteDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://networkconnectivity.googleapis.com/v1/projects/{{project}}/locations/global/policyBasedRoutes/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting PolicyBasedRoute %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "PolicyBasedRoute")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = networkConnectivityOperationWaitTime(
+		config, res, project, "Deleting PolicyBasedRoute",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting PolicyBasedRoute %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceNetworkConnectivityPolicyBasedRouteImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/global/policyBasedRoutes/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/global/policyBasedRoutes/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteDescription(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	return ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteFilter(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["protocol_version"] =
+		flattenNetworkConnectivityPolicyBasedRouteFilterProtocolVersion(original["protocolVersion"], d)
+	transformed["ip_protocol"] =
+		flattenNetworkConnectivityPolicyBasedRouteFilterIpProtocol(original["ipProtocol"], d)
+	transformed["src_range"] =
+		flattenNetworkConnectivityPolicyBasedRouteFilterSrcRange(original["srcRange"], d)
+	transformed["dest_range"] =
+		flattenNetworkConnectivityPolicyBasedRouteFilterDestRange(original["destRange"], d)
+	return []interface{}{transformed}
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteFilterProtocolVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteFilterIpProtocol(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteFilterSrcRange(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteFilterDestRange(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteNextHopIlbIp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteNextHopOtherRoutes(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRoutePriority(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteVirtualMachine(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["tags"] =
+		flattenNetworkConnectivityPolicyBasedRouteVirtualMachineTags(original["tags"], d)
+	return []interface{}{transformed}
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteVirtualMachineTags(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteInterconnectAttachment(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["region"] =
+		flattenNetworkConnectivityPolicyBasedRouteInterconnectAttachmentRegion(original["region"], d)
+	return []interface{}{transformed}
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteInterconnectAttachmentRegion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteKind(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteWarnings(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"code":            flattenNetworkConnectivityPolicyBasedRouteWarningsCode(original["code"], d),
+			"data":            flattenNetworkConnectivityPolicyBasedRouteWarningsData(original["data"], d),
+			"warning_message": flattenNetworkConnectivityPolicyBasedRouteWarningsWarningMessage(original["warningMessage"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteWarningsCode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteWarningsData(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenNetworkConnectivityPolicyBasedRouteWarningsWarningMessage(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandNetworkConnectivityPolicyBasedRouteDescription(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteFilter(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedProtocolVersion, err := expandNetworkConnectivityPolicyBasedRouteFilterProtocolVersion(original["protocol_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedProtocolVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["protocolVersion"] = transformedProtocolVersion
+	}
+
+	transformedIpProtocol, err := expandNetworkConnectivityPolicyBasedRouteFilterIpProtocol(original["ip_protocol"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIpProtocol); val.IsValid() && !isEmptyValue(val) {
+		transformed["ipProtocol"] = transformedIpProtocol
+	}
+
+	transformedSrcRange, err := expandNetworkConnectivityPolicyBasedRouteFilterSrcRange(original["src_range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSrcRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["srcRange"] = transformedSrcRange
+	}
+
+	transformedDestRange, err := expandNetworkConnectivityPolicyBasedRouteFilterDestRange(original["dest_range"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDestRange); val.IsValid() && !isEmptyValue(val) {
+		transformed["destRange"] = transformedDestRange
+	}
+
+	return transformed, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteFilterProtocolVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteFilterIpProtocol(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteFilterSrcRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteFilterDestRange(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteNextHopIlbIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteNextHopOtherRoutes(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRoutePriority(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteVirtualMachine(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedTags, err := expandNetworkConnectivityPolicyBasedRouteVirtualMachineTags(original["tags"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTags); val.IsValid() && !isEmptyValue(val) {
+		transformed["tags"] = transformedTags
+	}
+
+	return transformed, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteVirtualMachineTags(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteInterconnectAttachment(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedRegion, err := expandNetworkConnectivityPolicyBasedRouteInterconnectAttachmentRegion(original["region"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRegion); val.IsValid() && !isEmptyValue(val) {
+		transformed["region"] = transformedRegion
+	}
+
+	return transformed, nil
+}
+
+func expandNetworkConnectivityPolicyBasedRouteInterconnectAttachmentRegion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
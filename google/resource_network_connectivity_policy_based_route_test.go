@@ -0,0 +1,93 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkConnectivityPolicyBasedRoute_networkConnectivityPolicyBasedRouteBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkConnectivityPolicyBasedRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNetworkConnectivityPolicyBasedRoute_networkConnectivityPolicyBasedRouteBasicExample(context),
+			},
+			{
+				ResourceName:      "google_network_connectivity_policy_based_route.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccNetworkConnectivityPolicyBasedRoute_networkConnectivityPolicyBasedRouteBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "my_network" {
+  name                    = "tf-test-my-network%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_network_connectivity_policy_based_route" "default" {
+  name        = "tf-test-my-pbr%{random_suffix}"
+  description = "My routing policy"
+  network     = "${google_compute_network.my_network.id}"
+  priority    = 2302
+
+  filter {
+    protocol_version = "IPV4"
+    ip_protocol      = "UDP"
+    src_range        = "10.0.0.0/24"
+    dest_range       = "0.0.0.0/0"
+  }
+
+  next_hop_other_routes = "DEFAULT_ROUTING"
+
+  virtual_machine {
+    tags = ["restricted"]
+  }
+
+  labels = {
+    env = "default"
+  }
+}
+`, context)
+}
+
+func testAccCheckNetworkConnectivityPolicyBasedRouteDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_network_connectivity_policy_based_route" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://networkconnectivity.googleapis.com/v1/projects/{{project}}/locations/global/policyBasedRoutes/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("NetworkConnectivityPolicyBasedRoute still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,838 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceOracleDatabaseAutonomousDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOracleDatabaseAutonomousDatabaseCreate,
+		Read:   resourceOracleDatabaseAutonomousDatabaseRead,
+		Delete: resourceOracleDatabaseAutonomousDatabaseDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOracleDatabaseAutonomousDatabaseImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(14400 * time.Second),
+			Delete: schema.DefaultTimeout(7200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autonomous_database_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_workload": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"OLTP", "DW", "AJD", "APEX"}, false),
+						},
+						"license_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"LICENSE_INCLUDED", "BRING_YOUR_OWN_LICENSE"}, false),
+						},
+						"backup_retention_period_days": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"character_set": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"compute_count": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"customer_contacts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"data_storage_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"data_storage_size_tb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"db_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"is_auto_scaling_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"is_storage_auto_scaling_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"maintenance_schedule_type": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"EARLY", "REGULAR", ""}, false),
+						},
+						"n_character_set": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"private_endpoint_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"private_endpoint_label": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"lifecycle_details": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oci_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ocid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"admin_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"database": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceOracleDatabaseAutonomousDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	databaseProp, err := expandOracleDatabaseAutonomousDatabaseDatabase(d.Get("database"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("database"); !isEmptyValue(reflect.ValueOf(databaseProp)) && (ok || !reflect.DeepEqual(v, databaseProp)) {
+		obj["database"] = databaseProp
+	}
+	displayNameProp, err := expandOracleDatabaseAutonomousDatabaseDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	adminPasswordProp, err := expandOracleDatabaseAutonomousDatabaseAdminPassword(d.Get("admin_password"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("admin_password"); !isEmptyValue(reflect.ValueOf(adminPasswordProp)) && (ok || !reflect.DeepEqual(v, adminPasswordProp)) {
+		obj["adminPassword"] = adminPasswordProp
+	}
+	labelsProp, err := expandOracleDatabaseAutonomousDatabaseLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	networkProp, err := expandOracleDatabaseAutonomousDatabaseNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	cidrProp, err := expandOracleDatabaseAutonomousDatabaseCidr(d.Get("cidr"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("cidr"); !isEmptyValue(reflect.ValueOf(cidrProp)) && (ok || !reflect.DeepEqual(v, cidrProp)) {
+		obj["cidr"] = cidrProp
+	}
+	propertiesProp, err := expandOracleDatabaseAutonomousDatabaseProperties(d.Get("properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("properties"); !isEmptyValue(reflect.ValueOf(propertiesProp)) && (ok || !reflect.DeepEqual(v, propertiesProp)) {
+		obj["properties"] = propertiesProp
+	}
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/autonomousDatabases?autonomousDatabaseId={{autonomous_database_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new AutonomousDatabase: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating AutonomousDatabase: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/autonomousDatabases/{{autonomous_database_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := oracleDatabaseOperationWaitTime(
+		config, res, project, "Creating AutonomousDatabase",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create AutonomousDatabase: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating AutonomousDatabase %q: %#v", d.Id(), res)
+
+	return resourceOracleDatabaseAutonomousDatabaseRead(d, meta)
+}
+
+func resourceOracleDatabaseAutonomousDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/autonomousDatabases/{{autonomous_database_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OracleDatabaseAutonomousDatabase %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+
+	if err := d.Set("database", flattenOracleDatabaseAutonomousDatabaseDatabase(res["database"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("display_name", flattenOracleDatabaseAutonomousDatabaseDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("labels", flattenOracleDatabaseAutonomousDatabaseLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("network", flattenOracleDatabaseAutonomousDatabaseNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("cidr", flattenOracleDatabaseAutonomousDatabaseCidr(res["cidr"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("properties", flattenOracleDatabaseAutonomousDatabaseProperties(res["properties"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("name", flattenOracleDatabaseAutonomousDatabaseName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("entitlement_id", flattenOracleDatabaseAutonomousDatabaseEntitlementId(res["entitlementId"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+	if err := d.Set("create_time", flattenOracleDatabaseAutonomousDatabaseCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading AutonomousDatabase: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOracleDatabaseAutonomousDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/autonomousDatabases/{{autonomous_database_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting AutonomousDatabase %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "AutonomousDatabase")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = oracleDatabaseOperationWaitTime(
+		config, res, project, "Deleting AutonomousDatabase",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting AutonomousDatabase %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceOracleDatabaseAutonomousDatabaseImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/autonomousDatabases/(?P<autonomous_database_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<autonomous_database_id>[^/]+)", "(?P<location>[^/]+)/(?P<autonomous_database_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/autonomousDatabases/{{autonomous_database_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenOracleDatabaseAutonomousDatabaseDatabase(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseCidr(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseProperties(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["db_workload"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesDbWorkload(original["dbWorkload"], d)
+	transformed["license_type"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesLicenseType(original["licenseType"], d)
+	transformed["compute_count"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesComputeCount(original["computeCount"], d)
+	transformed["data_storage_size_tb"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeTb(original["dataStorageSizeTb"], d)
+	transformed["data_storage_size_gb"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeGb(original["dataStorageSizeGb"], d)
+	transformed["db_version"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesDbVersion(original["dbVersion"], d)
+	transformed["character_set"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesCharacterSet(original["characterSet"], d)
+	transformed["n_character_set"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesNCharacterSet(original["nCharacterSet"], d)
+	transformed["backup_retention_period_days"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesBackupRetentionPeriodDays(original["backupRetentionPeriodDays"], d)
+	transformed["is_auto_scaling_enabled"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesIsAutoScalingEnabled(original["isAutoScalingEnabled"], d)
+	transformed["is_storage_auto_scaling_enabled"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesIsStorageAutoScalingEnabled(original["isStorageAutoScalingEnabled"], d)
+	transformed["maintenance_schedule_type"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesMaintenanceScheduleType(original["maintenanceScheduleType"], d)
+	transformed["private_endpoint_ip"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointIp(original["privateEndpointIp"], d)
+	transformed["private_endpoint_label"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointLabel(original["privateEndpointLabel"], d)
+	transformed["customer_contacts"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesCustomerContacts(original["customerContacts"], d)
+	transformed["ocid"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesOcid(original["ocid"], d)
+	transformed["state"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesState(original["state"], d)
+	transformed["lifecycle_details"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesLifecycleDetails(original["lifecycleDetails"], d)
+	transformed["private_endpoint"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpoint(original["privateEndpoint"], d)
+	transformed["oci_url"] =
+		flattenOracleDatabaseAutonomousDatabasePropertiesOciUrl(original["ociUrl"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesDbWorkload(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesLicenseType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesComputeCount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeTb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesDbVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesCharacterSet(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesNCharacterSet(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesBackupRetentionPeriodDays(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesIsAutoScalingEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesIsStorageAutoScalingEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesMaintenanceScheduleType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointIp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointLabel(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesCustomerContacts(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"email": flattenOracleDatabaseAutonomousDatabasePropertiesCustomerContactsEmail(original["email"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesCustomerContactsEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesOcid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesLifecycleDetails(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesPrivateEndpoint(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabasePropertiesOciUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseEntitlementId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseAutonomousDatabaseCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandOracleDatabaseAutonomousDatabaseDatabase(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseAdminPassword(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseCidr(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabaseProperties(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDbWorkload, err := expandOracleDatabaseAutonomousDatabasePropertiesDbWorkload(original["db_workload"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDbWorkload); val.IsValid() && !isEmptyValue(val) {
+		transformed["dbWorkload"] = transformedDbWorkload
+	}
+
+	transformedLicenseType, err := expandOracleDatabaseAutonomousDatabasePropertiesLicenseType(original["license_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLicenseType); val.IsValid() && !isEmptyValue(val) {
+		transformed["licenseType"] = transformedLicenseType
+	}
+
+	transformedComputeCount, err := expandOracleDatabaseAutonomousDatabasePropertiesComputeCount(original["compute_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedComputeCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["computeCount"] = transformedComputeCount
+	}
+
+	transformedDataStorageSizeTb, err := expandOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeTb(original["data_storage_size_tb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDataStorageSizeTb); val.IsValid() && !isEmptyValue(val) {
+		transformed["dataStorageSizeTb"] = transformedDataStorageSizeTb
+	}
+
+	transformedDataStorageSizeGb, err := expandOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeGb(original["data_storage_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDataStorageSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["dataStorageSizeGb"] = transformedDataStorageSizeGb
+	}
+
+	transformedDbVersion, err := expandOracleDatabaseAutonomousDatabasePropertiesDbVersion(original["db_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDbVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["dbVersion"] = transformedDbVersion
+	}
+
+	transformedCharacterSet, err := expandOracleDatabaseAutonomousDatabasePropertiesCharacterSet(original["character_set"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCharacterSet); val.IsValid() && !isEmptyValue(val) {
+		transformed["characterSet"] = transformedCharacterSet
+	}
+
+	transformedNCharacterSet, err := expandOracleDatabaseAutonomousDatabasePropertiesNCharacterSet(original["n_character_set"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNCharacterSet); val.IsValid() && !isEmptyValue(val) {
+		transformed["nCharacterSet"] = transformedNCharacterSet
+	}
+
+	transformedBackupRetentionPeriodDays, err := expandOracleDatabaseAutonomousDatabasePropertiesBackupRetentionPeriodDays(original["backup_retention_period_days"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedBackupRetentionPeriodDays); val.IsValid() && !isEmptyValue(val) {
+		transformed["backupRetentionPeriodDays"] = transformedBackupRetentionPeriodDays
+	}
+
+	transformedIsAutoScalingEnabled, err := expandOracleDatabaseAutonomousDatabasePropertiesIsAutoScalingEnabled(original["is_auto_scaling_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIsAutoScalingEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["isAutoScalingEnabled"] = transformedIsAutoScalingEnabled
+	}
+
+	transformedIsStorageAutoScalingEnabled, err := expandOracleDatabaseAutonomousDatabasePropertiesIsStorageAutoScalingEnabled(original["is_storage_auto_scaling_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIsStorageAutoScalingEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["isStorageAutoScalingEnabled"] = transformedIsStorageAutoScalingEnabled
+	}
+
+	transformedMaintenanceScheduleType, err := expandOracleDatabaseAutonomousDatabasePropertiesMaintenanceScheduleType(original["maintenance_schedule_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaintenanceScheduleType); val.IsValid() && !isEmptyValue(val) {
+		transformed["maintenanceScheduleType"] = transformedMaintenanceScheduleType
+	}
+
+	transformedPrivateEndpointIp, err := expandOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointIp(original["private_endpoint_ip"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPrivateEndpointIp); val.IsValid() && !isEmptyValue(val) {
+		transformed["privateEndpointIp"] = transformedPrivateEndpointIp
+	}
+
+	transformedPrivateEndpointLabel, err := expandOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointLabel(original["private_endpoint_label"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPrivateEndpointLabel); val.IsValid() && !isEmptyValue(val) {
+		transformed["privateEndpointLabel"] = transformedPrivateEndpointLabel
+	}
+
+	transformedCustomerContacts, err := expandOracleDatabaseAutonomousDatabasePropertiesCustomerContacts(original["customer_contacts"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCustomerContacts); val.IsValid() && !isEmptyValue(val) {
+		transformed["customerContacts"] = transformedCustomerContacts
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesDbWorkload(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesLicenseType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesComputeCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeTb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesDataStorageSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesDbVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesCharacterSet(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesNCharacterSet(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesBackupRetentionPeriodDays(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesIsAutoScalingEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesIsStorageAutoScalingEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesMaintenanceScheduleType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointIp(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesPrivateEndpointLabel(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesCustomerContacts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedEmail, err := expandOracleDatabaseAutonomousDatabasePropertiesCustomerContactsEmail(original["email"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedEmail); val.IsValid() && !isEmptyValue(val) {
+			transformed["email"] = transformedEmail
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandOracleDatabaseAutonomousDatabasePropertiesCustomerContactsEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
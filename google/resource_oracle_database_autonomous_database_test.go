@@ -0,0 +1,84 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOracleDatabaseAutonomousDatabase_oracledatabaseAutonomousDatabaseBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"network_name":  "oracledatabase-network",
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOracleDatabaseAutonomousDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOracleDatabaseAutonomousDatabase_oracledatabaseAutonomousDatabaseBasicExample(context),
+			},
+			{
+				ResourceName:            "google_oracle_database_autonomous_database.myADB",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admin_password", "labels"},
+			},
+		},
+	})
+}
+
+func testAccOracleDatabaseAutonomousDatabase_oracledatabaseAutonomousDatabaseBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_oracle_database_autonomous_database" "myADB" {
+  autonomous_database_id = "tf-test-my-instance%{random_suffix}"
+  location               = "us-east4"
+  database               = "testdb%{random_suffix}"
+  admin_password         = "123Abpassword"
+  network                = "projects/%{project}/global/networks/%{network_name}"
+  cidr                   = "10.5.0.0/24"
+
+  properties {
+    compute_count        = "2"
+    data_storage_size_tb = "1"
+    db_version           = "19c"
+    db_workload          = "OLTP"
+    license_type         = "LICENSE_INCLUDED"
+  }
+}
+`, context)
+}
+
+func testAccCheckOracleDatabaseAutonomousDatabaseDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_oracle_database_autonomous_database" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/autonomousDatabases/{{autonomous_database_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("OracleDatabaseAutonomousDatabase still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,865 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceOracleDatabaseCloudExadataInfrastructure() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOracleDatabaseCloudExadataInfrastructureCreate,
+		Read:   resourceOracleDatabaseCloudExadataInfrastructureRead,
+		Delete: resourceOracleDatabaseCloudExadataInfrastructureDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOracleDatabaseCloudExadataInfrastructureImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(14400 * time.Second),
+			Delete: schema.DefaultTimeout(7200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cloud_exadata_infrastructure_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"gcp_oracle_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shape": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"compute_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"customer_contacts": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"email": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"maintenance_window": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days_of_week": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"hours_of_day": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+									"lead_time_week": {
+										Type:     schema.TypeInt,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+									},
+									"months": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"patching_mode": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ROLLING", "NON_ROLLING", ""}, false),
+									},
+									"preference": {
+										Type:         schema.TypeString,
+										Computed:     true,
+										Optional:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice([]string{"CUSTOM_PREFERENCE", "NO_PREFERENCE", ""}, false),
+									},
+									"weeks_of_month": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+								},
+							},
+						},
+						"storage_count": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"total_storage_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"cpu_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"data_storage_size_tb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"db_node_storage_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_cpu_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_data_storage_tb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"max_db_node_storage_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_memory_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"oci_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ocid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"entitlement_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceOracleDatabaseCloudExadataInfrastructureCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandOracleDatabaseCloudExadataInfrastructureDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	gcpOracleZoneProp, err := expandOracleDatabaseCloudExadataInfrastructureGcpOracleZone(d.Get("gcp_oracle_zone"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("gcp_oracle_zone"); !isEmptyValue(reflect.ValueOf(gcpOracleZoneProp)) && (ok || !reflect.DeepEqual(v, gcpOracleZoneProp)) {
+		obj["gcpOracleZone"] = gcpOracleZoneProp
+	}
+	labelsProp, err := expandOracleDatabaseCloudExadataInfrastructureLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	propertiesProp, err := expandOracleDatabaseCloudExadataInfrastructureProperties(d.Get("properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("properties"); !isEmptyValue(reflect.ValueOf(propertiesProp)) && (ok || !reflect.DeepEqual(v, propertiesProp)) {
+		obj["properties"] = propertiesProp
+	}
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures?cloudExadataInfrastructureId={{cloud_exadata_infrastructure_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new CloudExadataInfrastructure: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating CloudExadataInfrastructure: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures/{{cloud_exadata_infrastructure_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := oracleDatabaseOperationWaitTime(
+		config, res, project, "Creating CloudExadataInfrastructure",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create CloudExadataInfrastructure: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating CloudExadataInfrastructure %q: %#v", d.Id(), res)
+
+	return resourceOracleDatabaseCloudExadataInfrastructureRead(d, meta)
+}
+
+func resourceOracleDatabaseCloudExadataInfrastructureRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures/{{cloud_exadata_infrastructure_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OracleDatabaseCloudExadataInfrastructure %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenOracleDatabaseCloudExadataInfrastructureDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("gcp_oracle_zone", flattenOracleDatabaseCloudExadataInfrastructureGcpOracleZone(res["gcpOracleZone"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("labels", flattenOracleDatabaseCloudExadataInfrastructureLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("properties", flattenOracleDatabaseCloudExadataInfrastructureProperties(res["properties"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("name", flattenOracleDatabaseCloudExadataInfrastructureName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("entitlement_id", flattenOracleDatabaseCloudExadataInfrastructureEntitlementId(res["entitlementId"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+	if err := d.Set("create_time", flattenOracleDatabaseCloudExadataInfrastructureCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudExadataInfrastructure: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOracleDatabaseCloudExadataInfrastructureDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures/{{cloud_exadata_infrastructure_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting CloudExadataInfrastructure %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "CloudExadataInfrastructure")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = oracleDatabaseOperationWaitTime(
+		config, res, project, "Deleting CloudExadataInfrastructure",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting CloudExadataInfrastructure %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceOracleDatabaseCloudExadataInfrastructureImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/cloudExadataInfrastructures/(?P<cloud_exadata_infrastructure_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cloud_exadata_infrastructure_id>[^/]+)", "(?P<location>[^/]+)/(?P<cloud_exadata_infrastructure_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures/{{cloud_exadata_infrastructure_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureGcpOracleZone(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureProperties(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["shape"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesShape(original["shape"], d)
+	transformed["compute_count"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesComputeCount(original["computeCount"], d)
+	transformed["storage_count"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesStorageCount(original["storageCount"], d)
+	transformed["total_storage_size_gb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesTotalStorageSizeGb(original["totalStorageSizeGb"], d)
+	transformed["maintenance_window"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindow(original["maintenanceWindow"], d)
+	transformed["customer_contacts"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContacts(original["customerContacts"], d)
+	transformed["state"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesState(original["state"], d)
+	transformed["ocid"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesOcid(original["ocid"], d)
+	transformed["cpu_count"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesCpuCount(original["cpuCount"], d)
+	transformed["max_cpu_count"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxCpuCount(original["maxCpuCount"], d)
+	transformed["memory_size_gb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMemorySizeGb(original["memorySizeGb"], d)
+	transformed["max_memory_gb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxMemoryGb(original["maxMemoryGb"], d)
+	transformed["db_node_storage_size_gb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesDbNodeStorageSizeGb(original["dbNodeStorageSizeGb"], d)
+	transformed["max_db_node_storage_size_gb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxDbNodeStorageSizeGb(original["maxDbNodeStorageSizeGb"], d)
+	transformed["data_storage_size_tb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesDataStorageSizeTb(original["dataStorageSizeTb"], d)
+	transformed["max_data_storage_tb"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxDataStorageTb(original["maxDataStorageTb"], d)
+	transformed["oci_url"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesOciUrl(original["ociUrl"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesShape(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesComputeCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesStorageCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesTotalStorageSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindow(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["preference"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPreference(original["preference"], d)
+	transformed["months"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowMonths(original["months"], d)
+	transformed["weeks_of_month"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowWeeksOfMonth(original["weeksOfMonth"], d)
+	transformed["days_of_week"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowDaysOfWeek(original["daysOfWeek"], d)
+	transformed["hours_of_day"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowHoursOfDay(original["hoursOfDay"], d)
+	transformed["lead_time_week"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowLeadTimeWeek(original["leadTimeWeek"], d)
+	transformed["patching_mode"] =
+		flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPatchingMode(original["patchingMode"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPreference(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowMonths(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowWeeksOfMonth(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowDaysOfWeek(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowHoursOfDay(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowLeadTimeWeek(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPatchingMode(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContacts(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"email": flattenOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContactsEmail(original["email"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContactsEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesOcid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesCpuCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxCpuCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMemorySizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxMemoryGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesDbNodeStorageSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxDbNodeStorageSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesDataStorageSizeTb(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesMaxDataStorageTb(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructurePropertiesOciUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureEntitlementId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudExadataInfrastructureCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandOracleDatabaseCloudExadataInfrastructureDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructureGcpOracleZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructureLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructureProperties(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedShape, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesShape(original["shape"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedShape); val.IsValid() && !isEmptyValue(val) {
+		transformed["shape"] = transformedShape
+	}
+
+	transformedComputeCount, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesComputeCount(original["compute_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedComputeCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["computeCount"] = transformedComputeCount
+	}
+
+	transformedStorageCount, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesStorageCount(original["storage_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedStorageCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["storageCount"] = transformedStorageCount
+	}
+
+	transformedTotalStorageSizeGb, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesTotalStorageSizeGb(original["total_storage_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTotalStorageSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["totalStorageSizeGb"] = transformedTotalStorageSizeGb
+	}
+
+	transformedMaintenanceWindow, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindow(original["maintenance_window"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMaintenanceWindow); val.IsValid() && !isEmptyValue(val) {
+		transformed["maintenanceWindow"] = transformedMaintenanceWindow
+	}
+
+	transformedCustomerContacts, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContacts(original["customer_contacts"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCustomerContacts); val.IsValid() && !isEmptyValue(val) {
+		transformed["customerContacts"] = transformedCustomerContacts
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesShape(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesComputeCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesStorageCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesTotalStorageSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindow(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedPreference, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPreference(original["preference"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPreference); val.IsValid() && !isEmptyValue(val) {
+		transformed["preference"] = transformedPreference
+	}
+
+	transformedMonths, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowMonths(original["months"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMonths); val.IsValid() && !isEmptyValue(val) {
+		transformed["months"] = transformedMonths
+	}
+
+	transformedWeeksOfMonth, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowWeeksOfMonth(original["weeks_of_month"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedWeeksOfMonth); val.IsValid() && !isEmptyValue(val) {
+		transformed["weeksOfMonth"] = transformedWeeksOfMonth
+	}
+
+	transformedDaysOfWeek, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowDaysOfWeek(original["days_of_week"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDaysOfWeek); val.IsValid() && !isEmptyValue(val) {
+		transformed["daysOfWeek"] = transformedDaysOfWeek
+	}
+
+	transformedHoursOfDay, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowHoursOfDay(original["hours_of_day"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHoursOfDay); val.IsValid() && !isEmptyValue(val) {
+		transformed["hoursOfDay"] = transformedHoursOfDay
+	}
+
+	transformedLeadTimeWeek, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowLeadTimeWeek(original["lead_time_week"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLeadTimeWeek); val.IsValid() && !isEmptyValue(val) {
+		transformed["leadTimeWeek"] = transformedLeadTimeWeek
+	}
+
+	transformedPatchingMode, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPatchingMode(original["patching_mode"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedPatchingMode); val.IsValid() && !isEmptyValue(val) {
+		transformed["patchingMode"] = transformedPatchingMode
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPreference(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowMonths(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowWeeksOfMonth(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowDaysOfWeek(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowHoursOfDay(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowLeadTimeWeek(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesMaintenanceWindowPatchingMode(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContacts(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedEmail, err := expandOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContactsEmail(original["email"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedEmail); val.IsValid() && !isEmptyValue(val) {
+			transformed["email"] = transformedEmail
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandOracleDatabaseCloudExadataInfrastructurePropertiesCustomerContactsEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,77 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOracleDatabaseCloudExadataInfrastructure_oracledatabaseCloudExadataInfrastructureBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOracleDatabaseCloudExadataInfrastructureDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOracleDatabaseCloudExadataInfrastructure_oracledatabaseCloudExadataInfrastructureBasicExample(context),
+			},
+			{
+				ResourceName:            "google_oracle_database_cloud_exadata_infrastructure.my-cloud-exadata",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels"},
+			},
+		},
+	})
+}
+
+func testAccOracleDatabaseCloudExadataInfrastructure_oracledatabaseCloudExadataInfrastructureBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_oracle_database_cloud_exadata_infrastructure" "my-cloud-exadata" {
+  cloud_exadata_infrastructure_id = "tf-test-my-instance%{random_suffix}"
+  display_name                    = "tf-test-my-instance%{random_suffix} displayname"
+  location                        = "us-east4"
+
+  properties {
+    shape         = "Exadata.X9M"
+    compute_count = "2"
+    storage_count = "3"
+  }
+}
+`, context)
+}
+
+func testAccCheckOracleDatabaseCloudExadataInfrastructureDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_oracle_database_cloud_exadata_infrastructure" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudExadataInfrastructures/{{cloud_exadata_infrastructure_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("OracleDatabaseCloudExadataInfrastructure still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,979 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceOracleDatabaseCloudVmCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOracleDatabaseCloudVmClusterCreate,
+		Read:   resourceOracleDatabaseCloudVmClusterRead,
+		Delete: resourceOracleDatabaseCloudVmClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOracleDatabaseCloudVmClusterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(7200 * time.Second),
+			Delete: schema.DefaultTimeout(7200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backup_subnet_cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cloud_vm_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"exadata_infrastructure": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cpu_core_count": {
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"license_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"LICENSE_INCLUDED", "BRING_YOUR_OWN_LICENSE"}, false),
+						},
+						"cluster_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"data_storage_size_tb": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"db_node_storage_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"diagnostics_data_collection_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"diagnostics_events_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"health_monitoring_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+									"incident_logs_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"disk_redundancy": {
+							Type:         schema.TypeString,
+							Computed:     true,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"HIGH", "NORMAL", ""}, false),
+						},
+						"gi_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"hostname_prefix": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"local_backup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"memory_size_gb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"node_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ocpu_count": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+						},
+						"sparse_diskgroup_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"ssh_public_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"time_zone": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"domain": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oci_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ocid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scan_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"scan_listener_port_tcp": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gcp_oracle_zone": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceOracleDatabaseCloudVmClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	exadataInfrastructureProp, err := expandOracleDatabaseCloudVmClusterExadataInfrastructure(d.Get("exadata_infrastructure"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("exadata_infrastructure"); !isEmptyValue(reflect.ValueOf(exadataInfrastructureProp)) && (ok || !reflect.DeepEqual(v, exadataInfrastructureProp)) {
+		obj["exadataInfrastructure"] = exadataInfrastructureProp
+	}
+	displayNameProp, err := expandOracleDatabaseCloudVmClusterDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandOracleDatabaseCloudVmClusterLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	propertiesProp, err := expandOracleDatabaseCloudVmClusterProperties(d.Get("properties"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("properties"); !isEmptyValue(reflect.ValueOf(propertiesProp)) && (ok || !reflect.DeepEqual(v, propertiesProp)) {
+		obj["properties"] = propertiesProp
+	}
+	cidrProp, err := expandOracleDatabaseCloudVmClusterCidr(d.Get("cidr"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("cidr"); !isEmptyValue(reflect.ValueOf(cidrProp)) && (ok || !reflect.DeepEqual(v, cidrProp)) {
+		obj["cidr"] = cidrProp
+	}
+	backupSubnetCidrProp, err := expandOracleDatabaseCloudVmClusterBackupSubnetCidr(d.Get("backup_subnet_cidr"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("backup_subnet_cidr"); !isEmptyValue(reflect.ValueOf(backupSubnetCidrProp)) && (ok || !reflect.DeepEqual(v, backupSubnetCidrProp)) {
+		obj["backupSubnetCidr"] = backupSubnetCidrProp
+	}
+	networkProp, err := expandOracleDatabaseCloudVmClusterNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudVmClusters?cloudVmClusterId={{cloud_vm_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new CloudVmCluster: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating CloudVmCluster: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/cloudVmClusters/{{cloud_vm_cluster_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := oracleDatabaseOperationWaitTime(
+		config, res, project, "Creating CloudVmCluster",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create CloudVmCluster: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating CloudVmCluster %q: %#v", d.Id(), res)
+
+	return resourceOracleDatabaseCloudVmClusterRead(d, meta)
+}
+
+func resourceOracleDatabaseCloudVmClusterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudVmClusters/{{cloud_vm_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OracleDatabaseCloudVmCluster %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+
+	if err := d.Set("exadata_infrastructure", flattenOracleDatabaseCloudVmClusterExadataInfrastructure(res["exadataInfrastructure"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("display_name", flattenOracleDatabaseCloudVmClusterDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("labels", flattenOracleDatabaseCloudVmClusterLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("properties", flattenOracleDatabaseCloudVmClusterProperties(res["properties"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("cidr", flattenOracleDatabaseCloudVmClusterCidr(res["cidr"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("backup_subnet_cidr", flattenOracleDatabaseCloudVmClusterBackupSubnetCidr(res["backupSubnetCidr"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("network", flattenOracleDatabaseCloudVmClusterNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("name", flattenOracleDatabaseCloudVmClusterName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("gcp_oracle_zone", flattenOracleDatabaseCloudVmClusterGcpOracleZone(res["gcpOracleZone"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+	if err := d.Set("create_time", flattenOracleDatabaseCloudVmClusterCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading CloudVmCluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOracleDatabaseCloudVmClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudVmClusters/{{cloud_vm_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting CloudVmCluster %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "CloudVmCluster")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = oracleDatabaseOperationWaitTime(
+		config, res, project, "Deleting CloudVmCluster",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting CloudVmCluster %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceOracleDatabaseCloudVmClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/cloudVmClusters/(?P<cloud_vm_cluster_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<cloud_vm_cluster_id>[^/]+)", "(?P<location>[^/]+)/(?P<cloud_vm_cluster_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/cloudVmClusters/{{cloud_vm_cluster_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenOracleDatabaseCloudVmClusterExadataInfrastructure(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterProperties(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["license_type"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesLicenseType(original["licenseType"], d)
+	transformed["cpu_core_count"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesCpuCoreCount(original["cpuCoreCount"], d)
+	transformed["gi_version"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesGiVersion(original["giVersion"], d)
+	transformed["time_zone"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesTimeZone(original["timeZone"], d)
+	transformed["ssh_public_keys"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesSshPublicKeys(original["sshPublicKeys"], d)
+	transformed["node_count"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesNodeCount(original["nodeCount"], d)
+	transformed["ocpu_count"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesOcpuCount(original["ocpuCount"], d)
+	transformed["memory_size_gb"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesMemorySizeGb(original["memorySizeGb"], d)
+	transformed["db_node_storage_size_gb"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDbNodeStorageSizeGb(original["dbNodeStorageSizeGb"], d)
+	transformed["data_storage_size_tb"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDataStorageSizeTb(original["dataStorageSizeTb"], d)
+	transformed["disk_redundancy"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDiskRedundancy(original["diskRedundancy"], d)
+	transformed["sparse_diskgroup_enabled"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesSparseDiskgroupEnabled(original["sparseDiskgroupEnabled"], d)
+	transformed["local_backup_enabled"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesLocalBackupEnabled(original["localBackupEnabled"], d)
+	transformed["hostname_prefix"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesHostnamePrefix(original["hostnamePrefix"], d)
+	transformed["cluster_name"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesClusterName(original["clusterName"], d)
+	transformed["diagnostics_data_collection_options"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptions(original["diagnosticsDataCollectionOptions"], d)
+	transformed["ocid"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesOcid(original["ocid"], d)
+	transformed["state"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesState(original["state"], d)
+	transformed["hostname"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesHostname(original["hostname"], d)
+	transformed["domain"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDomain(original["domain"], d)
+	transformed["scan_dns"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesScanDns(original["scanDns"], d)
+	transformed["scan_listener_port_tcp"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesScanListenerPortTcp(original["scanListenerPortTcp"], d)
+	transformed["oci_url"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesOciUrl(original["ociUrl"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesLicenseType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesCpuCoreCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesGiVersion(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesTimeZone(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["id"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesTimeZoneId(original["id"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesTimeZoneId(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesSshPublicKeys(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesNodeCount(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesOcpuCount(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesMemorySizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDbNodeStorageSizeGb(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDataStorageSizeTb(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDiskRedundancy(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesSparseDiskgroupEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesLocalBackupEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesHostnamePrefix(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesClusterName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptions(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["diagnostics_events_enabled"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsDiagnosticsEventsEnabled(original["diagnosticsEventsEnabled"], d)
+	transformed["health_monitoring_enabled"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsHealthMonitoringEnabled(original["healthMonitoringEnabled"], d)
+	transformed["incident_logs_enabled"] =
+		flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsIncidentLogsEnabled(original["incidentLogsEnabled"], d)
+	return []interface{}{transformed}
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsDiagnosticsEventsEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsHealthMonitoringEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsIncidentLogsEnabled(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesOcid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesHostname(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesDomain(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesScanDns(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesScanListenerPortTcp(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterPropertiesOciUrl(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterCidr(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterBackupSubnetCidr(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterGcpOracleZone(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenOracleDatabaseCloudVmClusterCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandOracleDatabaseCloudVmClusterExadataInfrastructure(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandOracleDatabaseCloudVmClusterProperties(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedLicenseType, err := expandOracleDatabaseCloudVmClusterPropertiesLicenseType(original["license_type"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLicenseType); val.IsValid() && !isEmptyValue(val) {
+		transformed["licenseType"] = transformedLicenseType
+	}
+
+	transformedCpuCoreCount, err := expandOracleDatabaseCloudVmClusterPropertiesCpuCoreCount(original["cpu_core_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedCpuCoreCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["cpuCoreCount"] = transformedCpuCoreCount
+	}
+
+	transformedGiVersion, err := expandOracleDatabaseCloudVmClusterPropertiesGiVersion(original["gi_version"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedGiVersion); val.IsValid() && !isEmptyValue(val) {
+		transformed["giVersion"] = transformedGiVersion
+	}
+
+	transformedTimeZone, err := expandOracleDatabaseCloudVmClusterPropertiesTimeZone(original["time_zone"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedTimeZone); val.IsValid() && !isEmptyValue(val) {
+		transformed["timeZone"] = transformedTimeZone
+	}
+
+	transformedSshPublicKeys, err := expandOracleDatabaseCloudVmClusterPropertiesSshPublicKeys(original["ssh_public_keys"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSshPublicKeys); val.IsValid() && !isEmptyValue(val) {
+		transformed["sshPublicKeys"] = transformedSshPublicKeys
+	}
+
+	transformedNodeCount, err := expandOracleDatabaseCloudVmClusterPropertiesNodeCount(original["node_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNodeCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["nodeCount"] = transformedNodeCount
+	}
+
+	transformedOcpuCount, err := expandOracleDatabaseCloudVmClusterPropertiesOcpuCount(original["ocpu_count"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedOcpuCount); val.IsValid() && !isEmptyValue(val) {
+		transformed["ocpuCount"] = transformedOcpuCount
+	}
+
+	transformedMemorySizeGb, err := expandOracleDatabaseCloudVmClusterPropertiesMemorySizeGb(original["memory_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedMemorySizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["memorySizeGb"] = transformedMemorySizeGb
+	}
+
+	transformedDbNodeStorageSizeGb, err := expandOracleDatabaseCloudVmClusterPropertiesDbNodeStorageSizeGb(original["db_node_storage_size_gb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDbNodeStorageSizeGb); val.IsValid() && !isEmptyValue(val) {
+		transformed["dbNodeStorageSizeGb"] = transformedDbNodeStorageSizeGb
+	}
+
+	transformedDataStorageSizeTb, err := expandOracleDatabaseCloudVmClusterPropertiesDataStorageSizeTb(original["data_storage_size_tb"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDataStorageSizeTb); val.IsValid() && !isEmptyValue(val) {
+		transformed["dataStorageSizeTb"] = transformedDataStorageSizeTb
+	}
+
+	transformedDiskRedundancy, err := expandOracleDatabaseCloudVmClusterPropertiesDiskRedundancy(original["disk_redundancy"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiskRedundancy); val.IsValid() && !isEmptyValue(val) {
+		transformed["diskRedundancy"] = transformedDiskRedundancy
+	}
+
+	transformedSparseDiskgroupEnabled, err := expandOracleDatabaseCloudVmClusterPropertiesSparseDiskgroupEnabled(original["sparse_diskgroup_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedSparseDiskgroupEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["sparseDiskgroupEnabled"] = transformedSparseDiskgroupEnabled
+	}
+
+	transformedLocalBackupEnabled, err := expandOracleDatabaseCloudVmClusterPropertiesLocalBackupEnabled(original["local_backup_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedLocalBackupEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["localBackupEnabled"] = transformedLocalBackupEnabled
+	}
+
+	transformedHostnamePrefix, err := expandOracleDatabaseCloudVmClusterPropertiesHostnamePrefix(original["hostname_prefix"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHostnamePrefix); val.IsValid() && !isEmptyValue(val) {
+		transformed["hostnamePrefix"] = transformedHostnamePrefix
+	}
+
+	transformedClusterName, err := expandOracleDatabaseCloudVmClusterPropertiesClusterName(original["cluster_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedClusterName); val.IsValid() && !isEmptyValue(val) {
+		transformed["clusterName"] = transformedClusterName
+	}
+
+	transformedDiagnosticsDataCollectionOptions, err := expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptions(original["diagnostics_data_collection_options"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiagnosticsDataCollectionOptions); val.IsValid() && !isEmptyValue(val) {
+		transformed["diagnosticsDataCollectionOptions"] = transformedDiagnosticsDataCollectionOptions
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesLicenseType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesCpuCoreCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesGiVersion(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesTimeZone(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedId, err := expandOracleDatabaseCloudVmClusterPropertiesTimeZoneId(original["id"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedId); val.IsValid() && !isEmptyValue(val) {
+		transformed["id"] = transformedId
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesTimeZoneId(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesSshPublicKeys(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesNodeCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesOcpuCount(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesMemorySizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDbNodeStorageSizeGb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDataStorageSizeTb(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDiskRedundancy(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesSparseDiskgroupEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesLocalBackupEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesHostnamePrefix(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesClusterName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptions(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDiagnosticsEventsEnabled, err := expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsDiagnosticsEventsEnabled(original["diagnostics_events_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDiagnosticsEventsEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["diagnosticsEventsEnabled"] = transformedDiagnosticsEventsEnabled
+	}
+
+	transformedHealthMonitoringEnabled, err := expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsHealthMonitoringEnabled(original["health_monitoring_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedHealthMonitoringEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["healthMonitoringEnabled"] = transformedHealthMonitoringEnabled
+	}
+
+	transformedIncidentLogsEnabled, err := expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsIncidentLogsEnabled(original["incident_logs_enabled"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedIncidentLogsEnabled); val.IsValid() && !isEmptyValue(val) {
+		transformed["incidentLogsEnabled"] = transformedIncidentLogsEnabled
+	}
+
+	return transformed, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsDiagnosticsEventsEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsHealthMonitoringEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterPropertiesDiagnosticsDataCollectionOptionsIncidentLogsEnabled(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterCidr(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterBackupSubnetCidr(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandOracleDatabaseCloudVmClusterNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,108 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOracleDatabaseCloudVmCluster_oracledatabaseCloudVmclusterBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"project":       getTestProjectFromEnv(),
+		"network_name":  "oracledatabase-network",
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOracleDatabaseCloudVmClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOracleDatabaseCloudVmCluster_oracledatabaseCloudVmclusterBasicExample(context),
+			},
+			{
+				ResourceName:            "google_oracle_database_cloud_vm_cluster.my_vmcluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels"},
+			},
+		},
+	})
+}
+
+func testAccOracleDatabaseCloudVmCluster_oracledatabaseCloudVmclusterBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_oracle_database_cloud_vm_cluster" "my_vmcluster" {
+  cloud_vm_cluster_id    = "tf-test-my-instance%{random_suffix}"
+  display_name           = "tf-test-my-instance%{random_suffix} displayname"
+  location               = "us-east4"
+  exadata_infrastructure = "${google_oracle_database_cloud_exadata_infrastructure.cloudExadataInfrastructures.id}"
+  network                = "projects/%{project}/global/networks/%{network_name}"
+  cidr                   = "10.5.0.0/24"
+  backup_subnet_cidr     = "10.6.0.0/24"
+
+  properties {
+    license_type    = "LICENSE_INCLUDED"
+    ssh_public_keys = ["ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQCz1X2744t+6vRLmE5u6nHi6/QWh8bQDgHmd+OIxRQIGA/IWUtCs2FnaCNZcqvZkaeyjk5v0lTA/n+9jvO42Ipib53athrfVG8gRt8fzPL66C6ZqHq+6zZophhrCdfJh/0G4x9xJh5gdMprlaCR1P8yAaVvhBQSKGc4SiIkyMNBcHJ5YTtMQMTfxaB4G1sHZ6SDAY9a6Cq/zNjDwfPapWLsiP4mRhE5SSjJX6l6EYbkm0JeLQg+AbJiNEPvrvDp1wtTxzlPJtIivthmLMThFxK7+DkrYFuLvN5AHUdo9KTDLvHtDCvV70oUBHDFWgbNSGLVPxn3iUfvuhDn6+yTEeyJ"]
+    cpu_core_count  = "4"
+    gi_version      = "19.0.0.0"
+
+    time_zone {
+      id = "UTC"
+    }
+
+    node_count               = "2"
+    ocpu_count               = "4.0"
+    data_storage_size_tb     = 2
+    db_node_storage_size_gb  = 120
+    memory_size_gb           = 60
+    local_backup_enabled     = false
+    sparse_diskgroup_enabled = false
+  }
+}
+
+resource "google_oracle_database_cloud_exadata_infrastructure" "cloudExadataInfrastructures" {
+  cloud_exadata_infrastructure_id = "tf-test-my-exadata%{random_suffix}"
+  display_name                    = "tf-test-my-exadata%{random_suffix} displayname"
+  location                        = "us-east4"
+
+  properties {
+    shape         = "Exadata.X9M"
+    compute_count = "2"
+    storage_count = "3"
+  }
+}
+`, context)
+}
+
+func testAccCheckOracleDatabaseCloudVmClusterDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_oracle_database_cloud_vm_cluster" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://oracledatabase.googleapis.com/v1/projects/{{project}}/locations/{{location}}/cloudVmClusters/{{cloud_vm_cluster_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("OracleDatabaseCloudVmCluster still exists at %s", url)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// Boolean rule fields are strings, as the API treats an unset field
+// differently from one set to false.
+var orgPolicyPolicyRuleBoolValues = []string{"TRUE", "FALSE", ""}
+
+func resourceOrgPolicyPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrgPolicyPolicyCreate,
+		Read:   resourceOrgPolicyPolicyRead,
+		Update: resourceOrgPolicyPolicyUpdate,
+		Delete: resourceOrgPolicyPolicyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceOrgPolicyPolicyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+			Update: schema.DefaultTimeout(4 * time.Minute),
+			Delete: schema.DefaultTimeout(4 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"parent": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`^(projects|folders|organizations)/[^/]+$`),
+			},
+			"spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"inherit_from_parent": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"reset": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"rules": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"values": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"allowed_values": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"denied_values": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"allow_all": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(orgPolicyPolicyRuleBoolValues, false),
+									},
+									"deny_all": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(orgPolicyPolicyRuleBoolValues, false),
+									},
+									"enforce": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(orgPolicyPolicyRuleBoolValues, false),
+									},
+									"condition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"expression": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"title": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"description": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+												"location": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"etag": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceOrgPolicyPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://orgpolicy.googleapis.com/v2/{{parent}}/policies")
+	if err != nil {
+		return err
+	}
+
+	obj := map[string]interface{}{
+		"name": orgPolicyPolicyName(d),
+		"spec": expandOrgPolicyPolicySpec(d.Get("spec")),
+	}
+
+	log.Printf("[DEBUG] Creating new Policy: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Policy: %s", err)
+	}
+
+	d.SetId(orgPolicyPolicyName(d))
+
+	log.Printf("[DEBUG] Finished creating Policy %q: %#v", d.Id(), res)
+
+	return resourceOrgPolicyPolicyRead(d, meta)
+}
+
+func resourceOrgPolicyPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	res, err := sendRequest(config, "GET", "https://orgpolicy.googleapis.com/v2/"+orgPolicyPolicyName(d), nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("OrgPolicyPolicy %q", d.Id()))
+	}
+
+	if err := d.Set("spec", flattenOrgPolicyPolicySpec(res["spec"])); err != nil {
+		return fmt.Errorf("Error reading Policy: %s", err)
+	}
+
+	return nil
+}
+
+func resourceOrgPolicyPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := map[string]interface{}{
+		"name": orgPolicyPolicyName(d),
+		"spec": expandOrgPolicyPolicySpec(d.Get("spec")),
+	}
+
+	log.Printf("[DEBUG] Updating Policy %q: %#v", d.Id(), obj)
+	_, err := sendRequestWithTimeout(config, "PATCH", "https://orgpolicy.googleapis.com/v2/"+orgPolicyPolicyName(d), obj, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("Error updating Policy %q: %s", d.Id(), err)
+	}
+
+	return resourceOrgPolicyPolicyRead(d, meta)
+}
+
+func resourceOrgPolicyPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	log.Printf("[DEBUG] Deleting Policy %q", d.Id())
+	_, err := sendRequestWithTimeout(config, "DELETE", "https://orgpolicy.googleapis.com/v2/"+orgPolicyPolicyName(d), nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Policy")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Policy %q", d.Id())
+	return nil
+}
+
+func resourceOrgPolicyPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"(?P<parent>(?:projects|folders|organizations)/[^/]+)/policies/(?P<name>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	d.Set("name", fmt.Sprintf("%s/policies/%s", d.Get("parent").(string), d.Get("name").(string)))
+	d.SetId(orgPolicyPolicyName(d))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// orgPolicyPolicyName returns the full name of the policy. The name may be
+// configured either in full or as just the constraint name.
+func orgPolicyPolicyName(d *schema.ResourceData) string {
+	return fmt.Sprintf("%s/policies/%s", d.Get("parent").(string), GetResourceNameFromSelfLink(d.Get("name").(string)))
+}
+
+func expandOrgPolicyPolicySpec(v interface{}) map[string]interface{} {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	raw := l[0].(map[string]interface{})
+
+	rules := make([]interface{}, 0)
+	for _, r := range raw["rules"].([]interface{}) {
+		if r == nil {
+			continue
+		}
+		rules = append(rules, expandOrgPolicyPolicySpecRule(r.(map[string]interface{})))
+	}
+
+	return map[string]interface{}{
+		"inheritFromParent": raw["inherit_from_parent"],
+		"reset":             raw["reset"],
+		"rules":             rules,
+	}
+}
+
+func expandOrgPolicyPolicySpecRule(raw map[string]interface{}) map[string]interface{} {
+	transformed := map[string]interface{}{}
+
+	if l := raw["values"].([]interface{}); len(l) > 0 && l[0] != nil {
+		values := l[0].(map[string]interface{})
+		transformed["values"] = map[string]interface{}{
+			"allowedValues": values["allowed_values"],
+			"deniedValues":  values["denied_values"],
+		}
+	}
+	for field, api := range map[string]string{"allow_all": "allowAll", "deny_all": "denyAll", "enforce": "enforce"} {
+		switch raw[field] {
+		case "TRUE":
+			transformed[api] = true
+		case "FALSE":
+			transformed[api] = false
+		}
+	}
+	if l := raw["condition"].([]interface{}); len(l) > 0 && l[0] != nil {
+		condition := l[0].(map[string]interface{})
+		transformed["condition"] = map[string]interface{}{
+			"expression":  condition["expression"],
+			"title":       condition["title"],
+			"description": condition["description"],
+			"location":    condition["location"],
+		}
+	}
+
+	return transformed
+}
+
+func flattenOrgPolicyPolicySpec(v interface{}) []interface{} {
+	original, ok := v.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	rules := make([]interface{}, 0)
+	if l, ok := original["rules"].([]interface{}); ok {
+		for _, r := range l {
+			rules = append(rules, flattenOrgPolicyPolicySpecRule(r.(map[string]interface{})))
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"inherit_from_parent": original["inheritFromParent"],
+			"reset":               original["reset"],
+			"rules":               rules,
+			"etag":                original["etag"],
+			"update_time":         original["updateTime"],
+		},
+	}
+}
+
+func flattenOrgPolicyPolicySpecRule(original map[string]interface{}) map[string]interface{} {
+	transformed := map[string]interface{}{}
+
+	if values, ok := original["values"].(map[string]interface{}); ok {
+		transformed["values"] = []interface{}{
+			map[string]interface{}{
+				"allowed_values": values["allowedValues"],
+				"denied_values":  values["deniedValues"],
+			},
+		}
+	}
+	for field, api := range map[string]string{"allow_all": "allowAll", "deny_all": "denyAll", "enforce": "enforce"} {
+		if b, ok := original[api].(bool); ok {
+			if b {
+				transformed[field] = "TRUE"
+			} else {
+				transformed[field] = "FALSE"
+			}
+		}
+	}
+	if condition, ok := original["condition"].(map[string]interface{}); ok {
+		transformed["condition"] = []interface{}{
+			map[string]interface{}{
+				"expression":  condition["expression"],
+				"title":       condition["title"],
+				"description": condition["description"],
+				"location":    condition["location"],
+			},
+		}
+	}
+
+	return transformed
+}
@@ -0,0 +1,165 @@
+package google
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestOrgPolicyPolicySpec_expandFlatten(t *testing.T) {
+	t.Parallel()
+
+	spec := []interface{}{
+		map[string]interface{}{
+			"inherit_from_parent": true,
+			"reset":               false,
+			"rules": []interface{}{
+				map[string]interface{}{
+					"values": []interface{}{
+						map[string]interface{}{
+							"allowed_values": []interface{}{"projects/allowed-project"},
+							"denied_values":  []interface{}{},
+						},
+					},
+					"allow_all": "",
+					"deny_all":  "",
+					"enforce":   "",
+					"condition": []interface{}{},
+				},
+				map[string]interface{}{
+					"values":    []interface{}{},
+					"allow_all": "",
+					"deny_all":  "",
+					"enforce":   "FALSE",
+					"condition": []interface{}{
+						map[string]interface{}{
+							"expression":  "resource.matchTag('123/env', 'dev')",
+							"title":       "dev",
+							"description": "",
+							"location":    "",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expanded := expandOrgPolicyPolicySpec(spec)
+	rules := expanded["rules"].([]interface{})
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
+	}
+	if _, ok := rules[0].(map[string]interface{})["enforce"]; ok {
+		t.Errorf("expected an unset enforce to be left out of the request, got %v", rules[0])
+	}
+	if enforce := rules[1].(map[string]interface{})["enforce"]; enforce != false {
+		t.Errorf("expected enforce to be sent as false, got %v", enforce)
+	}
+
+	flattened := flattenOrgPolicyPolicySpec(expanded)
+	rule := flattened[0].(map[string]interface{})["rules"].([]interface{})[1].(map[string]interface{})
+	if rule["enforce"] != "FALSE" {
+		t.Errorf("expected enforce to be read as FALSE, got %v", rule["enforce"])
+	}
+	condition := rule["condition"].([]interface{})[0].(map[string]interface{})
+	if !reflect.DeepEqual(condition["expression"], "resource.matchTag('123/env', 'dev')") {
+		t.Errorf("expected the condition to round-trip, got %v", condition)
+	}
+}
+
+func TestAccOrgPolicyPolicy_project(t *testing.T) {
+	t.Parallel()
+
+	org := getTestOrgFromEnv(t)
+	pid := "terraform-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrgPolicyPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrgPolicyPolicy_project(pid, pname, org, "TRUE"),
+			},
+			{
+				ResourceName:      "google_org_policy_policy.boolean",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "google_org_policy_policy.list",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccOrgPolicyPolicy_project(pid, pname, org, "FALSE"),
+			},
+			{
+				ResourceName:      "google_org_policy_policy.boolean",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOrgPolicyPolicy_project(pid, name, org, enforce string) string {
+	return fmt.Sprintf(`
+resource "google_project" "acceptance" {
+  project_id = "%s"
+  name       = "%s"
+  org_id     = "%s"
+}
+
+resource "google_org_policy_policy" "boolean" {
+  name   = "projects/${google_project.acceptance.project_id}/policies/iam.disableServiceAccountKeyUpload"
+  parent = "projects/${google_project.acceptance.project_id}"
+
+  spec {
+    rules {
+      enforce = "%s"
+    }
+  }
+}
+
+resource "google_org_policy_policy" "list" {
+  name   = "projects/${google_project.acceptance.project_id}/policies/compute.vmExternalIpAccess"
+  parent = "projects/${google_project.acceptance.project_id}"
+
+  spec {
+    inherit_from_parent = true
+
+    rules {
+      values {
+        denied_values = ["projects/${google_project.acceptance.project_id}/zones/us-central1-a/instances/bastion"]
+      }
+    }
+  }
+}
+`, pid, name, org, enforce)
+}
+
+func testAccCheckOrgPolicyPolicyDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_org_policy_policy" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := "https://orgpolicy.googleapis.com/v2/" + rs.Primary.ID
+		if _, err := sendRequest(config, "GET", url, nil); err == nil {
+			return fmt.Errorf("OrgPolicyPolicy still exists at %s", url)
+		}
+	}
+
+	return nil
+}
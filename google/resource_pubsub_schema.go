@@ -0,0 +1,185 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourcePubsubSchema() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePubsubSchemaCreate,
+		Read:   resourcePubsubSchemaRead,
+		Delete: resourcePubsubSchemaDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourcePubsubSchemaImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"definition": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PROTOCOL_BUFFER", "AVRO"}, false),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourcePubsubSchemaCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	typeProp, err := expandPubsubSchemaType(d.Get("type"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("type"); !isEmptyValue(reflect.ValueOf(typeProp)) && (ok || !reflect.DeepEqual(v, typeProp)) {
+		obj["type"] = typeProp
+	}
+	definitionProp, err := expandPubsubSchemaDefinition(d.Get("definition"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("definition"); !isEmptyValue(reflect.ValueOf(definitionProp)) && (ok || !reflect.DeepEqual(v, definitionProp)) {
+		obj["definition"] = definitionProp
+	}
+
+	// Validate the definition first so a malformed schema fails with the
+	// validation message instead of a generic create error.
+	validateUrl, err := replaceVars(d, config, "https://pubsub.googleapis.com/v1/projects/{{project}}/schemas:validate")
+	if err != nil {
+		return err
+	}
+	if _, err := sendRequestWithTimeout(config, "POST", validateUrl, map[string]interface{}{"schema": obj}, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error validating Schema definition: %s", err)
+	}
+
+	url, err := replaceVars(d, config, "https://pubsub.googleapis.com/v1/projects/{{project}}/schemas?schemaId={{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Schema: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Schema: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/schemas/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Schema %q: %#v", d.Id(), res)
+
+	return resourcePubsubSchemaRead(d, meta)
+}
+
+func resourcePubsubSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://pubsub.googleapis.com/v1/projects/{{project}}/schemas/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("PubsubSchema %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Schema: %s", err)
+	}
+
+	if err := d.Set("type", flattenPubsubSchemaType(res["type"], d)); err != nil {
+		return fmt.Errorf("Error reading Schema: %s", err)
+	}
+	if err := d.Set("definition", flattenPubsubSchemaDefinition(res["definition"], d)); err != nil {
+		return fmt.Errorf("Error reading Schema: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePubsubSchemaDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://pubsub.googleapis.com/v1/projects/{{project}}/schemas/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Schema %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Schema")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Schema %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourcePubsubSchemaImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/schemas/(?P<name>[^/]+)", "(?P<project>[^/]+)/(?P<name>[^/]+)", "(?P<name>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/schemas/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenPubsubSchemaType(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenPubsubSchemaDefinition(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandPubsubSchemaType(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandPubsubSchemaDefinition(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
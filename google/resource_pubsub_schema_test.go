@@ -0,0 +1,140 @@
+package google
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccPubsubSchema_pubsubSchemaBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSchema_pubsubSchemaBasicExample(context),
+			},
+			{
+				ResourceName:      "google_pubsub_schema.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPubsubSchema_pubsubSchemaBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_pubsub_schema" "example" {
+  name       = "tf-test-example%{random_suffix}"
+  type       = "AVRO"
+  definition = "{\n  \"type\" : \"record\",\n  \"name\" : \"Avro\",\n  \"fields\" : [\n    {\n      \"name\" : \"StringField\",\n      \"type\" : \"string\"\n    },\n    {\n      \"name\" : \"IntField\",\n      \"type\" : \"int\"\n    }\n  ]\n}\n"
+}
+`, context)
+}
+
+func TestAccPubsubSchema_pubsubSchemaProtobufExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSchema_pubsubSchemaProtobufExample(context),
+			},
+			{
+				ResourceName:      "google_pubsub_schema.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPubsubSchema_pubsubSchemaProtobufExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_pubsub_schema" "example" {
+  name       = "tf-test-example%{random_suffix}"
+  type       = "PROTOCOL_BUFFER"
+  definition = "syntax = \"proto3\";\nmessage Results {\nstring message_request = 1;\nstring message_response = 2;\nstring timestamp_request = 3;\nstring timestamp_response = 4;\n}"
+}
+
+resource "google_pubsub_topic" "example" {
+  name = "tf-test-example-topic%{random_suffix}"
+
+  schema_settings {
+    schema   = google_pubsub_schema.example.id
+    encoding = "JSON"
+  }
+}
+`, context)
+}
+
+func TestAccPubsubSchema_invalidDefinition(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSchemaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPubsubSchema_invalidDefinition(acctest.RandString(10)),
+				ExpectError: regexp.MustCompile("Error validating Schema definition"),
+			},
+		},
+	})
+}
+
+func testAccPubsubSchema_invalidDefinition(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_schema" "invalid" {
+  name       = "tf-test-invalid-%s"
+  type       = "AVRO"
+  definition = "{\"type\" : \"record\"}"
+}
+`, suffix)
+}
+
+func testAccCheckPubsubSchemaDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_pubsub_schema" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://pubsub.googleapis.com/v1/projects/{{project}}/schemas/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("PubsubSchema still exists at %s", url)
+		}
+	}
+
+	return nil
+}
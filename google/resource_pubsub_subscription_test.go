@@ -81,6 +81,123 @@ func TestAccPubsubSubscription_update(t *testing.T) {
 	})
 }
 
+func TestAccPubsubSubscription_deadLetterRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_deadLetterRetryPolicy(topic, subscription, 10, "20s"),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubSubscription_deadLetterRetryPolicy(topic, subscription, 15, "30s"),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the policies and exactly-once delivery updates the
+				// subscription in place.
+				Config: testAccPubsubSubscription_filter(topic, subscription),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foo", "dead_letter_policy.#", "0"),
+					resource.TestCheckResourceAttr("google_pubsub_subscription.foo", "enable_exactly_once_delivery", "false"),
+				),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubSubscription_bigqueryConfig(t *testing.T) {
+	t.Parallel()
+
+	dataset := fmt.Sprintf("tftestdataset%s", acctest.RandString(10))
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_bigqueryConfig(dataset, topic, subscription, false),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubSubscription_bigqueryConfig(dataset, topic, subscription, true),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubSubscription_cloudStorageConfig(t *testing.T) {
+	t.Parallel()
+
+	bucket := fmt.Sprintf("tf-test-bucket-%s", acctest.RandString(10))
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	subscription := fmt.Sprintf("tf-test-sub-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubSubscription_cloudStorageConfig(bucket, topic, subscription, "pre-", "300s"),
+				Check:  resource.TestCheckResourceAttrSet("google_pubsub_subscription.foo", "cloud_storage_config.0.state"),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubSubscription_cloudStorageConfigAvro(bucket, topic, subscription),
+			},
+			{
+				ResourceName:      "google_pubsub_subscription.foo",
+				ImportStateId:     subscription,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 // TODO: Add acceptance test for push delivery.
 //
 // Testing push endpoints is tricky for the following reason:
@@ -110,6 +227,171 @@ resource "google_pubsub_subscription" "foo" {
 }`, topic, subscription, label, deadline)
 }
 
+func testAccPubsubSubscription_deadLetterRetryPolicy(topic, subscription string, maxDeliveryAttempts int, minimumBackoff string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_topic" "dead_letter" {
+	name = "%s-dead-letter"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	filter = "attributes.foo = \"bar\""
+
+	dead_letter_policy {
+		dead_letter_topic     = "${google_pubsub_topic.dead_letter.id}"
+		max_delivery_attempts = %d
+	}
+
+	retry_policy {
+		minimum_backoff = "%s"
+		maximum_backoff = "600s"
+	}
+
+	enable_exactly_once_delivery = true
+}`, topic, topic, subscription, maxDeliveryAttempts, minimumBackoff)
+}
+
+func testAccPubsubSubscription_filter(topic, subscription string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	filter = "attributes.foo = \"bar\""
+}`, topic, subscription)
+}
+
+func testAccPubsubSubscription_bigqueryConfig(dataset, topic, subscription string, useTopicSchema bool) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_project_iam_member" "editor" {
+	role   = "roles/bigquery.dataEditor"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_project_iam_member" "viewer" {
+	role   = "roles/bigquery.metadataViewer"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_bigquery_dataset" "test" {
+	dataset_id = "%s"
+}
+
+resource "google_bigquery_table" "test" {
+	dataset_id = "${google_bigquery_dataset.test.dataset_id}"
+	table_id   = "test"
+
+	schema = <<EOF
+[
+	{
+		"name": "data",
+		"type": "STRING",
+		"mode": "NULLABLE",
+		"description": "The data"
+	}
+]
+EOF
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	bigquery_config {
+		table               = "${google_bigquery_table.test.project}.${google_bigquery_table.test.dataset_id}.${google_bigquery_table.test.table_id}"
+		use_topic_schema    = %t
+		drop_unknown_fields = true
+	}
+
+	depends_on = ["google_project_iam_member.editor", "google_project_iam_member.viewer"]
+}`, dataset, topic, subscription, useTopicSchema)
+}
+
+func testAccPubsubSubscription_cloudStorageConfig(bucket, topic, subscription, filenamePrefix, maxDuration string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_storage_bucket" "test" {
+	name = "%s"
+}
+
+resource "google_storage_bucket_iam_member" "admin" {
+	bucket = "${google_storage_bucket.test.name}"
+	role   = "roles/storage.admin"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	cloud_storage_config {
+		bucket          = "${google_storage_bucket.test.name}"
+		filename_prefix = "%s"
+		filename_suffix = "-suffix"
+		max_bytes       = 1000
+		max_duration    = "%s"
+	}
+
+	depends_on = ["google_storage_bucket_iam_member.admin"]
+}`, bucket, topic, subscription, filenamePrefix, maxDuration)
+}
+
+func testAccPubsubSubscription_cloudStorageConfigAvro(bucket, topic, subscription string) string {
+	return fmt.Sprintf(`
+data "google_project" "project" {}
+
+resource "google_storage_bucket" "test" {
+	name = "%s"
+}
+
+resource "google_storage_bucket_iam_member" "admin" {
+	bucket = "${google_storage_bucket.test.name}"
+	role   = "roles/storage.admin"
+	member = "serviceAccount:service-${data.google_project.project.number}@gcp-sa-pubsub.iam.gserviceaccount.com"
+}
+
+resource "google_pubsub_topic" "foo" {
+	name = "%s"
+}
+
+resource "google_pubsub_subscription" "foo" {
+	name  = "%s"
+	topic = "${google_pubsub_topic.foo.id}"
+
+	cloud_storage_config {
+		bucket       = "${google_storage_bucket.test.name}"
+		max_duration = "60s"
+
+		avro_config {
+			write_metadata = true
+		}
+	}
+
+	depends_on = ["google_storage_bucket_iam_member.admin"]
+}`, bucket, topic, subscription)
+}
+
 func TestGetComputedTopicName(t *testing.T) {
 	type testData struct {
 		project  string
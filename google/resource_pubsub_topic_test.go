@@ -0,0 +1,115 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccPubsubTopic_update(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_update(topic, "foo", "bar", "us-central1", "86600s"),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPubsubTopic_update(topic, "foo", "baz", "us-east1", "1200s"),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.foo",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccPubsubTopic_cmek(t *testing.T) {
+	t.Parallel()
+
+	topic := fmt.Sprintf("tf-test-topic-%s", acctest.RandString(10))
+	keyRing := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	key := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPubsubTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPubsubTopic_cmek(topic, keyRing, key),
+			},
+			{
+				ResourceName:      "google_pubsub_topic.topic",
+				ImportStateId:     topic,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPubsubTopic_update(topic, key, value, region, retention string) string {
+	return fmt.Sprintf(`
+resource "google_pubsub_topic" "foo" {
+  name = "%s"
+
+  labels = {
+    %s = "%s"
+  }
+
+  message_storage_policy {
+    allowed_persistence_regions = [
+      "%s",
+    ]
+  }
+
+  message_retention_duration = "%s"
+}
+`, topic, key, value, region, retention)
+}
+
+func testAccPubsubTopic_cmek(topic, keyRing, key string) string {
+	return fmt.Sprintf(`
+data "google_project_service_agent" "pubsub" {
+  service = "pubsub.googleapis.com"
+}
+
+resource "google_kms_key_ring" "key_ring" {
+  name     = "%s"
+  location = "global"
+}
+
+resource "google_kms_crypto_key" "crypto_key" {
+  name     = "%s"
+  key_ring = "${google_kms_key_ring.key_ring.self_link}"
+}
+
+resource "google_kms_crypto_key_iam_member" "pubsub" {
+  crypto_key_id = "${google_kms_crypto_key.crypto_key.self_link}"
+  role          = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+  member        = "${data.google_project_service_agent.pubsub.member}"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name         = "%s"
+  kms_key_name = "${google_kms_crypto_key_iam_member.pubsub.crypto_key_id}"
+}
+`, keyRing, key, topic)
+}
@@ -0,0 +1,92 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccRedisCluster_redisClusterHaExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"network_name":  BootstrapSharedServiceConnectionPolicy(t, "gcp-memorystore-redis"),
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedisClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisCluster_redisClusterHaExample(context),
+			},
+			{
+				ResourceName:      "google_redis_cluster.cluster-ha",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRedisCluster_redisClusterHaExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_redis_cluster" "cluster-ha" {
+  name          = "tf-test-ha-cluster%{random_suffix}"
+  shard_count   = 3
+  replica_count = 1
+  node_type     = "REDIS_SHARED_CORE_NANO"
+  region        = "us-central1"
+
+  psc_configs {
+    network = "%{network_name}"
+  }
+
+  transit_encryption_mode = "TRANSIT_ENCRYPTION_MODE_DISABLED"
+  authorization_mode      = "AUTH_MODE_DISABLED"
+
+  redis_configs = {
+    maxmemory-policy = "volatile-ttl"
+  }
+
+  persistence_config {
+    mode = "RDB"
+    rdb_config {
+      rdb_snapshot_period     = "ONE_HOUR"
+      rdb_snapshot_start_time = "2024-10-02T15:01:23Z"
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckRedisClusterDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_redis_cluster" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://redis.googleapis.com/v1/projects/{{project}}/locations/{{region}}/clusters/{{name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("RedisCluster still exists at %s", url)
+		}
+	}
+
+	return nil
+}
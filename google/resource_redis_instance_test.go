@@ -38,6 +38,58 @@ func TestAccRedisInstance_update(t *testing.T) {
 	})
 }
 
+func TestAccRedisInstance_readReplicas(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstance_readReplicas(name, 2),
+			},
+			{
+				ResourceName:      "google_redis_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRedisInstance_readReplicas(name, 3),
+			},
+			{
+				ResourceName:      "google_redis_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRedisInstance_privateServiceAccess(t *testing.T) {
+	t.Parallel()
+
+	name := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRedisInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedisInstance_privateServiceAccess(name),
+			},
+			{
+				ResourceName:      "google_redis_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccRedisInstance_update(name string) string {
 	return fmt.Sprintf(`
 resource "google_redis_instance" "test" {
@@ -74,5 +126,62 @@ resource "google_redis_instance" "test" {
 		maxmemory-policy       = "noeviction"
 		notify-keyspace-events = ""
 	}
+
+	auth_enabled = true
+
+	maintenance_policy {
+		weekly_maintenance_window {
+			day = "TUESDAY"
+			start_time {
+				hours   = 0
+				minutes = 30
+			}
+		}
+	}
 }`, name)
 }
+
+func testAccRedisInstance_readReplicas(name string, replicaCount int) string {
+	return fmt.Sprintf(`
+resource "google_redis_instance" "test" {
+	name           = "%s"
+	memory_size_gb = 5
+	region         = "us-central1"
+	tier           = "STANDARD_HA"
+	redis_version  = "REDIS_6_X"
+
+	read_replicas_mode      = "READ_REPLICAS_ENABLED"
+	replica_count           = %d
+	transit_encryption_mode = "SERVER_AUTHENTICATION"
+}`, name, replicaCount)
+}
+
+func testAccRedisInstance_privateServiceAccess(name string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "redis_network" {
+	name = "%s"
+}
+
+resource "google_compute_global_address" "service_range" {
+	name          = "%s"
+	purpose       = "VPC_PEERING"
+	address_type  = "INTERNAL"
+	prefix_length = 16
+	network       = "${google_compute_network.redis_network.self_link}"
+}
+
+resource "google_service_networking_connection" "private_service_connection" {
+	network                 = "${google_compute_network.redis_network.self_link}"
+	service                 = "servicenetworking.googleapis.com"
+	reserved_peering_ranges = ["${google_compute_global_address.service_range.name}"]
+}
+
+resource "google_redis_instance" "test" {
+	name           = "%s"
+	memory_size_gb = 1
+	region         = "us-central1"
+
+	authorized_network = "${google_service_networking_connection.private_service_connection.network}"
+	connect_mode       = "PRIVATE_SERVICE_ACCESS"
+}`, name, name, name)
+}
@@ -0,0 +1,613 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSecretManagerSecret() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretManagerSecretCreate,
+		Read:   resourceSecretManagerSecretRead,
+		Update: resourceSecretManagerSecretUpdate,
+		Delete: resourceSecretManagerSecretDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecretManagerSecretImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"replication": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"automatic": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"user_managed": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"replicas": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"location": {
+													Type:     schema.TypeString,
+													Required: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"expire_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"rotation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"next_rotation_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"rotation_period": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecretManagerSecretCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandSecretManagerSecretLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	replicationProp, err := expandSecretManagerSecretReplication(d.Get("replication"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("replication"); !isEmptyValue(reflect.ValueOf(replicationProp)) && (ok || !reflect.DeepEqual(v, replicationProp)) {
+		obj["replication"] = replicationProp
+	}
+	topicsProp, err := expandSecretManagerSecretTopics(d.Get("topics"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("topics"); !isEmptyValue(reflect.ValueOf(topicsProp)) && (ok || !reflect.DeepEqual(v, topicsProp)) {
+		obj["topics"] = topicsProp
+	}
+	rotationProp, err := expandSecretManagerSecretRotation(d.Get("rotation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rotation"); !isEmptyValue(reflect.ValueOf(rotationProp)) && (ok || !reflect.DeepEqual(v, rotationProp)) {
+		obj["rotation"] = rotationProp
+	}
+	expireTimeProp, err := expandSecretManagerSecretExpireTime(d.Get("expire_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("expire_time"); !isEmptyValue(reflect.ValueOf(expireTimeProp)) && (ok || !reflect.DeepEqual(v, expireTimeProp)) {
+		obj["expireTime"] = expireTimeProp
+	}
+	ttlProp, err := expandSecretManagerSecretTtl(d.Get("ttl"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ttl"); !isEmptyValue(reflect.ValueOf(ttlProp)) && (ok || !reflect.DeepEqual(v, ttlProp)) {
+		obj["ttl"] = ttlProp
+	}
+
+	url, err := replaceVars(d, config, "https://secretmanager.googleapis.com/v1/projects/{{project}}/secrets?secretId={{secret_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Secret: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Secret: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/secrets/{{secret_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Secret %q: %#v", d.Id(), res)
+
+	return resourceSecretManagerSecretRead(d, meta)
+}
+
+func resourceSecretManagerSecretRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://secretmanager.googleapis.com/v1/projects/{{project}}/secrets/{{secret_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecretManagerSecret %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+
+	if err := d.Set("labels", flattenSecretManagerSecretLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("replication", flattenSecretManagerSecretReplication(res["replication"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("topics", flattenSecretManagerSecretTopics(res["topics"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("rotation", flattenSecretManagerSecretRotation(res["rotation"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("expire_time", flattenSecretManagerSecretExpireTime(res["expireTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("name", flattenSecretManagerSecretName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+	if err := d.Set("create_time", flattenSecretManagerSecretCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecretManagerSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	labelsProp, err := expandSecretManagerSecretLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	topicsProp, err := expandSecretManagerSecretTopics(d.Get("topics"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("topics"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, topicsProp)) {
+		obj["topics"] = topicsProp
+	}
+	rotationProp, err := expandSecretManagerSecretRotation(d.Get("rotation"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("rotation"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, rotationProp)) {
+		obj["rotation"] = rotationProp
+	}
+	expireTimeProp, err := expandSecretManagerSecretExpireTime(d.Get("expire_time"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("expire_time"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, expireTimeProp)) {
+		obj["expireTime"] = expireTimeProp
+	}
+	ttlProp, err := expandSecretManagerSecretTtl(d.Get("ttl"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ttl"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, ttlProp)) {
+		obj["ttl"] = ttlProp
+	}
+
+	url, err := replaceVars(d, config, "https://secretmanager.googleapis.com/v1/projects/{{project}}/secrets/{{secret_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Secret %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("topics") {
+		updateMask = append(updateMask, "topics")
+	}
+
+	if d.HasChange("rotation") {
+		updateMask = append(updateMask, "rotation")
+	}
+
+	if d.HasChange("expire_time") {
+		updateMask = append(updateMask, "expireTime")
+	}
+
+	if d.HasChange("ttl") {
+		updateMask = append(updateMask, "ttl")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Secret %q: %s", d.Id(), err)
+	}
+
+	return resourceSecretManagerSecretRead(d, meta)
+}
+
+func resourceSecretManagerSecretDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://secretmanager.googleapis.com/v1/projects/{{project}}/secrets/{{secret_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Secret %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Secret")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Secret %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecretManagerSecretImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/secrets/(?P<secret_id>[^/]+)", "(?P<project>[^/]+)/(?P<secret_id>[^/]+)", "(?P<secret_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/secrets/{{secret_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenSecretManagerSecretLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretReplication(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["automatic"] =
+		flattenSecretManagerSecretReplicationAutomatic(original["automatic"], d)
+	transformed["user_managed"] =
+		flattenSecretManagerSecretReplicationUserManaged(original["userManaged"], d)
+	return []interface{}{transformed}
+}
+
+func flattenSecretManagerSecretReplicationAutomatic(v interface{}, d *schema.ResourceData) interface{} {
+	return v != nil
+}
+
+func flattenSecretManagerSecretReplicationUserManaged(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["replicas"] =
+		flattenSecretManagerSecretReplicationUserManagedReplicas(original["replicas"], d)
+	return []interface{}{transformed}
+}
+
+func flattenSecretManagerSecretReplicationUserManagedReplicas(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"location": flattenSecretManagerSecretReplicationUserManagedReplicasLocation(original["location"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenSecretManagerSecretReplicationUserManagedReplicasLocation(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretTopics(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.([]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		original := raw.(map[string]interface{})
+		if len(original) < 1 {
+			// Do not include empty json objects coming back from the api
+			continue
+		}
+		transformed = append(transformed, map[string]interface{}{
+			"name": flattenSecretManagerSecretTopicsName(original["name"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenSecretManagerSecretTopicsName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretRotation(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["next_rotation_time"] =
+		flattenSecretManagerSecretRotationNextRotationTime(original["nextRotationTime"], d)
+	transformed["rotation_period"] =
+		flattenSecretManagerSecretRotationRotationPeriod(original["rotationPeriod"], d)
+	return []interface{}{transformed}
+}
+
+func flattenSecretManagerSecretRotationNextRotationTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretRotationRotationPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretExpireTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSecretManagerSecretCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandSecretManagerSecretLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandSecretManagerSecretReplication(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedAutomatic, err := expandSecretManagerSecretReplicationAutomatic(original["automatic"], d, config)
+	if err != nil {
+		return nil, err
+	} else if transformedAutomatic != nil {
+		transformed["automatic"] = transformedAutomatic
+	}
+
+	transformedUserManaged, err := expandSecretManagerSecretReplicationUserManaged(original["user_managed"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedUserManaged); val.IsValid() && !isEmptyValue(val) {
+		transformed["userManaged"] = transformedUserManaged
+	}
+
+	return transformed, nil
+}
+
+func expandSecretManagerSecretReplicationAutomatic(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	if v == nil || !v.(bool) {
+		return nil, nil
+	}
+	// Automatic replication is enabled by sending an empty object.
+	return map[string]interface{}{}, nil
+}
+
+func expandSecretManagerSecretReplicationUserManaged(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	replicas := make([]interface{}, 0)
+	for _, r := range original["replicas"].([]interface{}) {
+		if r == nil {
+			continue
+		}
+		replica := r.(map[string]interface{})
+		replicas = append(replicas, map[string]interface{}{
+			"location": replica["location"],
+		})
+	}
+	transformed["replicas"] = replicas
+
+	return transformed, nil
+}
+
+func expandSecretManagerSecretTopics(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	req := make([]interface{}, 0, len(l))
+	for _, raw := range l {
+		if raw == nil {
+			continue
+		}
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedName, err := expandSecretManagerSecretTopicsName(original["name"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedName); val.IsValid() && !isEmptyValue(val) {
+			transformed["name"] = transformedName
+		}
+
+		req = append(req, transformed)
+	}
+	return req, nil
+}
+
+func expandSecretManagerSecretTopicsName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecretManagerSecretRotation(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedNextRotationTime, err := expandSecretManagerSecretRotationNextRotationTime(original["next_rotation_time"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedNextRotationTime); val.IsValid() && !isEmptyValue(val) {
+		transformed["nextRotationTime"] = transformedNextRotationTime
+	}
+
+	transformedRotationPeriod, err := expandSecretManagerSecretRotationRotationPeriod(original["rotation_period"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedRotationPeriod); val.IsValid() && !isEmptyValue(val) {
+		transformed["rotationPeriod"] = transformedRotationPeriod
+	}
+
+	return transformed, nil
+}
+
+func expandSecretManagerSecretRotationNextRotationTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecretManagerSecretRotationRotationPeriod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecretManagerSecretExpireTime(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSecretManagerSecretTtl(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,133 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSecretManagerSecret_secretConfigBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecretManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretManagerSecret_secretConfigBasicExample(context),
+			},
+			{
+				ResourceName:            "google_secret_manager_secret.secret-basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ttl"},
+			},
+		},
+	})
+}
+
+func testAccSecretManagerSecret_secretConfigBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_secret_manager_secret" "secret-basic" {
+  secret_id = "tf-test-secret%{random_suffix}"
+
+  labels = {
+    label = "my-label"
+  }
+
+  replication {
+    user_managed {
+      replicas {
+        location = "us-central1"
+      }
+      replicas {
+        location = "us-east1"
+      }
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckSecretManagerSecretDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_secret_manager_secret" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://secretmanager.googleapis.com/v1/projects/{{project}}/secrets/{{secret_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("SecretManagerSecret still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func TestAccSecretManagerSecret_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecretManagerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretManagerSecret_automatic(suffix, "my-label"),
+			},
+			{
+				ResourceName:            "google_secret_manager_secret.secret-automatic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ttl"},
+			},
+			{
+				Config: testAccSecretManagerSecret_automatic(suffix, "my-other-label"),
+			},
+			{
+				ResourceName:            "google_secret_manager_secret.secret-automatic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ttl"},
+			},
+		},
+	})
+}
+
+func testAccSecretManagerSecret_automatic(suffix, label string) string {
+	return fmt.Sprintf(`
+resource "google_secret_manager_secret" "secret-automatic" {
+  secret_id = "tf-test-secret%s"
+  ttl       = "36000s"
+
+  labels = {
+    label = "%s"
+  }
+
+  replication {
+    automatic = true
+  }
+}
+`, suffix, label)
+}
@@ -0,0 +1,231 @@
+package google
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var secretManagerSecretVersionNameRegex = regexp.MustCompile(`^(projects/[^/]+/secrets/[^/]+)/versions/([^/]+)$`)
+
+func resourceSecretManagerSecretVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSecretManagerSecretVersionCreate,
+		Read:   resourceSecretManagerSecretVersionRead,
+		Update: resourceSecretManagerSecretVersionUpdate,
+		Delete: resourceSecretManagerSecretVersionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceSecretManagerSecretVersionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secret": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_data": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destroy_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretManagerSecretVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := map[string]interface{}{
+		"payload": map[string]interface{}{
+			"data": base64.StdEncoding.EncodeToString([]byte(d.Get("secret_data").(string))),
+		},
+	}
+
+	url, err := replaceVars(d, config, "https://secretmanager.googleapis.com/v1/{{secret}}:addVersion")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new SecretVersion for %q", d.Get("secret"))
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating SecretVersion: %s", err)
+	}
+
+	// The version number is assigned by the server, so the id comes from the response.
+	name, ok := res["name"].(string)
+	if !ok || name == "" {
+		return fmt.Errorf("Error creating SecretVersion: name is missing from the response")
+	}
+	d.SetId(name)
+
+	if !d.Get("enabled").(bool) {
+		if err := setSecretManagerSecretVersionState(d, config, "disable", d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Finished creating SecretVersion %q", d.Id())
+
+	return resourceSecretManagerSecretVersionRead(d, meta)
+}
+
+func resourceSecretManagerSecretVersionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s", d.Id())
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("SecretManagerSecretVersion %q", d.Id()))
+	}
+
+	// Destroyed versions can't be recovered, so treat them as gone.
+	if res["state"] == "DESTROYED" {
+		log.Printf("[WARN] Removing SecretVersion %q because it has been destroyed", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	parts := secretManagerSecretVersionNameRegex.FindStringSubmatch(d.Id())
+	if parts == nil {
+		return fmt.Errorf("Invalid SecretVersion id %q, expected projects/{{project}}/secrets/{{secret}}/versions/{{version}}", d.Id())
+	}
+
+	if err := d.Set("secret", parts[1]); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+	if err := d.Set("version", parts[2]); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+	if err := d.Set("name", res["name"]); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+	if err := d.Set("create_time", res["createTime"]); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+	if err := d.Set("destroy_time", res["destroyTime"]); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+	enabled := res["state"] == "ENABLED"
+	if err := d.Set("enabled", enabled); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+
+	// Disabled versions can't be accessed, so keep the payload from state.
+	if !enabled {
+		return nil
+	}
+
+	data, err := accessSecretManagerSecretVersion(config, d.Id())
+	if err != nil {
+		return err
+	}
+	if err := d.Set("secret_data", data); err != nil {
+		return fmt.Errorf("Error reading SecretVersion: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSecretManagerSecretVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("enabled") {
+		action := "disable"
+		if d.Get("enabled").(bool) {
+			action = "enable"
+		}
+		if err := setSecretManagerSecretVersionState(d, config, action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceSecretManagerSecretVersionRead(d, meta)
+}
+
+func resourceSecretManagerSecretVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s:destroy", d.Id())
+
+	log.Printf("[DEBUG] Destroying SecretVersion %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "POST", url, map[string]interface{}{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "SecretVersion")
+	}
+
+	log.Printf("[DEBUG] Finished destroying SecretVersion %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceSecretManagerSecretVersionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if !secretManagerSecretVersionNameRegex.MatchString(d.Id()) {
+		return nil, fmt.Errorf("Invalid SecretVersion id %q, expected projects/{{project}}/secrets/{{secret}}/versions/{{version}}", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setSecretManagerSecretVersionState(d *schema.ResourceData, config *Config, action string, timeout time.Duration) error {
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s:%s", d.Id(), action)
+
+	log.Printf("[DEBUG] Calling %s on SecretVersion %q", action, d.Id())
+	if _, err := sendRequestWithTimeout(config, "POST", url, map[string]interface{}{}, timeout); err != nil {
+		return fmt.Errorf("Error calling %s on SecretVersion %q: %s", action, d.Id(), err)
+	}
+	return nil
+}
+
+// accessSecretManagerSecretVersion returns the decoded payload of the secret
+// version with the given name.
+func accessSecretManagerSecretVersion(config *Config, name string) (string, error) {
+	url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s:access", name)
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return "", fmt.Errorf("Error accessing SecretVersion %q: %s", name, err)
+	}
+
+	payload, ok := res["payload"].(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("Error accessing SecretVersion %q: payload is missing from the response", name)
+	}
+	data, err := base64.StdEncoding.DecodeString(payload["data"].(string))
+	if err != nil {
+		return "", fmt.Errorf("Error decoding SecretVersion %q payload: %s", name, err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,86 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSecretManagerSecretVersion_update(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSecretManagerSecretVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretManagerSecretVersion_basic(suffix, true),
+				Check:  resource.TestCheckResourceAttr("google_secret_manager_secret_version.secret-version-basic", "enabled", "true"),
+			},
+			{
+				ResourceName:      "google_secret_manager_secret_version.secret-version-basic",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSecretManagerSecretVersion_basic(suffix, false),
+				Check:  resource.TestCheckResourceAttr("google_secret_manager_secret_version.secret-version-basic", "enabled", "false"),
+			},
+			{
+				Config: testAccSecretManagerSecretVersion_basic(suffix, true),
+				Check:  resource.TestCheckResourceAttr("google_secret_manager_secret_version.secret-version-basic", "enabled", "true"),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretManagerSecretVersionDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_secret_manager_secret_version" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("https://secretmanager.googleapis.com/v1/%s", rs.Primary.ID)
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			// The secret may have been deleted along with its versions.
+			continue
+		}
+
+		if res["state"] != "DESTROYED" {
+			return fmt.Errorf("SecretVersion still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccSecretManagerSecretVersion_basic(suffix string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_secret_manager_secret" "secret-basic" {
+  secret_id = "tf-test-secret-version-%s"
+
+  replication {
+    automatic = true
+  }
+}
+
+resource "google_secret_manager_secret_version" "secret-version-basic" {
+  secret      = "${google_secret_manager_secret.secret-basic.id}"
+  secret_data = "my-tf-test-secret%s"
+  enabled     = %t
+}
+`, suffix, suffix, enabled)
+}
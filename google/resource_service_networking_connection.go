@@ -1,3 +1,246 @@
 package google
 
-// Magic Modules doesn't let us remove files - blank out beta-only common-compile files for now.
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+)
+
+const serviceNetworkingBasePath = "https://servicenetworking.googleapis.com/v1/"
+
+func resourceServiceNetworkingConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceNetworkingConnectionCreate,
+		Read:   resourceServiceNetworkingConnectionRead,
+		Update: resourceServiceNetworkingConnectionUpdate,
+		Delete: resourceServiceNetworkingConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceNetworkingConnectionImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"reserved_peering_ranges": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"peering": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceServiceNetworkingConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	network, err := serviceNetworkingNetworkName(d, config)
+	if err != nil {
+		return err
+	}
+
+	service := d.Get("service").(string)
+	obj := map[string]interface{}{
+		"network":               network,
+		"reservedPeeringRanges": convertStringArr(d.Get("reserved_peering_ranges").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Creating Service Networking Connection for %q: %#v", service, obj)
+	res, err := sendRequestWithTimeout(config, "POST", serviceNetworkingBasePath+"services/"+service+"/connections", obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Service Networking Connection: %s", err)
+	}
+
+	if err := serviceNetworkingOperationWaitTime(config, res, "", "Creating Service Networking Connection", int(d.Timeout(schema.TimeoutCreate).Minutes())); err != nil {
+		return err
+	}
+
+	d.SetId(serviceNetworkingConnectionId(d.Get("network").(string), service))
+
+	return resourceServiceNetworkingConnectionRead(d, meta)
+}
+
+func resourceServiceNetworkingConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	connectionNetwork, service, err := parseServiceNetworkingConnectionId(d.Id())
+	if err != nil {
+		return err
+	}
+	d.Set("network", connectionNetwork)
+	d.Set("service", service)
+
+	network, err := serviceNetworkingNetworkName(d, config)
+	if err != nil {
+		return err
+	}
+
+	u, err := addQueryParams(serviceNetworkingBasePath+"services/"+service+"/connections", map[string]string{"network": network})
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", u, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Service Networking Connection %q", d.Id()))
+	}
+
+	connections, _ := res["connections"].([]interface{})
+	var connection map[string]interface{}
+	for _, c := range connections {
+		if m, ok := c.(map[string]interface{}); ok && m["network"] == network {
+			connection = m
+			break
+		}
+	}
+	if connection == nil {
+		log.Printf("[WARN] Removing Service Networking Connection %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("peering", connection["peering"])
+	d.Set("reserved_peering_ranges", connection["reservedPeeringRanges"])
+
+	return nil
+}
+
+func resourceServiceNetworkingConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	if d.HasChange("reserved_peering_ranges") {
+		network, err := serviceNetworkingNetworkName(d, config)
+		if err != nil {
+			return err
+		}
+
+		obj := map[string]interface{}{
+			"network":               network,
+			"reservedPeeringRanges": convertStringArr(d.Get("reserved_peering_ranges").([]interface{})),
+		}
+
+		// The connection name is always "-", a connection is identified by the
+		// service and network pair. Removing ranges that are in use requires force.
+		u, err := addQueryParams(serviceNetworkingBasePath+"services/"+d.Get("service").(string)+"/connections/-", map[string]string{
+			"updateMask": "reservedPeeringRanges",
+			"force":      "true",
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Updating Service Networking Connection %q: %#v", d.Id(), obj)
+		res, err := sendRequestWithTimeout(config, "PATCH", u, obj, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error updating Service Networking Connection %q: %s", d.Id(), err)
+		}
+
+		if err := serviceNetworkingOperationWaitTime(config, res, "", "Updating Service Networking Connection", int(d.Timeout(schema.TimeoutUpdate).Minutes())); err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceNetworkingConnectionRead(d, meta)
+}
+
+// Service Networking has no delete method for connections in v1, so the
+// connection is torn down by removing its VPC peering from the consumer network.
+func resourceServiceNetworkingConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	networkFieldValue, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return err
+	}
+
+	peering := d.Get("peering").(string)
+	request := &compute.NetworksRemovePeeringRequest{
+		Name: peering,
+	}
+
+	op, err := config.clientCompute.Networks.RemovePeering(networkFieldValue.Project, networkFieldValue.Name, request).Do()
+	if err != nil {
+		if gerr, ok := err.(*googleapi.Error); ok && gerr.Code == 404 {
+			log.Printf("[WARN] Peering `%s` already removed from network `%s`", peering, networkFieldValue.Name)
+		} else {
+			return fmt.Errorf("Error removing peering `%s` from network `%s`: %s", peering, networkFieldValue.Name, err)
+		}
+	} else {
+		err = computeOperationWaitTime(config, op, networkFieldValue.Project, "Removing Service Networking Connection", int(d.Timeout(schema.TimeoutDelete).Minutes()))
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceServiceNetworkingConnectionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseServiceNetworkingConnectionId(d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// serviceNetworkingNetworkName returns the network in the
+// projects/{projectNumber}/global/networks/{name} form the API expects.
+func serviceNetworkingNetworkName(d *schema.ResourceData, config *Config) (string, error) {
+	networkFieldValue, err := ParseNetworkFieldValue(d.Get("network").(string), d, config)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := config.clientResourceManager.Projects.Get(networkFieldValue.Project).Do()
+	if err != nil {
+		return "", fmt.Errorf("Error getting project number for %q: %s", networkFieldValue.Project, err)
+	}
+
+	return fmt.Sprintf("projects/%d/global/networks/%s", project.ProjectNumber, networkFieldValue.Name), nil
+}
+
+// The id is the configured network and the service separated by a colon, with
+// the network escaped so it doesn't contain further separators.
+func serviceNetworkingConnectionId(network, service string) string {
+	return fmt.Sprintf("%s:%s", url.QueryEscape(network), service)
+}
+
+func parseServiceNetworkingConnectionId(id string) (string, string, error) {
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("Invalid Service Networking Connection id %q, expected {{network}}:{{service}}", id)
+	}
+
+	network, err := url.QueryUnescape(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("Invalid network in Service Networking Connection id %q: %s", id, err)
+	}
+
+	return network, parts[1], nil
+}
@@ -1,3 +1,130 @@
 package google
 
-// Magic Modules doesn't let us remove files - blank out beta-only common-compile files for now.
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestServiceNetworkingConnectionId(t *testing.T) {
+	cases := []string{
+		"default",
+		"projects/my-project/global/networks/my-network",
+		"https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network",
+	}
+
+	for _, network := range cases {
+		id := serviceNetworkingConnectionId(network, "servicenetworking.googleapis.com")
+		gotNetwork, gotService, err := parseServiceNetworkingConnectionId(id)
+		if err != nil {
+			t.Fatalf("unexpected error parsing id %q: %s", id, err)
+		}
+		if gotNetwork != network {
+			t.Errorf("expected network %q, got %q", network, gotNetwork)
+		}
+		if gotService != "servicenetworking.googleapis.com" {
+			t.Errorf("expected service %q, got %q", "servicenetworking.googleapis.com", gotService)
+		}
+	}
+
+	if _, _, err := parseServiceNetworkingConnectionId("default"); err == nil {
+		t.Errorf("expected an error parsing an id without a service")
+	}
+}
+
+func TestAccServiceNetworkingConnection_update(t *testing.T) {
+	t.Parallel()
+
+	network := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	addr1 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	addr2 := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckServiceNetworkingConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceNetworkingConnection(network, addr1, addr2, "${google_compute_global_address.foo.name}"),
+			},
+			{
+				ResourceName:      "google_service_networking_connection.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccServiceNetworkingConnection(network, addr1, addr2, "${google_compute_global_address.bar.name}"),
+			},
+			{
+				ResourceName:      "google_service_networking_connection.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckServiceNetworkingConnectionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "google_service_networking_connection" {
+			continue
+		}
+
+		network, _, err := parseServiceNetworkingConnectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		networkFieldValue, err := parseGlobalFieldValue("networks", network, "project", nil, config, true)
+		if err != nil {
+			return err
+		}
+
+		n, err := config.clientCompute.Networks.Get(networkFieldValue.Project, networkFieldValue.Name).Do()
+		if err != nil {
+			// The network was destroyed along with the connection.
+			continue
+		}
+		for _, p := range n.Peerings {
+			if p.Name == rs.Primary.Attributes["peering"] {
+				return fmt.Errorf("Service Networking Connection %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccServiceNetworkingConnection(network, addr1, addr2, reservedRange string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "servicenet" {
+  name = "%s"
+}
+
+resource "google_compute_global_address" "foo" {
+  name          = "%s"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = "${google_compute_network.servicenet.self_link}"
+}
+
+resource "google_compute_global_address" "bar" {
+  name          = "%s"
+  purpose       = "VPC_PEERING"
+  address_type  = "INTERNAL"
+  prefix_length = 16
+  network       = "${google_compute_network.servicenet.self_link}"
+}
+
+resource "google_service_networking_connection" "foobar" {
+  network                 = "${google_compute_network.servicenet.self_link}"
+  service                 = "servicenetworking.googleapis.com"
+  reserved_peering_ranges = ["%s"]
+}
+`, network, addr1, addr2, reservedRange)
+}
@@ -23,12 +23,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 )
 
 func resourceSourceRepoRepository() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSourceRepoRepositoryCreate,
 		Read:   resourceSourceRepoRepositoryRead,
+		Update: resourceSourceRepoRepositoryUpdate,
 		Delete: resourceSourceRepoRepositoryDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -37,6 +39,7 @@ func resourceSourceRepoRepository() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
 
@@ -46,6 +49,28 @@ func resourceSourceRepoRepository() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"pubsub_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"message_format": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PROTOBUF", "JSON"}, false),
+						},
+						"service_account_email": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -74,6 +99,10 @@ func resourceSourceRepoRepositoryCreate(d *schema.ResourceData, meta interface{}
 	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
 		obj["name"] = nameProp
 	}
+	pubsubConfigsProp, err := expandSourceRepoRepositoryPubsubConfigs(d.Get("pubsub_configs"), d, config)
+	if err != nil {
+		return err
+	}
 
 	url, err := replaceVars(d, config, "https://sourcerepo.googleapis.com/v1/projects/{{project}}/repos")
 	if err != nil {
@@ -95,6 +124,13 @@ func resourceSourceRepoRepositoryCreate(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[DEBUG] Finished creating Repository %q: %#v", d.Id(), res)
 
+	// Pub/Sub notifications can't be configured when the repository is created,
+	// so they're patched in afterwards.
+	if len(pubsubConfigsProp) > 0 {
+		log.Printf("[DEBUG] Updating Repository %q to add pubsub_configs", d.Id())
+		return resourceSourceRepoRepositoryUpdate(d, meta)
+	}
+
 	return resourceSourceRepoRepositoryRead(d, meta)
 }
 
@@ -128,10 +164,45 @@ func resourceSourceRepoRepositoryRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("size", flattenSourceRepoRepositorySize(res["size"], d)); err != nil {
 		return fmt.Errorf("Error reading Repository: %s", err)
 	}
+	if err := d.Set("pubsub_configs", flattenSourceRepoRepositoryPubsubConfigs(res["pubsubConfigs"], d)); err != nil {
+		return fmt.Errorf("Error reading Repository: %s", err)
+	}
 
 	return nil
 }
 
+func resourceSourceRepoRepositoryUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	pubsubConfigsProp, err := expandSourceRepoRepositoryPubsubConfigs(d.Get("pubsub_configs"), d, config)
+	if err != nil {
+		return err
+	}
+
+	// The repository is wrapped in an UpdateRepoRequest, and pubsubConfigs is the
+	// only field that can be updated.
+	obj := map[string]interface{}{
+		"repo": map[string]interface{}{
+			"pubsubConfigs": pubsubConfigsProp,
+		},
+		"updateMask": "pubsubConfigs",
+	}
+
+	url, err := replaceVars(d, config, "https://sourcerepo.googleapis.com/v1/projects/{{project}}/repos/{{name}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Repository %q: %#v", d.Id(), obj)
+	_, err = sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Repository %q: %s", d.Id(), err)
+	}
+
+	return resourceSourceRepoRepositoryRead(d, meta)
+}
+
 func resourceSourceRepoRepositoryDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -191,6 +262,72 @@ func flattenSourceRepoRepositorySize(v interface{}, d *schema.ResourceData) inte
 	return v
 }
 
+// The API keys pubsubConfigs by topic; they're stored as a set of blocks that
+// carry their own topic.
+func flattenSourceRepoRepositoryPubsubConfigs(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return v
+	}
+	l := v.(map[string]interface{})
+	transformed := make([]interface{}, 0, len(l))
+	for topic, raw := range l {
+		original := raw.(map[string]interface{})
+		transformed = append(transformed, map[string]interface{}{
+			"topic":                 topic,
+			"message_format":        flattenSourceRepoRepositoryPubsubConfigsMessageFormat(original["messageFormat"], d),
+			"service_account_email": flattenSourceRepoRepositoryPubsubConfigsServiceAccountEmail(original["serviceAccountEmail"], d),
+		})
+	}
+	return transformed
+}
+
+func flattenSourceRepoRepositoryPubsubConfigsMessageFormat(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSourceRepoRepositoryPubsubConfigsServiceAccountEmail(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func expandSourceRepoRepositoryName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	return replaceVars(d, config, "projects/{{project}}/repos/{{name}}")
 }
+
+func expandSourceRepoRepositoryPubsubConfigs(v interface{}, d TerraformResourceData, config *Config) (map[string]interface{}, error) {
+	if v == nil {
+		return map[string]interface{}{}, nil
+	}
+	m := make(map[string]interface{})
+	for _, raw := range v.(*schema.Set).List() {
+		original := raw.(map[string]interface{})
+		transformed := make(map[string]interface{})
+
+		transformedTopic := original["topic"].(string)
+		transformed["topic"] = transformedTopic
+
+		transformedMessageFormat, err := expandSourceRepoRepositoryPubsubConfigsMessageFormat(original["message_format"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedMessageFormat); val.IsValid() && !isEmptyValue(val) {
+			transformed["messageFormat"] = transformedMessageFormat
+		}
+
+		transformedServiceAccountEmail, err := expandSourceRepoRepositoryPubsubConfigsServiceAccountEmail(original["service_account_email"], d, config)
+		if err != nil {
+			return nil, err
+		} else if val := reflect.ValueOf(transformedServiceAccountEmail); val.IsValid() && !isEmptyValue(val) {
+			transformed["serviceAccountEmail"] = transformedServiceAccountEmail
+		}
+
+		m[transformedTopic] = transformed
+	}
+	return m, nil
+}
+
+func expandSourceRepoRepositoryPubsubConfigsMessageFormat(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSourceRepoRepositoryPubsubConfigsServiceAccountEmail(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,127 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSourceRepoRepositoryIamBinding(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-repo-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepositoryIamBinding_basic(name, account),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/repos/%s roles/source.reader", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSourceRepoRepositoryIamMember(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-repo-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepositoryIamMember_basic(name, account),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/repos/%s roles/source.reader serviceAccount:%s@%s.iam.gserviceaccount.com", getTestProjectFromEnv(), name, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSourceRepoRepositoryIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	name := "tf-test-repo-" + acctest.RandString(10)
+	account := "tf-test-repo-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepositoryIamPolicy_basic(name, account),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("projects/%s/repos/%s", getTestProjectFromEnv(), name),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSourceRepoRepositoryIam_base(name, account string) string {
+	return fmt.Sprintf(`
+resource "google_sourcerepo_repository" "default" {
+  name = "%s"
+}
+
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Source Repositories IAM Testing Account"
+}
+`, name, account)
+}
+
+func testAccSourceRepoRepositoryIamBinding_basic(name, account string) string {
+	return testAccSourceRepoRepositoryIam_base(name, account) + `
+resource "google_sourcerepo_repository_iam_binding" "foo" {
+  repository = "${google_sourcerepo_repository.default.name}"
+  role       = "roles/source.reader"
+  members    = ["serviceAccount:${google_service_account.test.email}"]
+}
+`
+}
+
+func testAccSourceRepoRepositoryIamMember_basic(name, account string) string {
+	return testAccSourceRepoRepositoryIam_base(name, account) + `
+resource "google_sourcerepo_repository_iam_member" "foo" {
+  repository = "${google_sourcerepo_repository.default.name}"
+  role       = "roles/source.reader"
+  member     = "serviceAccount:${google_service_account.test.email}"
+}
+`
+}
+
+func testAccSourceRepoRepositoryIamPolicy_basic(name, account string) string {
+	return testAccSourceRepoRepositoryIam_base(name, account) + `
+data "google_iam_policy" "foo" {
+  binding {
+    role    = "roles/source.reader"
+    members = ["serviceAccount:${google_service_account.test.email}"]
+  }
+}
+
+resource "google_sourcerepo_repository_iam_policy" "foo" {
+  repository  = "${google_sourcerepo_repository.default.name}"
+  policy_data = "${data.google_iam_policy.foo.policy_data}"
+}
+`
+}
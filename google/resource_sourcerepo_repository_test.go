@@ -36,3 +36,73 @@ resource "google_sourcerepo_repository" "acceptance" {
 }
 	`, repositoryName)
 }
+
+func TestAccSourceRepoRepository_update(t *testing.T) {
+	t.Parallel()
+
+	repositoryName := fmt.Sprintf("source-repo-repository-test-%s", acctest.RandString(10))
+	accountId := fmt.Sprintf("account-id-%s", acctest.RandString(10))
+	topicName := fmt.Sprintf("topic-name-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSourceRepoRepositoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSourceRepoRepository_basic(repositoryName),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceRepoRepository_extended(accountId, topicName, repositoryName),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSourceRepoRepository_basic(repositoryName),
+			},
+			{
+				ResourceName:      "google_sourcerepo_repository.acceptance",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSourceRepoRepository_extended(accountId string, topicName string, repositoryName string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "test-account" {
+  account_id   = "%s"
+  display_name = "Test Service Account"
+}
+
+resource "google_pubsub_topic" "topic" {
+  name = "%s"
+}
+
+resource "google_pubsub_topic_iam_member" "publisher" {
+  topic  = "${google_pubsub_topic.topic.name}"
+  role   = "roles/pubsub.publisher"
+  member = "serviceAccount:${google_service_account.test-account.email}"
+}
+
+resource "google_sourcerepo_repository" "acceptance" {
+  name = "%s"
+
+  pubsub_configs {
+    topic                 = "${google_pubsub_topic.topic.id}"
+    message_format        = "JSON"
+    service_account_email = "${google_service_account.test-account.email}"
+  }
+
+  depends_on = ["google_pubsub_topic_iam_member.publisher"]
+}
+`, accountId, topicName, repositoryName)
+}
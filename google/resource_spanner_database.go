@@ -27,6 +27,7 @@ func resourceSpannerDatabase() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSpannerDatabaseCreate,
 		Read:   resourceSpannerDatabaseRead,
+		Update: resourceSpannerDatabaseUpdate,
 		Delete: resourceSpannerDatabaseDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -35,9 +36,12 @@ func resourceSpannerDatabase() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(240 * time.Second),
+			Update: schema.DefaultTimeout(240 * time.Second),
 			Delete: schema.DefaultTimeout(240 * time.Second),
 		},
 
+		CustomizeDiff: resourceSpannerDBDdlCustomDiff,
+
 		Schema: map[string]*schema.Schema{
 			"instance": {
 				Type:             schema.TypeString,
@@ -54,11 +58,31 @@ func resourceSpannerDatabase() *schema.Resource {
 			"ddl": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"encryption_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_key_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"version_retention_period": {
+				Type:         schema.TypeString,
+				Computed:     true,
+				Optional:     true,
+				ValidateFunc: validateRegexp(`^\d+(\.\d+)?[smhd]$`),
+			},
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -89,6 +113,18 @@ func resourceSpannerDatabaseCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("ddl"); !isEmptyValue(reflect.ValueOf(extraStatementsProp)) && (ok || !reflect.DeepEqual(v, extraStatementsProp)) {
 		obj["extraStatements"] = extraStatementsProp
 	}
+	versionRetentionPeriodProp, err := expandSpannerDatabaseVersionRetentionPeriod(d.Get("version_retention_period"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("version_retention_period"); !isEmptyValue(reflect.ValueOf(versionRetentionPeriodProp)) && (ok || !reflect.DeepEqual(v, versionRetentionPeriodProp)) {
+		obj["versionRetentionPeriod"] = versionRetentionPeriodProp
+	}
+	encryptionConfigProp, err := expandSpannerDatabaseEncryptionConfig(d.Get("encryption_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("encryption_config"); !isEmptyValue(reflect.ValueOf(encryptionConfigProp)) && (ok || !reflect.DeepEqual(v, encryptionConfigProp)) {
+		obj["encryptionConfig"] = encryptionConfigProp
+	}
 	instanceProp, err := expandSpannerDatabaseInstance(d.Get("instance"), d, config)
 	if err != nil {
 		return err
@@ -119,6 +155,22 @@ func resourceSpannerDatabaseCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.SetId(id)
 
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := spannerOperationWaitTime(
+		config, res, project, "Creating Database",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Database: %s", waitErr)
+	}
+
 	log.Printf("[DEBUG] Finished creating Database %q: %#v", d.Id(), res)
 
 	return resourceSpannerDatabaseRead(d, meta)
@@ -153,6 +205,12 @@ func resourceSpannerDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("name", flattenSpannerDatabaseName(res["name"], d)); err != nil {
 		return fmt.Errorf("Error reading Database: %s", err)
 	}
+	if err := d.Set("version_retention_period", flattenSpannerDatabaseVersionRetentionPeriod(res["versionRetentionPeriod"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
+	if err := d.Set("encryption_config", flattenSpannerDatabaseEncryptionConfig(res["encryptionConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading Database: %s", err)
+	}
 	if err := d.Set("state", flattenSpannerDatabaseState(res["state"], d)); err != nil {
 		return fmt.Errorf("Error reading Database: %s", err)
 	}
@@ -163,6 +221,56 @@ func resourceSpannerDatabaseRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+func resourceSpannerDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	versionRetentionPeriodProp, err := expandSpannerDatabaseVersionRetentionPeriod(d.Get("version_retention_period"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("version_retention_period"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, versionRetentionPeriodProp)) {
+		obj["versionRetentionPeriod"] = versionRetentionPeriodProp
+	}
+	extraStatementsProp, err := expandSpannerDatabaseDdl(d.Get("ddl"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("ddl"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, extraStatementsProp)) {
+		obj["extraStatements"] = extraStatementsProp
+	}
+
+	obj, err = resourceSpannerDatabaseUpdateEncoder(d, meta, obj)
+	if err != nil {
+		return err
+	}
+
+	url, err := replaceVars(d, config, "https://spanner.googleapis.com/v1/projects/{{project}}/instances/{{instance}}/databases/{{name}}/ddl")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Database %q: %#v", d.Id(), obj)
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Database %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	err = spannerOperationWaitTime(
+		config, res, project, "Updating Database",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceSpannerDatabaseRead(d, meta)
+}
+
 func resourceSpannerDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -202,6 +310,27 @@ func flattenSpannerDatabaseName(v interface{}, d *schema.ResourceData) interface
 	return v
 }
 
+func flattenSpannerDatabaseVersionRetentionPeriod(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenSpannerDatabaseEncryptionConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["kms_key_name"] =
+		flattenSpannerDatabaseEncryptionConfigKmsKeyName(original["kmsKeyName"], d)
+	return []interface{}{transformed}
+}
+func flattenSpannerDatabaseEncryptionConfigKmsKeyName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
 func flattenSpannerDatabaseState(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -221,6 +350,33 @@ func expandSpannerDatabaseDdl(v interface{}, d TerraformResourceData, config *Co
 	return v, nil
 }
 
+func expandSpannerDatabaseVersionRetentionPeriod(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandSpannerDatabaseEncryptionConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedKmsKeyName, err := expandSpannerDatabaseEncryptionConfigKmsKeyName(original["kms_key_name"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedKmsKeyName); val.IsValid() && !isEmptyValue(val) {
+		transformed["kmsKeyName"] = transformedKmsKeyName
+	}
+
+	return transformed, nil
+}
+
+func expandSpannerDatabaseEncryptionConfigKmsKeyName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandSpannerDatabaseInstance(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
 	f, err := parseGlobalFieldValue("instances", v.(string), "project", d, config, true)
 	if err != nil {
@@ -231,11 +387,60 @@ func expandSpannerDatabaseInstance(v interface{}, d TerraformResourceData, confi
 
 func resourceSpannerDatabaseEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
 	obj["createStatement"] = fmt.Sprintf("CREATE DATABASE `%s`", obj["name"])
+	if period, ok := obj["versionRetentionPeriod"]; ok {
+		// version_retention_period can only be set through DDL, so it's applied
+		// alongside the other statements run when the database is created.
+		retentionDdl := fmt.Sprintf("ALTER DATABASE `%s` SET OPTIONS (version_retention_period=\"%s\")", obj["name"], period)
+		statements, _ := obj["extraStatements"].([]interface{})
+		obj["extraStatements"] = append(statements, retentionDdl)
+		delete(obj, "versionRetentionPeriod")
+	}
 	delete(obj, "name")
 	delete(obj, "instance")
 	return obj, nil
 }
 
+func resourceSpannerDatabaseUpdateEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	old, new := d.GetChange("ddl")
+	oldDdls := old.([]interface{})
+	newDdls := new.([]interface{})
+	updateDdls := []string{}
+
+	// Statements are applied in order, so only the ones appended to the list need
+	// to be run. Any other change to ddl recreates the database.
+	for i := len(oldDdls); i < len(newDdls); i++ {
+		updateDdls = append(updateDdls, newDdls[i].(string))
+	}
+
+	if d.HasChange("version_retention_period") {
+		retentionDdl := fmt.Sprintf("ALTER DATABASE `%s` SET OPTIONS (version_retention_period=\"%s\")", d.Get("name"), obj["versionRetentionPeriod"])
+		updateDdls = append(updateDdls, retentionDdl)
+	}
+
+	newObj := make(map[string]interface{})
+	newObj["statements"] = updateDdls
+	return newObj, nil
+}
+
+// resourceSpannerDBDdlCustomDiff recreates the database if any existing ddl
+// statement was removed or changed, as statements can't be rolled back.
+func resourceSpannerDBDdlCustomDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	old, new := diff.GetChange("ddl")
+	oldDdls := old.([]interface{})
+	newDdls := new.([]interface{})
+
+	if len(newDdls) < len(oldDdls) {
+		return diff.ForceNew("ddl")
+	}
+
+	for i := range oldDdls {
+		if newDdls[i].(string) != oldDdls[i].(string) {
+			return diff.ForceNew("ddl")
+		}
+	}
+	return nil
+}
+
 func resourceSpannerDatabaseDecoder(d *schema.ResourceData, meta interface{}, res map[string]interface{}) (map[string]interface{}, error) {
 	config := meta.(*Config)
 	d.SetId(res["name"].(string))
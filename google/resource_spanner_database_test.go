@@ -71,6 +71,65 @@ resource "google_spanner_database" "basic" {
 `, instanceName, instanceName, databaseName)
 }
 
+func TestAccSpannerDatabase_update(t *testing.T) {
+	t.Parallel()
+
+	rnd := acctest.RandString(10)
+	instanceName := fmt.Sprintf("my-instance-%s", rnd)
+	databaseName := fmt.Sprintf("mydb_%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSpannerDatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpannerDatabase_update(instanceName, databaseName, "1h", false),
+			},
+			{
+				ResourceName:            "google_spanner_database.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ddl"},
+			},
+			{
+				Config: testAccSpannerDatabase_update(instanceName, databaseName, "2d", true),
+			},
+			{
+				ResourceName:            "google_spanner_database.basic",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ddl"},
+			},
+		},
+	})
+}
+
+func testAccSpannerDatabase_update(instanceName, databaseName, retentionPeriod string, addTable bool) string {
+	extraDdl := ""
+	if addTable {
+		extraDdl = "\"CREATE TABLE t2 (t2 INT64 NOT NULL,) PRIMARY KEY(t2)\","
+	}
+	return fmt.Sprintf(`
+resource "google_spanner_instance" "basic" {
+  name             = "%s"
+  config           = "regional-us-central1"
+  display_name     = "display-%s"
+  processing_units = 100
+}
+
+resource "google_spanner_database" "basic" {
+  instance                 = "${google_spanner_instance.basic.name}"
+  name                     = "%s"
+  version_retention_period = "%s"
+  ddl = [
+    "CREATE TABLE t1 (t1 INT64 NOT NULL,) PRIMARY KEY(t1)",
+    %s
+  ]
+}
+`, instanceName, instanceName, databaseName, retentionPeriod, extraDdl)
+}
+
 // Unit Tests for type spannerDatabaseId
 func TestDatabaseNameForApi(t *testing.T) {
 	id := spannerDatabaseId{
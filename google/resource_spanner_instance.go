@@ -67,9 +67,16 @@ func resourceSpannerInstance() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"num_nodes": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1,
+				Type:          schema.TypeInt,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"processing_units"},
+			},
+			"processing_units": {
+				Type:          schema.TypeInt,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"num_nodes"},
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -113,6 +120,12 @@ func resourceSpannerInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("num_nodes"); !isEmptyValue(reflect.ValueOf(nodeCountProp)) && (ok || !reflect.DeepEqual(v, nodeCountProp)) {
 		obj["nodeCount"] = nodeCountProp
 	}
+	processingUnitsProp, err := expandSpannerInstanceProcessingUnits(d.Get("processing_units"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("processing_units"); !isEmptyValue(reflect.ValueOf(processingUnitsProp)) && (ok || !reflect.DeepEqual(v, processingUnitsProp)) {
+		obj["processingUnits"] = processingUnitsProp
+	}
 	labelsProp, err := expandSpannerInstanceLabels(d.Get("labels"), d, config)
 	if err != nil {
 		return err
@@ -152,8 +165,10 @@ func resourceSpannerInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Instance: %s", waitErr)
 	}
 
@@ -205,6 +220,9 @@ func resourceSpannerInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("num_nodes", flattenSpannerInstanceNum_nodes(res["nodeCount"], d)); err != nil {
 		return fmt.Errorf("Error reading Instance: %s", err)
 	}
+	if err := d.Set("processing_units", flattenSpannerInstanceProcessingUnits(res["processingUnits"], d)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
 	if err := d.Set("labels", flattenSpannerInstanceLabels(res["labels"], d)); err != nil {
 		return fmt.Errorf("Error reading Instance: %s", err)
 	}
@@ -237,6 +255,12 @@ func resourceSpannerInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 	} else if v, ok := d.GetOkExists("num_nodes"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, nodeCountProp)) {
 		obj["nodeCount"] = nodeCountProp
 	}
+	processingUnitsProp, err := expandSpannerInstanceProcessingUnits(d.Get("processing_units"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("processing_units"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, processingUnitsProp)) {
+		obj["processingUnits"] = processingUnitsProp
+	}
 	labelsProp, err := expandSpannerInstanceLabels(d.Get("labels"), d, config)
 	if err != nil {
 		return err
@@ -350,6 +374,16 @@ func flattenSpannerInstanceNum_nodes(v interface{}, d *schema.ResourceData) inte
 	return v
 }
 
+func flattenSpannerInstanceProcessingUnits(v interface{}, d *schema.ResourceData) interface{} {
+	// Handles the string fixed64 format
+	if strVal, ok := v.(string); ok {
+		if intVal, err := strconv.ParseInt(strVal, 10, 64); err == nil {
+			return intVal
+		} // let terraform core handle it if we can't convert the string to an int.
+	}
+	return v
+}
+
 func flattenSpannerInstanceLabels(v interface{}, d *schema.ResourceData) interface{} {
 	return v
 }
@@ -384,6 +418,10 @@ func expandSpannerInstanceNum_nodes(v interface{}, d TerraformResourceData, conf
 	return v, nil
 }
 
+func expandSpannerInstanceProcessingUnits(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
 func expandSpannerInstanceLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
 	if v == nil {
 		return map[string]string{}, nil
@@ -396,6 +434,10 @@ func expandSpannerInstanceLabels(v interface{}, d TerraformResourceData, config
 }
 
 func resourceSpannerInstanceEncoder(d *schema.ResourceData, meta interface{}, obj map[string]interface{}) (map[string]interface{}, error) {
+	// Default to a single node if neither num_nodes nor processing_units is set
+	if obj["processingUnits"] == nil && obj["nodeCount"] == nil {
+		obj["nodeCount"] = 1
+	}
 	newObj := make(map[string]interface{})
 	newObj["instance"] = obj
 	if obj["name"] == nil {
@@ -420,6 +462,9 @@ func resourceSpannerInstanceUpdateEncoder(d *schema.ResourceData, meta interface
 	if d.HasChange("num_nodes") {
 		updateMask = append(updateMask, "nodeCount")
 	}
+	if d.HasChange("processing_units") {
+		updateMask = append(updateMask, "processingUnits")
+	}
 	if d.HasChange("display_name") {
 		updateMask = append(updateMask, "displayName")
 	}
@@ -126,6 +126,35 @@ func TestAccSpannerInstance_update(t *testing.T) {
 	})
 }
 
+func TestAccSpannerInstance_processingUnits(t *testing.T) {
+	t.Parallel()
+
+	dName := fmt.Sprintf("spanner-dname-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSpannerInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpannerInstance_processingUnits(dName, 100),
+			},
+			{
+				ResourceName:      "google_spanner_instance.updater",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSpannerInstance_processingUnits(dName, 500),
+			},
+			{
+				ResourceName:      "google_spanner_instance.updater",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccSpannerInstance_basic(name string) string {
 	return fmt.Sprintf(`
 resource "google_spanner_instance" "basic" {
@@ -165,3 +194,13 @@ resource "google_spanner_instance" "updater" {
 }
 `, name, nodes, extraLabel)
 }
+
+func testAccSpannerInstance_processingUnits(name string, processingUnits int) string {
+	return fmt.Sprintf(`
+resource "google_spanner_instance" "updater" {
+  config           = "regional-us-central1"
+  display_name     = "%s"
+  processing_units = %d
+}
+`, name, processingUnits)
+}
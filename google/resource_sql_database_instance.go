@@ -118,6 +118,41 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 										// start_time is randomly assigned if not set
 										Computed: true,
 									},
+									"location": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"point_in_time_recovery_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"transaction_log_retention_days": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										// Defaults differ between database engines
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(1, 7),
+									},
+									"backup_retention_settings": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"retained_backups": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+												"retention_unit": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      "COUNT",
+													ValidateFunc: validation.StringInSlice([]string{"COUNT"}, false),
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -189,6 +224,10 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 										ValidateFunc:     orEmpty(validateRegexp(privateNetworkLinkRegex)),
 										DiffSuppressFunc: compareSelfLinkRelativePaths,
 									},
+									"allocated_ip_range": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -321,6 +360,19 @@ func resourceSqlDatabaseInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"root_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"replica_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -490,7 +542,15 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 		defer mutexKV.Unlock(instanceMutexKey(project, instance.MasterInstanceName))
 	}
 
-	op, err := config.clientSqlAdmin.Instances.Insert(project, instance).Do()
+	obj, err := sqlDatabaseInstanceJson(instance, d)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("root_password"); ok {
+		obj["rootPassword"] = v.(string)
+	}
+
+	op, err := sendSqlAdminRequest(config, "POST", fmt.Sprintf("projects/%s/instances", project), obj, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return fmt.Errorf("Error, failed to create instance %s: %s", instance.Name, err)
 	}
@@ -499,7 +559,9 @@ func resourceSqlDatabaseInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	err = sqladminOperationWaitTime(config, op, project, "Create Instance", int(d.Timeout(schema.TimeoutCreate).Minutes()))
 	if err != nil {
-		d.SetId("")
+		if !isOperationCancelledError(err) {
+			d.SetId("")
+		}
 		return err
 	}
 
@@ -689,6 +751,51 @@ func expandBackupConfiguration(configured []interface{}) *sqladmin.BackupConfigu
 	}
 }
 
+// sqlDatabaseInstanceJson converts instance to the JSON form sent to the API
+// and adds the settings the vendored sqladmin client can't represent.
+func sqlDatabaseInstanceJson(instance *sqladmin.DatabaseInstance, d *schema.ResourceData) (map[string]interface{}, error) {
+	obj, err := ConvertToMap(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, ok := obj["settings"].(map[string]interface{})
+	if !ok {
+		return obj, nil
+	}
+
+	if v, ok := d.GetOk("settings.0.backup_configuration.0"); ok {
+		_backupConfiguration := v.(map[string]interface{})
+		backup, ok := settings["backupConfiguration"].(map[string]interface{})
+		if !ok {
+			backup = make(map[string]interface{})
+			settings["backupConfiguration"] = backup
+		}
+		backup["pointInTimeRecoveryEnabled"] = _backupConfiguration["point_in_time_recovery_enabled"].(bool)
+		if v := _backupConfiguration["location"].(string); v != "" {
+			backup["location"] = v
+		}
+		if v := _backupConfiguration["transaction_log_retention_days"].(int); v > 0 {
+			backup["transactionLogRetentionDays"] = v
+		}
+		if v := _backupConfiguration["backup_retention_settings"].([]interface{}); len(v) > 0 && v[0] != nil {
+			_retention := v[0].(map[string]interface{})
+			backup["backupRetentionSettings"] = map[string]interface{}{
+				"retainedBackups": _retention["retained_backups"].(int),
+				"retentionUnit":   _retention["retention_unit"].(string),
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("settings.0.ip_configuration.0.allocated_ip_range"); ok {
+		if ipConfiguration, ok := settings["ipConfiguration"].(map[string]interface{}); ok {
+			ipConfiguration["allocatedIpRange"] = v.(string)
+		}
+	}
+
+	return obj, nil
+}
+
 func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -697,10 +804,12 @@ func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	var instance *sqladmin.DatabaseInstance
+	// The instance is read through the JSON API so settings the vendored
+	// client doesn't know about (such as point-in-time recovery) are kept.
+	var res map[string]interface{}
 	err = retry(
 		func() error {
-			instance, err = config.clientSqlAdmin.Instances.Get(project, d.Id()).Do()
+			res, err = sendRequest(config, "GET", fmt.Sprintf("%sprojects/%s/instances/%s", sqlAdminBasePath, project, d.Id()), nil)
 			return err
 		},
 	)
@@ -709,13 +818,19 @@ func resourceSqlDatabaseInstanceRead(d *schema.ResourceData, meta interface{}) e
 		return handleNotFoundError(err, d, fmt.Sprintf("SQL Database Instance %q", d.Get("name").(string)))
 	}
 
+	instance := &sqladmin.DatabaseInstance{}
+	if err := Convert(res, instance); err != nil {
+		return err
+	}
+
 	d.Set("name", instance.Name)
 	d.Set("region", instance.Region)
 	d.Set("database_version", instance.DatabaseVersion)
 	d.Set("connection_name", instance.ConnectionName)
 	d.Set("service_account_email_address", instance.ServiceAccountEmailAddress)
 
-	if err := d.Set("settings", flattenSettings(instance.Settings)); err != nil {
+	rawSettings, _ := res["settings"].(map[string]interface{})
+	if err := d.Set("settings", flattenSettings(instance.Settings, rawSettings)); err != nil {
 		log.Printf("[WARN] Failed to set SQL Database Instance Settings")
 	}
 
@@ -778,9 +893,14 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 		defer mutexKV.Unlock(instanceMutexKey(project, v.(string)))
 	}
 
-	op, err := config.clientSqlAdmin.Instances.Update(project, d.Get("name").(string), instance).Do()
+	obj, err := sqlDatabaseInstanceJson(instance, d)
+	if err != nil {
+		return err
+	}
+
+	op, err := sendSqlAdminRequest(config, "PUT", fmt.Sprintf("projects/%s/instances/%s", project, d.Get("name").(string)), obj, d.Timeout(schema.TimeoutUpdate))
 	if err != nil {
-		return fmt.Errorf("Error, failed to update instance settings for %s: %s", instance.Name, err)
+		return fmt.Errorf("Error, failed to update instance settings for %s: %s", d.Get("name").(string), err)
 	}
 
 	err = sqladminOperationWaitTime(config, op, project, "Update Instance", int(d.Timeout(schema.TimeoutUpdate).Minutes()))
@@ -794,6 +914,10 @@ func resourceSqlDatabaseInstanceUpdate(d *schema.ResourceData, meta interface{})
 func resourceSqlDatabaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Error, failed to delete instance because deletion_protection is set to true. Set it to false to proceed with instance deletion")
+	}
+
 	project, err := getProject(d, config)
 	if err != nil {
 		return err
@@ -840,10 +964,13 @@ func resourceSqlDatabaseInstanceImport(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(id)
 
+	// deletion_protection is only known to Terraform, so use the default.
+	d.Set("deletion_protection", true)
+
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
+func flattenSettings(settings *sqladmin.Settings, rawSettings map[string]interface{}) []map[string]interface{} {
 	data := map[string]interface{}{
 		"version":                     settings.SettingsVersion,
 		"tier":                        settings.Tier,
@@ -859,7 +986,8 @@ func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
 	}
 
 	if settings.BackupConfiguration != nil {
-		data["backup_configuration"] = flattenBackupConfiguration(settings.BackupConfiguration)
+		rawBackup, _ := rawSettings["backupConfiguration"].(map[string]interface{})
+		data["backup_configuration"] = flattenBackupConfiguration(settings.BackupConfiguration, rawBackup)
 	}
 
 	if settings.DatabaseFlags != nil {
@@ -867,7 +995,8 @@ func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
 	}
 
 	if settings.IpConfiguration != nil {
-		data["ip_configuration"] = flattenIpConfiguration(settings.IpConfiguration)
+		rawIpConfiguration, _ := rawSettings["ipConfiguration"].(map[string]interface{})
+		data["ip_configuration"] = flattenIpConfiguration(settings.IpConfiguration, rawIpConfiguration)
 	}
 
 	if settings.LocationPreference != nil {
@@ -889,11 +1018,23 @@ func flattenSettings(settings *sqladmin.Settings) []map[string]interface{} {
 	return []map[string]interface{}{data}
 }
 
-func flattenBackupConfiguration(backupConfiguration *sqladmin.BackupConfiguration) []map[string]interface{} {
+func flattenBackupConfiguration(backupConfiguration *sqladmin.BackupConfiguration, rawBackup map[string]interface{}) []map[string]interface{} {
 	data := map[string]interface{}{
-		"binary_log_enabled": backupConfiguration.BinaryLogEnabled,
-		"enabled":            backupConfiguration.Enabled,
-		"start_time":         backupConfiguration.StartTime,
+		"binary_log_enabled":             backupConfiguration.BinaryLogEnabled,
+		"enabled":                        backupConfiguration.Enabled,
+		"start_time":                     backupConfiguration.StartTime,
+		"location":                       rawBackup["location"],
+		"point_in_time_recovery_enabled": rawBackup["pointInTimeRecoveryEnabled"],
+		"transaction_log_retention_days": rawBackup["transactionLogRetentionDays"],
+	}
+
+	if rs, ok := rawBackup["backupRetentionSettings"].(map[string]interface{}); ok {
+		data["backup_retention_settings"] = []map[string]interface{}{
+			{
+				"retained_backups": rs["retainedBackups"],
+				"retention_unit":   rs["retentionUnit"],
+			},
+		}
 	}
 
 	return []map[string]interface{}{data}
@@ -914,11 +1055,12 @@ func flattenDatabaseFlags(databaseFlags []*sqladmin.DatabaseFlags) []map[string]
 	return flags
 }
 
-func flattenIpConfiguration(ipConfiguration *sqladmin.IpConfiguration) interface{} {
+func flattenIpConfiguration(ipConfiguration *sqladmin.IpConfiguration, rawIpConfiguration map[string]interface{}) interface{} {
 	data := map[string]interface{}{
-		"ipv4_enabled":    ipConfiguration.Ipv4Enabled,
-		"private_network": ipConfiguration.PrivateNetwork,
-		"require_ssl":     ipConfiguration.RequireSsl,
+		"ipv4_enabled":       ipConfiguration.Ipv4Enabled,
+		"private_network":    ipConfiguration.PrivateNetwork,
+		"allocated_ip_range": rawIpConfiguration["allocatedIpRange"],
+		"require_ssl":        ipConfiguration.RequireSsl,
 	}
 
 	if ipConfiguration.AuthorizedNetworks != nil {
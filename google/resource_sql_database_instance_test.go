@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -25,6 +26,7 @@ var ignoredReplicaConfigurationFields = []string{
 	"replica_configuration.0.ssl_cipher",
 	"replica_configuration.0.username",
 	"replica_configuration.0.verify_server_certificate",
+	"deletion_protection",
 }
 
 func init() {
@@ -161,21 +163,24 @@ func TestAccSqlDatabaseInstance_basicFirstGen(t *testing.T) {
 				Config: fmt.Sprintf(testGoogleSqlDatabaseInstance_basic, instanceID),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      resourceName,
-				ImportStateId:     fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instanceName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("projects/%s/instances/%s", getTestProjectFromEnv(), instanceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      resourceName,
-				ImportStateId:     fmt.Sprintf("%s/%s", getTestProjectFromEnv(), instanceName),
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportStateId:           fmt.Sprintf("%s/%s", getTestProjectFromEnv(), instanceName),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -193,9 +198,10 @@ func TestAccSqlDatabaseInstance_basicInferredName(t *testing.T) {
 				Config: testGoogleSqlDatabaseInstance_basic2,
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -217,9 +223,10 @@ func TestAccSqlDatabaseInstance_basicSecondGen(t *testing.T) {
 				Check: testAccCheckGoogleSqlDatabaseRootUserDoesNotExist(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -243,9 +250,10 @@ func TestAccSqlDatabaseInstance_dontDeleteDefaultUserOnReplica(t *testing.T) {
 				Config: testGoogleSqlDatabaseInstanceConfig_withoutReplica(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				PreConfig: func() {
@@ -288,9 +296,10 @@ func TestAccSqlDatabaseInstance_settings_basic(t *testing.T) {
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -311,9 +320,10 @@ func TestAccSqlDatabaseInstance_replica(t *testing.T) {
 					testGoogleSqlDatabaseInstance_replica, databaseID, databaseID, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_master",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_master",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				ResourceName:            "google_sql_database_instance.replica1",
@@ -347,14 +357,16 @@ func TestAccSqlDatabaseInstance_slave(t *testing.T) {
 					testGoogleSqlDatabaseInstance_slave, masterID, slaveID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_master",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_master",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance_slave",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance_slave",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -375,9 +387,96 @@ func TestAccSqlDatabaseInstance_highAvailability(t *testing.T) {
 					testGoogleSqlDatabaseInstance_highAvailability, instanceID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccSqlDatabaseInstance_pointInTimeRecovery(t *testing.T) {
+	t.Parallel()
+
+	instanceID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_pointInTimeRecovery, instanceID, 7),
+			},
+			{
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_pointInTimeRecovery, instanceID, 3),
+			},
+			{
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccSqlDatabaseInstance_sqlServer(t *testing.T) {
+	t.Parallel()
+
+	instanceID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_sqlServer, instanceID, acctest.RandString(16)),
+			},
+			{
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"root_password", "deletion_protection"},
+			},
+		},
+	})
+}
+
+func TestAccSqlDatabaseInstance_deletionProtection(t *testing.T) {
+	t.Parallel()
+
+	instanceID := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_deletionProtection, instanceID, "true"),
+			},
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_deletionProtection, instanceID, "true"),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection is set to true"),
+			},
+			{
+				Config: fmt.Sprintf(
+					testGoogleSqlDatabaseInstance_deletionProtection, instanceID, "false"),
 			},
 		},
 	})
@@ -398,9 +497,10 @@ func TestAccSqlDatabaseInstance_diskspecs(t *testing.T) {
 					testGoogleSqlDatabaseInstance_diskspecs, masterID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -421,9 +521,10 @@ func TestAccSqlDatabaseInstance_maintenance(t *testing.T) {
 					testGoogleSqlDatabaseInstance_maintenance, masterID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -444,18 +545,20 @@ func TestAccSqlDatabaseInstance_settings_upgrade(t *testing.T) {
 					testGoogleSqlDatabaseInstance_basic, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -476,18 +579,20 @@ func TestAccSqlDatabaseInstance_settingsDowngrade(t *testing.T) {
 					testGoogleSqlDatabaseInstance_settings, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_basic, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -509,27 +614,30 @@ func TestAccSqlDatabaseInstance_authNets(t *testing.T) {
 					testGoogleSqlDatabaseInstance_authNets_step1, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_authNets_step2, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_authNets_step1, databaseID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -552,9 +660,10 @@ func TestAccSqlDatabaseInstance_multipleOperations(t *testing.T) {
 					testGoogleSqlDatabaseInstance_multipleOperations, databaseID, instanceID, userID),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -576,18 +685,20 @@ func TestAccSqlDatabaseInstance_basic_with_user_labels(t *testing.T) {
 				Check: testAccCheckGoogleSqlDatabaseRootUserDoesNotExist(databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 			{
 				Config: fmt.Sprintf(
 					testGoogleSqlDatabaseInstance_basic_with_user_labels_update, databaseName),
 			},
 			{
-				ResourceName:      "google_sql_database_instance.instance",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "google_sql_database_instance.instance",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deletion_protection"},
 			},
 		},
 	})
@@ -632,6 +743,7 @@ func testAccCheckGoogleSqlDatabaseRootUserDoesNotExist(instance string) resource
 
 var testGoogleSqlDatabaseInstance_basic = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central"
 	settings {
@@ -643,6 +755,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_basic2 = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	region = "us-central"
 	settings {
 		tier = "D0"
@@ -652,6 +765,7 @@ resource "google_sql_database_instance" "instance" {
 `
 var testGoogleSqlDatabaseInstance_basic3 = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "%s"
 	region = "us-central1"
 	settings {
@@ -662,6 +776,7 @@ resource "google_sql_database_instance" "instance" {
 
 func testGoogleSqlDatabaseInstanceConfig_withoutReplica(instanceName string) string {
 	return fmt.Sprintf(`resource "google_sql_database_instance" "instance" {
+  deletion_protection = false
   name               = "%s"
   region             = "us-central1"
   database_version   = "MYSQL_5_7"
@@ -681,6 +796,7 @@ func testGoogleSqlDatabaseInstanceConfig_withoutReplica(instanceName string) str
 func testGoogleSqlDatabaseInstanceConfig_withReplica(instanceName, failoverName string) string {
 	return fmt.Sprintf(`
 resource "google_sql_database_instance" "instance" {
+  deletion_protection = false
   name               = "%s"
   region             = "us-central1"
   database_version   = "MYSQL_5_7"
@@ -697,6 +813,7 @@ resource "google_sql_database_instance" "instance" {
 }
 
 resource "google_sql_database_instance" "instance-failover" {
+  deletion_protection = false
   name               = "%s"
   region             = "us-central1"
   database_version   = "MYSQL_5_7"
@@ -715,6 +832,7 @@ resource "google_sql_database_instance" "instance-failover" {
 
 var testGoogleSqlDatabaseInstance_settings = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central"
 	settings {
@@ -746,6 +864,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_replica = `
 resource "google_sql_database_instance" "instance_master" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	database_version = "MYSQL_5_6"
 	region = "us-central1"
@@ -762,6 +881,7 @@ resource "google_sql_database_instance" "instance_master" {
 }
 
 resource "google_sql_database_instance" "replica1" {
+	deletion_protection = false
 	name = "tf-lw-%d-1"
 	database_version = "MYSQL_5_6"
 	region = "us-central1"
@@ -783,6 +903,7 @@ resource "google_sql_database_instance" "replica1" {
 }
 
 resource "google_sql_database_instance" "replica2" {
+	deletion_protection = false
 	name = "tf-lw-%d-2"
 	database_version = "MYSQL_5_6"
 	region = "us-central1"
@@ -806,6 +927,7 @@ resource "google_sql_database_instance" "replica2" {
 
 var testGoogleSqlDatabaseInstance_slave = `
 resource "google_sql_database_instance" "instance_master" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central1"
 
@@ -820,6 +942,7 @@ resource "google_sql_database_instance" "instance_master" {
 }
 
 resource "google_sql_database_instance" "instance_slave" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central1"
 
@@ -833,6 +956,7 @@ resource "google_sql_database_instance" "instance_slave" {
 
 var testGoogleSqlDatabaseInstance_highAvailability = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central1"
 	database_version = "POSTGRES_9_6"
@@ -849,8 +973,65 @@ resource "google_sql_database_instance" "instance" {
 }
 `
 
+var testGoogleSqlDatabaseInstance_pointInTimeRecovery = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "POSTGRES_11"
+	deletion_protection = false
+
+	settings {
+		tier = "db-f1-micro"
+
+		backup_configuration {
+			enabled = true
+			start_time = "04:00"
+			location = "us"
+			point_in_time_recovery_enabled = true
+			transaction_log_retention_days = %d
+
+			backup_retention_settings {
+				retained_backups = 14
+			}
+		}
+	}
+}
+`
+
+var testGoogleSqlDatabaseInstance_sqlServer = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	database_version = "SQLSERVER_2017_STANDARD"
+	root_password = "%s"
+	deletion_protection = false
+
+	settings {
+		tier = "db-custom-2-3840"
+
+		backup_configuration {
+			enabled = true
+			point_in_time_recovery_enabled = true
+		}
+	}
+}
+`
+
+var testGoogleSqlDatabaseInstance_deletionProtection = `
+resource "google_sql_database_instance" "instance" {
+	name = "tf-lw-%d"
+	region = "us-central1"
+	deletion_protection = %s
+
+	settings {
+		tier = "db-f1-micro"
+	}
+}
+`
+
 var testGoogleSqlDatabaseInstance_diskspecs = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central1"
 
@@ -865,6 +1046,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_maintenance = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central1"
 
@@ -882,6 +1064,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_authNets_step1 = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central"
 	settings {
@@ -902,6 +1085,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_authNets_step2 = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-lw-%d"
 	region = "us-central"
 	settings {
@@ -917,6 +1101,7 @@ resource "google_sql_database_instance" "instance" {
 
 var testGoogleSqlDatabaseInstance_multipleOperations = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "tf-test-%s"
 	region = "us-central"
 	settings {
@@ -940,6 +1125,7 @@ resource "google_sql_user" "user" {
 
 var testGoogleSqlDatabaseInstance_basic_with_user_labels = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "%s"
 	region = "us-central1"
 	settings {
@@ -953,6 +1139,7 @@ resource "google_sql_database_instance" "instance" {
 `
 var testGoogleSqlDatabaseInstance_basic_with_user_labels_update = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "%s"
 	region = "us-central1"
 	settings {
@@ -182,6 +182,7 @@ func testAccSqlDatabaseDestroy(s *terraform.State) error {
 
 var testGoogleSqlDatabase_basic = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "%s"
 	region = "us-central"
 	settings {
@@ -196,6 +197,7 @@ resource "google_sql_database" "database" {
 `
 var testGoogleSqlDatabase_latin1 = `
 resource "google_sql_database_instance" "instance" {
+	deletion_protection = false
 	name = "%s"
 	region = "us-central"
 	settings {
@@ -97,6 +97,7 @@ func testAccSqlClientCertDestroy(s *terraform.State) error {
 func testGoogleSqlClientCert_mysql(instance string) string {
 	return fmt.Sprintf(`
 	resource "google_sql_database_instance" "instance" {
+		deletion_protection = false
 		name = "%s"
 		region = "us-central"
 		settings {
@@ -119,6 +120,7 @@ func testGoogleSqlClientCert_mysql(instance string) string {
 func testGoogleSqlClientCert_postgres(instance string) string {
 	return fmt.Sprintf(`
 	resource "google_sql_database_instance" "instance" {
+		deletion_protection = false
 		name = "%s"
 		region = "us-central1"
 		database_version = "POSTGRES_9_6"
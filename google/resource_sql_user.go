@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
@@ -47,6 +48,14 @@ func resourceSqlUser() *schema.Resource {
 				Sensitive: true,
 			},
 
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: emptyOrDefaultStringSuppress("BUILT_IN"),
+				ValidateFunc:     validation.StringInSlice([]string{"BUILT_IN", "CLOUD_IAM_USER", "CLOUD_IAM_SERVICE_ACCOUNT", ""}, false),
+			},
+
 			"project": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -69,6 +78,11 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 	instance := d.Get("instance").(string)
 	password := d.Get("password").(string)
 	host := d.Get("host").(string)
+	userType := d.Get("type").(string)
+
+	if sqlUserIsIam(userType) && password != "" {
+		return fmt.Errorf("Error, password can't be set for %s users", userType)
+	}
 
 	user := &sqladmin.User{
 		Name:     name,
@@ -77,10 +91,19 @@ func resourceSqlUserCreate(d *schema.ResourceData, meta interface{}) error {
 		Host:     host,
 	}
 
+	// The vendored client doesn't know about user types, so the user is
+	// inserted through the JSON API.
+	obj, err := ConvertToMap(user)
+	if err != nil {
+		return err
+	}
+	if userType != "" {
+		obj["type"] = userType
+	}
+
 	mutexKV.Lock(instanceMutexKey(project, instance))
 	defer mutexKV.Unlock(instanceMutexKey(project, instance))
-	op, err := config.clientSqlAdmin.Users.Insert(project, instance,
-		user).Do()
+	op, err := sendSqlAdminRequest(config, "POST", fmt.Sprintf("projects/%s/instances/%s/users", project, instance), obj, DefaultRequestTimeout)
 
 	if err != nil {
 		return fmt.Errorf("Error, failed to insert "+
@@ -113,22 +136,34 @@ func resourceSqlUserRead(d *schema.ResourceData, meta interface{}) error {
 	name := d.Get("name").(string)
 	host := d.Get("host").(string)
 
-	var users *sqladmin.UsersListResponse
+	// Users are listed through the JSON API so their type, which the vendored
+	// client drops, can be read.
+	var res map[string]interface{}
 	err = nil
 	err = retryTime(func() error {
-		users, err = config.clientSqlAdmin.Users.List(project, instance).Do()
+		res, err = sendRequest(config, "GET", fmt.Sprintf("%sprojects/%s/instances/%s/users", sqlAdminBasePath, project, instance), nil)
 		return err
 	}, 5)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("SQL User %q in instance %q", name, instance))
 	}
 
+	users := &sqladmin.UsersListResponse{}
+	if err := Convert(res, users); err != nil {
+		return err
+	}
+	rawUsers, _ := res["items"].([]interface{})
+
 	var user *sqladmin.User
-	for _, currentUser := range users.Items {
+	var userType interface{}
+	for i, currentUser := range users.Items {
 		// The second part of this conditional is irrelevant for postgres instances because
 		// host and currentUser.Host will always both be empty.
 		if currentUser.Name == name && currentUser.Host == host {
 			user = currentUser
+			if i < len(rawUsers) {
+				userType = rawUsers[i].(map[string]interface{})["type"]
+			}
 			break
 		}
 	}
@@ -143,6 +178,7 @@ func resourceSqlUserRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("host", user.Host)
 	d.Set("instance", user.Instance)
 	d.Set("name", user.Name)
+	d.Set("type", userType)
 	d.Set("project", project)
 	d.SetId(fmt.Sprintf("%s/%s/%s", user.Name, user.Host, user.Instance))
 	return nil
@@ -229,6 +265,12 @@ func resourceSqlUserDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// sqlUserIsIam returns true if userType is one of the Cloud IAM user types,
+// which authenticate with IAM instead of a password.
+func sqlUserIsIam(userType string) bool {
+	return userType == "CLOUD_IAM_USER" || userType == "CLOUD_IAM_SERVICE_ACCOUNT"
+}
+
 func resourceSqlUserImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	parts := strings.Split(d.Id(), "/")
 
@@ -77,6 +77,32 @@ func TestAccSqlUser_postgres(t *testing.T) {
 	})
 }
 
+func TestAccSqlUser_postgresIAM(t *testing.T) {
+	t.Parallel()
+
+	instance := acctest.RandomWithPrefix("i")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccSqlUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleSqlUser_postgresIAM(instance),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGoogleSqlUserExists("google_sql_user.user"),
+					resource.TestCheckResourceAttr("google_sql_user.user", "type", "CLOUD_IAM_USER"),
+				),
+			},
+			{
+				ResourceName:      "google_sql_user.user",
+				ImportStateId:     fmt.Sprintf("%s/%s/admin@hashicorptest.com", getTestProjectFromEnv(), instance),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleSqlUserExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -133,6 +159,7 @@ func testAccSqlUserDestroy(s *terraform.State) error {
 func testGoogleSqlUser_mysql(instance, password string) string {
 	return fmt.Sprintf(`
 	resource "google_sql_database_instance" "instance" {
+		deletion_protection = false
 		name = "%s"
 		region = "us-central"
 		settings {
@@ -159,6 +186,7 @@ func testGoogleSqlUser_mysql(instance, password string) string {
 func testGoogleSqlUser_postgres(instance, password string) string {
 	return fmt.Sprintf(`
 	resource "google_sql_database_instance" "instance" {
+		deletion_protection = false
 		name = "%s"
 		region = "us-central1"
 		database_version = "POSTGRES_9_6"
@@ -175,3 +203,29 @@ func testGoogleSqlUser_postgres(instance, password string) string {
 	}
 	`, instance, password)
 }
+
+func testGoogleSqlUser_postgresIAM(instance string) string {
+	return fmt.Sprintf(`
+	resource "google_sql_database_instance" "instance" {
+		deletion_protection = false
+		name = "%s"
+		region = "us-central1"
+		database_version = "POSTGRES_11"
+
+		settings {
+			tier = "db-f1-micro"
+
+			database_flags {
+				name  = "cloudsql.iam_authentication"
+				value = "on"
+			}
+		}
+	}
+
+	resource "google_sql_user" "user" {
+		name = "admin@hashicorptest.com"
+		instance = "${google_sql_database_instance.instance.name}"
+		type = "CLOUD_IAM_USER"
+	}
+	`, instance)
+}
@@ -30,6 +30,10 @@ func resourceStorageBucket() *schema.Resource {
 			State: resourceStorageBucketStateImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -70,14 +74,36 @@ func resourceStorageBucket() *schema.Resource {
 
 			"location": {
 				Type:     schema.TypeString,
-				Default:  "US",
 				Optional: true,
+				// Defaults to the provider's storage_location, or US if that isn't set.
+				Computed: true,
 				ForceNew: true,
 				StateFunc: func(s interface{}) string {
 					return strings.ToUpper(s.(string))
 				},
 			},
 
+			"custom_placement_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_locations": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							MinItems: 2,
+							MaxItems: 2,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							// The API returns data locations upper-cased.
+							Set: storageBucketDataLocationHash,
+						},
+					},
+				},
+			},
+
 			"predefined_acl": {
 				Type:     schema.TypeString,
 				Removed:  "Please use resource \"storage_bucket_acl.predefined_acl\" instead.",
@@ -243,6 +269,30 @@ func resourceStorageBucket() *schema.Resource {
 					},
 				},
 			},
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_locked": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"retention_period": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3155760000),
+						},
+					},
+				},
+			},
+			"bucket_policy_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"logging": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -275,7 +325,7 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	// Get the bucket and location
 	bucket := d.Get("name").(string)
-	location := d.Get("location").(string)
+	location := getStorageLocation(d, config)
 
 	// Create a bucket, setting the labels, location and name.
 	sb := &storage.Bucket{
@@ -336,10 +386,22 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if v, ok := d.GetOk("retention_policy"); ok {
+		sb.RetentionPolicy = expandBucketRetentionPolicy(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("bucket_policy_only"); ok {
+		sb.IamConfiguration = expandBucketIamConfiguration(v.(bool))
+	}
+
 	var res *storage.Bucket
 
 	err = retry(func() error {
-		res, err = config.clientStorage.Buckets.Insert(project, sb).Do()
+		if v, ok := d.GetOk("custom_placement_config"); ok {
+			res, err = insertStorageBucketWithPlacement(config, project, sb, v.([]interface{}))
+		} else {
+			res, err = config.clientStorage.Buckets.Insert(project, sb).Do()
+		}
 		return err
 	})
 
@@ -351,6 +413,18 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Created bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
 	d.SetId(res.Id)
+
+	if isPolicyLocked(d.Get("retention_policy").([]interface{})) {
+		if err := lockRetentionPolicy(config, bucket, res.Metageneration); err != nil {
+			return err
+		}
+	}
+
+	parent := fmt.Sprintf("//storage.googleapis.com/projects/_/buckets/%s", res.Name)
+	if err := createDefaultResourceTagBindings(config, parent, res.Location, int(d.Timeout(schema.TimeoutCreate).Minutes())); err != nil {
+		return err
+	}
+
 	return resourceStorageBucketRead(d, meta)
 }
 
@@ -432,6 +506,23 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("retention_policy") {
+		old, new := d.GetChange("retention_policy")
+		if err := validateRetentionPolicyChange(d.Get("name").(string), old.([]interface{}), new.([]interface{})); err != nil {
+			return err
+		}
+
+		if v, ok := d.GetOk("retention_policy"); ok {
+			sb.RetentionPolicy = expandBucketRetentionPolicy(v.([]interface{}))
+		} else {
+			sb.NullFields = append(sb.NullFields, "RetentionPolicy")
+		}
+	}
+
+	if d.HasChange("bucket_policy_only") {
+		sb.IamConfiguration = expandBucketIamConfiguration(d.Get("bucket_policy_only").(bool))
+	}
+
 	if d.HasChange("labels") {
 		sb.Labels = expandLabels(d)
 		if len(sb.Labels) == 0 {
@@ -462,6 +553,15 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 
 	log.Printf("[DEBUG] Patched bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
+	// A retention policy can only be locked after it has been applied to the
+	// bucket, and the lock itself is irreversible.
+	if d.HasChange("retention_policy") && isPolicyLocked(d.Get("retention_policy").([]interface{})) &&
+		(res.RetentionPolicy == nil || !res.RetentionPolicy.IsLocked) {
+		if err := lockRetentionPolicy(config, res.Name, res.Metageneration); err != nil {
+			return err
+		}
+	}
+
 	// Assign the bucket ID as the resource ID
 	d.Set("self_link", res.SelfLink)
 	d.SetId(res.Id)
@@ -472,12 +572,17 @@ func resourceStorageBucketUpdate(d *schema.ResourceData, meta interface{}) error
 func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
-	// Get the bucket and acl
+	// Get the bucket and acl. The bucket is read through the JSON API so its
+	// custom placement config, which the vendored client drops, is kept.
 	bucket := d.Get("name").(string)
-	res, err := config.clientStorage.Buckets.Get(bucket).Do()
+	raw, err := sendRequest(config, "GET", fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s", bucket), nil)
 	if err != nil {
 		return handleNotFoundError(err, d, fmt.Sprintf("Storage Bucket %q", d.Get("name").(string)))
 	}
+	res := &storage.Bucket{}
+	if err := Convert(raw, res); err != nil {
+		return err
+	}
 	log.Printf("[DEBUG] Read bucket %v at location %v\n\n", res.Name, res.SelfLink)
 
 	// We are trying to support several different use cases for bucket. Buckets are globally
@@ -513,6 +618,14 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("versioning", flattenBucketVersioning(res.Versioning))
 	d.Set("lifecycle_rule", flattenBucketLifecycle(res.Lifecycle))
 	d.Set("labels", res.Labels)
+	d.Set("retention_policy", flattenBucketRetentionPolicy(res.RetentionPolicy))
+	d.Set("custom_placement_config", flattenBucketCustomPlacementConfig(raw["customPlacementConfig"]))
+
+	if res.IamConfiguration != nil && res.IamConfiguration.BucketPolicyOnly != nil {
+		d.Set("bucket_policy_only", res.IamConfiguration.BucketPolicyOnly.Enabled)
+	} else {
+		d.Set("bucket_policy_only", false)
+	}
 
 	if res.Billing == nil {
 		d.Set("requester_pays", nil)
@@ -524,6 +637,28 @@ func resourceStorageBucketRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// insertStorageBucketWithPlacement creates sb through the JSON API with the
+// given custom placement config, which the vendored client can't represent.
+func insertStorageBucketWithPlacement(config *Config, project string, sb *storage.Bucket, placement []interface{}) (*storage.Bucket, error) {
+	obj, err := ConvertToMap(sb)
+	if err != nil {
+		return nil, err
+	}
+	obj["customPlacementConfig"] = expandBucketCustomPlacementConfig(placement)
+
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/b?project=%s", project)
+	raw, err := sendRequest(config, "POST", url, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &storage.Bucket{}
+	if err := Convert(raw, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -711,6 +846,70 @@ func flattenBucketLogging(bucketLogging *storage.BucketLogging) []map[string]int
 	return loggings
 }
 
+func expandBucketRetentionPolicy(configured interface{}) *storage.BucketRetentionPolicy {
+	retentionPolicies := configured.([]interface{})
+	if len(retentionPolicies) == 0 || retentionPolicies[0] == nil {
+		return nil
+	}
+	retentionPolicy := retentionPolicies[0].(map[string]interface{})
+
+	bucketRetentionPolicy := &storage.BucketRetentionPolicy{
+		RetentionPeriod: int64(retentionPolicy["retention_period"].(int)),
+	}
+
+	return bucketRetentionPolicy
+}
+
+func flattenBucketRetentionPolicy(bucketRetentionPolicy *storage.BucketRetentionPolicy) []map[string]interface{} {
+	bucketRetentionPolicies := make([]map[string]interface{}, 0, 1)
+
+	if bucketRetentionPolicy == nil {
+		return bucketRetentionPolicies
+	}
+
+	retentionPolicy := map[string]interface{}{
+		"is_locked":        bucketRetentionPolicy.IsLocked,
+		"retention_period": bucketRetentionPolicy.RetentionPeriod,
+	}
+
+	bucketRetentionPolicies = append(bucketRetentionPolicies, retentionPolicy)
+	return bucketRetentionPolicies
+}
+
+func isPolicyLocked(retentionPolicies []interface{}) bool {
+	if len(retentionPolicies) == 0 || retentionPolicies[0] == nil {
+		return false
+	}
+	return retentionPolicies[0].(map[string]interface{})["is_locked"].(bool)
+}
+
+// validateRetentionPolicyChange rejects changes that would unlock or remove a
+// locked retention policy, since GCS can't undo a lock.
+func validateRetentionPolicyChange(bucket string, old, new []interface{}) error {
+	if isPolicyLocked(old) && !isPolicyLocked(new) {
+		return fmt.Errorf("Retention policy on bucket %q is locked and cannot be unlocked or removed", bucket)
+	}
+	return nil
+}
+
+func lockRetentionPolicy(config *Config, bucketName string, metageneration int64) error {
+	_, err := config.clientStorage.Buckets.LockRetentionPolicy(bucketName, metageneration).Do()
+	if err != nil {
+		return fmt.Errorf("Error locking retention policy on bucket %q: %s", bucketName, err)
+	}
+
+	return nil
+}
+
+func expandBucketIamConfiguration(bucketPolicyOnly bool) *storage.BucketIamConfiguration {
+	return &storage.BucketIamConfiguration{
+		BucketPolicyOnly: &storage.BucketIamConfigurationBucketPolicyOnly{
+			Enabled:         bucketPolicyOnly,
+			ForceSendFields: []string{"Enabled"},
+		},
+	}
+}
+
 func expandBucketVersioning(configured interface{}) *storage.BucketVersioning {
 	versionings := configured.([]interface{})
 	versioning := versionings[0].(map[string]interface{})
@@ -1006,3 +1205,36 @@ func resourceGCSBucketLifecycleRuleConditionHash(v interface{}) int {
 
 	return hashcode.String(buf.String())
 }
+
+func expandBucketCustomPlacementConfig(configured []interface{}) map[string]interface{} {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+
+	placement := configured[0].(map[string]interface{})
+	dataLocations := make([]string, 0)
+	for _, l := range placement["data_locations"].(*schema.Set).List() {
+		dataLocations = append(dataLocations, strings.ToUpper(l.(string)))
+	}
+
+	return map[string]interface{}{
+		"dataLocations": dataLocations,
+	}
+}
+
+func flattenBucketCustomPlacementConfig(v interface{}) []map[string]interface{} {
+	placement, ok := v.(map[string]interface{})
+	if !ok || len(placement) == 0 {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"data_locations": schema.NewSet(storageBucketDataLocationHash, placement["dataLocations"].([]interface{})),
+		},
+	}
+}
+
+func storageBucketDataLocationHash(v interface{}) int {
+	return hashcode.String(strings.ToUpper(v.(string)))
+}
@@ -91,6 +91,52 @@ func TestAccStorageBucketIamMember(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucketIamBinding_withCondition(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketIamBinding_withCondition(bucket, account),
+			},
+			{
+				ResourceName:      "google_storage_bucket_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("b/%s roles/storage.objectViewer Expires after 2030", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageBucketIamMember_withCondition(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketIamMember_withCondition(bucket, account),
+			},
+			{
+				ResourceName:      "google_storage_bucket_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("b/%s roles/storage.objectViewer serviceAccount:%s-1@%s.iam.gserviceaccount.com Reports prefix only", bucket, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleStorageBucketIam(bucket, role string, members []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*Config)
@@ -263,3 +309,56 @@ resource "google_storage_bucket_iam_member" "foo" {
 }
 `, bucket, account)
 }
+
+func testAccStorageBucketIamBinding_withCondition(bucket, account string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name               = "%s"
+	bucket_policy_only = true
+}
+
+resource "google_service_account" "test-account-1" {
+	account_id   = "%s-1"
+	display_name = "Iam Testing Account"
+}
+
+resource "google_storage_bucket_iam_binding" "foo" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	role = "roles/storage.objectViewer"
+	members = [
+		"serviceAccount:${google_service_account.test-account-1.email}",
+	]
+
+	condition {
+		title       = "Expires after 2030"
+		description = "Expiring at midnight of 2030-12-31"
+		expression  = "request.time < timestamp(\"2031-01-01T00:00:00Z\")"
+	}
+}
+`, bucket, account)
+}
+
+func testAccStorageBucketIamMember_withCondition(bucket, account string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name               = "%s"
+	bucket_policy_only = true
+}
+
+resource "google_service_account" "test-account-1" {
+	account_id   = "%s-1"
+	display_name = "Iam Testing Account"
+}
+
+resource "google_storage_bucket_iam_member" "foo" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	role = "roles/storage.objectViewer"
+	member = "serviceAccount:${google_service_account.test-account-1.email}"
+
+	condition {
+		title      = "Reports prefix only"
+		expression = "resource.name.startsWith(\"projects/_/buckets/${google_storage_bucket.bucket.name}/objects/reports/\")"
+	}
+}
+`, bucket, account)
+}
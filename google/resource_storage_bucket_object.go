@@ -10,8 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"io/ioutil"
+	"net/http"
+	"strings"
 
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
@@ -146,6 +149,40 @@ func resourceStorageBucketObject() *schema.Resource {
 				Computed: true,
 			},
 
+			"kms_key_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"customer_encryption"},
+				DiffSuppressFunc: compareCryptoKeyVersions,
+			},
+
+			"customer_encryption": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"kms_key_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_algorithm": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  "AES256",
+						},
+						"encryption_key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validateCustomerEncryptionKey,
+						},
+					},
+				},
+			},
+
 			"self_link": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -214,6 +251,14 @@ func resourceStorageBucketObjectCreate(d *schema.ResourceData, meta interface{})
 	insertCall.Name(name)
 	insertCall.Media(media)
 
+	if v, ok := d.GetOk("kms_key_name"); ok {
+		insertCall.KmsKeyName(v.(string))
+	}
+
+	if v, ok := d.GetOk("customer_encryption"); ok {
+		setEncryptionHeaders(insertCall.Header(), v.([]interface{}))
+	}
+
 	_, err := insertCall.Do()
 
 	if err != nil {
@@ -232,6 +277,12 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	objectsService := storage.NewObjectsService(config.clientStorage)
 	getCall := objectsService.Get(bucket, name)
 
+	// Objects encrypted with a customer-supplied key only report their hashes
+	// when the same key is sent along with the request.
+	if v, ok := d.GetOk("customer_encryption"); ok {
+		setEncryptionHeaders(getCall.Header(), v.([]interface{}))
+	}
+
 	res, err := getCall.Do()
 
 	if err != nil {
@@ -249,6 +300,7 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("storage_class", res.StorageClass)
 	d.Set("self_link", res.SelfLink)
 	d.Set("output_name", res.Name)
+	d.Set("kms_key_name", res.KmsKeyName)
 
 	d.SetId(objectGetId(res))
 
@@ -281,6 +333,26 @@ func resourceStorageBucketObjectDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func setEncryptionHeaders(headers http.Header, customerEncryption []interface{}) {
+	enc := customerEncryption[0].(map[string]interface{})
+	key := enc["encryption_key"].(string)
+
+	headers.Set("X-Goog-Encryption-Algorithm", enc["encryption_algorithm"].(string))
+	headers.Set("X-Goog-Encryption-Key", key)
+
+	// The API expects the hash of the raw key, not of its base64 encoding. The
+	// key has already been checked by validateCustomerEncryptionKey.
+	decoded, _ := base64.StdEncoding.DecodeString(key)
+	keyHash := sha256.Sum256(decoded)
+	headers.Set("X-Goog-Encryption-Key-Sha256", base64.StdEncoding.EncodeToString(keyHash[:]))
+}
+
+// The API reports the key version that encrypted the object, so
+// "projects/p/.../cryptoKeys/k" and ".../cryptoKeys/k/cryptoKeyVersions/1" are equivalent.
+func compareCryptoKeyVersions(_, old, new string, _ *schema.ResourceData) bool {
+	return strings.Split(old, "/cryptoKeyVersions/")[0] == strings.Split(new, "/cryptoKeyVersions/")[0]
+}
+
 func getFileMd5Hash(filename string) string {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
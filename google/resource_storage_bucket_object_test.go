@@ -5,8 +5,10 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 
@@ -221,6 +223,72 @@ func TestAccStorageObject_cacheControl(t *testing.T) {
 	})
 }
 
+func TestAccStorageObject_customerEncryption(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	data := []byte(content)
+	h := md5.New()
+	if _, err := h.Write(data); err != nil {
+		t.Errorf("error calculating md5: %v", err)
+	}
+	data_md5 := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	testFile := getNewTmpTestFile(t, "tf-test")
+	if err := ioutil.WriteFile(testFile.Name(), data, 0644); err != nil {
+		t.Errorf("error writing file: %v", err)
+	}
+
+	customerEncryptionKey := "qI6+xvCZE9jUm94nJWIulFc8rthN56mfsaaaaaaaaaa="
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleStorageBucketsObject_customerEncryption(bucketName, testFile.Name(), customerEncryptionKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_object.object", "md5hash", data_md5),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket_object.object", "customer_encryption.0.encryption_algorithm", "AES256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageObject_kmsKeyName(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	keyRingName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	testFile := getNewTmpTestFile(t, "tf-test")
+	if err := ioutil.WriteFile(testFile.Name(), []byte(content), 0644); err != nil {
+		t.Errorf("error writing file: %v", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleStorageBucketsObject_kmsKeyName(bucketName, keyRingName, testFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"google_storage_bucket_object.object", "kms_key_name",
+						regexp.MustCompile(fmt.Sprintf("keyRings/%s/cryptoKeys/%s(/cryptoKeyVersions/[0-9]+)?$", keyRingName, keyRingName))),
+				),
+			},
+			{
+				// The API reports the key version, which must not cause a diff.
+				Config:   testGoogleStorageBucketsObject_kmsKeyName(bucketName, keyRingName, testFile.Name()),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccStorageObject_storageClass(t *testing.T) {
 	t.Parallel()
 
@@ -375,6 +443,57 @@ resource "google_storage_bucket_object" "object" {
 `, bucketName, objectName, sourceFilename, cacheControl)
 }
 
+func testGoogleStorageBucketsObject_customerEncryption(bucketName, sourceFilename, customerEncryptionKey string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_storage_bucket_object" "object" {
+	name = "%s"
+	bucket = "${google_storage_bucket.bucket.name}"
+	source = "%s"
+	customer_encryption {
+		encryption_key = "%s"
+	}
+}
+`, bucketName, objectName, sourceFilename, customerEncryptionKey)
+}
+
+func testGoogleStorageBucketsObject_kmsKeyName(bucketName, keyRingName, sourceFilename string) string {
+	return fmt.Sprintf(`
+data "google_storage_project_service_account" "gcs_account" {}
+
+resource "google_kms_key_ring" "key_ring" {
+	name     = "%s"
+	location = "us-central1"
+}
+
+resource "google_kms_crypto_key" "key" {
+	name     = "%s"
+	key_ring = "${google_kms_key_ring.key_ring.self_link}"
+}
+
+resource "google_kms_crypto_key_iam_member" "gcs" {
+	crypto_key_id = "${google_kms_crypto_key.key.self_link}"
+	role          = "roles/cloudkms.cryptoKeyEncrypterDecrypter"
+	member        = "serviceAccount:${data.google_storage_project_service_account.gcs_account.email_address}"
+}
+
+resource "google_storage_bucket" "bucket" {
+	name     = "%s"
+	location = "us-central1"
+}
+
+resource "google_storage_bucket_object" "object" {
+	name         = "%s"
+	bucket       = "${google_storage_bucket.bucket.name}"
+	source       = "%s"
+	kms_key_name = "${google_kms_crypto_key_iam_member.gcs.crypto_key_id}"
+}
+`, keyRingName, keyRingName, bucketName, objectName, sourceFilename)
+}
+
 func testGoogleStorageBucketsObject_storageClass(bucketName string, storageClass string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
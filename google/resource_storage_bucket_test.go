@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -94,6 +95,28 @@ func TestAccStorageBucket_lowercaseLocation(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_dualRegion(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_dualRegion(bucketName),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_customAttributes(t *testing.T) {
 	t.Parallel()
 
@@ -657,6 +680,171 @@ func TestAccStorageBucket_versioning(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_retentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_retentionPolicy(bucketName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.retention_period", "10"),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.is_locked", "false"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageBucket_retentionPolicy(bucketName, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.retention_period", "20"),
+				),
+			},
+			{
+				Config: testAccStorageBucket_basic(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStorageBucket_retentionPolicyLocked(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_retentionPolicy(bucketName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						"google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.is_locked", "false"),
+				),
+			},
+			{
+				Config: testAccStorageBucket_lockedRetentionPolicy(bucketName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "retention_policy.0.is_locked", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccStorageBucket_retentionPolicy(bucketName, 10),
+				ExpectError: regexp.MustCompile("is locked and cannot be unlocked or removed"),
+			},
+		},
+	})
+}
+
+func TestValidateRetentionPolicyChange(t *testing.T) {
+	t.Parallel()
+
+	unlocked := []interface{}{map[string]interface{}{"retention_period": 10, "is_locked": false}}
+	locked := []interface{}{map[string]interface{}{"retention_period": 10, "is_locked": true}}
+
+	cases := map[string]struct {
+		Old, New      []interface{}
+		ExpectedError bool
+	}{
+		"add policy":        {Old: []interface{}{}, New: unlocked},
+		"lock policy":       {Old: unlocked, New: locked},
+		"keep locked":       {Old: locked, New: locked},
+		"remove unlocked":   {Old: unlocked, New: []interface{}{}},
+		"unlock policy":     {Old: locked, New: unlocked, ExpectedError: true},
+		"remove locked":     {Old: locked, New: []interface{}{}, ExpectedError: true},
+		"nil locked policy": {Old: locked, New: []interface{}{nil}, ExpectedError: true},
+	}
+
+	for tn, tc := range cases {
+		err := validateRetentionPolicyChange("my-bucket", tc.Old, tc.New)
+		if (err != nil) != tc.ExpectedError {
+			t.Errorf("bad: %s, expected error %t, got %v", tn, tc.ExpectedError, err)
+		}
+	}
+}
+
+func TestIsPolicyLocked(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Policies []interface{}
+		Expected bool
+	}{
+		"empty":    {Policies: []interface{}{}, Expected: false},
+		"nil":      {Policies: []interface{}{nil}, Expected: false},
+		"unlocked": {Policies: []interface{}{map[string]interface{}{"is_locked": false}}, Expected: false},
+		"locked":   {Policies: []interface{}{map[string]interface{}{"is_locked": true}}, Expected: true},
+	}
+
+	for tn, tc := range cases {
+		if got := isPolicyLocked(tc.Policies); got != tc.Expected {
+			t.Errorf("bad: %s, expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
+func TestAccStorageBucket_bucketPolicyOnly(t *testing.T) {
+	t.Parallel()
+
+	bucketName := fmt.Sprintf("tf-test-acl-bucket-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_bucketPolicyOnly(bucketName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "bucket_policy_only", "true"),
+				),
+			},
+			{
+				ResourceName:      "google_storage_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageBucket_bucketPolicyOnly(bucketName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "bucket_policy_only", "false"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccStorageBucket_logging(t *testing.T) {
 	t.Parallel()
 
@@ -950,6 +1138,19 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_dualRegion(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	location = "US"
+
+	custom_placement_config {
+		data_locations = ["us-east1", "us-west1"]
+	}
+}
+`, bucketName)
+}
+
 func testAccStorageBucket_customAttributes(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -1064,6 +1265,38 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName)
 }
 
+func testAccStorageBucket_retentionPolicy(bucketName string, period int) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	retention_policy {
+	  retention_period = %d
+	}
+}
+`, bucketName, period)
+}
+
+func testAccStorageBucket_lockedRetentionPolicy(bucketName string, period int) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	retention_policy {
+	  is_locked        = true
+	  retention_period = %d
+	}
+}
+`, bucketName, period)
+}
+
+func testAccStorageBucket_bucketPolicyOnly(bucketName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+	bucket_policy_only = %t
+}
+`, bucketName, enabled)
+}
+
 func testAccStorageBucket_logging(bucketName string, logBucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
@@ -0,0 +1,205 @@
+package google
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceStorageHmacKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageHmacKeyCreate,
+		Read:   resourceStorageHmacKeyRead,
+		Update: resourceStorageHmacKeyUpdate,
+		Delete: resourceStorageHmacKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageHmacKeyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_account_email": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ACTIVE",
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "INACTIVE"}, false),
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"access_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageHmacKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/projects/%s/hmacKeys?serviceAccountEmail=%s", project, d.Get("service_account_email").(string))
+	res, err := sendRequest(config, "POST", url, nil)
+	if err != nil {
+		return fmt.Errorf("Error creating HmacKey: %s", err)
+	}
+
+	// The secret is only ever returned here, so it has to be stored now.
+	d.Set("secret", res["secret"])
+
+	metadata, ok := res["metadata"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error creating HmacKey: response has no metadata")
+	}
+	accessId := metadata["accessId"].(string)
+	d.Set("access_id", accessId)
+	d.SetId(fmt.Sprintf("projects/%s/hmacKeys/%s", project, accessId))
+	log.Printf("[DEBUG] Finished creating HmacKey %q: %#v", d.Id(), metadata)
+
+	// Keys are always created active, so deactivate it if that was asked for.
+	if d.Get("state").(string) != metadata["state"] {
+		if err := updateStorageHmacKeyState(config, project, accessId, d.Get("state").(string)); err != nil {
+			// Record the state the key was created in, so the next plan
+			// tries the update again.
+			d.Set("state", metadata["state"])
+			return err
+		}
+	}
+
+	return resourceStorageHmacKeyRead(d, meta)
+}
+
+func resourceStorageHmacKeyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/projects/%s/hmacKeys/%s", project, d.Get("access_id").(string))
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("StorageHmacKey %q", d.Id()))
+	}
+
+	// Deleted keys stay readable for a while before disappearing.
+	if res["state"] == "DELETED" {
+		log.Printf("[WARN] Removing StorageHmacKey %q because it's gone", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("project", project)
+	d.Set("service_account_email", res["serviceAccountEmail"])
+	d.Set("state", res["state"])
+	d.Set("access_id", res["accessId"])
+	d.Set("time_created", res["timeCreated"])
+	d.Set("updated", res["updated"])
+
+	return nil
+}
+
+func resourceStorageHmacKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("state") {
+		if err := updateStorageHmacKeyState(config, project, d.Get("access_id").(string), d.Get("state").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageHmacKeyRead(d, meta)
+}
+
+func resourceStorageHmacKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	accessId := d.Get("access_id").(string)
+
+	// Only inactive keys can be deleted.
+	if d.Get("state").(string) != "INACTIVE" {
+		if err := updateStorageHmacKeyState(config, project, accessId, "INACTIVE"); err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("StorageHmacKey %q", d.Id()))
+		}
+	}
+
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/projects/%s/hmacKeys/%s", project, accessId)
+	log.Printf("[DEBUG] Deleting HmacKey %q", d.Id())
+	if _, err := sendRequest(config, "DELETE", url, nil); err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("StorageHmacKey %q", d.Id()))
+	}
+
+	log.Printf("[DEBUG] Finished deleting HmacKey %q", d.Id())
+	return nil
+}
+
+func resourceStorageHmacKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{
+		"projects/(?P<project>[^/]+)/hmacKeys/(?P<access_id>[^/]+)",
+		"(?P<project>[^/]+)/(?P<access_id>[^/]+)",
+		"(?P<access_id>[^/]+)",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	id, err := replaceVars(d, config, "projects/{{project}}/hmacKeys/{{access_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateStorageHmacKeyState(config *Config, project, accessId, state string) error {
+	url := fmt.Sprintf("https://www.googleapis.com/storage/v1/projects/%s/hmacKeys/%s", project, accessId)
+	obj := map[string]interface{}{
+		"state": state,
+	}
+
+	log.Printf("[DEBUG] Setting HmacKey %q state to %s", accessId, state)
+	if _, err := sendRequest(config, "PUT", url, obj); err != nil {
+		return errwrap.Wrapf(fmt.Sprintf("Error updating HmacKey %q: {{err}}", accessId), err)
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccStorageHmacKey_update(t *testing.T) {
+	t.Parallel()
+
+	saName := fmt.Sprintf("tf-test-saname-%s", acctest.RandString(10))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageHmacKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGoogleStorageHmacKeyBasic(saName, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("google_storage_hmac_key.key", "secret"),
+				),
+			},
+			{
+				ResourceName:            "google_storage_hmac_key.key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			{
+				Config: testAccGoogleStorageHmacKeyBasic(saName, "INACTIVE"),
+			},
+			{
+				ResourceName:            "google_storage_hmac_key.key",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func testAccCheckStorageHmacKeyDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_hmac_key" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url := fmt.Sprintf("https://www.googleapis.com/storage/v1/%s", rs.Primary.ID)
+		res, err := sendRequest(config, "GET", url, nil)
+		if err == nil && res["state"] != "DELETED" {
+			return fmt.Errorf("StorageHmacKey still exists at %s", url)
+		}
+	}
+
+	return nil
+}
+
+func testAccGoogleStorageHmacKeyBasic(saName, state string) string {
+	return fmt.Sprintf(`
+resource "google_service_account" "service_account" {
+  account_id = "%s"
+}
+
+resource "google_storage_hmac_key" "key" {
+  service_account_email = "${google_service_account.service_account.email}"
+  state                 = "%s"
+}
+`, saName, state)
+}
@@ -0,0 +1,196 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceStorageManagedFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageManagedFolderCreate,
+		Read:   resourceStorageManagedFolderRead,
+		Delete: resourceStorageManagedFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageManagedFolderImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(240 * time.Second),
+			Delete: schema.DefaultTimeout(240 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRegexp(`/$`),
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metageneration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"update_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageManagedFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	bucketProp, err := expandStorageManagedFolderBucket(d.Get("bucket"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("bucket"); !isEmptyValue(reflect.ValueOf(bucketProp)) && (ok || !reflect.DeepEqual(v, bucketProp)) {
+		obj["bucket"] = bucketProp
+	}
+	nameProp, err := expandStorageManagedFolderName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !isEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/storage/v1/b/{{bucket}}/managedFolders")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new ManagedFolder: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating ManagedFolder: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating ManagedFolder %q: %#v", d.Id(), res)
+
+	return resourceStorageManagedFolderRead(d, meta)
+}
+
+func resourceStorageManagedFolderRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/storage/v1/b/{{bucket}}/managedFolders/{{%name}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("StorageManagedFolder %q", d.Id()))
+	}
+
+	if err := d.Set("bucket", flattenStorageManagedFolderBucket(res["bucket"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("name", flattenStorageManagedFolderName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("create_time", flattenStorageManagedFolderCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("update_time", flattenStorageManagedFolderUpdateTime(res["updateTime"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("metageneration", flattenStorageManagedFolderMetageneration(res["metageneration"], d)); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+	if err := d.Set("self_link", ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading ManagedFolder: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageManagedFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://www.googleapis.com/storage/v1/b/{{bucket}}/managedFolders/{{%name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting ManagedFolder %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "ManagedFolder")
+	}
+
+	log.Printf("[DEBUG] Finished deleting ManagedFolder %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceStorageManagedFolderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"(?P<bucket>[^/]+)/managedFolders/(?P<name>.+)", "(?P<bucket>[^/]+)/(?P<name>.+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenStorageManagedFolderBucket(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenStorageManagedFolderName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenStorageManagedFolderCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenStorageManagedFolderUpdateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenStorageManagedFolderMetageneration(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandStorageManagedFolderBucket(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandStorageManagedFolderName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
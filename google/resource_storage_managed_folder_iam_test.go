@@ -0,0 +1,246 @@
+package google
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccStorageManagedFolderIamBinding(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Test IAM Binding creation
+				Config: testAccStorageManagedFolderIamBinding_basic(bucket, account),
+				Check: testAccCheckGoogleStorageManagedFolderIam(bucket, "reports/", "roles/storage.objectViewer", []string{
+					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/reports/ roles/storage.objectViewer", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test IAM Binding update
+				Config: testAccStorageManagedFolderIamBinding_update(bucket, account),
+				Check: testAccCheckGoogleStorageManagedFolderIam(bucket, "reports/", "roles/storage.objectViewer", []string{
+					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+					fmt.Sprintf("serviceAccount:%s-2@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func TestAccStorageManagedFolderIamMember(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// Test Iam Member creation (no update for member, no need to test)
+				Config: testAccStorageManagedFolderIamMember_basic(bucket, account),
+				Check: testAccCheckGoogleStorageManagedFolderIam(bucket, "reports/", "roles/storage.objectAdmin", []string{
+					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+		},
+	})
+}
+
+func TestAccStorageManagedFolderIamPolicy(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolderIamPolicy_basic(bucket, account),
+				Check: testAccCheckGoogleStorageManagedFolderIam(bucket, "reports/", "roles/storage.objectViewer", []string{
+					fmt.Sprintf("serviceAccount:%s-1@%s.iam.gserviceaccount.com", account, getTestProjectFromEnv()),
+				}),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("%s/reports/", bucket),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageManagedFolderIamMember_withCondition(t *testing.T) {
+	t.Parallel()
+
+	bucket := acctest.RandomWithPrefix("tf-test")
+	account := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolderIamMember_withCondition(bucket, account),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/reports/ roles/storage.objectViewer serviceAccount:%s-1@%s.iam.gserviceaccount.com Expires after 2030", bucket, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckGoogleStorageManagedFolderIam(bucket, folder, role string, members []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		p, err := sendRequest(config, "GET", fmt.Sprintf("https://www.googleapis.com/storage/v1/b/%s/managedFolders/%s/iam", bucket, url.PathEscape(folder)), nil)
+		if err != nil {
+			return err
+		}
+
+		bindings, _ := p["bindings"].([]interface{})
+		for _, raw := range bindings {
+			binding := raw.(map[string]interface{})
+			if binding["role"] != role {
+				continue
+			}
+
+			got := convertStringArr(binding["members"].([]interface{}))
+			sort.Strings(members)
+			sort.Strings(got)
+
+			if reflect.DeepEqual(members, got) {
+				return nil
+			}
+
+			return fmt.Errorf("Binding found but expected members is %v, got %v", members, got)
+		}
+
+		return fmt.Errorf("No binding for role %q", role)
+	}
+}
+
+func testAccStorageManagedFolderIam_base(bucket, account string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name               = "%s"
+	bucket_policy_only = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+	bucket = "${google_storage_bucket.bucket.name}"
+	name   = "reports/"
+}
+
+resource "google_service_account" "test-account-1" {
+	account_id   = "%s-1"
+	display_name = "Iam Testing Account"
+}
+
+resource "google_service_account" "test-account-2" {
+	account_id   = "%s-2"
+	display_name = "Iam Testing Account"
+}
+`, bucket, account, account)
+}
+
+func testAccStorageManagedFolderIamBinding_basic(bucket, account string) string {
+	return testAccStorageManagedFolderIam_base(bucket, account) + `
+resource "google_storage_managed_folder_iam_binding" "foo" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	managed_folder = "${google_storage_managed_folder.folder.name}"
+	role           = "roles/storage.objectViewer"
+	members        = [
+		"serviceAccount:${google_service_account.test-account-1.email}",
+	]
+}
+`
+}
+
+func testAccStorageManagedFolderIamBinding_update(bucket, account string) string {
+	return testAccStorageManagedFolderIam_base(bucket, account) + `
+resource "google_storage_managed_folder_iam_binding" "foo" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	managed_folder = "${google_storage_managed_folder.folder.name}"
+	role           = "roles/storage.objectViewer"
+	members        = [
+		"serviceAccount:${google_service_account.test-account-1.email}",
+		"serviceAccount:${google_service_account.test-account-2.email}",
+	]
+}
+`
+}
+
+func testAccStorageManagedFolderIamMember_basic(bucket, account string) string {
+	return testAccStorageManagedFolderIam_base(bucket, account) + `
+resource "google_storage_managed_folder_iam_member" "foo" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	managed_folder = "${google_storage_managed_folder.folder.name}"
+	role           = "roles/storage.objectAdmin"
+	member         = "serviceAccount:${google_service_account.test-account-1.email}"
+}
+`
+}
+
+func testAccStorageManagedFolderIamPolicy_basic(bucket, account string) string {
+	return testAccStorageManagedFolderIam_base(bucket, account) + `
+data "google_iam_policy" "foo-policy" {
+	binding {
+		role = "roles/storage.objectViewer"
+
+		members = [
+			"serviceAccount:${google_service_account.test-account-1.email}",
+		]
+	}
+}
+
+resource "google_storage_managed_folder_iam_policy" "foo" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	managed_folder = "${google_storage_managed_folder.folder.name}"
+	policy_data    = "${data.google_iam_policy.foo-policy.policy_data}"
+}
+`
+}
+
+func testAccStorageManagedFolderIamMember_withCondition(bucket, account string) string {
+	return testAccStorageManagedFolderIam_base(bucket, account) + `
+resource "google_storage_managed_folder_iam_member" "foo" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	managed_folder = "${google_storage_managed_folder.folder.name}"
+	role           = "roles/storage.objectViewer"
+	member         = "serviceAccount:${google_service_account.test-account-1.email}"
+	condition {
+		title       = "Expires after 2030"
+		description = "Expires after 2030"
+		expression  = "request.time < timestamp(\"2030-01-01T00:00:00Z\")"
+	}
+}
+`
+}
@@ -0,0 +1,75 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccStorageManagedFolder_storageManagedFolderBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStorageManagedFolderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolder_storageManagedFolderBasicExample(context),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder.folder",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStorageManagedFolder_storageManagedFolderBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name               = "my-bucket-%{random_suffix}"
+  location           = "EU"
+  bucket_policy_only = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = "${google_storage_bucket.bucket.name}"
+  name   = "managed/folder/name/"
+}
+`, context)
+}
+
+func testAccCheckStorageManagedFolderDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_storage_managed_folder" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://www.googleapis.com/storage/v1/b/{{bucket}}/managedFolders/{{%name}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("StorageManagedFolder still exists at %s", url)
+		}
+	}
+
+	return nil
+}
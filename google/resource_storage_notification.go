@@ -2,10 +2,15 @@ package google
 
 import (
 	"fmt"
+	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/errwrap"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
 )
 
@@ -18,6 +23,10 @@ func resourceStorageNotification() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(4 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:     schema.TypeString,
@@ -97,7 +106,27 @@ func resourceStorageNotificationCreate(d *schema.ResourceData, meta interface{})
 		Topic:            computedTopicName,
 	}
 
-	res, err := config.clientStorage.Notifications.Insert(bucket, storageNotification).Do()
+	// The bucket's service agent is usually granted publish rights on the topic in
+	// the same apply, and the grant takes a while to propagate. Until it does the
+	// API rejects the notification, so keep trying rather than failing the apply.
+	var res *storage.Notification
+	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		var err error
+		res, err = config.clientStorage.Notifications.Insert(bucket, storageNotification).Do()
+		if err != nil {
+			if isStorageNotificationPublishPermissionError(err) {
+				// The API returns the same error for a topic that doesn't exist,
+				// which no amount of waiting will fix.
+				if _, terr := config.clientPubsub.Projects.Topics.Get(computedTopicName).Do(); isGoogleApiErrorWithCode(terr, 404) {
+					return resource.NonRetryableError(fmt.Errorf("topic %s does not exist: %s", computedTopicName, err))
+				}
+				log.Printf("[DEBUG] Storage service agent can't publish to %s yet, retrying: %s", computedTopicName, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return fmt.Errorf("Error creating notification config for bucket %s: %v", bucket, err)
 	}
@@ -141,6 +170,14 @@ func resourceStorageNotificationDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func isStorageNotificationPublishPermissionError(err error) bool {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return false
+	}
+	return gerr.Code == 400 && strings.Contains(gerr.Message, "permission to publish")
+}
+
 func resourceStorageNotificationParseID(id string) (string, string) {
 	//bucket, NotificationID
 	parts := strings.Split(id, "/")
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/storage/v1"
 )
 
@@ -103,6 +105,55 @@ func TestAccStorageNotification_withEventsAndAttributes(t *testing.T) {
 	})
 }
 
+func TestAccStorageNotification_missingTopic(t *testing.T) {
+	t.Parallel()
+
+	bucketName := testBucketName()
+	topicName := fmt.Sprintf("tf-pstopic-test-%d", acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageNotificationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Fails straight away instead of retrying until the create timeout.
+				Config:      testGoogleStorageNotificationMissingTopic(bucketName, topicName),
+				ExpectError: regexp.MustCompile("topic .* does not exist"),
+			},
+		},
+	})
+}
+
+func TestStorageNotificationPublishPermissionError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected bool
+	}{
+		"missing publish permission": {
+			Err: &googleapi.Error{
+				Code:    400,
+				Message: "The service account 'service-123@gs-project-accounts.iam.gserviceaccount.com' does not have permission to publish messages to to the Cloud Pub/Sub topic '//pubsub.googleapis.com/projects/p/topics/t' or that topic does not exist.",
+			},
+			Expected: true,
+		},
+		"other bad request": {
+			Err:      &googleapi.Error{Code: 400, Message: "Invalid argument"},
+			Expected: false,
+		},
+		"not found": {
+			Err:      &googleapi.Error{Code: 404, Message: "Not Found"},
+			Expected: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := isStorageNotificationPublishPermissionError(tc.Err); got != tc.Expected {
+			t.Errorf("bad: %s, expected %t, got %t", tn, tc.Expected, got)
+		}
+	}
+}
+
 func testAccStorageNotificationDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
@@ -175,6 +226,20 @@ func testAccCheckStorageNotificationCheckAttributes(notification *storage.Notifi
 	}
 }
 
+func testGoogleStorageNotificationMissingTopic(bucketName, topicName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+	name = "%s"
+}
+
+resource "google_storage_notification" "notification" {
+	bucket         = "${google_storage_bucket.bucket.name}"
+	payload_format = "JSON_API_V1"
+	topic          = "%s"
+}
+`, bucketName, topicName)
+}
+
 func testGoogleStorageNotificationBasic(bucketName, topicName, topic string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "bucket" {
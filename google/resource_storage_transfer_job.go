@@ -84,21 +84,18 @@ func resourceStorageTransferJob() *schema.Resource {
 						"schedule_start_date": {
 							Type:     schema.TypeList,
 							Required: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem:     dateObjectSchema(),
 						},
 						"schedule_end_date": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem:     dateObjectSchema(),
 						},
 						"start_time_of_day": {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem:     timeObjectSchema(),
 						},
@@ -197,25 +194,21 @@ func timeObjectSchema() *schema.Resource {
 			"hours": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 24),
 			},
 			"minutes": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 59),
 			},
 			"seconds": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 60),
 			},
 			"nanos": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 999999999),
 			},
 		},
@@ -228,21 +221,18 @@ func dateObjectSchema() *schema.Resource {
 			"year": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 9999),
 			},
 
 			"month": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(1, 12),
 			},
 
 			"day": {
 				Type:         schema.TypeInt,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(0, 31),
 			},
 		},
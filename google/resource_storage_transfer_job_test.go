@@ -60,6 +60,50 @@ func TestAccStorageTransferJob_basic(t *testing.T) {
 	})
 }
 
+func TestAccStorageTransferJob_objectConditions(t *testing.T) {
+	t.Parallel()
+
+	testDataSourceBucketName := acctest.RandString(10)
+	testDataSinkName := acctest.RandString(10)
+	testTransferJobDescription := acctest.RandString(10)
+	var jobName string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccStorageTransferJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageTransferJob_objectConditions(getTestProjectFromEnv(), testDataSourceBucketName, testDataSinkName, testTransferJobDescription, 2019),
+				Check:  testAccStoreStorageTransferJobName(&jobName),
+			},
+			{
+				ResourceName:      "google_storage_transfer_job.transfer_job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Changing the schedule updates the job in place.
+				Config: testAccStorageTransferJob_objectConditions(getTestProjectFromEnv(), testDataSourceBucketName, testDataSinkName, testTransferJobDescription, 2020),
+				Check: resource.TestCheckResourceAttrPtr(
+					"google_storage_transfer_job.transfer_job", "name", &jobName),
+			},
+			{
+				ResourceName:      "google_storage_transfer_job.transfer_job",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStoreStorageTransferJobName(name *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		*name = s.RootModule().Resources["google_storage_transfer_job.transfer_job"].Primary.Attributes["name"]
+		return nil
+	}
+}
+
 func testAccStorageTransferJob_basic(project string, dataSourceBucketName string, dataSinkBucketName string, transferJobDescription string) string {
 	return fmt.Sprintf(`
 data "google_storage_transfer_project_service_account" "default" {
@@ -130,6 +174,79 @@ resource "google_storage_transfer_job" "transfer_job" {
 `, project, dataSourceBucketName, project, dataSinkBucketName, project, transferJobDescription, project)
 }
 
+func testAccStorageTransferJob_objectConditions(project string, dataSourceBucketName string, dataSinkBucketName string, transferJobDescription string, endYear int) string {
+	return fmt.Sprintf(`
+data "google_storage_transfer_project_service_account" "default" {
+  project       = "%s"
+}
+
+resource "google_storage_bucket" "data_source" {
+  name          = "%s"
+  project       = "%s"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_iam_member" "data_source" {
+  bucket        = "${google_storage_bucket.data_source.name}"
+  role          = "roles/storage.admin"
+  member        = "serviceAccount:${data.google_storage_transfer_project_service_account.default.email}"
+}
+
+resource "google_storage_bucket" "data_sink" {
+  name          = "%s"
+  project       = "%s"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_iam_member" "data_sink" {
+  bucket        = "${google_storage_bucket.data_sink.name}"
+  role          = "roles/storage.admin"
+  member        = "serviceAccount:${data.google_storage_transfer_project_service_account.default.email}"
+}
+
+resource "google_storage_transfer_job" "transfer_job" {
+	description	= "%s"
+	project     = "%s"
+
+	transfer_spec {
+		object_conditions {
+			max_time_elapsed_since_last_modification = "600s"
+			include_prefixes = ["logs/"]
+			exclude_prefixes = ["logs/tmp/"]
+		}
+		transfer_options {
+			delete_objects_unique_in_sink = false
+			overwrite_objects_already_existing_in_sink = true
+		}
+		gcs_data_source {
+			bucket_name = "${google_storage_bucket.data_source.name}"
+		}
+		gcs_data_sink {
+			bucket_name = "${google_storage_bucket.data_sink.name}"
+		}
+	}
+
+	schedule {
+		schedule_start_date {
+			year	= 2018
+			month	= 10
+			day		= 1
+		}
+		schedule_end_date {
+			year	= %d
+			month	= 10
+			day		= 1
+		}
+	}
+
+	depends_on = [
+		"google_storage_bucket_iam_member.data_source",
+		"google_storage_bucket_iam_member.data_sink",
+	]
+}
+`, project, dataSourceBucketName, project, dataSinkBucketName, project, transferJobDescription, project, endYear)
+}
+
 func testAccStorageTransferJobDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
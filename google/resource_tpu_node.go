@@ -246,8 +246,10 @@ func resourceTpuNodeCreate(d *schema.ResourceData, meta interface{}) error {
 		int(d.Timeout(schema.TimeoutCreate).Minutes()))
 
 	if waitErr != nil {
-		// The resource didn't actually create
-		d.SetId("")
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
 		return fmt.Errorf("Error waiting to create Node: %s", waitErr)
 	}
 
@@ -0,0 +1,428 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWorkstationsWorkstation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkstationsWorkstationCreate,
+		Read:   resourceWorkstationsWorkstationRead,
+		Update: resourceWorkstationsWorkstationUpdate,
+		Delete: resourceWorkstationsWorkstationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceWorkstationsWorkstationImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workstation_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workstation_config_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workstation_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"env": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkstationsWorkstationCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandWorkstationsWorkstationDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandWorkstationsWorkstationLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	annotationsProp, err := expandWorkstationsWorkstationAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(annotationsProp)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	envProp, err := expandWorkstationsWorkstationEnv(d.Get("env"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("env"); !isEmptyValue(reflect.ValueOf(envProp)) && (ok || !reflect.DeepEqual(v, envProp)) {
+		obj["env"] = envProp
+	}
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations?workstationId={{workstation_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new Workstation: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating Workstation: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := workstationsOperationWaitTime(
+		config, res, project, "Creating Workstation",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create Workstation: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating Workstation %q: %#v", d.Id(), res)
+
+	return resourceWorkstationsWorkstationRead(d, meta)
+}
+
+func resourceWorkstationsWorkstationRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("WorkstationsWorkstation %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+
+	if err := d.Set("display_name", flattenWorkstationsWorkstationDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("labels", flattenWorkstationsWorkstationLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("annotations", flattenWorkstationsWorkstationAnnotations(res["annotations"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("env", flattenWorkstationsWorkstationEnv(res["env"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("name", flattenWorkstationsWorkstationName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("uid", flattenWorkstationsWorkstationUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("etag", flattenWorkstationsWorkstationEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("create_time", flattenWorkstationsWorkstationCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("host", flattenWorkstationsWorkstationHost(res["host"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+	if err := d.Set("state", flattenWorkstationsWorkstationState(res["state"], d)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkstationsWorkstationUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandWorkstationsWorkstationDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandWorkstationsWorkstationLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	annotationsProp, err := expandWorkstationsWorkstationAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	envProp, err := expandWorkstationsWorkstationEnv(d.Get("env"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("env"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, envProp)) {
+		obj["env"] = envProp
+	}
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating Workstation %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("annotations") {
+		updateMask = append(updateMask, "annotations")
+	}
+
+	if d.HasChange("env") {
+		updateMask = append(updateMask, "env")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating Workstation %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = workstationsOperationWaitTime(
+		config, res, project, "Updating Workstation",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceWorkstationsWorkstationRead(d, meta)
+}
+
+func resourceWorkstationsWorkstationDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting Workstation %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "Workstation")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = workstationsOperationWaitTime(
+		config, res, project, "Deleting Workstation",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting Workstation %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceWorkstationsWorkstationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workstationClusters/(?P<workstation_cluster_id>[^/]+)/workstationConfigs/(?P<workstation_config_id>[^/]+)/workstations/(?P<workstation_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<workstation_cluster_id>[^/]+)/(?P<workstation_config_id>[^/]+)/(?P<workstation_id>[^/]+)", "(?P<location>[^/]+)/(?P<workstation_cluster_id>[^/]+)/(?P<workstation_config_id>[^/]+)/(?P<workstation_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenWorkstationsWorkstationDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationEnv(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationHost(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationState(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandWorkstationsWorkstationDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandWorkstationsWorkstationLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandWorkstationsWorkstationAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandWorkstationsWorkstationEnv(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
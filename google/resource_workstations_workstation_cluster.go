@@ -0,0 +1,609 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWorkstationsWorkstationCluster() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkstationsWorkstationClusterCreate,
+		Read:   resourceWorkstationsWorkstationClusterRead,
+		Update: resourceWorkstationsWorkstationClusterUpdate,
+		Delete: resourceWorkstationsWorkstationClusterDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceWorkstationsWorkstationClusterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1200 * time.Second),
+			Update: schema.DefaultTimeout(1200 * time.Second),
+			Delete: schema.DefaultTimeout(1200 * time.Second),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"subnetwork": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: compareSelfLinkOrResourceName,
+			},
+			"workstation_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"domain_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_cluster_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable_private_endpoint": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+						"allowed_projects": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"cluster_hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_attachment_uri": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"control_plane_ip": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"degraded": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"etag": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkstationsWorkstationClusterCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	networkProp, err := expandWorkstationsWorkstationClusterNetwork(d.Get("network"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("network"); !isEmptyValue(reflect.ValueOf(networkProp)) && (ok || !reflect.DeepEqual(v, networkProp)) {
+		obj["network"] = networkProp
+	}
+	subnetworkProp, err := expandWorkstationsWorkstationClusterSubnetwork(d.Get("subnetwork"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("subnetwork"); !isEmptyValue(reflect.ValueOf(subnetworkProp)) && (ok || !reflect.DeepEqual(v, subnetworkProp)) {
+		obj["subnetwork"] = subnetworkProp
+	}
+	displayNameProp, err := expandWorkstationsWorkstationClusterDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(displayNameProp)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandWorkstationsWorkstationClusterLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(labelsProp)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	annotationsProp, err := expandWorkstationsWorkstationClusterAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(annotationsProp)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+	privateClusterConfigProp, err := expandWorkstationsWorkstationClusterPrivateClusterConfig(d.Get("private_cluster_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("private_cluster_config"); !isEmptyValue(reflect.ValueOf(privateClusterConfigProp)) && (ok || !reflect.DeepEqual(v, privateClusterConfigProp)) {
+		obj["privateClusterConfig"] = privateClusterConfigProp
+	}
+	domainConfigProp, err := expandWorkstationsWorkstationClusterDomainConfig(d.Get("domain_config"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("domain_config"); !isEmptyValue(reflect.ValueOf(domainConfigProp)) && (ok || !reflect.DeepEqual(v, domainConfigProp)) {
+		obj["domainConfig"] = domainConfigProp
+	}
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters?workstationClusterId={{workstation_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Creating new WorkstationCluster: %#v", obj)
+	res, err := sendRequestWithTimeout(config, "POST", url, obj, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error creating WorkstationCluster: %s", err)
+	}
+
+	// Store the ID now
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	waitErr := workstationsOperationWaitTime(
+		config, res, project, "Creating WorkstationCluster",
+		int(d.Timeout(schema.TimeoutCreate).Minutes()))
+
+	if waitErr != nil {
+		if !isOperationCancelledError(waitErr) {
+			// The resource didn't actually create
+			d.SetId("")
+		}
+		return fmt.Errorf("Error waiting to create WorkstationCluster: %s", waitErr)
+	}
+
+	log.Printf("[DEBUG] Finished creating WorkstationCluster %q: %#v", d.Id(), res)
+
+	return resourceWorkstationsWorkstationClusterRead(d, meta)
+}
+
+func resourceWorkstationsWorkstationClusterRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	res, err := sendRequest(config, "GET", url, nil)
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("WorkstationsWorkstationCluster %q", d.Id()))
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+
+	if err := d.Set("network", flattenWorkstationsWorkstationClusterNetwork(res["network"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("subnetwork", flattenWorkstationsWorkstationClusterSubnetwork(res["subnetwork"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("display_name", flattenWorkstationsWorkstationClusterDisplayName(res["displayName"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("labels", flattenWorkstationsWorkstationClusterLabels(res["labels"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("annotations", flattenWorkstationsWorkstationClusterAnnotations(res["annotations"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("private_cluster_config", flattenWorkstationsWorkstationClusterPrivateClusterConfig(res["privateClusterConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("domain_config", flattenWorkstationsWorkstationClusterDomainConfig(res["domainConfig"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("name", flattenWorkstationsWorkstationClusterName(res["name"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("uid", flattenWorkstationsWorkstationClusterUid(res["uid"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("etag", flattenWorkstationsWorkstationClusterEtag(res["etag"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("create_time", flattenWorkstationsWorkstationClusterCreateTime(res["createTime"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("degraded", flattenWorkstationsWorkstationClusterDegraded(res["degraded"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+	if err := d.Set("control_plane_ip", flattenWorkstationsWorkstationClusterControlPlaneIp(res["controlPlaneIp"], d)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
+
+	return nil
+}
+
+func resourceWorkstationsWorkstationClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	obj := make(map[string]interface{})
+	displayNameProp, err := expandWorkstationsWorkstationClusterDisplayName(d.Get("display_name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("display_name"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, displayNameProp)) {
+		obj["displayName"] = displayNameProp
+	}
+	labelsProp, err := expandWorkstationsWorkstationClusterLabels(d.Get("labels"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("labels"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, labelsProp)) {
+		obj["labels"] = labelsProp
+	}
+	annotationsProp, err := expandWorkstationsWorkstationClusterAnnotations(d.Get("annotations"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("annotations"); !isEmptyValue(reflect.ValueOf(v)) && (ok || !reflect.DeepEqual(v, annotationsProp)) {
+		obj["annotations"] = annotationsProp
+	}
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating WorkstationCluster %q: %#v", d.Id(), obj)
+	updateMask := []string{}
+
+	if d.HasChange("display_name") {
+		updateMask = append(updateMask, "displayName")
+	}
+
+	if d.HasChange("labels") {
+		updateMask = append(updateMask, "labels")
+	}
+
+	if d.HasChange("annotations") {
+		updateMask = append(updateMask, "annotations")
+	}
+	// updateMask is a URL parameter but not present in the schema, so replaceVars
+	// won't set it
+	url, err = addQueryParams(url, map[string]string{"updateMask": strings.Join(updateMask, ",")})
+	if err != nil {
+		return err
+	}
+	res, err := sendRequestWithTimeout(config, "PATCH", url, obj, d.Timeout(schema.TimeoutUpdate))
+
+	if err != nil {
+		return fmt.Errorf("Error updating WorkstationCluster %q: %s", d.Id(), err)
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = workstationsOperationWaitTime(
+		config, res, project, "Updating WorkstationCluster",
+		int(d.Timeout(schema.TimeoutUpdate).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceWorkstationsWorkstationClusterRead(d, meta)
+}
+
+func resourceWorkstationsWorkstationClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	url, err := replaceVars(d, config, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+	log.Printf("[DEBUG] Deleting WorkstationCluster %q", d.Id())
+	res, err := sendRequestWithTimeout(config, "DELETE", url, obj, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return handleNotFoundError(err, d, "WorkstationCluster")
+	}
+
+	project, err := getProject(d, config)
+	if err != nil {
+		return err
+	}
+	err = workstationsOperationWaitTime(
+		config, res, project, "Deleting WorkstationCluster",
+		int(d.Timeout(schema.TimeoutDelete).Minutes()))
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting WorkstationCluster %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceWorkstationsWorkstationClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+	if err := parseImportId([]string{"projects/(?P<project>[^/]+)/locations/(?P<location>[^/]+)/workstationClusters/(?P<workstation_cluster_id>[^/]+)", "(?P<project>[^/]+)/(?P<location>[^/]+)/(?P<workstation_cluster_id>[^/]+)", "(?P<location>[^/]+)/(?P<workstation_cluster_id>[^/]+)"}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := replaceVars(d, config, "projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenWorkstationsWorkstationClusterNetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterSubnetwork(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterDisplayName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterLabels(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterAnnotations(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterPrivateClusterConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["enable_private_endpoint"] =
+		flattenWorkstationsWorkstationClusterPrivateClusterConfigEnablePrivateEndpoint(original["enablePrivateEndpoint"], d)
+	transformed["allowed_projects"] =
+		flattenWorkstationsWorkstationClusterPrivateClusterConfigAllowedProjects(original["allowedProjects"], d)
+	transformed["cluster_hostname"] =
+		flattenWorkstationsWorkstationClusterPrivateClusterConfigClusterHostname(original["clusterHostname"], d)
+	transformed["service_attachment_uri"] =
+		flattenWorkstationsWorkstationClusterPrivateClusterConfigServiceAttachmentUri(original["serviceAttachmentUri"], d)
+	return []interface{}{transformed}
+}
+
+func flattenWorkstationsWorkstationClusterPrivateClusterConfigEnablePrivateEndpoint(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterPrivateClusterConfigAllowedProjects(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterPrivateClusterConfigClusterHostname(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterPrivateClusterConfigServiceAttachmentUri(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterDomainConfig(v interface{}, d *schema.ResourceData) interface{} {
+	if v == nil {
+		return nil
+	}
+	original := v.(map[string]interface{})
+	if len(original) == 0 {
+		return nil
+	}
+	transformed := make(map[string]interface{})
+	transformed["domain"] =
+		flattenWorkstationsWorkstationClusterDomainConfigDomain(original["domain"], d)
+	return []interface{}{transformed}
+}
+
+func flattenWorkstationsWorkstationClusterDomainConfigDomain(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterName(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterUid(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterEtag(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterCreateTime(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterDegraded(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func flattenWorkstationsWorkstationClusterControlPlaneIp(v interface{}, d *schema.ResourceData) interface{} {
+	return v
+}
+
+func expandWorkstationsWorkstationClusterNetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseGlobalFieldValue("networks", v.(string), "project", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for network: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandWorkstationsWorkstationClusterSubnetwork(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	f, err := parseRegionalFieldValue("subnetworks", v.(string), "project", "location", "location", d, config, true)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid value for subnetwork: %s", err)
+	}
+	return f.RelativeLink(), nil
+}
+
+func expandWorkstationsWorkstationClusterDisplayName(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandWorkstationsWorkstationClusterLabels(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandWorkstationsWorkstationClusterAnnotations(v interface{}, d TerraformResourceData, config *Config) (map[string]string, error) {
+	if v == nil {
+		return map[string]string{}, nil
+	}
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m, nil
+}
+
+func expandWorkstationsWorkstationClusterPrivateClusterConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedEnablePrivateEndpoint, err := expandWorkstationsWorkstationClusterPrivateClusterConfigEnablePrivateEndpoint(original["enable_private_endpoint"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedEnablePrivateEndpoint); val.IsValid() && !isEmptyValue(val) {
+		transformed["enablePrivateEndpoint"] = transformedEnablePrivateEndpoint
+	}
+
+	transformedAllowedProjects, err := expandWorkstationsWorkstationClusterPrivateClusterConfigAllowedProjects(original["allowed_projects"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedAllowedProjects); val.IsValid() && !isEmptyValue(val) {
+		transformed["allowedProjects"] = transformedAllowedProjects
+	}
+
+	return transformed, nil
+}
+
+func expandWorkstationsWorkstationClusterPrivateClusterConfigEnablePrivateEndpoint(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandWorkstationsWorkstationClusterPrivateClusterConfigAllowedProjects(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandWorkstationsWorkstationClusterDomainConfig(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	l := v.([]interface{})
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+	raw := l[0]
+	original := raw.(map[string]interface{})
+	transformed := make(map[string]interface{})
+
+	transformedDomain, err := expandWorkstationsWorkstationClusterDomainConfigDomain(original["domain"], d, config)
+	if err != nil {
+		return nil, err
+	} else if val := reflect.ValueOf(transformedDomain); val.IsValid() && !isEmptyValue(val) {
+		transformed["domain"] = transformedDomain
+	}
+
+	return transformed, nil
+}
+
+func expandWorkstationsWorkstationClusterDomainConfigDomain(v interface{}, d TerraformResourceData, config *Config) (interface{}, error) {
+	return v, nil
+}
@@ -0,0 +1,143 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkstationsWorkstationCluster_workstationClusterBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkstationsWorkstationClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationCluster_workstationClusterBasicExample(context),
+			},
+			{
+				ResourceName:            "google_workstations_workstation_cluster.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "annotations"},
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationCluster_workstationClusterBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-workstation-cluster%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-workstation-cluster%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-workstation-cluster%{random_suffix}"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+
+  labels = {
+    "label" = "key"
+  }
+
+  annotations = {
+    label-one = "value-one"
+  }
+}
+`, context)
+}
+
+func TestAccWorkstationsWorkstationCluster_workstationClusterPrivateExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkstationsWorkstationClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationCluster_workstationClusterPrivateExample(context),
+			},
+			{
+				ResourceName:      "google_workstations_workstation_cluster.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationCluster_workstationClusterPrivateExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-workstation-cluster%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-workstation-cluster%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-workstation-cluster-private%{random_suffix}"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+
+  private_cluster_config {
+    enable_private_endpoint = true
+  }
+}
+`, context)
+}
+
+func testAccCheckWorkstationsWorkstationClusterDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_workstations_workstation_cluster" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("WorkstationsWorkstationCluster still exists at %s", url)
+		}
+	}
+
+	return nil
+}
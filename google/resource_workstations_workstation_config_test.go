@@ -0,0 +1,186 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkstationsWorkstationConfig_workstationConfigBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkstationsWorkstationConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationConfig_workstationConfigBasicExample(context),
+			},
+			{
+				ResourceName:            "google_workstations_workstation_config.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "annotations"},
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationConfig_workstationConfigBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-workstation-cluster%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-workstation-cluster%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-workstation-cluster%{random_suffix}"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+}
+
+resource "google_workstations_workstation_config" "default" {
+  workstation_config_id  = "tf-test-workstation-config%{random_suffix}"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+
+  idle_timeout    = "600s"
+  running_timeout = "21600s"
+
+  host {
+    gce_instance {
+      machine_type                = "e2-standard-4"
+      boot_disk_size_gb           = 35
+      disable_public_ip_addresses = true
+    }
+  }
+
+  labels = {
+    "label" = "key"
+  }
+}
+`, context)
+}
+
+func TestAccWorkstationsWorkstationConfig_workstationConfigContainerExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkstationsWorkstationConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationConfig_workstationConfigContainerExample(context),
+			},
+			{
+				ResourceName:      "google_workstations_workstation_config.default",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationConfig_workstationConfigContainerExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-workstation-cluster%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-workstation-cluster%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-workstation-cluster%{random_suffix}"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+}
+
+resource "google_workstations_workstation_config" "default" {
+  workstation_config_id  = "tf-test-workstation-config%{random_suffix}"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+
+  host {
+    gce_instance {
+      machine_type      = "n1-standard-4"
+      boot_disk_size_gb = 35
+    }
+  }
+
+  persistent_directories {
+    mount_path = "/home"
+
+    gce_pd {
+      size_gb        = 200
+      fs_type        = "ext4"
+      disk_type      = "pd-standard"
+      reclaim_policy = "DELETE"
+    }
+  }
+
+  container {
+    image       = "intellij"
+    working_dir = "/home"
+
+    env = {
+      NAME = "FOO"
+      BABE = "bar"
+    }
+  }
+}
+`, context)
+}
+
+func testAccCheckWorkstationsWorkstationConfigDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_workstations_workstation_config" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("WorkstationsWorkstationConfig still exists at %s", url)
+		}
+	}
+
+	return nil
+}
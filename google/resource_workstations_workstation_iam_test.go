@@ -0,0 +1,129 @@
+package google
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccWorkstationsWorkstationIamBinding(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	account := "tf-test-ws-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationIamBinding_basic(suffix, account),
+			},
+			{
+				ResourceName: "google_workstations_workstation_iam_binding.foo",
+				ImportStateId: fmt.Sprintf("projects/%s/locations/us-central1/workstationClusters/tf-test-cluster-%s/workstationConfigs/tf-test-config-%s/workstations/tf-test-ws-%s roles/workstations.user",
+					getTestProjectFromEnv(), suffix, suffix, suffix),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWorkstationsWorkstationIamMember(t *testing.T) {
+	t.Parallel()
+
+	suffix := acctest.RandString(10)
+	account := "tf-test-ws-iam-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstationIamMember_basic(suffix, account),
+			},
+			{
+				ResourceName: "google_workstations_workstation_iam_member.foo",
+				ImportStateId: fmt.Sprintf("projects/%s/locations/us-central1/workstationClusters/tf-test-cluster-%s/workstationConfigs/tf-test-config-%s/workstations/tf-test-ws-%s roles/workstations.user serviceAccount:%s@%s.iam.gserviceaccount.com",
+					getTestProjectFromEnv(), suffix, suffix, suffix, account, getTestProjectFromEnv()),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstationIam_base(suffix string) string {
+	return fmt.Sprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-ws-%s"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-ws-%s"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-cluster-%s"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+}
+
+resource "google_workstations_workstation_config" "default" {
+  workstation_config_id  = "tf-test-config-%s"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+}
+
+resource "google_workstations_workstation" "default" {
+  workstation_id         = "tf-test-ws-%s"
+  workstation_config_id  = "${google_workstations_workstation_config.default.workstation_config_id}"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+}
+`, suffix, suffix, suffix, suffix, suffix)
+}
+
+func testAccWorkstationsWorkstationIamBinding_basic(suffix, account string) string {
+	return testAccWorkstationsWorkstationIam_base(suffix) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Workstations IAM Testing Account"
+}
+
+resource "google_workstations_workstation_iam_binding" "foo" {
+  location               = "us-central1"
+  workstation_cluster_id = "${google_workstations_workstation.default.workstation_cluster_id}"
+  workstation_config_id  = "${google_workstations_workstation.default.workstation_config_id}"
+  workstation_id         = "${google_workstations_workstation.default.workstation_id}"
+  role                   = "roles/workstations.user"
+  members                = ["serviceAccount:${google_service_account.test.email}"]
+}
+`, account)
+}
+
+func testAccWorkstationsWorkstationIamMember_basic(suffix, account string) string {
+	return testAccWorkstationsWorkstationIam_base(suffix) + fmt.Sprintf(`
+resource "google_service_account" "test" {
+  account_id   = "%s"
+  display_name = "Workstations IAM Testing Account"
+}
+
+resource "google_workstations_workstation_iam_member" "foo" {
+  location               = "us-central1"
+  workstation_cluster_id = "${google_workstations_workstation.default.workstation_cluster_id}"
+  workstation_config_id  = "${google_workstations_workstation.default.workstation_config_id}"
+  workstation_id         = "${google_workstations_workstation.default.workstation_id}"
+  role                   = "roles/workstations.user"
+  member                 = "serviceAccount:${google_service_account.test.email}"
+}
+`, account)
+}
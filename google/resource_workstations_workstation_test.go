@@ -0,0 +1,117 @@
+package google
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkstationsWorkstation_workstationBasicExample(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix": acctest.RandString(10),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkstationsWorkstationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkstationsWorkstation_workstationBasicExample(context),
+			},
+			{
+				ResourceName:            "google_workstations_workstation.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"labels", "annotations"},
+			},
+		},
+	})
+}
+
+func testAccWorkstationsWorkstation_workstationBasicExample(context map[string]interface{}) string {
+	return Nprintf(`
+resource "google_compute_network" "default" {
+  name                    = "tf-test-workstation-cluster%{random_suffix}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "tf-test-workstation-cluster%{random_suffix}"
+  ip_cidr_range = "10.0.0.0/24"
+  region        = "us-central1"
+  network       = "${google_compute_network.default.name}"
+}
+
+resource "google_workstations_workstation_cluster" "default" {
+  workstation_cluster_id = "tf-test-workstation-cluster%{random_suffix}"
+  network                = "${google_compute_network.default.name}"
+  subnetwork             = "${google_compute_subnetwork.default.name}"
+  location               = "us-central1"
+}
+
+resource "google_workstations_workstation_config" "default" {
+  workstation_config_id  = "tf-test-workstation-config%{random_suffix}"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+
+  host {
+    gce_instance {
+      machine_type                = "e2-standard-4"
+      boot_disk_size_gb           = 35
+      disable_public_ip_addresses = true
+    }
+  }
+}
+
+resource "google_workstations_workstation" "default" {
+  workstation_id         = "tf-test-work-station%{random_suffix}"
+  workstation_config_id  = "${google_workstations_workstation_config.default.workstation_config_id}"
+  workstation_cluster_id = "${google_workstations_workstation_cluster.default.workstation_cluster_id}"
+  location               = "us-central1"
+
+  labels = {
+    "label" = "key"
+  }
+
+  env = {
+    name = "foo"
+  }
+
+  annotations = {
+    label-one = "value-one"
+  }
+}
+`, context)
+}
+
+func testAccCheckWorkstationsWorkstationDestroy(s *terraform.State) error {
+	for name, rs := range s.RootModule().Resources {
+		if rs.Type != "google_workstations_workstation" {
+			continue
+		}
+		if strings.HasPrefix(name, "data.") {
+			continue
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		url, err := replaceVarsForTest(rs, "https://workstations.googleapis.com/v1/projects/{{project}}/locations/{{location}}/workstationClusters/{{workstation_cluster_id}}/workstationConfigs/{{workstation_config_id}}/workstations/{{workstation_id}}")
+		if err != nil {
+			return err
+		}
+
+		_, err = sendRequest(config, "GET", url, nil)
+		if err == nil {
+			return fmt.Errorf("WorkstationsWorkstation still exists at %s", url)
+		}
+	}
+
+	return nil
+}
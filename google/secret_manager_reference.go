@@ -0,0 +1,177 @@
+package google
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const secretManagerBasePath = "https://secretmanager.googleapis.com/v1/"
+
+var secretManagerSecretNameRegex = regexp.MustCompile(`^projects/([^/]+)/secrets/([^/]+)$`)
+
+// secretManagerReferenceSchema describes where a serverless resource references
+// Secret Manager secrets, either as environment variables or mounted volumes.
+type secretManagerReferenceSchema struct {
+	// Path to the blocks naming a secret. A "*" segment matches every element
+	// of the list at that position.
+	Path string
+	// Secret is the field holding the secret id or its full resource name.
+	Secret string
+	// Project is the optional field holding the project of the secret.
+	Project string
+	// Version is the field holding the version, either on the block itself or
+	// on each element of the Versions list.
+	Version  string
+	Versions string
+}
+
+var cloudFunctionsSecretReferences = []secretManagerReferenceSchema{
+	{Path: "secret_environment_variables.*", Secret: "secret", Project: "project_id", Version: "version"},
+	{Path: "secret_volumes.*", Secret: "secret", Project: "project_id", Versions: "versions", Version: "version"},
+}
+
+var cloudfunctions2FunctionSecretReferences = []secretManagerReferenceSchema{
+	{Path: "service_config.0.secret_environment_variables.*", Secret: "secret", Project: "project_id", Version: "version"},
+	{Path: "service_config.0.secret_volumes.*", Secret: "secret", Project: "project_id", Versions: "versions", Version: "version"},
+}
+
+var cloudRunV2ServiceSecretReferences = []secretManagerReferenceSchema{
+	{Path: "template.0.containers.*.env.*.value_source.0.secret_key_ref.0", Secret: "secret", Version: "version"},
+	{Path: "template.0.volumes.*.secret.0", Secret: "secret", Versions: "items", Version: "version"},
+}
+
+var cloudRunV2JobSecretReferences = []secretManagerReferenceSchema{
+	{Path: "template.0.template.0.containers.*.env.*.value_source.0.secret_key_ref.0", Secret: "secret", Version: "version"},
+	{Path: "template.0.template.0.volumes.*.secret.0", Secret: "secret", Versions: "items", Version: "version"},
+}
+
+type secretManagerReference struct {
+	Project string
+	Secret  string
+	Version string
+}
+
+func (r secretManagerReference) secretName() string {
+	return fmt.Sprintf("projects/%s/secrets/%s", r.Project, r.Secret)
+}
+
+// validateSecretManagerSecretVersion accepts "latest", which follows new
+// versions of the secret on every deployment, or a version number that pins it.
+func validateSecretManagerSecretVersion(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "latest" {
+		return
+	}
+	if n, err := strconv.Atoi(value); err != nil || n < 1 {
+		errors = append(errors, fmt.Errorf("%q must be \"latest\" or a positive version number, got %q", k, value))
+	}
+	return
+}
+
+// getSecretManagerReferences returns every secret version referenced by d in
+// the locations described by schemas. Secrets without a project use the
+// resource's project.
+func getSecretManagerReferences(d TerraformResourceData, config *Config, schemas []secretManagerReferenceSchema) ([]secretManagerReference, error) {
+	project, err := getProject(d, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []secretManagerReference
+	for _, s := range schemas {
+		for _, path := range expandSecretManagerReferencePath(d, s.Path) {
+			ref := secretManagerReference{
+				Project: project,
+				Secret:  d.Get(path + "." + s.Secret).(string),
+			}
+			if ref.Secret == "" {
+				continue
+			}
+			if s.Project != "" {
+				if v := d.Get(path + "." + s.Project).(string); v != "" {
+					ref.Project = v
+				}
+			}
+			if parts := secretManagerSecretNameRegex.FindStringSubmatch(ref.Secret); parts != nil {
+				ref.Project, ref.Secret = parts[1], parts[2]
+			}
+
+			if s.Versions == "" {
+				ref.Version = d.Get(path + "." + s.Version).(string)
+				refs = append(refs, ref)
+				continue
+			}
+
+			versionsPath := path + "." + s.Versions
+			versionCount := d.Get(versionsPath + ".#").(int)
+			if versionCount == 0 {
+				refs = append(refs, ref)
+			}
+			for i := 0; i < versionCount; i++ {
+				versionRef := ref
+				versionRef.Version = d.Get(fmt.Sprintf("%s.%d.%s", versionsPath, i, s.Version)).(string)
+				refs = append(refs, versionRef)
+			}
+		}
+	}
+	return refs, nil
+}
+
+// expandSecretManagerReferencePath replaces each "*" segment of path with the
+// indexes of the list it refers to.
+func expandSecretManagerReferencePath(d TerraformResourceData, path string) []string {
+	i := strings.Index(path, "*")
+	if i < 0 {
+		if _, ok := d.GetOk(path); !ok {
+			return nil
+		}
+		return []string{path}
+	}
+
+	list := strings.TrimSuffix(path[:i], ".")
+	count, _ := d.Get(list + ".#").(int)
+	var paths []string
+	for n := 0; n < count; n++ {
+		paths = append(paths, expandSecretManagerReferencePath(d, fmt.Sprintf("%s.%d%s", list, n, path[i+1:]))...)
+	}
+	return paths
+}
+
+// checkSecretManagerReferences verifies that the secrets, and any pinned
+// versions, referenced by d exist and are enabled before they're deployed.
+// Secret Manager permissions aren't required to deploy a function or service
+// that reads a secret, so only definite failures are reported.
+func checkSecretManagerReferences(d TerraformResourceData, config *Config, schemas []secretManagerReferenceSchema) error {
+	refs, err := getSecretManagerReferences(d, config, schemas)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range refs {
+		url := secretManagerBasePath + ref.secretName()
+		if ref.Version != "" && ref.Version != "latest" {
+			url = fmt.Sprintf("%s/versions/%s", url, ref.Version)
+		}
+
+		res, err := sendRequest(config, "GET", url, nil)
+		if err != nil {
+			if isGoogleApiErrorWithCode(err, 404) {
+				if ref.Version != "" && ref.Version != "latest" {
+					return fmt.Errorf("Version %q of secret %q does not exist", ref.Version, ref.secretName())
+				}
+				return fmt.Errorf("Secret %q does not exist", ref.secretName())
+			}
+			log.Printf("[WARN] Unable to verify secret %q: %s", ref.secretName(), err)
+			continue
+		}
+
+		if state, ok := res["state"].(string); ok && state != "ENABLED" {
+			return fmt.Errorf("Version %q of secret %q is %s, only enabled versions can be used", ref.Version, ref.secretName(), state)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package google
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidateSecretManagerSecretVersion(t *testing.T) {
+	cases := map[string]bool{
+		"latest": true,
+		"1":      true,
+		"42":     true,
+		"0":      false,
+		"-1":     false,
+		"Latest": false,
+		"v1":     false,
+		"":       false,
+	}
+
+	for version, valid := range cases {
+		_, errs := validateSecretManagerSecretVersion(version, "version")
+		if valid && len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", version, errs)
+		}
+		if !valid && len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", version)
+		}
+	}
+}
+
+func TestGetSecretManagerReferences_cloudFunctions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudFunctionsFunction().Schema, map[string]interface{}{
+		"project": "my-project",
+		"secret_environment_variables": []interface{}{
+			map[string]interface{}{
+				"key":     "API_KEY",
+				"secret":  "api-key",
+				"version": "3",
+			},
+		},
+		"secret_volumes": []interface{}{
+			map[string]interface{}{
+				"mount_path": "/etc/secrets",
+				"project_id": "other-project",
+				"secret":     "certs",
+				"versions": []interface{}{
+					map[string]interface{}{"path": "/cert", "version": "latest"},
+					map[string]interface{}{"path": "/old-cert", "version": "1"},
+				},
+			},
+			map[string]interface{}{
+				"mount_path": "/etc/keys",
+				"secret":     "keys",
+			},
+		},
+	})
+
+	refs, err := getSecretManagerReferences(d, &Config{}, cloudFunctionsSecretReferences)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []secretManagerReference{
+		{Project: "my-project", Secret: "api-key", Version: "3"},
+		{Project: "other-project", Secret: "certs", Version: "latest"},
+		{Project: "other-project", Secret: "certs", Version: "1"},
+		{Project: "my-project", Secret: "keys"},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected references %#v, got %#v", expected, refs)
+	}
+}
+
+func TestGetSecretManagerReferences_cloudRunV2Service(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudRunV2Service().Schema, map[string]interface{}{
+		"project": "my-project",
+		"template": []interface{}{
+			map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{
+						"image": "us-docker.pkg.dev/cloudrun/container/hello",
+						"env": []interface{}{
+							map[string]interface{}{
+								"name":  "PLAIN",
+								"value": "value",
+							},
+							map[string]interface{}{
+								"name": "SECRET",
+								"value_source": []interface{}{
+									map[string]interface{}{
+										"secret_key_ref": []interface{}{
+											map[string]interface{}{
+												"secret":  "projects/other-project/secrets/token",
+												"version": "2",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"volumes": []interface{}{
+					map[string]interface{}{
+						"name": "certs",
+						"secret": []interface{}{
+							map[string]interface{}{
+								"secret": "certs",
+								"items": []interface{}{
+									map[string]interface{}{"path": "cert", "version": "latest"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	refs, err := getSecretManagerReferences(d, &Config{}, cloudRunV2ServiceSecretReferences)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []secretManagerReference{
+		{Project: "other-project", Secret: "token", Version: "2"},
+		{Project: "my-project", Secret: "certs", Version: "latest"},
+	}
+	if !reflect.DeepEqual(refs, expected) {
+		t.Errorf("expected references %#v, got %#v", expected, refs)
+	}
+}
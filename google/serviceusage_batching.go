@@ -0,0 +1,41 @@
+package google
+
+import (
+	"fmt"
+	"time"
+)
+
+// batchRequestEnableService enables a service on a project, batched together
+// with other services being enabled on the same project at the same time.
+func batchRequestEnableService(service, project string, config *Config, timeout time.Duration) error {
+	if config.requestBatcherServiceUsage == nil {
+		return enableService(service, project, config)
+	}
+
+	req := &BatchRequest{
+		Body:     []string{service},
+		CombineF: combineServiceUsageServicesBatches,
+		SendF: func(body interface{}) (interface{}, error) {
+			return nil, enableServices(body.([]string), project, config)
+		},
+		DebugId: fmt.Sprintf("Enable Project Service %q for project %q", service, project),
+	}
+
+	_, err := config.requestBatcherServiceUsage.SendRequestWithTimeout(
+		fmt.Sprintf("project/%s/services:batchEnable", project), req, timeout)
+	return err
+}
+
+func combineServiceUsageServicesBatches(body interface{}, toAdd interface{}) (interface{}, error) {
+	services, ok := body.([]string)
+	if !ok {
+		return nil, fmt.Errorf("Expected batch body type to be []string, got %T", body)
+	}
+	add, ok := toAdd.([]string)
+	if !ok {
+		return nil, fmt.Errorf("Expected request body type to be []string, got %T", toAdd)
+	}
+
+	// Only add services that aren't in the batch yet.
+	return append(services, diffStringSlice(add, services)...), nil
+}
@@ -3,6 +3,7 @@ package google
 import (
 	"fmt"
 
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/serviceusage/v1"
 )
 
@@ -29,5 +30,21 @@ func serviceUsageOperationWaitTime(config *Config, op *serviceusage.Operation, a
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
+}
+
+// serviceUsageOperationWaitTimeWithResponse waits on an operation returned by a
+// raw REST call (such as the v1beta1-only generateServiceIdentity) and returns
+// its response.
+func serviceUsageOperationWaitTimeWithResponse(config *Config, op map[string]interface{}, activity string, timeoutMinutes int) (googleapi.RawMessage, error) {
+	w := &ServiceUsageOperationWaiter{
+		Service: config.clientServiceUsage,
+	}
+	if err := w.SetOp(op); err != nil {
+		return nil, err
+	}
+	if err := OperationWait(config.context, w, activity, timeoutMinutes); err != nil {
+		return nil, err
+	}
+	return w.Op.Response, nil
 }
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"time"
 
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 )
 
+const sqlAdminBasePath = "https://www.googleapis.com/sql/v1beta4/"
+
 type SqlAdminOperationWaiter struct {
 	Service *sqladmin.Service
 	Op      *sqladmin.Operation
@@ -108,7 +111,7 @@ func sqladminOperationWaitTime(config *Config, op *sqladmin.Operation, project,
 	if err := w.SetOp(op); err != nil {
 		return err
 	}
-	return OperationWait(w, activity, timeoutMinutes)
+	return OperationWait(config.context, w, activity, timeoutMinutes)
 }
 
 // SqlAdminOperationError wraps sqladmin.OperationError and implements the
@@ -124,3 +127,18 @@ func (e SqlAdminOperationError) Error() string {
 
 	return buf.String()
 }
+
+// sendSqlAdminRequest sends obj to path under the sqladmin API and
+// returns the operation it started.
+func sendSqlAdminRequest(config *Config, method, path string, obj map[string]interface{}, timeout time.Duration) (*sqladmin.Operation, error) {
+	res, err := sendRequestWithTimeout(config, method, sqlAdminBasePath+path, obj, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	op := &sqladmin.Operation{}
+	if err := Convert(res, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
@@ -0,0 +1,37 @@
+package google
+
+import (
+	"fmt"
+)
+
+type TagsOperationWaiter struct {
+	Config *Config
+	// BasePath is the global or location-specific Resource Manager endpoint
+	// that started the operation.
+	BasePath string
+	CommonOperationWaiter
+}
+
+func (w *TagsOperationWaiter) QueryOp() (interface{}, error) {
+	if w == nil {
+		return nil, fmt.Errorf("Cannot query operation, it's unset or nil.")
+	}
+	// Returns the proper get.
+	url := fmt.Sprintf("%s%s", w.BasePath, w.CommonOperationWaiter.Op.Name)
+	return sendRequest(w.Config, "GET", url, nil)
+}
+
+func tagsOperationWaitTime(config *Config, op map[string]interface{}, basePath, activity string, timeoutMinutes int) error {
+	if val, ok := op["name"]; !ok || val == "" {
+		// This was a synchronous call - there is no operation to wait for.
+		return nil
+	}
+	w := &TagsOperationWaiter{
+		Config:   config,
+		BasePath: basePath,
+	}
+	if err := w.CommonOperationWaiter.SetOp(op); err != nil {
+		return err
+	}
+	return OperationWait(config.context, w, activity, timeoutMinutes)
+}
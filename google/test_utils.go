@@ -1,10 +1,38 @@
 package google
 
 import (
+	"os"
 	"reflect"
 	"strconv"
 )
 
+// The environment variables that describe the project and credentials used by
+// acceptance tests. They are read by the bootstrap helpers as well as the tests.
+var credsEnvVars = []string{
+	"GOOGLE_CREDENTIALS",
+	"GOOGLE_CLOUD_KEYFILE_JSON",
+	"GCLOUD_KEYFILE_JSON",
+	"GOOGLE_USE_DEFAULT_CREDENTIALS",
+}
+
+var projectEnvVars = []string{
+	"GOOGLE_PROJECT",
+	"GCLOUD_PROJECT",
+	"CLOUDSDK_CORE_PROJECT",
+}
+
+var regionEnvVars = []string{
+	"GOOGLE_REGION",
+	"GCLOUD_REGION",
+	"CLOUDSDK_COMPUTE_REGION",
+}
+
+var zoneEnvVars = []string{
+	"GOOGLE_ZONE",
+	"GCLOUD_ZONE",
+	"CLOUDSDK_COMPUTE_ZONE",
+}
+
 type ResourceDataMock struct {
 	FieldsInSchema      map[string]interface{}
 	FieldsWithHasChange []string
@@ -66,3 +94,31 @@ func toBool(attribute string) (bool, error) {
 	}
 	return strconv.ParseBool(attribute)
 }
+
+// testAccPreCheck ensures at least one of the project env variables is set.
+func getTestProjectFromEnv() string {
+	return multiEnvSearch(projectEnvVars)
+}
+
+// testAccPreCheck ensures at least one of the credentials env variables is set.
+func getTestCredsFromEnv() string {
+	return multiEnvSearch(credsEnvVars)
+}
+
+// testAccPreCheck ensures at least one of the region env variables is set.
+func getTestRegionFromEnv() string {
+	return multiEnvSearch(regionEnvVars)
+}
+
+func getTestZoneFromEnv() string {
+	return multiEnvSearch(zoneEnvVars)
+}
+
+func multiEnvSearch(ks []string) string {
+	for _, k := range ks {
+		if v := os.Getenv(k); v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/api/googleapi"
@@ -34,12 +35,21 @@ func isEmptyValue(v reflect.Value) bool {
 	return false
 }
 
-func sendRequest(config *Config, method, rawurl string, body map[string]interface{}) (map[string]interface{}, error) {
-	return sendRequestWithTimeout(config, method, rawurl, body, DefaultRequestTimeout)
+func sendRequest(config *Config, method, rawurl string, body map[string]interface{}, errorRetryPredicates ...RetryErrorPredicateFunc) (map[string]interface{}, error) {
+	return sendRequestWithTimeout(config, method, rawurl, body, DefaultRequestTimeout, errorRetryPredicates...)
 }
 
-func sendRequestWithTimeout(config *Config, method, rawurl string, body map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+func sendRequestWithTimeout(config *Config, method, rawurl string, body map[string]interface{}, timeout time.Duration, errorRetryPredicates ...RetryErrorPredicateFunc) (map[string]interface{}, error) {
+	return sendRequestWithHeaders(config, method, rawurl, body, nil, timeout, errorRetryPredicates...)
+}
+
+// sendRequestWithHeaders sends the request like sendRequestWithTimeout, with
+// headers added to it, e.g. an If-Match precondition.
+func sendRequestWithHeaders(config *Config, method, rawurl string, body map[string]interface{}, headers http.Header, timeout time.Duration, errorRetryPredicates ...RetryErrorPredicateFunc) (map[string]interface{}, error) {
 	reqHeaders := make(http.Header)
+	for k, v := range headers {
+		reqHeaders[k] = v
+	}
 	reqHeaders.Set("User-Agent", config.userAgent)
 	reqHeaders.Set("Content-Type", "application/json")
 
@@ -67,6 +77,7 @@ func sendRequestWithTimeout(config *Config, method, rawurl string, body map[stri
 				return err
 			}
 
+			req = req.WithContext(config.context)
 			req.Header = reqHeaders
 			res, err = config.client.Do(req)
 			if err != nil {
@@ -81,6 +92,7 @@ func sendRequestWithTimeout(config *Config, method, rawurl string, body map[stri
 			return nil
 		},
 		timeout,
+		errorRetryPredicates...,
 	)
 	if err != nil {
 		return nil, err
@@ -119,57 +131,85 @@ func addQueryParams(rawurl string, params map[string]string) (string, error) {
 	return u.String(), nil
 }
 
-func replaceVars(d TerraformResourceData, config *Config, linkTmpl string) (string, error) {
-	re := regexp.MustCompile("{{([[:word:]]+)}}")
-	f, err := buildReplacementFunc(re, d, config, linkTmpl)
-	if err != nil {
-		return "", err
-	}
-	return re.ReplaceAllStringFunc(linkTmpl, f), nil
+// replaceVarsRegexp matches the {{var}} placeholders of a URL template. A
+// {{%var}} placeholder is path escaped, for values that may contain slashes.
+var replaceVarsRegexp = regexp.MustCompile("{{(%?[[:word:]]+)}}")
+
+// urlTemplates caches parsed URL templates by their source. The same few
+// templates are expanded for every resource of a type, so each is parsed once
+// rather than on every call to replaceVars.
+var urlTemplates sync.Map
+
+type urlTemplate struct {
+	// literals has one more element than vars. The expanded template is
+	// literals[0] + vars[0] + literals[1] + ... + literals[len(vars)].
+	literals []string
+	vars     []string
 }
 
-func buildReplacementFunc(re *regexp.Regexp, d TerraformResourceData, config *Config, linkTmpl string) (func(string) string, error) {
-	var project, region, zone string
-	var err error
+func parseUrlTemplate(linkTmpl string) *urlTemplate {
+	if t, ok := urlTemplates.Load(linkTmpl); ok {
+		return t.(*urlTemplate)
+	}
 
-	if strings.Contains(linkTmpl, "{{project}}") {
-		project, err = getProject(d, config)
-		if err != nil {
-			return nil, err
-		}
+	t := &urlTemplate{}
+	last := 0
+	for _, m := range replaceVarsRegexp.FindAllStringSubmatchIndex(linkTmpl, -1) {
+		t.literals = append(t.literals, linkTmpl[last:m[0]])
+		t.vars = append(t.vars, linkTmpl[m[2]:m[3]])
+		last = m[1]
 	}
+	t.literals = append(t.literals, linkTmpl[last:])
 
-	if strings.Contains(linkTmpl, "{{region}}") {
-		region, err = getRegion(d, config)
-		if err != nil {
-			return nil, err
-		}
+	urlTemplates.Store(linkTmpl, t)
+	return t
+}
+
+func replaceVars(d TerraformResourceData, config *Config, linkTmpl string) (string, error) {
+	t := parseUrlTemplate(linkTmpl)
+	if len(t.vars) == 0 {
+		return linkTmpl, nil
 	}
 
-	if strings.Contains(linkTmpl, "{{zone}}") {
-		zone, err = getZone(d, config)
+	// project, region and zone may fall back to the provider values, so each is
+	// resolved at most once however often it appears in the template.
+	var project, region, zone string
+	var err error
+	for _, v := range t.vars {
+		switch {
+		case v == "project" && project == "":
+			project, err = getProject(d, config)
+		case v == "region" && region == "":
+			region, err = getRegion(d, config)
+		case v == "zone" && zone == "":
+			zone, err = getZone(d, config)
+		}
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 	}
 
-	f := func(s string) string {
-		m := re.FindStringSubmatch(s)[1]
-		if m == "project" {
-			return project
-		}
-		if m == "region" {
-			return region
-		}
-		if m == "zone" {
-			return zone
-		}
-		v, ok := d.GetOk(m)
-		if ok {
-			return fmt.Sprintf("%v", v)
+	var b strings.Builder
+	b.WriteString(t.literals[0])
+	for i, v := range t.vars {
+		switch v {
+		case "project":
+			b.WriteString(project)
+		case "region":
+			b.WriteString(region)
+		case "zone":
+			b.WriteString(zone)
+		default:
+			if strings.HasPrefix(v, "%") {
+				if val, ok := d.GetOk(v[1:]); ok {
+					b.WriteString(url.PathEscape(fmt.Sprintf("%v", val)))
+				}
+			} else if val, ok := d.GetOk(v); ok {
+				fmt.Fprintf(&b, "%v", val)
+			}
 		}
-		return ""
+		b.WriteString(t.literals[i+1])
 	}
 
-	return f, nil
+	return b.String(), nil
 }
@@ -1,6 +1,8 @@
 package google
 
 import (
+	"net/url"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
@@ -11,7 +13,7 @@ import (
 // This function isn't a test of transport.go; instead, it is used as an alternative
 // to replaceVars inside tests.
 func replaceVarsForTest(rs *terraform.ResourceState, linkTmpl string) (string, error) {
-	re := regexp.MustCompile("{{([[:word:]]+)}}")
+	re := regexp.MustCompile("{{(%?[[:word:]]+)}}")
 	var project, region, zone string
 
 	if strings.Contains(linkTmpl, "{{project}}") {
@@ -38,6 +40,10 @@ func replaceVarsForTest(rs *terraform.ResourceState, linkTmpl string) (string, e
 			return zone
 		}
 
+		if strings.HasPrefix(m, "%") {
+			return url.PathEscape(rs.Primary.Attributes[m[1:]])
+		}
+
 		if v, ok := rs.Primary.Attributes[m]; ok {
 			return v
 		}
@@ -126,6 +132,36 @@ func TestReplaceVars(t *testing.T) {
 			},
 			Expected: "projects/project1/zones/zone1/instances/instance1",
 		},
+		"repeated values": {
+			Template: "projects/{{project}}/locations/{{region}}/{{name}}?parent=projects/{{project}}/{{name}}",
+			SchemaValues: map[string]interface{}{
+				"name": "resource1",
+			},
+			Config: &Config{
+				Project: "default-project",
+				Region:  "default-region",
+			},
+			Expected: "projects/default-project/locations/default-region/resource1?parent=projects/default-project/resource1",
+		},
+		"escaped schema value": {
+			Template: "b/{{bucket}}/managedFolders/{{%name}}",
+			SchemaValues: map[string]interface{}{
+				"bucket": "bucket1",
+				"name":   "folder/subfolder/",
+			},
+			Expected: "b/bucket1/managedFolders/folder%2Fsubfolder%2F",
+		},
+		"unset schema value": {
+			Template: "{{name}}/{{missing}}",
+			SchemaValues: map[string]interface{}{
+				"name": "resource1",
+			},
+			Expected: "resource1/",
+		},
+		"no placeholders": {
+			Template: "https://www.googleapis.com/compute/v1/projects",
+			Expected: "https://www.googleapis.com/compute/v1/projects",
+		},
 	}
 
 	for tn, tc := range cases {
@@ -156,3 +192,68 @@ func TestReplaceVars(t *testing.T) {
 		}
 	}
 }
+
+func TestParseUrlTemplate_cached(t *testing.T) {
+	tmpl := "projects/{{project}}/zones/{{zone}}/instances/{{name}}"
+
+	first := parseUrlTemplate(tmpl)
+	if second := parseUrlTemplate(tmpl); first != second {
+		t.Errorf("expected the parsed template for %q to be reused", tmpl)
+	}
+
+	expectedVars := []string{"project", "zone", "name"}
+	if !reflect.DeepEqual(first.vars, expectedVars) {
+		t.Errorf("expected vars %v, got %v", expectedVars, first.vars)
+	}
+	expectedLiterals := []string{"projects/", "/zones/", "/instances/", ""}
+	if !reflect.DeepEqual(first.literals, expectedLiterals) {
+		t.Errorf("expected literals %q, got %q", expectedLiterals, first.literals)
+	}
+}
+
+func TestReplaceVars_usesCurrentLocation(t *testing.T) {
+	config := &Config{
+		Project: "default-project",
+		Region:  "default-region",
+	}
+	tmpl := "projects/{{project}}/regions/{{region}}"
+
+	d := &ResourceDataMock{
+		FieldsInSchema: map[string]interface{}{},
+	}
+	if _, err := replaceVars(d, config, tmpl); err != nil {
+		t.Fatal(err)
+	}
+
+	// Create often sets the project and region before calling Read, which
+	// builds its URLs from the same resource data.
+	d.Set("project", "project1")
+	d.Set("region", "region1")
+
+	v, err := replaceVars(d, config, tmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "projects/project1/regions/region1"; v != expected {
+		t.Errorf("expected %q, got %q", expected, v)
+	}
+}
+
+func BenchmarkReplaceVars(b *testing.B) {
+	d := &ResourceDataMock{
+		FieldsInSchema: map[string]interface{}{
+			"name": "subnetwork1",
+		},
+	}
+	config := &Config{
+		Project: "default-project",
+		Region:  "default-region",
+	}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := replaceVars(d, config, "https://www.googleapis.com/compute/v1/projects/{{project}}/regions/{{region}}/subnetworks/{{name}}"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package google
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// The universe domain of the public Google Cloud. Every API is served from a
+// subdomain of it, such as compute.googleapis.com.
+const defaultUniverseDomain = "googleapis.com"
+
+// universeDomainTransport is an http.RoundTripper that sends requests for
+// Google APIs to another universe, such as a Trusted Partner Cloud, by
+// swapping googleapis.com in the request host for the universe domain. This
+// covers both the API clients, which default to googleapis.com endpoints, and
+// the URL templates used by resources that send requests directly. See
+// universeDomainHost for which hosts are rewritten.
+type universeDomainTransport struct {
+	transport      http.RoundTripper
+	universeDomain string
+}
+
+func newUniverseDomainTransport(transport http.RoundTripper, universeDomain string) *universeDomainTransport {
+	return &universeDomainTransport{
+		transport:      transport,
+		universeDomain: universeDomain,
+	}
+}
+
+func (t *universeDomainTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := universeDomainHost(req.URL.Host, req.URL.Path, t.universeDomain)
+	if host == req.URL.Host {
+		return t.transport.RoundTrip(req)
+	}
+
+	// A RoundTripper mustn't modify the request it's given, so the rewritten
+	// URL goes on a copy.
+	u := *req.URL
+	u.Host = host
+	r := new(http.Request)
+	*r = *req
+	r.URL = &u
+	r.Host = host
+
+	return t.transport.RoundTrip(r)
+}
+
+// wwwServices maps the first path segment of requests to www.googleapis.com,
+// which served many APIs before each had its own endpoint, to the service
+// endpoint that serves the same paths in other universes.
+var wwwServices = map[string]string{
+	"bigquery": "bigquery",
+	"compute":  "compute",
+	"dns":      "dns",
+	"sql":      "sqladmin",
+	"storage":  "storage",
+}
+
+// universeDomainHost returns the host that serves a request for path on host
+// in the given universe. Service endpoints, such as compute.googleapis.com or
+// us-central1-run.googleapis.com, have their googleapis.com suffix replaced by
+// the universe domain. Requests to www.googleapis.com are sent to the endpoint
+// of the service in wwwServices that serves their path. Any other host is
+// returned as is, as there's no way to know where it's served from.
+func universeDomainHost(host, path, universeDomain string) string {
+	hostname, port := host, ""
+	if i := strings.LastIndex(host, ":"); i >= 0 {
+		hostname, port = host[:i], host[i:]
+	}
+
+	if !strings.HasSuffix(hostname, "."+defaultUniverseDomain) {
+		return host
+	}
+	service := strings.TrimSuffix(hostname, "."+defaultUniverseDomain)
+	if strings.Contains(service, ".") {
+		return host
+	}
+
+	if service == "www" {
+		segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+		if segments[0] == "upload" && len(segments) > 1 {
+			segments = segments[1:]
+		}
+		var ok bool
+		if service, ok = wwwServices[segments[0]]; !ok {
+			return host
+		}
+	}
+
+	return service + "." + universeDomain + port
+}
+
+// validateUniverseDomainCredentials checks that JSON credentials belong to
+// the given universe. Credentials that don't name a universe belong to
+// googleapis.com.
+func validateUniverseDomainCredentials(contents []byte, universeDomain string) error {
+	var creds struct {
+		UniverseDomain string `json:"universe_domain"`
+	}
+	if err := json.Unmarshal(contents, &creds); err != nil {
+		return fmt.Errorf("Unable to read the universe domain of the credentials: %s", err)
+	}
+
+	credsUniverseDomain := creds.UniverseDomain
+	if credsUniverseDomain == "" {
+		credsUniverseDomain = defaultUniverseDomain
+	}
+	if credsUniverseDomain != universeDomain {
+		return fmt.Errorf("The universe domain of the credentials (%q) doesn't match the configured universe_domain (%q)", credsUniverseDomain, universeDomain)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package google
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestUniverseDomainTransport(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"https://compute.googleapis.com/compute/v1/projects/foo":             "https://compute.example.goog/compute/v1/projects/foo",
+		"https://www.googleapis.com/storage/v1/b/foo":                        "https://storage.example.goog/storage/v1/b/foo",
+		"https://www.googleapis.com/upload/storage/v1/b/foo/o":               "https://storage.example.goog/upload/storage/v1/b/foo/o",
+		"https://www.googleapis.com/compute/v1/projects/foo":                 "https://compute.example.goog/compute/v1/projects/foo",
+		"https://www.googleapis.com/sql/v1beta4/projects/foo":                "https://sqladmin.example.goog/sql/v1beta4/projects/foo",
+		"https://www.googleapis.com/unknown/v1/foo":                          "https://www.googleapis.com/unknown/v1/foo",
+		"https://storage.googleapis.com/storage/v1/b/foo":                    "https://storage.example.goog/storage/v1/b/foo",
+		"https://foo.storage.googleapis.com/bar":                             "https://foo.storage.googleapis.com/bar",
+		"https://googleapis.com/foo":                                         "https://googleapis.com/foo",
+		"https://us-central1-run.googleapis.com/apis/serving.knative.dev/v1": "https://us-central1-run.example.goog/apis/serving.knative.dev/v1",
+		"https://bigtableadmin.googleapis.com:443/v2/projects/foo":           "https://bigtableadmin.example.goog:443/v2/projects/foo",
+		"https://oauth2.example.com/token":                                   "https://oauth2.example.com/token",
+		"https://notgoogleapis.com/foo":                                      "https://notgoogleapis.com/foo",
+	}
+
+	base := &countingTransport{}
+	rt := newUniverseDomainTransport(base, "example.goog")
+	for url, expected := range cases {
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatalf("unexpected error building request for %q: %s", url, err)
+		}
+
+		res, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("unexpected error sending request to %q: %s", url, err)
+		}
+		body, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("unexpected error reading response for %q: %s", url, err)
+		}
+
+		if string(body) != "GET "+expected {
+			t.Errorf("expected request to %q to be sent to %q, got %q", url, expected, body)
+		}
+		if req.URL.String() != url {
+			t.Errorf("expected the original request for %q not to be modified, got %q", url, req.URL)
+		}
+	}
+}
+
+func TestValidateUniverseDomainCredentials(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		Credentials    string
+		UniverseDomain string
+		ExpectError    bool
+	}{
+		"default universe": {
+			Credentials:    `{"type": "service_account"}`,
+			UniverseDomain: "googleapis.com",
+		},
+		"default credentials in a partner universe": {
+			Credentials:    `{"type": "service_account"}`,
+			UniverseDomain: "example.goog",
+			ExpectError:    true,
+		},
+		"partner universe": {
+			Credentials:    `{"type": "service_account", "universe_domain": "example.goog"}`,
+			UniverseDomain: "example.goog",
+		},
+		"partner credentials in the default universe": {
+			Credentials:    `{"type": "service_account", "universe_domain": "example.goog"}`,
+			UniverseDomain: "googleapis.com",
+			ExpectError:    true,
+		},
+		"invalid JSON": {
+			Credentials:    `not json`,
+			UniverseDomain: "googleapis.com",
+			ExpectError:    true,
+		},
+	}
+
+	for tn, tc := range cases {
+		err := validateUniverseDomainCredentials([]byte(tc.Credentials), tc.UniverseDomain)
+		if tc.ExpectError && err == nil {
+			t.Errorf("%s: expected an error", tn)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/errwrap"
@@ -52,6 +54,32 @@ func getProject(d TerraformResourceData, config *Config) (string, error) {
 	return getProjectFromSchema("project", d, config)
 }
 
+// getStorageLocation reads the "location" field from the given resource data
+// and falls back to the provider's storage_location, then to the API default
+// of US.
+func getStorageLocation(d TerraformResourceData, config *Config) string {
+	if v, ok := d.GetOk("location"); ok {
+		return v.(string)
+	}
+	if config.StorageLocation != "" {
+		return config.StorageLocation
+	}
+	return "US"
+}
+
+// getKmsLocation reads the "location" field from the given resource data and
+// falls back to the provider's kms_location. If neither is given, an error is
+// returned.
+func getKmsLocation(d TerraformResourceData, config *Config) (string, error) {
+	if v, ok := d.GetOk("location"); ok {
+		return v.(string), nil
+	}
+	if config.KmsLocation != "" {
+		return config.KmsLocation, nil
+	}
+	return "", fmt.Errorf("Cannot determine location: set in this resource, or set provider-level 'kms_location'.")
+}
+
 // getProjectFromDiff reads the "project" field from the given diff and falls
 // back to the provider's value if not given. If the provider's value is not
 // given, an error is returned.
@@ -334,14 +362,14 @@ func retryTime(retryFunc func() error, minutes int) error {
 	return retryTimeDuration(retryFunc, time.Duration(minutes)*time.Minute)
 }
 
-func retryTimeDuration(retryFunc func() error, duration time.Duration) error {
+func retryTimeDuration(retryFunc func() error, duration time.Duration, errorRetryPredicates ...RetryErrorPredicateFunc) error {
 	return resource.Retry(duration, func() *resource.RetryError {
 		err := retryFunc()
 		if err == nil {
 			return nil
 		}
 		for _, e := range errwrap.GetAllType(err, &googleapi.Error{}) {
-			if isRetryableError(e) {
+			if isRetryableError(e, errorRetryPredicates...) {
 				return resource.RetryableError(e)
 			}
 		}
@@ -349,7 +377,14 @@ func retryTimeDuration(retryFunc func() error, duration time.Duration) error {
 	})
 }
 
-func isRetryableError(err error) bool {
+func isRetryableError(err error, errorRetryPredicates ...RetryErrorPredicateFunc) bool {
+	for _, pred := range errorRetryPredicates {
+		if retry, reason := pred(err); retry {
+			log.Printf("[DEBUG] Dismissed an error as retryable. %s - %s", reason, err)
+			return true
+		}
+	}
+
 	if gerr, ok := err.(*googleapi.Error); ok && (gerr.Code == 429 || gerr.Code == 500 || gerr.Code == 502 || gerr.Code == 503) {
 		log.Printf("[DEBUG] Dismissed an error as retryable based on error code: %s", err)
 		return true
@@ -447,3 +482,32 @@ func paginatedListRequest(baseUrl string, config *Config, flattener func(map[str
 
 	return ls, nil
 }
+
+// compiledRegexps caches regular expressions that are built at runtime, such
+// as the link and import id patterns, by their source.
+var compiledRegexps sync.Map
+
+// compileRegexpCached is like regexp.Compile, but compiles each pattern only
+// once for the lifetime of the provider.
+func compileRegexpCached(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiledRegexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	compiledRegexps.Store(pattern, re)
+	return re, nil
+}
+
+// mustCompileRegexpCached is like regexp.MustCompile, but compiles each
+// pattern only once for the lifetime of the provider.
+func mustCompileRegexpCached(pattern string) *regexp.Regexp {
+	re, err := compileRegexpCached(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("regexp: Compile(%q): %s", pattern, err))
+	}
+	return re
+}
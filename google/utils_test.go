@@ -188,6 +188,44 @@ func TestGetRegion(t *testing.T) {
 	}
 }
 
+func TestGetStorageLocation(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceStorageBucket().Schema, map[string]interface{}{})
+	var config Config
+
+	if location := getStorageLocation(d, &config); location != "US" {
+		t.Fatalf("Location '%s' != 'US'", location)
+	}
+
+	config.StorageLocation = "EU"
+	if location := getStorageLocation(d, &config); location != "EU" {
+		t.Fatalf("Location '%s' != 'EU'", location)
+	}
+
+	d.Set("location", "ASIA")
+	if location := getStorageLocation(d, &config); location != "ASIA" {
+		t.Fatalf("Location '%s' != 'ASIA'", location)
+	}
+}
+
+func TestGetKmsLocation(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceKmsKeyRing().Schema, map[string]interface{}{})
+	var config Config
+
+	if _, err := getKmsLocation(d, &config); err == nil {
+		t.Fatalf("Expected an error when no location is set")
+	}
+
+	config.KmsLocation = "europe-west1"
+	if location, err := getKmsLocation(d, &config); err != nil || location != config.KmsLocation {
+		t.Fatalf("Location '%s' != '%s', %s", location, config.KmsLocation, err)
+	}
+
+	d.Set("location", "us-central1")
+	if location, err := getKmsLocation(d, &config); err != nil || location != "us-central1" {
+		t.Fatalf("Location '%s' != 'us-central1', %s", location, err)
+	}
+}
+
 func TestDatasourceSchemaFromResourceSchema(t *testing.T) {
 	type args struct {
 		rs map[string]*schema.Schema
@@ -528,3 +566,22 @@ func TestRetryTimeDuration_noretry(t *testing.T) {
 		t.Errorf("expected error function to be called exactly once, but was called %d times", i)
 	}
 }
+
+func TestCompileRegexpCached(t *testing.T) {
+	pattern := "^projects/([^/]+)/secrets/([^/]+)$"
+
+	re, err := compileRegexpCached(pattern)
+	if err != nil {
+		t.Fatalf("unexpected error compiling %q: %s", pattern, err)
+	}
+	if again := mustCompileRegexpCached(pattern); again != re {
+		t.Errorf("expected the compiled regexp for %q to be reused", pattern)
+	}
+	if !re.MatchString("projects/p/secrets/s") {
+		t.Errorf("expected %q to match", pattern)
+	}
+
+	if _, err := compileRegexpCached("projects/(?P<project"); err == nil {
+		t.Errorf("expected an error compiling an invalid pattern")
+	}
+}
@@ -1,6 +1,7 @@
 package google
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net"
 	"regexp"
@@ -142,6 +143,14 @@ func validateIpCidrRange(v interface{}, k string) (warnings []string, errors []e
 	return
 }
 
+func validateIpv4CidrRange(v interface{}, k string) (warnings []string, errors []error) {
+	ip, _, err := net.ParseCIDR(v.(string))
+	if err != nil || ip.To4() == nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid IPv4 CIDR range: %q", k, v))
+	}
+	return
+}
+
 func validateCloudIoTID(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 	if strings.HasPrefix(value, "goog") {
@@ -206,6 +215,27 @@ func validateDuration() schema.SchemaValidateFunc {
 	}
 }
 
+// validateCustomerEncryptionKey checks that a customer-supplied encryption key is
+// a base64-encoded 256-bit AES key, as required by the encryption headers.
+func validateCustomerEncryptionKey(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	key, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be base64 encoded: %s", k, err))
+		return
+	}
+	if len(key) != 32 {
+		es = append(es, fmt.Errorf("expected %s to decode to a 256-bit (32 byte) key, got %d bytes", k, len(key)))
+	}
+
+	return
+}
+
 // StringNotInSlice returns a SchemaValidateFunc which tests if the provided value
 // is of type string and that it matches none of the element in the invalid slice.
 // if ignorecase is true, case is ignored.
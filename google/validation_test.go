@@ -33,6 +33,26 @@ func TestValidateGCPName(t *testing.T) {
 	}
 }
 
+func TestValidateIpv4CidrRange(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "default route", Value: "0.0.0.0/0"},
+		{TestName: "private range", Value: "10.128.0.0/20"},
+		{TestName: "single address", Value: "192.168.0.1/32"},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "missing prefix", Value: "10.0.0.0", ExpectError: true},
+		{TestName: "prefix too long", Value: "10.0.0.0/33", ExpectError: true},
+		{TestName: "ipv6", Value: "2001:db8::/32", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateIpv4CidrRange)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate IPv4 CIDR ranges: %v", es)
+	}
+}
+
 func TestValidateRFC1918Network(t *testing.T) {
 	x := []RFC1918NetworkTestCase{
 		// No errors
@@ -317,3 +337,20 @@ func TestValidateProjectName(t *testing.T) {
 		t.Errorf("Failed to validate project ID's: %v", es)
 	}
 }
+
+func TestValidateCustomerEncryptionKey(t *testing.T) {
+	x := []StringValidationTestCase{
+		// No errors
+		{TestName: "32 bytes", Value: "qI6+xvCZE9jUm94nJWIulFc8rthN56mfsaaaaaaaaaa="},
+
+		// With errors
+		{TestName: "empty", Value: "", ExpectError: true},
+		{TestName: "not base64", Value: "not a base64 key!", ExpectError: true},
+		{TestName: "16 bytes", Value: "AAAAAAAAAAAAAAAAAAAAAA==", ExpectError: true},
+	}
+
+	es := testStringValidationCases(x, validateCustomerEncryptionKey)
+	if len(es) > 0 {
+		t.Errorf("Failed to validate customer encryption keys: %v", es)
+	}
+}